@@ -492,16 +492,6 @@ func NewBabylonApp(
 	)
 
 	// set up incentive keeper
-	app.IncentiveKeeper = incentivekeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(keys[incentivetypes.StoreKey]),
-		app.BankKeeper,
-		app.AccountKeeper,
-		&epochingKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		authtypes.FeeCollectorName,
-	)
-
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		legacyAmino,
@@ -612,6 +602,18 @@ func NewBabylonApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.IncentiveKeeper = incentivekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[incentivetypes.StoreKey]),
+		app.BankKeeper,
+		app.AccountKeeper,
+		&epochingKeeper,
+		&btclightclientKeeper,
+		&btcCheckpointKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		authtypes.FeeCollectorName,
+	)
+
 	// create querier for KVStore
 	storeQuerier, ok := app.CommitMultiStore().(storetypes.Queryable)
 	if !ok {
@@ -694,6 +696,7 @@ func NewBabylonApp(
 	app.FinalityKeeper = finalitykeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[finalitytypes.StoreKey]),
+		storeQuerier,
 		app.BTCStakingKeeper,
 		app.IncentiveKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -944,6 +947,7 @@ func NewBabylonApp(
 	if err != nil {
 		panic(err)
 	}
+	app.setupUpgradeHandlers()
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// BTCHeaderAccumulatorUpgradeName is the name of the upgrade that introduces
+// the BTC header accumulator. Its handler runs the in-place store migrations,
+// which backfill the accumulator with the BTC headers stored before the
+// upgrade, set the finality and BTC staking params introduced since the
+// previous version to their defaults, and backfill the indexes of BTC
+// delegations by staker address and delegator BTC PK
+const BTCHeaderAccumulatorUpgradeName = "btc-header-accumulator"
+
+// setupUpgradeHandlers registers the handlers of all software upgrades
+func (app *BabylonApp) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		BTCHeaderAccumulatorUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
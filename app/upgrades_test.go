@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
+)
+
+func TestBTCHeaderAccumulatorUpgrade(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false)
+	require.True(t, app.UpgradeKeeper.HasHandler(BTCHeaderAccumulatorUpgradeName))
+
+	// mimic the params of a chain before the upgrade, where the params
+	// introduced since then are unset
+	baselineFinalityParams := ftypes.Params{MinPubRand: ftypes.DefaultParams().MinPubRand}
+	finalityStore := ctx.KVStore(app.GetKey(ftypes.StoreKey))
+	finalityStore.Set(ftypes.ParamsKey, app.AppCodec().MustMarshal(&baselineFinalityParams))
+	require.Error(t, app.FinalityKeeper.GetParams(ctx).Validate())
+
+	baselineBTCStakingParams := app.BTCStakingKeeper.GetParams(ctx)
+	baselineBTCStakingParams.PreStakingInclusionTimeout = 0
+	baselineBTCStakingParams.MinCommissionChangeInterval = 0
+	baselineBTCStakingParams.CommissionChangeDelay = 0
+	require.NoError(t, app.BTCStakingKeeper.SetParams(ctx, baselineBTCStakingParams))
+
+	// mimic the module versions of a chain before the upgrade
+	fromVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	for _, moduleName := range []string{btclctypes.ModuleName, bstypes.ModuleName, ftypes.ModuleName} {
+		fromVM[moduleName] = 1
+	}
+	require.NoError(t, app.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM))
+
+	// apply the upgrade
+	err = app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{
+		Name:   BTCHeaderAccumulatorUpgradeName,
+		Height: ctx.HeaderInfo().Height,
+	})
+	require.NoError(t, err)
+
+	// the module versions are bumped
+	toVM, err := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, app.ModuleManager.GetVersionMap(), toVM)
+
+	// the unset params are set to their default values
+	finalityParams := app.FinalityKeeper.GetParams(ctx)
+	require.NoError(t, finalityParams.Validate())
+	defaultFinalityParams := ftypes.DefaultParams()
+	require.Equal(t, defaultFinalityParams.SignedBlocksWindow, finalityParams.SignedBlocksWindow)
+	require.Equal(t, defaultFinalityParams.FinalitySigTimeout, finalityParams.FinalitySigTimeout)
+	require.True(t, defaultFinalityParams.MinSignedPerWindow.Equal(finalityParams.MinSignedPerWindow))
+	require.Equal(t, defaultFinalityParams.JailDuration, finalityParams.JailDuration)
+	require.Zero(t, finalityParams.PruningRetentionBlocks)
+
+	btcStakingParams := app.BTCStakingKeeper.GetParams(ctx)
+	defaultBTCStakingParams := bstypes.DefaultParams()
+	require.Equal(t, defaultBTCStakingParams.PreStakingInclusionTimeout, btcStakingParams.PreStakingInclusionTimeout)
+	require.Equal(t, defaultBTCStakingParams.MinCommissionChangeInterval, btcStakingParams.MinCommissionChangeInterval)
+	require.Equal(t, defaultBTCStakingParams.CommissionChangeDelay, btcStakingParams.CommissionChangeDelay)
+
+	// the liveness of finality providers is tracked without panicking
+	require.NotPanics(t, func() { app.FinalityKeeper.HandleLiveness(ctx) })
+}
@@ -9,6 +9,23 @@ import (
 
 type BtcConfig struct {
 	Network string `mapstructure:"network"`
+
+	// Optional overrides of the parameters of the selected network. Empty
+	// values keep the parameters of the network
+	SignetChallenge          string `mapstructure:"signet-challenge"`
+	NetMagic                 string `mapstructure:"net-magic"`
+	GenesisHeader            string `mapstructure:"genesis-header"`
+	PowLimit                 string `mapstructure:"pow-limit"`
+	PowNoRetargeting         string `mapstructure:"pow-no-retargeting"`
+	TargetTimespan           string `mapstructure:"target-timespan"`
+	TargetTimePerBlock       string `mapstructure:"target-time-per-block"`
+	RetargetAdjustmentFactor string `mapstructure:"retarget-adjustment-factor"`
+	ReduceMinDifficulty      string `mapstructure:"reduce-min-difficulty"`
+	MinDiffReductionTime     string `mapstructure:"min-diff-reduction-time"`
+	Bech32HRP                string `mapstructure:"bech32-hrp"`
+	PubKeyHashAddrID         string `mapstructure:"pubkey-hash-addr-id"`
+	ScriptHashAddrID         string `mapstructure:"script-hash-addr-id"`
+	PrivateKeyID             string `mapstructure:"private-key-id"`
 }
 
 func defaultBabylonBtcConfig() BtcConfig {
@@ -44,5 +61,42 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+# The following optional settings override the parameters of the network
+# above, e.g. to run against a private signet or a customised regtest. Leave a
+# setting empty to keep the parameter of the network. The same settings must be
+# used by all nodes of the chain, as they affect consensus.
+
+# Hex-encoded signet challenge script. Only valid with network = "signet"
+signet-challenge = "{{ .BtcConfig.SignetChallenge }}"
+
+# Network magic, e.g. "0xdab5bffa". Required when changing the bech32 prefix
+# of a built-in network, so that the network can be registered
+net-magic = "{{ .BtcConfig.NetMagic }}"
+
+# Hex-encoded 80-byte genesis block header
+genesis-header = "{{ .BtcConfig.GenesisHeader }}"
+
+# Hex-encoded highest allowed proof of work target
+pow-limit = "{{ .BtcConfig.PowLimit }}"
+
+# Whether the difficulty never changes ("true" or "false")
+pow-no-retargeting = "{{ .BtcConfig.PowNoRetargeting }}"
+
+# Retarget settings, durations such as "336h" or "10m"
+target-timespan = "{{ .BtcConfig.TargetTimespan }}"
+target-time-per-block = "{{ .BtcConfig.TargetTimePerBlock }}"
+retarget-adjustment-factor = "{{ .BtcConfig.RetargetAdjustmentFactor }}"
+
+# Whether min difficulty blocks are allowed after min-diff-reduction-time
+reduce-min-difficulty = "{{ .BtcConfig.ReduceMinDifficulty }}"
+min-diff-reduction-time = "{{ .BtcConfig.MinDiffReductionTime }}"
+
+# Address prefixes: bech32 human-readable part of segwit and taproot addresses,
+# and version bytes of P2PKH addresses, P2SH addresses and WIF private keys
+bech32-hrp = "{{ .BtcConfig.Bech32HRP }}"
+pubkey-hash-addr-id = "{{ .BtcConfig.PubKeyHashAddrID }}"
+script-hash-addr-id = "{{ .BtcConfig.ScriptHashAddrID }}"
+private-key-id = "{{ .BtcConfig.PrivateKeyID }}"
 `
 }
@@ -90,6 +90,7 @@ Example:
 				return fmt.Errorf("failed to prepare genesis: %w", err)
 			}
 
+			mbm = withConfiguredBtcNet(mbm, clientCtx.Codec, serverCtx.Viper)
 			if err = mbm.ValidateGenesis(clientCtx.Codec, clientCtx.TxConfig, genesisState); err != nil {
 				return fmt.Errorf("error validating genesis file: %s", err)
 			}
@@ -7,12 +7,17 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/cobra"
 
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient"
+	btclightclienttypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
@@ -53,6 +58,7 @@ func ValidateGenesisCmd(mbm module.BasicManager, validator genutiltypes.MessageV
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
+			mbm = withConfiguredBtcNet(mbm, cdc, serverCtx.Viper)
 			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
@@ -67,6 +73,19 @@ func ValidateGenesisCmd(mbm module.BasicManager, validator genutiltypes.MessageV
 	}
 }
 
+// withConfiguredBtcNet returns a copy of the given module basic manager, in
+// which the genesis of the BTC light client module is validated against the
+// Bitcoin network configured in the app config rather than the Bitcoin mainnet
+func withConfiguredBtcNet(mbm module.BasicManager, cdc codec.Codec, appOpts servertypes.AppOptions) module.BasicManager {
+	btcConfig := bbn.ParseBtcOptionsFromConfig(appOpts)
+	configured := make(module.BasicManager, len(mbm))
+	for name, m := range mbm {
+		configured[name] = m
+	}
+	configured[btclightclienttypes.ModuleName] = btclightclient.NewAppModuleBasicWithBtcNet(cdc, btcConfig.NetParams())
+	return configured
+}
+
 // validateGenDoc reads a genesis file and validates that it is a correct
 // Tendermint GenesisDoc. This function does not do any cosmos-related
 // validation.
@@ -555,3 +555,37 @@ func GenerateMessageWithRandomSubmitterForEpoch(r *rand.Rand, epoch uint64) *btc
 
 	return msg
 }
+
+// GenRandomSubmissionKey generates a random submission key composed of two
+// transaction keys
+func GenRandomSubmissionKey(r *rand.Rand) *btcctypes.SubmissionKey {
+	keys := make([]*btcctypes.TransactionKey, 0, 2)
+	for i := 0; i < 2; i++ {
+		keys = append(keys, &btcctypes.TransactionKey{
+			Index: r.Uint32(),
+			Hash:  GenRandomBTCHeaderPrevBlock(r),
+		})
+	}
+	return &btcctypes.SubmissionKey{Key: keys}
+}
+
+// GenRandomSubmissionData generates random submission data of the given
+// submission key for the given epoch
+func GenRandomSubmissionData(r *rand.Rand, sk *btcctypes.SubmissionKey, epoch uint64) *btcctypes.SubmissionData {
+	txsInfo := make([]*btcctypes.TransactionInfo, 0, len(sk.Key))
+	for _, tk := range sk.Key {
+		txsInfo = append(txsInfo, btcctypes.NewTransactionInfo(
+			tk,
+			GenRandomByteArray(r, 100),
+			GenRandomByteArray(r, 32),
+		))
+	}
+	return &btcctypes.SubmissionData{
+		VigilanteAddresses: &btcctypes.CheckpointAddresses{
+			Submitter: GenRandomAccount().GetAddress(),
+			Reporter:  GenRandomAccount().GetAddress(),
+		},
+		TxsInfo: txsInfo,
+		Epoch:   epoch,
+	}
+}
@@ -23,12 +23,25 @@ import (
 )
 
 func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types.IncentiveKeeper) (*keeper.Keeper, sdk.Context) {
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
-
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
-	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	return FinalityKeeperWithStore(t, stateStore, bsKeeper, iKeeper)
+}
+
+// FinalityKeeperWithStore creates a finality keeper whose store is mounted to
+// the given multistore, where other modules' stores can be mounted as well
+func FinalityKeeperWithStore(
+	t testing.TB,
+	stateStore storetypes.CommitMultiStore,
+	bsKeeper types.BTCStakingKeeper,
+	iKeeper types.IncentiveKeeper,
+) (*keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
+	storeQuerier, ok := stateStore.(storetypes.Queryable)
+	require.True(t, ok)
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
@@ -36,6 +49,7 @@ func FinalityKeeper(t testing.TB, bsKeeper types.BTCStakingKeeper, iKeeper types
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
+		storeQuerier,
 		bsKeeper,
 		iKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -23,6 +23,17 @@ import (
 )
 
 func IncentiveKeeper(t testing.TB, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, epochingKeeper types.EpochingKeeper) (*keeper.Keeper, sdk.Context) {
+	return IncentiveKeeperWithBTCKeepers(t, bankKeeper, accountKeeper, epochingKeeper, nil, nil)
+}
+
+func IncentiveKeeperWithBTCKeepers(
+	t testing.TB,
+	bankKeeper types.BankKeeper,
+	accountKeeper types.AccountKeeper,
+	epochingKeeper types.EpochingKeeper,
+	btclcKeeper types.BTCLightClientKeeper,
+	btccKeeper types.BtcCheckpointKeeper,
+) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -39,6 +50,8 @@ func IncentiveKeeper(t testing.TB, bankKeeper types.BankKeeper, accountKeeper ty
 		bankKeeper,
 		accountKeeper,
 		epochingKeeper,
+		btclcKeeper,
+		btccKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
 	)
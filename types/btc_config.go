@@ -1,9 +1,16 @@
 package types
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"math/big"
+	"strconv"
+	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cast"
 )
@@ -22,8 +29,29 @@ const (
 	BtcSignet  SupportedBtcNetwork = "signet"
 )
 
+// Keys of the optional overrides of the network parameters in the
+// `btc-config` section of app.toml. An empty or missing value keeps the
+// parameter of the base network selected by `btc-config.network`
+const (
+	BtcConfigNetwork                  = "btc-config.network"
+	BtcConfigSignetChallenge          = "btc-config.signet-challenge"
+	BtcConfigNetMagic                 = "btc-config.net-magic"
+	BtcConfigGenesisHeader            = "btc-config.genesis-header"
+	BtcConfigPowLimit                 = "btc-config.pow-limit"
+	BtcConfigPowNoRetargeting         = "btc-config.pow-no-retargeting"
+	BtcConfigTargetTimespan           = "btc-config.target-timespan"
+	BtcConfigTargetTimePerBlock       = "btc-config.target-time-per-block"
+	BtcConfigRetargetAdjustmentFactor = "btc-config.retarget-adjustment-factor"
+	BtcConfigReduceMinDifficulty      = "btc-config.reduce-min-difficulty"
+	BtcConfigMinDiffReductionTime     = "btc-config.min-diff-reduction-time"
+	BtcConfigBech32HRP                = "btc-config.bech32-hrp"
+	BtcConfigPubKeyHashAddrID         = "btc-config.pubkey-hash-addr-id"
+	BtcConfigScriptHashAddrID         = "btc-config.script-hash-addr-id"
+	BtcConfigPrivateKeyID             = "btc-config.private-key-id"
+)
+
 func getParams(opts servertypes.AppOptions) *chaincfg.Params {
-	valueInterface := opts.Get("btc-config.network")
+	valueInterface := opts.Get(BtcConfigNetwork)
 
 	if valueInterface == nil {
 		panic("Bitcoin network should be provided in options")
@@ -50,9 +78,192 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 	}
 }
 
+// getOptionalString returns the value of the given option as a string, or an
+// empty string if the option is not set
+func getOptionalString(opts servertypes.AppOptions, key string) (string, error) {
+	valueInterface := opts.Get(key)
+	if valueInterface == nil {
+		return "", nil
+	}
+	value, err := cast.ToStringE(valueInterface)
+	if err != nil {
+		return "", fmt.Errorf("%s should be a valid string: %w", key, err)
+	}
+	return value, nil
+}
+
+// applyParamsOverrides returns a copy of the given base network parameters
+// with the overrides specified in the options applied. The base parameters
+// are returned untouched if no override is specified
+func applyParamsOverrides(base *chaincfg.Params, opts servertypes.AppOptions) (*chaincfg.Params, error) {
+	values := make(map[string]string)
+	for _, key := range []string{
+		BtcConfigSignetChallenge,
+		BtcConfigNetMagic,
+		BtcConfigGenesisHeader,
+		BtcConfigPowLimit,
+		BtcConfigPowNoRetargeting,
+		BtcConfigTargetTimespan,
+		BtcConfigTargetTimePerBlock,
+		BtcConfigRetargetAdjustmentFactor,
+		BtcConfigReduceMinDifficulty,
+		BtcConfigMinDiffReductionTime,
+		BtcConfigBech32HRP,
+		BtcConfigPubKeyHashAddrID,
+		BtcConfigScriptHashAddrID,
+		BtcConfigPrivateKeyID,
+	} {
+		value, err := getOptionalString(opts, key)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			values[key] = value
+		}
+	}
+	if len(values) == 0 {
+		return base, nil
+	}
+
+	// never modify the built-in parameters of btcd
+	params := *base
+
+	if challengeHex, ok := values[BtcConfigSignetChallenge]; ok {
+		if base.Net != chaincfg.SigNetParams.Net {
+			return nil, fmt.Errorf("%s can only be used with the signet network", BtcConfigSignetChallenge)
+		}
+		challenge, err := hex.DecodeString(challengeHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigSignetChallenge, err)
+		}
+		params = chaincfg.CustomSignetParams(challenge, nil)
+	}
+	if netMagic, ok := values[BtcConfigNetMagic]; ok {
+		magic, err := strconv.ParseUint(netMagic, 0, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigNetMagic, err)
+		}
+		params.Net = wire.BitcoinNet(magic)
+	}
+	if genesisHeader, ok := values[BtcConfigGenesisHeader]; ok {
+		headerBytes, err := NewBTCHeaderBytesFromHex(genesisHeader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigGenesisHeader, err)
+		}
+		header := headerBytes.ToBlockHeader()
+		genesisHash := header.BlockHash()
+		params.GenesisBlock = &wire.MsgBlock{Header: *header}
+		params.GenesisHash = &genesisHash
+		// checkpoints of the base network are meaningless with another genesis
+		params.Checkpoints = nil
+	}
+	if powLimitHex, ok := values[BtcConfigPowLimit]; ok {
+		powLimit, ok := new(big.Int).SetString(powLimitHex, 16)
+		if !ok || powLimit.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid %s: %s is not a positive hex number", BtcConfigPowLimit, powLimitHex)
+		}
+		params.PowLimit = powLimit
+		params.PowLimitBits = blockchain.BigToCompact(powLimit)
+	}
+	if noRetargeting, ok := values[BtcConfigPowNoRetargeting]; ok {
+		value, err := strconv.ParseBool(noRetargeting)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigPowNoRetargeting, err)
+		}
+		params.PoWNoRetargeting = value
+	}
+	for key, target := range map[string]*time.Duration{
+		BtcConfigTargetTimespan:       &params.TargetTimespan,
+		BtcConfigTargetTimePerBlock:   &params.TargetTimePerBlock,
+		BtcConfigMinDiffReductionTime: &params.MinDiffReductionTime,
+	} {
+		if value, ok := values[key]; ok {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			if duration <= 0 {
+				return nil, fmt.Errorf("invalid %s: duration must be positive", key)
+			}
+			*target = duration
+		}
+	}
+	if factor, ok := values[BtcConfigRetargetAdjustmentFactor]; ok {
+		value, err := strconv.ParseInt(factor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigRetargetAdjustmentFactor, err)
+		}
+		if value <= 0 {
+			return nil, fmt.Errorf("invalid %s: factor must be positive", BtcConfigRetargetAdjustmentFactor)
+		}
+		params.RetargetAdjustmentFactor = value
+	}
+	if reduceMinDifficulty, ok := values[BtcConfigReduceMinDifficulty]; ok {
+		value, err := strconv.ParseBool(reduceMinDifficulty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", BtcConfigReduceMinDifficulty, err)
+		}
+		params.ReduceMinDifficulty = value
+	}
+	if hrp, ok := values[BtcConfigBech32HRP]; ok {
+		params.Bech32HRPSegwit = hrp
+	}
+	for key, target := range map[string]*byte{
+		BtcConfigPubKeyHashAddrID: &params.PubKeyHashAddrID,
+		BtcConfigScriptHashAddrID: &params.ScriptHashAddrID,
+		BtcConfigPrivateKeyID:     &params.PrivateKeyID,
+	} {
+		if value, ok := values[key]; ok {
+			id, err := strconv.ParseUint(value, 0, 8)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", key, err)
+			}
+			*target = byte(id)
+		}
+	}
+
+	if params.TargetTimespan < params.TargetTimePerBlock {
+		return nil, fmt.Errorf("target timespan %s must not be shorter than target time per block %s",
+			params.TargetTimespan, params.TargetTimePerBlock)
+	}
+
+	if err := registerParams(&params); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// registerParams registers the custom network parameters in btcd, so that
+// addresses with the custom prefixes can be decoded by btcutil. Registering
+// a network with the same magic again (e.g. when the config is parsed several
+// times in one process) is fine as long as its bech32 prefix is known
+func registerParams(params *chaincfg.Params) error {
+	err := chaincfg.Register(params)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, chaincfg.ErrDuplicateNet) {
+		return err
+	}
+	if !chaincfg.IsBech32SegwitPrefix(params.Bech32HRPSegwit + "1") {
+		return fmt.Errorf(
+			"network magic %d is already registered with another bech32 prefix, set %s to a unique value",
+			uint32(params.Net), BtcConfigNetMagic,
+		)
+	}
+	return nil
+}
+
+// ParseBtcOptionsFromConfig parses the Bitcoin network parameters from the
+// `btc-config` section of the app config. It panics if the config is invalid
 func ParseBtcOptionsFromConfig(opts servertypes.AppOptions) BtcConfig {
+	params, err := applyParamsOverrides(getParams(opts), opts)
+	if err != nil {
+		panic(fmt.Sprintf("invalid Bitcoin network config: %v", err))
+	}
 	return BtcConfig{
-		btcNetParams: getParams(opts),
+		btcNetParams: params,
 	}
 }
 
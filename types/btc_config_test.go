@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	simsutils "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bip322"
+	"github.com/babylonchain/babylon/types"
+)
+
+func TestParseBtcOptionsFromConfig(t *testing.T) {
+	t.Run("built-in network is used as is", func(t *testing.T) {
+		opts := simsutils.AppOptionsMap{types.BtcConfigNetwork: string(types.BtcSimnet)}
+		cfg := types.ParseBtcOptionsFromConfig(opts)
+		require.Equal(t, &chaincfg.SimNetParams, cfg.NetParams())
+	})
+
+	t.Run("custom signet challenge", func(t *testing.T) {
+		// OP_1 OP_TRUE challenge, accepting any block
+		opts := simsutils.AppOptionsMap{
+			types.BtcConfigNetwork:         string(types.BtcSignet),
+			types.BtcConfigSignetChallenge: "5151",
+		}
+		defaultSignetNet := chaincfg.SigNetParams.Net
+		cfg := types.ParseBtcOptionsFromConfig(opts)
+		params := cfg.NetParams()
+		require.NotEqual(t, defaultSignetNet, params.Net)
+		require.Equal(t, chaincfg.SigNetParams.Bech32HRPSegwit, params.Bech32HRPSegwit)
+		// the built-in signet params are untouched
+		require.Equal(t, defaultSignetNet, chaincfg.SigNetParams.Net)
+	})
+
+	t.Run("customised regtest", func(t *testing.T) {
+		genesisHeader := chaincfg.SimNetParams.GenesisBlock.Header
+		genesisHeaderBytes := types.NewBTCHeaderBytesFromBlockHeader(&genesisHeader)
+		opts := simsutils.AppOptionsMap{
+			types.BtcConfigNetwork:                  string(types.BtcRegtest),
+			types.BtcConfigNetMagic:                 "0xb0b1b2b3",
+			types.BtcConfigGenesisHeader:            genesisHeaderBytes.MarshalHex(),
+			types.BtcConfigPowLimit:                 "00ffff0000000000000000000000000000000000000000000000000000000000",
+			types.BtcConfigPowNoRetargeting:         "false",
+			types.BtcConfigTargetTimespan:           "1h",
+			types.BtcConfigTargetTimePerBlock:       "1m",
+			types.BtcConfigRetargetAdjustmentFactor: 2,
+			types.BtcConfigReduceMinDifficulty:      false,
+			types.BtcConfigBech32HRP:                "bbnrt",
+			types.BtcConfigPubKeyHashAddrID:         "0x10",
+			types.BtcConfigScriptHashAddrID:         "0x11",
+		}
+		cfg := types.ParseBtcOptionsFromConfig(opts)
+		params := cfg.NetParams()
+		require.Equal(t, uint32(0xb0b1b2b3), uint32(params.Net))
+		require.Equal(t, genesisHeader.BlockHash(), *params.GenesisHash)
+		require.Equal(t, uint32(0x2000ffff), params.PowLimitBits)
+		require.False(t, params.PoWNoRetargeting)
+		require.Equal(t, time.Hour, params.TargetTimespan)
+		require.Equal(t, time.Minute, params.TargetTimePerBlock)
+		require.Equal(t, int64(2), cfg.RetargetAdjustmentFactor())
+		require.False(t, cfg.ReduceMinDifficulty())
+		require.Equal(t, byte(0x10), params.PubKeyHashAddrID)
+		require.Equal(t, byte(0x11), params.ScriptHashAddrID)
+		// the built-in regtest params are untouched
+		require.Equal(t, "bcrt", chaincfg.RegressionNetParams.Bech32HRPSegwit)
+		require.True(t, chaincfg.RegressionNetParams.PoWNoRetargeting)
+
+		// addresses with the custom prefixes are decodable
+		sk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		addr, err := bip322.PubKeyToP2TrSpendAddress(sk.PubKey(), params)
+		require.NoError(t, err)
+		require.Contains(t, addr.EncodeAddress(), "bbnrt1")
+		decoded, err := btcutil.DecodeAddress(addr.EncodeAddress(), params)
+		require.NoError(t, err)
+		require.True(t, decoded.IsForNet(params))
+
+		// parsing the same config again is fine
+		cfg2 := types.ParseBtcOptionsFromConfig(opts)
+		require.Equal(t, params.Net, cfg2.NetParams().Net)
+	})
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		for _, opts := range []simsutils.AppOptionsMap{
+			// signet challenge on another network
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigSignetChallenge: "51"},
+			// custom bech32 prefix with the magic of a built-in network
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigBech32HRP: "bbnx"},
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigGenesisHeader: "00"},
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigPowLimit: "xyz"},
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigTargetTimespan: "-1h"},
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigRetargetAdjustmentFactor: "0"},
+			{types.BtcConfigNetwork: string(types.BtcRegtest), types.BtcConfigPubKeyHashAddrID: "256"},
+		} {
+			require.Panics(t, func() { types.ParseBtcOptionsFromConfig(opts) })
+		}
+	})
+}
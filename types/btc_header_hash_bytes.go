@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -129,6 +130,10 @@ func (m *BTCHeaderHashBytes) Eq(hash *BTCHeaderHashBytes) bool {
 	return m.String() == hash.String()
 }
 
+func (m BTCHeaderHashBytes) Equal(other BTCHeaderHashBytes) bool {
+	return bytes.Equal(m, other)
+}
+
 func toChainhash(data []byte) (*chainhash.Hash, error) {
 	return chainhash.NewHash(data)
 }
@@ -1,12 +1,14 @@
 package btccheckpoint_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/babylonchain/babylon/x/btccheckpoint"
 	"github.com/stretchr/testify/require"
 
 	simapp "github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 )
 
@@ -38,3 +40,68 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, app.BtcCheckpointKeeper.GetParams(ctx).BtcConfirmationDepth, uint64(888))
 	require.Equal(t, app.BtcCheckpointKeeper.GetParams(ctx).CheckpointFinalizationTimeout, uint64(999))
 }
+
+func TestExportImportGenesis(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false)
+
+	// epochs 1..3 are finalized with a single submission, epoch 4 is
+	// confirmed and epoch 5 has several competing submissions
+	gs := types.DefaultGenesis()
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		numSubmissions := 1
+		status := types.Finalized
+		switch epoch {
+		case 4:
+			status = types.Confirmed
+		case 5:
+			numSubmissions = 3
+			status = types.Submitted
+		}
+
+		ed := types.NewEmptyEpochData()
+		ed.Status = status
+		for i := 0; i < numSubmissions; i++ {
+			sk := datagen.GenRandomSubmissionKey(r)
+			ed.AppendKey(*sk)
+			gs.Submissions = append(gs.Submissions, &types.SubmissionEntry{
+				SubmissionKey: sk,
+				Data:          datagen.GenRandomSubmissionData(r, sk, epoch),
+			})
+		}
+		gs.Epochs = append(gs.Epochs, &types.EpochEntry{EpochNumber: epoch, Data: &ed})
+	}
+	gs.LastFinalizedEpochNumber = 3
+	require.NoError(t, gs.Validate())
+
+	btccheckpoint.InitGenesis(ctx, app.BtcCheckpointKeeper, *gs)
+
+	// the populated keeper answers queries on the imported state
+	for _, entry := range gs.Epochs {
+		require.Equal(t, entry.Data, app.BtcCheckpointKeeper.GetEpochData(ctx, entry.EpochNumber))
+	}
+	for _, entry := range gs.Submissions {
+		require.Equal(t, entry.Data, app.BtcCheckpointKeeper.GetSubmissionData(ctx, *entry.SubmissionKey))
+	}
+
+	exported := btccheckpoint.ExportGenesis(ctx, app.BtcCheckpointKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, gs.Params, exported.Params)
+	require.Equal(t, gs.Epochs, exported.Epochs)
+	require.ElementsMatch(t, gs.Submissions, exported.Submissions)
+	require.Equal(t, gs.LastFinalizedEpochNumber, exported.LastFinalizedEpochNumber)
+
+	// re-import the exported state into a fresh app and export it again
+	newApp := simapp.Setup(t, false)
+	newCtx := newApp.BaseApp.NewContext(false)
+	btccheckpoint.InitGenesis(newCtx, newApp.BtcCheckpointKeeper, *exported)
+
+	reExported := btccheckpoint.ExportGenesis(newCtx, newApp.BtcCheckpointKeeper)
+	require.Equal(t, exported, reExported)
+
+	bestStatus, bestSk, err := newApp.BtcCheckpointKeeper.GetBestSubmission(newCtx, 3)
+	require.NoError(t, err)
+	require.Equal(t, types.Finalized, bestStatus)
+	require.Equal(t, gs.Epochs[2].Data.Keys[0], bestSk)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/btccheckpoint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	for _, entry := range gs.Epochs {
+		k.saveEpochData(ctx, entry.EpochNumber, entry.Data)
+	}
+
+	for _, entry := range gs.Submissions {
+		k.saveSubmission(ctx, *entry.SubmissionKey, *entry.Data)
+	}
+
+	if gs.LastFinalizedEpochNumber > 0 {
+		k.setLastFinalizedEpochNumber(ctx, gs.LastFinalizedEpochNumber)
+	}
+
+	return k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochs, err := k.epochEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := k.submissionEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:                   k.GetParams(ctx),
+		Epochs:                   epochs,
+		Submissions:              submissions,
+		LastFinalizedEpochNumber: k.getLastFinalizedEpochNumber(ctx),
+	}, nil
+}
+
+// epochEntries loads the data of all epochs stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) epochEntries(ctx context.Context) ([]*types.EpochEntry, error) {
+	entries := make([]*types.EpochEntry, 0)
+
+	iter := k.epochDataStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var ed types.EpochData
+		if err := k.cdc.Unmarshal(iter.Value(), &ed); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.EpochEntry{
+			EpochNumber: sdk.BigEndianToUint64(iter.Key()),
+			Data:        &ed,
+		})
+	}
+
+	return entries, nil
+}
+
+// submissionEntries loads all submissions stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) submissionEntries(ctx context.Context) ([]*types.SubmissionEntry, error) {
+	entries := make([]*types.SubmissionEntry, 0)
+
+	iter := k.submissionStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sk types.SubmissionKey
+		if err := k.cdc.Unmarshal(iter.Key(), &sk); err != nil {
+			return nil, err
+		}
+		var sd types.SubmissionData
+		if err := k.cdc.Unmarshal(iter.Value(), &sd); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.SubmissionEntry{
+			SubmissionKey: &sk,
+			Data:          &sd,
+		})
+	}
+
+	return entries, nil
+}
+
+func (k Keeper) submissionStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SubmisionKeyPrefix)
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"fmt"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,6 +14,91 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// submission key bytes -> epoch in which the submission is referenced
+	referenced := make(map[string]uint64)
+	epochs := make(map[uint64]*EpochData)
+	for _, entry := range gs.Epochs {
+		if entry.Data == nil {
+			return fmt.Errorf("epoch %d has nil epoch data", entry.EpochNumber)
+		}
+		if _, ok := epochs[entry.EpochNumber]; ok {
+			return fmt.Errorf("duplicated epoch data for epoch %d", entry.EpochNumber)
+		}
+		epochs[entry.EpochNumber] = entry.Data
+
+		if entry.Data.Status == Finalized && len(entry.Data.Keys) != 1 {
+			return fmt.Errorf("finalized epoch %d must have exactly one submission, got %d", entry.EpochNumber, len(entry.Data.Keys))
+		}
+		for _, sk := range entry.Data.Keys {
+			skBytes, err := validateSubmissionKey(sk)
+			if err != nil {
+				return fmt.Errorf("invalid submission key in epoch %d: %w", entry.EpochNumber, err)
+			}
+			if _, ok := referenced[skBytes]; ok {
+				return fmt.Errorf("submission key referenced more than once in epoch data")
+			}
+			referenced[skBytes] = entry.EpochNumber
+		}
+	}
+
+	submissions := make(map[string]struct{})
+	for _, entry := range gs.Submissions {
+		if entry.Data == nil {
+			return fmt.Errorf("submission has nil submission data")
+		}
+		skBytes, err := validateSubmissionKey(entry.SubmissionKey)
+		if err != nil {
+			return fmt.Errorf("invalid submission key: %w", err)
+		}
+		if _, ok := submissions[skBytes]; ok {
+			return fmt.Errorf("duplicated submission for epoch %d", entry.Data.Epoch)
+		}
+		submissions[skBytes] = struct{}{}
+
+		epoch, ok := referenced[skBytes]
+		if !ok {
+			return fmt.Errorf("submission for epoch %d is not referenced by any epoch data", entry.Data.Epoch)
+		}
+		if epoch != entry.Data.Epoch {
+			return fmt.Errorf("submission for epoch %d is referenced by epoch %d", entry.Data.Epoch, epoch)
+		}
+	}
+
+	if len(submissions) != len(referenced) {
+		return fmt.Errorf("epoch data references %d submissions, but %d submissions are provided", len(referenced), len(submissions))
+	}
+
+	if gs.LastFinalizedEpochNumber > 0 {
+		ed, ok := epochs[gs.LastFinalizedEpochNumber]
+		if !ok || ed.Status != Finalized {
+			return fmt.Errorf("last finalized epoch %d is not a finalized epoch", gs.LastFinalizedEpochNumber)
+		}
+	}
+
+	return nil
+}
 
-	return gs.Params.Validate()
+// validateSubmissionKey checks the submission key is well-formed and returns
+// its serialised form so that it can be used as map key
+func validateSubmissionKey(sk *SubmissionKey) (string, error) {
+	if sk == nil {
+		return "", fmt.Errorf("submission key is nil")
+	}
+	if len(sk.Key) < 2 {
+		return "", fmt.Errorf("submission key must be composed of at least 2 transaction keys, got %d", len(sk.Key))
+	}
+	for _, tk := range sk.Key {
+		if tk == nil || tk.Hash == nil {
+			return "", fmt.Errorf("submission key has empty transaction key")
+		}
+	}
+	skBytes, err := sk.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return string(skBytes), nil
 }
@@ -1,37 +1,140 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	// genState returns a genesis state with one finalized epoch and one
+	// submitted epoch with two submissions
+	genState := func() *types.GenesisState {
+		gs := types.DefaultGenesis()
+		for epoch := uint64(1); epoch <= 2; epoch++ {
+			ed := types.NewEmptyEpochData()
+			numSubmissions := 2
+			if epoch == 1 {
+				ed.Status = types.Finalized
+				numSubmissions = 1
+			}
+			for i := 0; i < numSubmissions; i++ {
+				sk := datagen.GenRandomSubmissionKey(r)
+				ed.AppendKey(*sk)
+				gs.Submissions = append(gs.Submissions, &types.SubmissionEntry{
+					SubmissionKey: sk,
+					Data:          datagen.GenRandomSubmissionData(r, sk, epoch),
+				})
+			}
+			gs.Epochs = append(gs.Epochs, &types.EpochEntry{EpochNumber: epoch, Data: &ed})
+		}
+		gs.LastFinalizedEpochNumber = 1
+		return gs
+	}
+
 	for _, tc := range []struct {
 		desc     string
-		genState *types.GenesisState
+		genState func() *types.GenesisState
 		valid    bool
 	}{
 		{
 			desc:     "default is valid",
-			genState: types.DefaultGenesis(),
+			genState: types.DefaultGenesis,
 			valid:    true,
 		},
 		{
 			desc: "valid genesis state",
-			genState: &types.GenesisState{
-				Params: types.Params{
-					BtcConfirmationDepth:          124,
-					CheckpointFinalizationTimeout: 12222,
-					CheckpointTag:                 types.DefaultCheckpointTag,
-				},
+			genState: func() *types.GenesisState {
+				return &types.GenesisState{
+					Params: types.Params{
+						BtcConfirmationDepth:          124,
+						CheckpointFinalizationTimeout: 12222,
+						CheckpointTag:                 types.DefaultCheckpointTag,
+					},
+				}
 			},
 			valid: true,
 		},
+		{
+			desc:     "valid genesis state with submissions",
+			genState: genState,
+			valid:    true,
+		},
+		{
+			desc: "duplicated epoch",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Epochs[1].EpochNumber = 1
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "finalized epoch with several submissions",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Epochs[1].Data.Status = types.Finalized
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "submission key with a single transaction key",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Epochs[0].Data.Keys[0].Key = gs.Epochs[0].Data.Keys[0].Key[:1]
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "missing submission data",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Submissions = gs.Submissions[1:]
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "submission not referenced by epoch data",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				sk := datagen.GenRandomSubmissionKey(r)
+				gs.Submissions = append(gs.Submissions, &types.SubmissionEntry{
+					SubmissionKey: sk,
+					Data:          datagen.GenRandomSubmissionData(r, sk, 2),
+				})
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "submission data with wrong epoch",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Submissions[0].Data.Epoch = 2
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "last finalized epoch is not finalized",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.LastFinalizedEpochNumber = 2
+				return gs
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := tc.genState.Validate()
+			err := tc.genState().Validate()
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
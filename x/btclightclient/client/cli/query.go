@@ -10,6 +10,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagBabylonHeight = "babylon-height"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(_ string) *cobra.Command {
 	// Group btclightclient queries under a subcommand
@@ -27,6 +31,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdTip())
 	cmd.AddCommand(CmdBaseHeader())
 	cmd.AddCommand(CmdHeaderDepth())
+	cmd.AddCommand(CmdDeepReorgAlarm())
+	cmd.AddCommand(CmdHeaderInclusionProof())
 
 	return cmd
 }
@@ -198,3 +204,60 @@ func CmdHeaderDepth() *cobra.Command {
 
 	return cmd
 }
+
+func CmdDeepReorgAlarm() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deep-reorg-alarm",
+		Short: "retrieve the pending deep reorg alarm, if any",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeepReorgAlarm(context.Background(), &types.QueryDeepReorgAlarmRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHeaderInclusionProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inclusion-proof [hex-hash]",
+		Short: "retrieve the proof that the main chain header with the given hash is included in the header accumulator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			babylonHeight, err := cmd.Flags().GetUint64(flagBabylonHeight)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HeaderInclusionProof(context.Background(), &types.QueryHeaderInclusionProofRequest{
+				Hash:          args[0],
+				BabylonHeight: babylonHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagBabylonHeight, 0, "Babylon height of the accumulator root to prove against (0 for the current root)")
+
+	return cmd
+}
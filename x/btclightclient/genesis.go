@@ -3,14 +3,16 @@ package btclightclient
 import (
 	"context"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, gs types.GenesisState) {
-	if err := gs.Validate(); err != nil {
+	if err := gs.ValidateWithBtcNet(k.GetBTCNet()); err != nil {
 		panic(err)
 	}
 
@@ -19,12 +21,63 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs types.GenesisState) {
 	}
 
 	k.InsertHeaderInfos(ctx, gs.BtcHeaders)
+
+	if err := k.ValidateTrustedCheckpoints(ctx, gs.Params.TrustedCheckpoints); err != nil {
+		panic(err)
+	}
+
+	for _, entry := range gs.HeaderReporters {
+		k.SetHeaderReporter(ctx, entry.Hash, sdk.MustAccAddressFromBech32(entry.Reporter))
+	}
+
+	if gs.DeepReorgAlarm != nil {
+		k.SetDeepReorgAlarm(ctx, gs.DeepReorgAlarm)
+	}
+
+	for i := range gs.RejectedForks {
+		k.SetRejectedFork(ctx, &gs.RejectedForks[i])
+	}
+
+	for _, root := range gs.AccumulatorRoots {
+		k.SetAccumulatorRoot(ctx, root)
+	}
+	if len(gs.AccumulatorRoots) == 0 {
+		// the accumulator over the genesis headers is the first root
+		root, err := k.GetCurrentAccumulatorRoot(ctx)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAccumulatorRoot(ctx, root)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
+	headers := k.GetMainChainFrom(ctx, 0)
+
+	headerReporters := make([]*types.HeaderReporterEntry, 0)
+	for _, header := range headers {
+		reporter := k.GetHeaderReporter(ctx, header.Hash)
+		if reporter == nil {
+			continue
+		}
+		headerReporters = append(headerReporters, &types.HeaderReporterEntry{
+			Hash:     header.Hash,
+			Reporter: reporter.String(),
+		})
+	}
+
+	rejectedForks := make([]bbn.BTCHeaderHashBytes, 0)
+	for _, hash := range k.GetRejectedForks(ctx) {
+		rejectedForks = append(rejectedForks, *hash)
+	}
+
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		BtcHeaders: k.GetMainChainFrom(ctx, 0),
+		Params:           k.GetParams(ctx),
+		BtcHeaders:       headers,
+		HeaderReporters:  headerReporters,
+		DeepReorgAlarm:   k.GetDeepReorgAlarm(ctx),
+		AccumulatorRoots: k.GetAccumulatorRoots(ctx),
+		RejectedForks:    rejectedForks,
 	}
 }
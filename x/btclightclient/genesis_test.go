@@ -12,9 +12,12 @@ import (
 	"github.com/babylonchain/babylon/x/btclightclient"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -46,12 +49,17 @@ func TestImportExport(t *testing.T) {
 		// only sender1 and sender2 are allowed to update
 		[]string{address1.String(), address2.String()},
 	)
+	params.MaxRollbackDepth = 5
 
 	k, ctx, stServ := keepertest.BTCLightClientKeeperWithCustomParams(t, params)
 	srv := keeper.NewMsgServerImpl(*k)
 
 	_, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, k, ctx, 0, 10)
 	initTip := chain.GetTipInfo()
+	params.TrustedCheckpoints = []types.TrustedCheckpoint{
+		{Height: initTip.Height, Hash: initTip.Hash},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
 
 	chainExtension := datagen.GenRandomValidChainStartingFrom(
 		r,
@@ -81,6 +89,23 @@ func TestImportExport(t *testing.T) {
 	_, err = srv.InsertHeaders(ctx, msg1)
 	require.NoError(t, err)
 
+	// a fork rolling back 10 headers raises a deep reorg alarm
+	forkChain := datagen.GenRandomValidChainStartingFrom(
+		r,
+		newTip.Height,
+		newTip.Header.ToBlockHeader(),
+		nil,
+		20,
+	)
+	msg2 := &types.MsgInsertHeaders{Signer: address1.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+	_, err = srv.InsertHeaders(ctx, msg2)
+	require.NoError(t, err)
+	require.NotNil(t, k.GetDeepReorgAlarm(ctx))
+
+	// a fork rejected by governance earlier
+	rejectedFork := datagen.GenRandomBTCHeaderInfo(r)
+	k.SetRejectedFork(ctx, rejectedFork.Hash)
+
 	genState := btclightclient.ExportGenesis(ctx, *k)
 	KvA := stServ.OpenKVStore(ctx)
 
@@ -89,6 +114,8 @@ func TestImportExport(t *testing.T) {
 
 	infos := kB.GetMainChainFrom(ctxb, 0)
 	require.Equal(t, len(infos), len(genState.BtcHeaders), "it should have the same amount of headers from before")
+	require.Equal(t, k.GetDeepReorgAlarm(ctx), kB.GetDeepReorgAlarm(ctxb))
+	require.True(t, kB.IsForkRejected(ctxb, rejectedFork.Hash))
 
 	KvB := stServB.OpenKVStore(ctxb)
 
@@ -96,3 +123,26 @@ func TestImportExport(t *testing.T) {
 	require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare btcligthclient")
 	require.Equal(t, len(failedKVAs), 0, "should not exist any difference froms states.")
 }
+
+func TestValidateGenesisWithBtcNet(t *testing.T) {
+	// a network that retargets every 60 blocks
+	btcNet := chaincfg.SimNetParams
+	btcNet.TargetTimespan = time.Hour
+	btcNet.TargetTimePerBlock = time.Minute
+
+	baseHeaderInfo := types.SimnetGenesisBlock()
+	baseHeaderInfo.Height = 60
+	genesisState := types.DefaultGenesis()
+	genesisState.BtcHeaders = []*types.BTCHeaderInfo{&baseHeaderInfo}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(genesisState)
+	require.NoError(t, err)
+
+	// the base header is not a retarget block of the Bitcoin mainnet
+	err = btclightclient.NewAppModuleBasic(cdc).ValidateGenesis(cdc, nil, bz)
+	require.Error(t, err)
+
+	// the base header is a retarget block of the given network
+	err = btclightclient.NewAppModuleBasicWithBtcNet(cdc, &btcNet).ValidateGenesis(cdc, nil, bz)
+	require.NoError(t, err)
+}
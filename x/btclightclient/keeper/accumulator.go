@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccumulatorLeafCount returns the number of headers in the accumulator
+func (s headersState) AccumulatorLeafCount() uint64 {
+	bz := s.store.Get(types.AccumulatorLeafCountKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (s headersState) setAccumulatorLeafCount(leafCount uint64) {
+	s.store.Set(types.AccumulatorLeafCountKey, sdk.Uint64ToBigEndian(leafCount))
+}
+
+func (s headersState) getAccumulatorNode(pos uint64) ([]byte, error) {
+	node := s.accumulator.Get(types.AccumulatorNodeKey(pos))
+	if node == nil {
+		return nil, types.ErrAccumulatorNodeNotFound.Wrapf("no accumulator node at position %d", pos)
+	}
+	return node, nil
+}
+
+// accumulatorCoversMainChain returns true if the accumulator has exactly one
+// leaf per main chain header since the base header, i.e., the leaf of a header
+// is at index header.Height - BaseHeader().Height
+func (s headersState) accumulatorCoversMainChain() bool {
+	if !s.TipExists() {
+		return s.AccumulatorLeafCount() == 0
+	}
+	return s.AccumulatorLeafCount() == s.GetTip().Height-s.BaseHeader().Height+1
+}
+
+// appendAccumulatorLeaf appends the leaf of the given header to the
+// accumulator, together with the parents of all mountains it completes
+func (s headersState) appendAccumulatorLeaf(h *types.BTCHeaderInfo) {
+	leafIndex := s.AccumulatorLeafCount()
+	pos := types.MMRSize(leafIndex)
+	node := types.AccumulatorLeaf(h.Height, h.Hash)
+	s.accumulator.Set(types.AccumulatorNodeKey(pos), node)
+
+	// merge the mountains of equal height, i.e., one per trailing 1 bit of
+	// the leaf index
+	for height := 0; leafIndex&(uint64(1)<<height) != 0; height++ {
+		left, err := s.getAccumulatorNode(pos + 1 - uint64(1)<<(height+1))
+		if err != nil {
+			// the accumulator is only ever extended by this function, so the
+			// left sibling always exists
+			panic(err)
+		}
+		node = types.AccumulatorNode(left, node)
+		pos++
+		s.accumulator.Set(types.AccumulatorNodeKey(pos), node)
+	}
+
+	s.setAccumulatorLeafCount(leafIndex + 1)
+}
+
+// truncateAccumulator removes all but the first leafCount leaves from the
+// accumulator
+func (s headersState) truncateAccumulator(leafCount uint64) {
+	iter := s.accumulator.Iterator(types.AccumulatorNodeKey(types.MMRSize(leafCount)), nil)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		s.accumulator.Delete(key)
+	}
+
+	s.setAccumulatorLeafCount(leafCount)
+}
+
+// accumulatorRoot returns the root of the accumulator over its first
+// leafCount leaves
+func (s headersState) accumulatorRoot(leafCount uint64) ([]byte, error) {
+	peakPositions := types.MMRPeakPositions(leafCount)
+	peaks := make([][]byte, len(peakPositions))
+	for i, pos := range peakPositions {
+		peak, err := s.getAccumulatorNode(pos)
+		if err != nil {
+			return nil, err
+		}
+		peaks[i] = peak
+	}
+	return types.AccumulatorRootFromPeaks(leafCount, peaks), nil
+}
+
+// accumulatorProof returns the inclusion proof of the given leaf against the
+// root of the accumulator over its first leafCount leaves
+func (s headersState) accumulatorProof(leafIndex, leafCount uint64) (*types.HeaderInclusionProof, error) {
+	siblingPositions, peakPositions, err := types.MMRProofPositions(leafIndex, leafCount)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &types.HeaderInclusionProof{
+		LeafIndex: leafIndex,
+		LeafCount: leafCount,
+		Siblings:  make([][]byte, len(siblingPositions)),
+		Peaks:     make([][]byte, len(peakPositions)),
+	}
+	for i, pos := range siblingPositions {
+		if proof.Siblings[i], err = s.getAccumulatorNode(pos); err != nil {
+			return nil, err
+		}
+	}
+	for i, pos := range peakPositions {
+		if proof.Peaks[i], err = s.getAccumulatorNode(pos); err != nil {
+			return nil, err
+		}
+	}
+	return proof, nil
+}
+
+func (k Keeper) accumulatorRootsStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.AccumulatorRootsPrefix)
+}
+
+// SetAccumulatorRoot records the given accumulator root
+func (k Keeper) SetAccumulatorRoot(ctx context.Context, root *types.AccumulatorRoot) {
+	store := k.accumulatorRootsStore(ctx)
+	store.Set(types.AccumulatorRootKey(root.BabylonHeight), k.cdc.MustMarshal(root))
+}
+
+// recordAccumulatorRoot records the current accumulator root at the current
+// Babylon height
+func (k Keeper) recordAccumulatorRoot(ctx context.Context) {
+	root, err := k.GetCurrentAccumulatorRoot(ctx)
+	if err != nil {
+		// the peaks of the accumulator are maintained upon every insertion
+		// and rollback, so this is a programming error
+		panic(err)
+	}
+	k.SetAccumulatorRoot(ctx, root)
+}
+
+// GetCurrentAccumulatorRoot returns the current root of the accumulator
+func (k Keeper) GetCurrentAccumulatorRoot(ctx context.Context) (*types.AccumulatorRoot, error) {
+	headerState := k.headersState(ctx)
+	leafCount := headerState.AccumulatorLeafCount()
+	root, err := headerState.accumulatorRoot(leafCount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccumulatorRoot{
+		BabylonHeight: uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height),
+		LeafCount:     leafCount,
+		Root:          root,
+	}, nil
+}
+
+// GetAccumulatorRoot returns the accumulator root at the end of the given
+// Babylon height, i.e., the last root recorded at or before it, or nil if
+// there is no such root
+func (k Keeper) GetAccumulatorRoot(ctx context.Context, babylonHeight uint64) *types.AccumulatorRoot {
+	store := k.accumulatorRootsStore(ctx)
+	iter := store.ReverseIterator(nil, types.AccumulatorRootKey(babylonHeight+1))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+	var root types.AccumulatorRoot
+	k.cdc.MustUnmarshal(iter.Value(), &root)
+	return &root
+}
+
+// GetAccumulatorRoots returns all recorded accumulator roots
+func (k Keeper) GetAccumulatorRoots(ctx context.Context) []*types.AccumulatorRoot {
+	store := k.accumulatorRootsStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	roots := make([]*types.AccumulatorRoot, 0)
+	for ; iter.Valid(); iter.Next() {
+		var root types.AccumulatorRoot
+		k.cdc.MustUnmarshal(iter.Value(), &root)
+		roots = append(roots, &root)
+	}
+	return roots
+}
+
+// BackfillAccumulator rebuilds the accumulator from the main chain headers
+// since the base header, and records its root at the current Babylon height.
+// Roots recorded before are removed, as they might not commit to the main
+// chain. It is a no-op if the accumulator already covers the main chain
+func (k Keeper) BackfillAccumulator(ctx context.Context) error {
+	headerState := k.headersState(ctx)
+	if headerState.accumulatorCoversMainChain() {
+		return nil
+	}
+
+	headerState.truncateAccumulator(0)
+	rootsStore := k.accumulatorRootsStore(ctx)
+	iter := rootsStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		rootsStore.Delete(key)
+	}
+
+	// collect the headers first, so that the store is not written while
+	// being iterated
+	headers := make([]*types.BTCHeaderInfo, 0)
+	headerState.IterateForwardHeaders(0, func(h *types.BTCHeaderInfo) bool {
+		headers = append(headers, h)
+		return false
+	})
+	for _, h := range headers {
+		headerState.appendAccumulatorLeaf(h)
+	}
+
+	root, err := k.GetCurrentAccumulatorRoot(ctx)
+	if err != nil {
+		return err
+	}
+	k.SetAccumulatorRoot(ctx, root)
+	return nil
+}
+
+// GetHeaderInclusionProof returns the proof that the main chain header with the
+// given hash is included in the accumulator root at the given Babylon height.
+// If babylonHeight is 0, the proof is against the current root.
+// Only roots recorded per Babylon height are kept, while the accumulator nodes
+// are unwound upon a BTC reorg. Thus, a past root that includes a rolled back
+// header can no longer be proven against, and ErrAccumulatorRootNotFound is
+// returned for it
+func (k Keeper) GetHeaderInclusionProof(
+	ctx context.Context,
+	hash *bbn.BTCHeaderHashBytes,
+	babylonHeight uint64,
+) (*types.BTCHeaderInfo, *types.AccumulatorRoot, *types.HeaderInclusionProof, error) {
+	headerState := k.headersState(ctx)
+
+	header, err := headerState.GetHeaderByHash(hash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !headerState.accumulatorCoversMainChain() {
+		return nil, nil, nil, types.ErrAccumulatorNodeNotFound.Wrap("the accumulator does not cover the main chain")
+	}
+
+	var root *types.AccumulatorRoot
+	if babylonHeight == 0 {
+		root, err = k.GetCurrentAccumulatorRoot(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	} else {
+		root = k.GetAccumulatorRoot(ctx, babylonHeight)
+		if root == nil {
+			return nil, nil, nil, types.ErrAccumulatorRootNotFound.Wrapf("no accumulator root at Babylon height %d", babylonHeight)
+		}
+		// the nodes of a past root can only be retrieved if none of its
+		// headers has been rolled back since
+		if root.LeafCount > headerState.AccumulatorLeafCount() {
+			return nil, nil, nil, types.ErrAccumulatorRootNotFound.Wrapf(
+				"the accumulator at Babylon height %d has been rolled back", babylonHeight)
+		}
+		currentRoot, err := headerState.accumulatorRoot(root.LeafCount)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !bytes.Equal(currentRoot, root.Root) {
+			return nil, nil, nil, types.ErrAccumulatorRootNotFound.Wrapf(
+				"the accumulator at Babylon height %d has been rolled back", babylonHeight)
+		}
+	}
+
+	leafIndex := header.Height - headerState.BaseHeader().Height
+	if leafIndex >= root.LeafCount {
+		return nil, nil, nil, types.ErrHeaderDoesNotExist.Wrapf(
+			"header %s is not included in the accumulator at Babylon height %d", hash.MarshalHex(), root.BabylonHeight)
+	}
+
+	proof, err := headerState.accumulatorProof(leafIndex, root.LeafCount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return header, root, proof, nil
+}
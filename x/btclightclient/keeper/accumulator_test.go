@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+// Property: the accumulator commits to the main chain headers at each Babylon
+// height, and is unwound on rollback
+func FuzzHeaderInclusionProofQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		// Babylon height 1: insert a chain
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, blcKeeper, ctx, datagen.RandomInt(r, 50)+10, datagen.RandomInt(r, 50)+10)
+		chainInfos := chain.GetChainInfo()
+		root1 := blcKeeper.GetAccumulatorRoot(ctx, 1)
+		require.NotNil(t, root1)
+		require.Equal(t, uint64(len(chainInfos)+1), root1.LeafCount)
+		currentRoot, err := blcKeeper.GetCurrentAccumulatorRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, currentRoot, root1)
+
+		// Babylon height 2: extend the chain
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+		tip := chain.GetTipInfo()
+		extension := datagen.GenRandomValidChainStartingFrom(r, tip.Height, tip.Header.ToBlockHeader(), nil, 5)
+		require.NoError(t, blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(extension)))
+		newTip := blcKeeper.GetTipInfo(ctx)
+
+		// headers are proven against the roots that include them
+		h := chainInfos[r.Intn(len(chainInfos))]
+		for _, babylonHeight := range []uint64{0, 1, 2, 3} {
+			resp, err := blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+				Hash:          h.Hash.MarshalHex(),
+				BabylonHeight: babylonHeight,
+			})
+			require.NoError(t, err)
+			require.NoError(t, resp.Proof.Verify(h.Height, h.Hash, resp.Root.Root))
+		}
+		_, err = blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash:          newTip.Hash.MarshalHex(),
+			BabylonHeight: 1,
+		})
+		require.ErrorIs(t, err, types.ErrHeaderDoesNotExist)
+		resp, err := blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash:          newTip.Hash.MarshalHex(),
+			BabylonHeight: 2,
+		})
+		require.NoError(t, err)
+		require.NoError(t, resp.Proof.Verify(newTip.Height, newTip.Hash, resp.Root.Root))
+		// a proof does not verify against another root
+		require.Error(t, resp.Proof.Verify(newTip.Height, newTip.Hash, root1.Root))
+
+		// Babylon height 3: fork the chain before the tip at Babylon height 1
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 3})
+		forkParent := chainInfos[r.Intn(len(chainInfos)-1)]
+		fork := datagen.GenRandomValidChainStartingFrom(r, forkParent.Height, forkParent.Header.ToBlockHeader(), nil, uint32(newTip.Height-forkParent.Height)+1)
+		require.NoError(t, blcKeeper.InsertHeaders(ctx, keepertest.NewBTCHeaderBytesList(fork)))
+
+		// the root at Babylon height 1 can no longer be proven against
+		_, err = blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash:          forkParent.Hash.MarshalHex(),
+			BabylonHeight: 1,
+		})
+		require.ErrorIs(t, err, types.ErrAccumulatorRootNotFound)
+		resp, err = blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash:          forkParent.Hash.MarshalHex(),
+			BabylonHeight: 3,
+		})
+		require.NoError(t, err)
+		require.NoError(t, resp.Proof.Verify(forkParent.Height, forkParent.Hash, resp.Root.Root))
+
+		// the unwound accumulator is the same as the one built from scratch
+		// over the main chain
+		mainChain := blcKeeper.GetMainChainFrom(ctx, 0)
+		blcKeeper2, ctx2 := keepertest.BTCLightClientKeeper(t)
+		ctx2 = ctx2.WithHeaderInfo(header.Info{Height: 3})
+		blcKeeper2.InsertHeaderInfos(ctx2, mainChain)
+		currentRoot, err = blcKeeper.GetCurrentAccumulatorRoot(ctx)
+		require.NoError(t, err)
+		currentRoot2, err := blcKeeper2.GetCurrentAccumulatorRoot(ctx2)
+		require.NoError(t, err)
+		require.Equal(t, currentRoot, currentRoot2)
+	})
+}
+
+// Property: the accumulator backfilled over the headers stored before it was
+// introduced is the same as the one built upon inserting them, and the
+// inclusion proof query returns an error rather than panicking before that
+func FuzzBackfillAccumulator(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx, storeService := keepertest.BTCLightClientKeeperWithCustomParams(t, types.DefaultParams())
+
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, blcKeeper, ctx, datagen.RandomInt(r, 50)+10, datagen.RandomInt(r, 50)+10)
+		expectedRoot, err := blcKeeper.GetCurrentAccumulatorRoot(ctx)
+		require.NoError(t, err)
+
+		// wipe the accumulator, as in a chain that stored headers before the
+		// accumulator was introduced
+		store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+		for _, p := range [][]byte{types.AccumulatorPrefix, types.AccumulatorRootsPrefix, types.AccumulatorLeafCountKey} {
+			iter := storetypes.KVStorePrefixIterator(store, p)
+			keys := make([][]byte, 0)
+			for ; iter.Valid(); iter.Next() {
+				keys = append(keys, iter.Key())
+			}
+			iter.Close()
+			for _, key := range keys {
+				store.Delete(key)
+			}
+		}
+
+		chainInfos := chain.GetChainInfo()
+		h := chainInfos[r.Intn(len(chainInfos))]
+		_, err = blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash: h.Hash.MarshalHex(),
+		})
+		require.ErrorIs(t, err, types.ErrAccumulatorNodeNotFound)
+
+		// backfill the accumulator at Babylon height 2
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+		require.NoError(t, blcKeeper.BackfillAccumulator(ctx))
+		backfilledRoot, err := blcKeeper.GetCurrentAccumulatorRoot(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expectedRoot.LeafCount, backfilledRoot.LeafCount)
+		require.Equal(t, expectedRoot.Root, backfilledRoot.Root)
+		require.Equal(t, []*types.AccumulatorRoot{backfilledRoot}, blcKeeper.GetAccumulatorRoots(ctx))
+
+		resp, err := blcKeeper.HeaderInclusionProof(ctx, &types.QueryHeaderInclusionProofRequest{
+			Hash: h.Hash.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, resp.Proof.Verify(h.Height, h.Hash, resp.Root.Root))
+
+		// backfilling again is a no-op
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 3})
+		require.NoError(t, blcKeeper.BackfillAccumulator(ctx))
+		require.Equal(t, []*types.AccumulatorRoot{backfilledRoot}, blcKeeper.GetAccumulatorRoots(ctx))
+	})
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDeepReorgAlarm stores the given deep reorg alarm, halting the processing
+// of headers until the alarm is resolved
+func (k Keeper) SetDeepReorgAlarm(ctx context.Context, alarm *types.DeepReorgAlarm) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.DeepReorgAlarmKey, k.cdc.MustMarshal(alarm)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) deleteDeepReorgAlarm(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.DeepReorgAlarmKey); err != nil {
+		panic(err)
+	}
+}
+
+// GetDeepReorgAlarm returns the pending deep reorg alarm, or nil if the
+// processing of headers is not halted
+func (k Keeper) GetDeepReorgAlarm(ctx context.Context) *types.DeepReorgAlarm {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.DeepReorgAlarmKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var alarm types.DeepReorgAlarm
+	k.cdc.MustUnmarshal(bz, &alarm)
+	return &alarm
+}
+
+// IsHeadersProcessingHalted returns true if a deep reorg alarm is pending
+func (k Keeper) IsHeadersProcessingHalted(ctx context.Context) bool {
+	return k.GetDeepReorgAlarm(ctx) != nil
+}
+
+// raiseDeepReorgAlarm halts the processing of headers instead of applying a
+// fork that rolls back more headers than allowed by the parameters
+func (k Keeper) raiseDeepReorgAlarm(
+	ctx context.Context,
+	rollbackFrom *types.BTCHeaderInfo,
+	rollbackTo *types.BTCHeaderInfo,
+	forkHeaders []*wire.BlockHeader,
+	reporter sdk.AccAddress,
+) {
+	alarm := &types.DeepReorgAlarm{
+		RollbackFrom: rollbackFrom,
+		RollbackTo:   rollbackTo,
+		Depth:        rollbackFrom.Height - rollbackTo.Height,
+		ForkHeaders:  make([]bbn.BTCHeaderBytes, len(forkHeaders)),
+	}
+	for i, header := range forkHeaders {
+		alarm.ForkHeaders[i] = bbn.NewBTCHeaderBytesFromBlockHeader(header)
+	}
+	if reporter != nil {
+		alarm.Reporter = reporter.String()
+	}
+
+	k.SetDeepReorgAlarm(ctx, alarm)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Error(
+		"deep BTC reorg detected, halting the processing of BTC headers until resolved by governance",
+		"rollback_from", rollbackFrom.Height,
+		"rollback_to", rollbackTo.Height,
+		"depth", alarm.Depth,
+	)
+	k.emitTypedEventWithLog(ctx, &types.EventBTCDeepReorgAlarm{Alarm: alarm})
+}
+
+// ResolveDeepReorg resolves the pending deep reorg alarm and resumes the
+// processing of headers. If applyFork is true, the fork of the alarm is
+// applied regardless of the maximum rollback depth, otherwise it is discarded
+func (k Keeper) ResolveDeepReorg(ctx context.Context, applyFork bool) error {
+	alarm := k.GetDeepReorgAlarm(ctx)
+	if alarm == nil {
+		return types.ErrNoDeepReorgAlarm
+	}
+	k.deleteDeepReorgAlarm(ctx)
+
+	if !applyFork {
+		// record the fork as rejected, so that it cannot be resubmitted
+		// to raise the same alarm again
+		k.rejectFork(ctx, alarm)
+	} else {
+		var reporter sdk.AccAddress
+		if alarm.Reporter != "" {
+			reporter = sdk.MustAccAddressFromBech32(alarm.Reporter)
+		}
+		forkHeaders := make([]*wire.BlockHeader, len(alarm.ForkHeaders))
+		for i, header := range alarm.ForkHeaders {
+			forkHeaders[i] = header.ToBlockHeader()
+		}
+		// the fork is validated again, as trusted checkpoints might have
+		// been added since the alarm was raised
+		if err := k.insertHeaders(ctx, forkHeaders, reporter, false); err != nil {
+			return err
+		}
+	}
+
+	k.emitTypedEventWithLog(ctx, &types.EventBTCDeepReorgResolved{ForkApplied: applyFork})
+	return nil
+}
+
+// rejectFork records the first header of the fork of the given alarm that is
+// not on the main chain as rejected. Every fork including this header shares
+// the rejected branch, as the header is the child of the common ancestor
+func (k Keeper) rejectFork(ctx context.Context, alarm *types.DeepReorgAlarm) {
+	headerState := k.headersState(ctx)
+	for _, header := range alarm.ForkHeaders {
+		hash := header.Hash()
+		if !headerState.HeaderExists(hash) {
+			k.SetRejectedFork(ctx, hash)
+			return
+		}
+	}
+}
+
+// SetRejectedFork records the given header as the first header of a fork
+// rejected by governance
+func (k Keeper) SetRejectedFork(ctx context.Context, hash *bbn.BTCHeaderHashBytes) {
+	store := k.rejectedForksStore(ctx)
+	store.Set(types.RejectedForkKey(hash), []byte{})
+}
+
+// IsForkRejected returns true if the given header is the first header of a
+// fork rejected by governance
+func (k Keeper) IsForkRejected(ctx context.Context, hash *bbn.BTCHeaderHashBytes) bool {
+	store := k.rejectedForksStore(ctx)
+	return store.Has(types.RejectedForkKey(hash))
+}
+
+// GetRejectedForks returns the first headers of all forks rejected by
+// governance
+func (k Keeper) GetRejectedForks(ctx context.Context) []*bbn.BTCHeaderHashBytes {
+	store := k.rejectedForksStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	hashes := make([]*bbn.BTCHeaderHashBytes, 0)
+	for ; iter.Valid(); iter.Next() {
+		hash, err := bbn.NewBTCHeaderHashBytesFromBytes(iter.Key())
+		if err != nil {
+			panic(err)
+		}
+		hashes = append(hashes, &hash)
+	}
+	return hashes
+}
+
+// checkRejectedForks returns an error if the given headers include the first
+// header of a fork rejected by governance, unless they also include a header
+// of a trusted checkpoint, i.e., governance has endorsed the fork since
+func (k Keeper) checkRejectedForks(ctx context.Context, headers []*wire.BlockHeader, checkpoints []types.TrustedCheckpoint) error {
+	var rejected *bbn.BTCHeaderHashBytes
+	hashes := make(map[string]struct{}, len(headers))
+	for _, header := range headers {
+		blockHash := header.BlockHash()
+		hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+		hashes[hash.MarshalHex()] = struct{}{}
+		if rejected == nil && k.IsForkRejected(ctx, &hash) {
+			rejected = &hash
+		}
+	}
+	if rejected == nil {
+		return nil
+	}
+	for _, cp := range checkpoints {
+		if _, ok := hashes[cp.Hash.MarshalHex()]; ok {
+			return nil
+		}
+	}
+	return types.ErrForkRejected.Wrapf("the fork starting at header %s is rejected", rejected.MarshalHex())
+}
+
+// rejectedForksStore returns the KVStore of the first headers of the forks
+// rejected by governance
+// prefix: RejectedForkPrefix
+// key: header hash
+// value: empty
+func (k Keeper) rejectedForksStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.RejectedForkPrefix)
+}
+
+// ValidateTrustedCheckpoints checks that the given trusted checkpoints do not
+// conflict with the current main chain
+func (k Keeper) ValidateTrustedCheckpoints(ctx context.Context, checkpoints []types.TrustedCheckpoint) error {
+	headerState := k.headersState(ctx)
+	for _, cp := range checkpoints {
+		header, err := headerState.GetHeaderByHeight(cp.Height)
+		if err != nil {
+			// the checkpoint is above the tip or below the base header
+			continue
+		}
+		if !header.Hash.Eq(cp.Hash) {
+			return types.ErrInvalidTrustedCheckpoint.Wrapf(
+				"checkpoint %s at height %d conflicts with main chain header %s",
+				cp.Hash.MarshalHex(), cp.Height, header.Hash.MarshalHex(),
+			)
+		}
+	}
+	return nil
+}
@@ -207,3 +207,37 @@ func (k Keeper) HeaderDepth(ctx context.Context, req *types.QueryHeaderDepthRequ
 
 	return &types.QueryHeaderDepthResponse{Depth: uint64(depth)}, nil
 }
+
+func (k Keeper) DeepReorgAlarm(ctx context.Context, req *types.QueryDeepReorgAlarmRequest) (*types.QueryDeepReorgAlarmResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryDeepReorgAlarmResponse{Alarm: k.GetDeepReorgAlarm(sdkCtx)}, nil
+}
+
+func (k Keeper) HeaderInclusionProof(ctx context.Context, req *types.QueryHeaderInclusionProofRequest) (*types.QueryHeaderInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	headerHash, err := bbn.NewBTCHeaderHashBytesFromHex(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "provided hash is not a valid hex string")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	header, root, proof, err := k.GetHeaderInclusionProof(sdkCtx, &headerHash, req.BabylonHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHeaderInclusionProofResponse{
+		Header: header.ToResponse(),
+		Root:   root,
+		Proof:  proof,
+	}, nil
+}
@@ -75,16 +75,24 @@ func (k *Keeper) SetHooks(bh types.BTCLightClientHooks) *Keeper {
 	return k
 }
 
+// insertHeaders validates and inserts the given headers. If the headers form a
+// fork rolling back more headers than the maximum rollback depth and
+// enforceMaxRollbackDepth is set, the fork is not applied and a deep reorg
+// alarm is raised instead
 func (k Keeper) insertHeaders(
 	ctx context.Context,
 	headers []*wire.BlockHeader,
+	reporter sdk.AccAddress,
+	enforceMaxRollbackDepth bool,
 ) error {
 
 	headerState := k.headersState(ctx)
+	params := k.GetParams(ctx)
 
 	result, err := k.bl.InsertHeaders(
 		headerState,
 		headers,
+		params.TrustedCheckpoints,
 	)
 
 	if err != nil {
@@ -93,6 +101,12 @@ func (k Keeper) insertHeaders(
 
 	// if we have rollback, first delete all headers up to the rollback point
 	if result.RollbackInfo != nil {
+		tip := headerState.GetTip()
+		rollbackTo := result.RollbackInfo.HeaderToRollbackTo
+		if enforceMaxRollbackDepth && params.IsRollbackTooDeep(tip.Height-rollbackTo.Height) {
+			k.raiseDeepReorgAlarm(ctx, tip, rollbackTo, headers, reporter)
+			return nil
+		}
 		// roll back to the height
 		headerState.rollBackHeadersUpTo(result.RollbackInfo.HeaderToRollbackTo.Height)
 		// trigger rollback event
@@ -102,9 +116,14 @@ func (k Keeper) insertHeaders(
 	for _, header := range result.HeadersToInsert {
 		h := header
 		headerState.insertHeader(h)
+		if reporter != nil {
+			headerState.setReporter(h.Hash, reporter)
+		}
 		k.triggerHeaderInserted(ctx, h)
 		k.triggerRollForward(ctx, h)
 	}
+
+	k.recordAccumulatorRoot(ctx)
 	return nil
 }
 
@@ -117,16 +136,31 @@ func (k Keeper) InsertHeaderInfos(ctx context.Context, infos []*types.BTCHeaderI
 }
 
 func (k Keeper) InsertHeaders(ctx context.Context, headers []bbn.BTCHeaderBytes) error {
+	return k.InsertHeadersWithReporter(ctx, headers, nil)
+}
+
+// InsertHeadersWithReporter inserts the headers and records the given reporter
+// for each header that is inserted in the main chain, so that the reporter can
+// be rewarded once the header is deep enough
+func (k Keeper) InsertHeadersWithReporter(ctx context.Context, headers []bbn.BTCHeaderBytes, reporter sdk.AccAddress) error {
 	if len(headers) == 0 {
 		return types.ErrEmptyMessage
 	}
 
+	if k.IsHeadersProcessingHalted(ctx) {
+		return types.ErrHeadersProcessingHalted
+	}
+
 	blockHeaders := make([]*wire.BlockHeader, len(headers))
 	for i, header := range headers {
 		blockHeaders[i] = header.ToBlockHeader()
 	}
 
-	return k.insertHeaders(ctx, blockHeaders)
+	if err := k.checkRejectedForks(ctx, blockHeaders, k.GetParams(ctx).TrustedCheckpoints); err != nil {
+		return err
+	}
+
+	return k.insertHeaders(ctx, blockHeaders, reporter, true)
 }
 
 // BlockHeight returns the height of the provided header
@@ -239,6 +273,17 @@ func (k Keeper) GetMainChainReverse(ctx context.Context) []*types.BTCHeaderInfo
 	return headers
 }
 
+// SetHeaderReporter records the reporter of the header with the given hash
+func (k Keeper) SetHeaderReporter(ctx context.Context, hash *bbn.BTCHeaderHashBytes, reporter sdk.AccAddress) {
+	k.headersState(ctx).setReporter(hash, reporter)
+}
+
+// GetHeaderReporter returns the address of the reporter who inserted the header
+// with the given hash, or nil if it is unknown
+func (k Keeper) GetHeaderReporter(ctx context.Context, hash *bbn.BTCHeaderHashBytes) sdk.AccAddress {
+	return k.headersState(ctx).GetReporter(hash)
+}
+
 func (k Keeper) GetBTCNet() *chaincfg.Params {
 	return k.btcConfig.NetParams()
 }
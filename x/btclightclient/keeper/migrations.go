@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, i.e., it backfills the header
+// accumulator with the headers stored before it was introduced.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.BackfillAccumulator(ctx)
+}
@@ -47,7 +47,7 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 		return nil, types.ErrUnauthorizedReporter.Wrapf("reporter %s is not authorized to insert headers", reporterAddress)
 	}
 
-	err := m.k.InsertHeaders(sdkCtx, msg.Headers)
+	err := m.k.InsertHeadersWithReporter(sdkCtx, msg.Headers, reporterAddress)
 
 	if err != nil {
 		return nil, err
@@ -65,6 +65,10 @@ func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	if err := ms.k.ValidateTrustedCheckpoints(sdkCtx, req.Params.TrustedCheckpoints); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid parameter: %v", err)
+	}
+
 	if err := ms.k.SetParams(sdkCtx, req.Params); err != nil {
 		return nil, err
 	}
@@ -72,6 +76,20 @@ func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+func (ms msgServer) ResolveDeepReorg(ctx context.Context, req *types.MsgResolveDeepReorg) (*types.MsgResolveDeepReorgResponse, error) {
+	if ms.k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := ms.k.ResolveDeepReorg(sdkCtx, req.ApplyFork); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveDeepReorgResponse{}, nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -10,10 +10,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/keeper"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, *keeper.Keeper, context.Context) {
@@ -153,6 +156,83 @@ func FuzzMsgServerReorgChain(f *testing.F) {
 	})
 }
 
+// Property: the reporter of each header in the main chain is recorded, and the
+// reporter of a rolled back header is removed
+func FuzzMsgServerHeaderReporter(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	reporter1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	reporter2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		srv, blcKeeper, sdkCtx := setupMsgServer(t)
+		ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			datagen.RandomInt(r, 50)+10,
+			datagen.RandomInt(r, 50)+10,
+		)
+		initTip := chain.GetTipInfo()
+		// headers not inserted through MsgInsertHeaders have no reporter
+		require.Nil(t, blcKeeper.GetHeaderReporter(ctx, initTip.Hash))
+
+		// reporter1 extends the chain
+		extensionLen := uint32(r.Int31n(20) + 2)
+		chainExtension := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Height,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			extensionLen,
+		)
+		msg := &types.MsgInsertHeaders{Signer: reporter1.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+		_, err := srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+		for _, header := range chainExtension {
+			blockHash := header.BlockHash()
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+			require.Equal(t, reporter1, blcKeeper.GetHeaderReporter(ctx, &hash))
+		}
+
+		// reporter2 forks the chain from the middle of the extension
+		forkHeight := initTip.Height + uint64(r.Int31n(int32(extensionLen)-1)) + 1
+		forkHeader := blcKeeper.GetHeaderByHeight(ctx, forkHeight)
+		require.NotNil(t, forkHeader)
+		forkChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			forkHeader.Height,
+			forkHeader.Header.ToBlockHeader(),
+			nil,
+			extensionLen+1,
+		)
+		msg = &types.MsgInsertHeaders{Signer: reporter2.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+
+		for _, header := range chainExtension {
+			blockHash := header.BlockHash()
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+			if info := blcKeeper.GetHeaderByHash(ctx, &hash); info != nil {
+				// headers up to the fork point remain in the main chain
+				require.LessOrEqual(t, info.Height, forkHeight)
+				require.Equal(t, reporter1, blcKeeper.GetHeaderReporter(ctx, &hash))
+			} else {
+				// rolled back headers are no longer credited to reporter1
+				require.Nil(t, blcKeeper.GetHeaderReporter(ctx, &hash))
+			}
+		}
+		for _, header := range forkChain {
+			blockHash := header.BlockHash()
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+			require.Equal(t, reporter2, blcKeeper.GetHeaderReporter(ctx, &hash))
+		}
+	})
+}
+
 func TestAllowUpdatesOnlyFromReportesInTheList(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	sender1 := secp256k1.GenPrivKey()
@@ -227,3 +307,196 @@ func TestAllowUpdatesOnlyFromReportesInTheList(t *testing.T) {
 	_, err = srv.InsertHeaders(sdkCtx, msg1)
 	require.NoError(t, err)
 }
+
+// Property: forks starting below the highest trusted checkpoint on the main
+// chain are rejected, while forks starting from it are accepted
+func FuzzMsgServerTrustedCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	reporter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		srv, blcKeeper, sdkCtx := setupMsgServer(t)
+		ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			datagen.RandomInt(r, 50)+10,
+			datagen.RandomInt(r, 50)+10,
+		)
+		initTip := chain.GetTipInfo()
+		baseHeight := initTip.Height - uint64(len(chain.Headers)) + 1
+
+		// checkpoints conflicting with the main chain are rejected
+		checkpointHeader := blcKeeper.GetHeaderByHeight(ctx, baseHeight+uint64(r.Intn(len(chain.Headers)-2))+1)
+		require.NotNil(t, checkpointHeader)
+		params := types.DefaultParams()
+		params.TrustedCheckpoints = []types.TrustedCheckpoint{
+			{Height: checkpointHeader.Height, Hash: initTip.Hash},
+		}
+		_, err := srv.UpdateParams(sdkCtx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.Error(t, err)
+
+		params.TrustedCheckpoints = []types.TrustedCheckpoint{
+			{Height: checkpointHeader.Height, Hash: checkpointHeader.Hash},
+		}
+		_, err = srv.UpdateParams(sdkCtx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(t, err)
+
+		// a heavier fork starting below the checkpoint is rejected
+		forkHeight := baseHeight - 1 + uint64(r.Intn(int(checkpointHeader.Height-baseHeight+1)))
+		forkHeader := blcKeeper.GetHeaderByHeight(ctx, forkHeight)
+		require.NotNil(t, forkHeader)
+		forkChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			forkHeader.Height,
+			forkHeader.Header.ToBlockHeader(),
+			nil,
+			uint32(initTip.Height-forkHeader.Height)+10,
+		)
+		msg := &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidHeader)
+		require.True(t, blcKeeper.GetTipInfo(ctx).Eq(initTip))
+
+		// a heavier fork starting from the checkpoint is accepted
+		forkChain = datagen.GenRandomValidChainStartingFrom(
+			r,
+			checkpointHeader.Height,
+			checkpointHeader.Header.ToBlockHeader(),
+			nil,
+			uint32(initTip.Height-checkpointHeader.Height)+10,
+		)
+		msg = &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+		forkTip := forkChain[len(forkChain)-1].BlockHash()
+		require.Equal(t, forkTip, *blcKeeper.GetTipInfo(ctx).Hash.ToChainhash())
+	})
+}
+
+// Property: a reorg deeper than the maximum rollback depth is not applied and
+// halts the processing of headers until governance resolves the alarm
+func FuzzMsgServerDeepReorgAlarm(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	reporter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		chainLength := datagen.RandomInt(r, 50) + 10
+		maxRollbackDepth := datagen.RandomInt(r, int(chainLength-2)) + 1
+		params := types.DefaultParams()
+		params.MaxRollbackDepth = maxRollbackDepth
+		srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
+		ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			datagen.RandomInt(r, 50)+10,
+			chainLength,
+		)
+		initTip := chain.GetTipInfo()
+
+		// resolving without alarm fails
+		_, err := srv.ResolveDeepReorg(sdkCtx, &types.MsgResolveDeepReorg{Authority: authority, ApplyFork: true})
+		require.ErrorIs(t, err, types.ErrNoDeepReorgAlarm)
+
+		// a reorg deeper than the maximum rollback depth raises an alarm
+		reorgDepth := maxRollbackDepth + datagen.RandomInt(r, int(chainLength-1-maxRollbackDepth)) + 1
+		forkHeader := blcKeeper.GetHeaderByHeight(ctx, initTip.Height-reorgDepth)
+		require.NotNil(t, forkHeader)
+		forkChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			forkHeader.Height,
+			forkHeader.Header.ToBlockHeader(),
+			nil,
+			uint32(reorgDepth)+10,
+		)
+		msg := &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+		require.True(t, blcKeeper.GetTipInfo(ctx).Eq(initTip))
+
+		alarm := blcKeeper.GetDeepReorgAlarm(ctx)
+		require.NotNil(t, alarm)
+		require.NoError(t, alarm.Validate())
+		require.Equal(t, reorgDepth, alarm.Depth)
+		require.True(t, alarm.RollbackFrom.Eq(initTip))
+		require.True(t, alarm.RollbackTo.Eq(forkHeader))
+		require.Equal(t, reporter.String(), alarm.Reporter)
+
+		// the processing of headers is halted
+		chainExtension := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Height,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			1,
+		)
+		msg = &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.ErrorIs(t, err, types.ErrHeadersProcessingHalted)
+
+		// only governance can resolve the alarm
+		_, err = srv.ResolveDeepReorg(sdkCtx, &types.MsgResolveDeepReorg{Authority: reporter.String(), ApplyFork: true})
+		require.Error(t, err)
+
+		applyFork := r.Intn(2) == 0
+		_, err = srv.ResolveDeepReorg(sdkCtx, &types.MsgResolveDeepReorg{Authority: authority, ApplyFork: applyFork})
+		require.NoError(t, err)
+		require.Nil(t, blcKeeper.GetDeepReorgAlarm(ctx))
+
+		tip := blcKeeper.GetTipInfo(ctx)
+		if applyFork {
+			forkTip := forkChain[len(forkChain)-1].BlockHash()
+			require.Equal(t, forkTip, *tip.Hash.ToChainhash())
+			require.Equal(t, reporter, blcKeeper.GetHeaderReporter(ctx, tip.Hash))
+		} else {
+			require.True(t, tip.Eq(initTip))
+		}
+
+		// the processing of headers is resumed
+		chainExtension = datagen.GenRandomValidChainStartingFrom(
+			r,
+			tip.Height,
+			tip.Header.ToBlockHeader(),
+			nil,
+			1,
+		)
+		msg = &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+		require.Equal(t, tip.Height+1, blcKeeper.GetTipInfo(ctx).Height)
+		if applyFork {
+			return
+		}
+
+		// the rejected fork cannot be resubmitted to raise the alarm again
+		msg = &types.MsgInsertHeaders{Signer: reporter.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.ErrorIs(t, err, types.ErrForkRejected)
+		require.Nil(t, blcKeeper.GetDeepReorgAlarm(ctx))
+		require.Len(t, blcKeeper.GetRejectedForks(ctx), 1)
+
+		// once governance endorses the fork with a trusted checkpoint, it
+		// can be resubmitted and raises the alarm again
+		forkTipHeight := forkHeader.Height + uint64(len(forkChain))
+		forkTipBlockHash := forkChain[len(forkChain)-1].BlockHash()
+		forkTipHash := bbn.NewBTCHeaderHashBytesFromChainhash(&forkTipBlockHash)
+		params.TrustedCheckpoints = []types.TrustedCheckpoint{{Height: forkTipHeight, Hash: &forkTipHash}}
+		_, err = srv.UpdateParams(sdkCtx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(t, err)
+		_, err = srv.InsertHeaders(sdkCtx, msg)
+		require.NoError(t, err)
+		require.NotNil(t, blcKeeper.GetDeepReorgAlarm(ctx))
+	})
+}
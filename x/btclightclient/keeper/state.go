@@ -18,6 +18,10 @@ type headersState struct {
 	cdc          codec.BinaryCodec
 	headers      storetypes.KVStore
 	hashToHeight storetypes.KVStore
+	reporters    storetypes.KVStore
+	accumulator  storetypes.KVStore
+	// store is the whole module store, for keys that are not namespaced
+	store storetypes.KVStore
 }
 
 func (k Keeper) headersState(ctx context.Context) headersState {
@@ -27,12 +31,16 @@ func (k Keeper) headersState(ctx context.Context) headersState {
 		cdc:          k.cdc,
 		headers:      prefix.NewStore(storeAdapter, types.HeadersObjectPrefix),
 		hashToHeight: prefix.NewStore(storeAdapter, types.HashToHeightPrefix),
+		reporters:    prefix.NewStore(storeAdapter, types.HashToReporterPrefix),
+		accumulator:  prefix.NewStore(storeAdapter, types.AccumulatorPrefix),
+		store:        storeAdapter,
 	}
 }
 
 // insertHeader Insert the header into the following storages:
 // - hash->height
 // - height -> HeaderInfo
+// - accumulator
 func (s headersState) insertHeader(h *types.BTCHeaderInfo) {
 	// Get necessary keys according
 	headersKey := types.HeadersObjectKey(h.Height)
@@ -41,6 +49,7 @@ func (s headersState) insertHeader(h *types.BTCHeaderInfo) {
 	// save concrete object
 	s.headers.Set(headersKey, s.cdc.MustMarshal(h))
 	s.hashToHeight.Set(heightKey, sdk.Uint64ToBigEndian(h.Height))
+	s.appendAccumulatorLeaf(h)
 }
 
 func (s headersState) deleteHeader(h *types.BTCHeaderInfo) {
@@ -51,6 +60,23 @@ func (s headersState) deleteHeader(h *types.BTCHeaderInfo) {
 	// save concrete object
 	s.headers.Delete(headersKey)
 	s.hashToHeight.Delete(heightKey)
+	// a rolled back header is no longer credited to its reporter
+	s.reporters.Delete(types.HeaderReporterKey(h.Hash))
+}
+
+// setReporter records the address of the reporter who inserted the header
+func (s headersState) setReporter(hash *bbn.BTCHeaderHashBytes, reporter sdk.AccAddress) {
+	s.reporters.Set(types.HeaderReporterKey(hash), reporter)
+}
+
+// GetReporter returns the address of the reporter who inserted the header, or
+// nil if the header was not inserted through a MsgInsertHeaders
+func (s headersState) GetReporter(hash *bbn.BTCHeaderHashBytes) sdk.AccAddress {
+	reporter := s.reporters.Get(types.HeaderReporterKey(hash))
+	if reporter == nil {
+		return nil
+	}
+	return sdk.AccAddress(reporter)
 }
 
 func (s headersState) rollBackHeadersUpTo(height uint64) {
@@ -77,6 +103,8 @@ func (s headersState) rollBackHeadersUpTo(height uint64) {
 	for _, header := range headersToDelete {
 		s.deleteHeader(header)
 	}
+	// unwind the accumulator
+	s.truncateAccumulator(s.AccumulatorLeafCount() - uint64(len(headersToDelete)))
 }
 
 // GetHeaderByHeight Retrieve a header by its height and hash
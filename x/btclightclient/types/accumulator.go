@@ -0,0 +1,205 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	bbn "github.com/babylonchain/babylon/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The light client maintains a Merkle Mountain Range (MMR) over the headers of
+// the main chain, starting from the base header. The MMR is stored as a list
+// of nodes in post-order, where node positions start from 0. Appending a
+// header adds its leaf and the parents of all the mountains it completes, and
+// rolling back headers removes the nodes from the end of the list, so the
+// nodes of the first n leaves never depend on the following leaves.
+//
+// To prevent second preimage attacks, leaves, inner nodes and the root are
+// hashed with distinct prefixes:
+//   - leaf = sha256(0x00 || height || header hash)
+//   - node = sha256(0x01 || left || right)
+//   - root = sha256(0x02 || leaf count || peaks from left to right)
+//
+// where integers are encoded as 8 bytes big endian.
+const (
+	accumulatorLeafPrefix byte = 0x00
+	accumulatorNodePrefix byte = 0x01
+	accumulatorRootPrefix byte = 0x02
+
+	// maxMountainHeight bounds the height of the mountains, so that the sizes
+	// of the mountains do not overflow
+	maxMountainHeight = 62
+)
+
+// AccumulatorLeaf returns the leaf of the header with the given height and hash
+func AccumulatorLeaf(height uint64, hash *bbn.BTCHeaderHashBytes) []byte {
+	h := sha256.New()
+	h.Write([]byte{accumulatorLeafPrefix})
+	h.Write(sdk.Uint64ToBigEndian(height))
+	h.Write(hash.MustMarshal())
+	return h.Sum(nil)
+}
+
+// AccumulatorNode returns the parent of the given nodes
+func AccumulatorNode(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{accumulatorNodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// AccumulatorRootFromPeaks returns the root of an accumulator with the given
+// number of leaves and peaks
+func AccumulatorRootFromPeaks(leafCount uint64, peaks [][]byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{accumulatorRootPrefix})
+	h.Write(sdk.Uint64ToBigEndian(leafCount))
+	for _, peak := range peaks {
+		h.Write(peak)
+	}
+	return h.Sum(nil)
+}
+
+// MMRSize returns the number of nodes of an MMR with the given number of leaves
+func MMRSize(leafCount uint64) uint64 {
+	return 2*leafCount - uint64(bits.OnesCount64(leafCount))
+}
+
+// MMRPeakPositions returns the positions of the peaks of an MMR with the given
+// number of leaves, from left to right
+func MMRPeakPositions(leafCount uint64) []uint64 {
+	peaks := make([]uint64, 0, bits.OnesCount64(leafCount))
+	offset := uint64(0)
+	for h := maxMountainHeight; h >= 0; h-- {
+		if leafCount&(uint64(1)<<h) == 0 {
+			continue
+		}
+		mountainSize := uint64(1)<<(h+1) - 1
+		peaks = append(peaks, offset+mountainSize-1)
+		offset += mountainSize
+	}
+	return peaks
+}
+
+// mmrMountain describes the mountain of an MMR containing a given leaf
+type mmrMountain struct {
+	// index of the mountain among all mountains, from left to right
+	index int
+	// height of the mountain, 0 for a single leaf
+	height int
+	// position of the first node of the mountain
+	offset uint64
+	// index of the leaf within the mountain
+	localIndex uint64
+}
+
+// findMMRMountain returns the mountain containing the given leaf in an MMR
+// with the given number of leaves
+func findMMRMountain(leafIndex, leafCount uint64) (*mmrMountain, error) {
+	if leafIndex >= leafCount {
+		return nil, fmt.Errorf("leaf index %d is out of range of %d leaves", leafIndex, leafCount)
+	}
+	index := 0
+	offset := uint64(0)
+	firstLeaf := uint64(0)
+	for h := maxMountainHeight; h >= 0; h-- {
+		if leafCount&(uint64(1)<<h) == 0 {
+			continue
+		}
+		mountainLeaves := uint64(1) << h
+		if leafIndex < firstLeaf+mountainLeaves {
+			return &mmrMountain{
+				index:      index,
+				height:     h,
+				offset:     offset,
+				localIndex: leafIndex - firstLeaf,
+			}, nil
+		}
+		index++
+		offset += 2*mountainLeaves - 1
+		firstLeaf += mountainLeaves
+	}
+	// unreachable as leafIndex < leafCount
+	return nil, fmt.Errorf("leaf index %d is out of range of %d leaves", leafIndex, leafCount)
+}
+
+// MMRProofPositions returns the positions of the nodes forming the inclusion
+// proof of the given leaf in an MMR with the given number of leaves, i.e., the
+// siblings of the path from the leaf to the peak of its mountain from bottom
+// to top, and the peaks of the other mountains from left to right
+func MMRProofPositions(leafIndex, leafCount uint64) (siblings []uint64, peaks []uint64, err error) {
+	mountain, err := findMMRMountain(leafIndex, leafCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// walk down the mountain from its peak to the leaf
+	siblings = make([]uint64, mountain.height)
+	start := mountain.offset
+	localIndex := mountain.localIndex
+	for h := mountain.height; h > 0; h-- {
+		childLeaves := uint64(1) << (h - 1)
+		childSize := 2*childLeaves - 1
+		if localIndex < childLeaves {
+			// the leaf is in the left child, the sibling is the right child
+			siblings[h-1] = start + 2*childSize - 1
+		} else {
+			// the leaf is in the right child, the sibling is the left child
+			siblings[h-1] = start + childSize - 1
+			start += childSize
+			localIndex -= childLeaves
+		}
+	}
+
+	for i, peak := range MMRPeakPositions(leafCount) {
+		if i != mountain.index {
+			peaks = append(peaks, peak)
+		}
+	}
+
+	return siblings, peaks, nil
+}
+
+// Verify verifies that the header with the given height and hash is included
+// in the accumulator with the given root. It does not depend on any state, so
+// that any consumer holding a trusted root can verify historical headers
+func (p *HeaderInclusionProof) Verify(height uint64, hash *bbn.BTCHeaderHashBytes, root []byte) error {
+	if hash == nil || hash.Size() != bbn.BTCHeaderHashLen {
+		return errors.New("invalid header hash")
+	}
+	mountain, err := findMMRMountain(p.LeafIndex, p.LeafCount)
+	if err != nil {
+		return err
+	}
+	if len(p.Siblings) != mountain.height {
+		return fmt.Errorf("expected %d siblings, got %d", mountain.height, len(p.Siblings))
+	}
+	numPeaks := bits.OnesCount64(p.LeafCount)
+	if len(p.Peaks) != numPeaks-1 {
+		return fmt.Errorf("expected %d peaks, got %d", numPeaks-1, len(p.Peaks))
+	}
+
+	node := AccumulatorLeaf(height, hash)
+	for h, sibling := range p.Siblings {
+		if mountain.localIndex&(uint64(1)<<h) == 0 {
+			node = AccumulatorNode(node, sibling)
+		} else {
+			node = AccumulatorNode(sibling, node)
+		}
+	}
+
+	peaks := make([][]byte, 0, numPeaks)
+	peaks = append(peaks, p.Peaks[:mountain.index]...)
+	peaks = append(peaks, node)
+	peaks = append(peaks, p.Peaks[mountain.index:]...)
+
+	if !bytes.Equal(AccumulatorRootFromPeaks(p.LeafCount, peaks), root) {
+		return errors.New("header is not included in the accumulator")
+	}
+	return nil
+}
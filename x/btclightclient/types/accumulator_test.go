@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+// appendLeaf appends the leaf of the given header to the given MMR nodes
+func appendLeaf(nodes [][]byte, leafIndex uint64, height uint64, hash *bbn.BTCHeaderHashBytes) [][]byte {
+	node := types.AccumulatorLeaf(height, hash)
+	nodes = append(nodes, node)
+	for h := 0; leafIndex&(uint64(1)<<h) != 0; h++ {
+		left := nodes[uint64(len(nodes))-uint64(1)<<(h+1)]
+		node = types.AccumulatorNode(left, node)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func FuzzHeaderInclusionProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		baseHeight := datagen.GenRandomBTCHeight(r)
+		leafCount := datagen.RandomInt(r, 200) + 1
+		hashes := make([]bbn.BTCHeaderHashBytes, leafCount)
+		nodes := make([][]byte, 0)
+		for i := uint64(0); i < leafCount; i++ {
+			hashes[i] = *datagen.GenRandomBTCHeaderInfo(r).Hash
+			nodes = appendLeaf(nodes, i, baseHeight+i, &hashes[i])
+		}
+		require.Equal(t, types.MMRSize(leafCount), uint64(len(nodes)))
+
+		peakPositions := types.MMRPeakPositions(leafCount)
+		peaks := make([][]byte, len(peakPositions))
+		for i, pos := range peakPositions {
+			peaks[i] = nodes[pos]
+		}
+		root := types.AccumulatorRootFromPeaks(leafCount, peaks)
+
+		for i := uint64(0); i < leafCount; i++ {
+			siblingPositions, peakPositions, err := types.MMRProofPositions(i, leafCount)
+			require.NoError(t, err)
+			proof := &types.HeaderInclusionProof{LeafIndex: i, LeafCount: leafCount}
+			for _, pos := range siblingPositions {
+				proof.Siblings = append(proof.Siblings, nodes[pos])
+			}
+			for _, pos := range peakPositions {
+				proof.Peaks = append(proof.Peaks, nodes[pos])
+			}
+			require.NoError(t, proof.Verify(baseHeight+i, &hashes[i], root))
+
+			// the proof does not verify another header or height
+			otherHash := *datagen.GenRandomBTCHeaderInfo(r).Hash
+			require.Error(t, proof.Verify(baseHeight+i, &otherHash, root))
+			require.Error(t, proof.Verify(baseHeight+i+1, &hashes[i], root))
+		}
+
+		// there is no proof for a leaf out of range
+		_, _, err := types.MMRProofPositions(leafCount, leafCount)
+		require.Error(t, err)
+	})
+}
@@ -25,6 +25,10 @@ type lightChainCtx struct {
 	blocksPerRetarget   int32
 	minRetargetTimespan int64
 	maxRetargetTimespan int64
+	// checkpoints are the hashes of the trusted checkpoints indexed by height
+	checkpoints map[int32]*chainhash.Hash
+	// previousCheckpoint is the highest trusted checkpoint on the main chain
+	previousCheckpoint blockchain.HeaderCtx
 }
 
 var _ blockchain.ChainCtx = (*lightChainCtx)(nil)
@@ -70,14 +74,55 @@ func (l *lightChainCtx) MaxRetargetTimespan() int64 {
 	return l.maxRetargetTimespan
 }
 
-// We never check checkpoints in our on-chain light client. Required by blockchain.ChainCtx interface
-func (l *lightChainCtx) VerifyCheckpoint(int32, *chainhash.Hash) bool {
-	return false
+// withCheckpoints returns a copy of the context which enforces the given
+// trusted checkpoints on top of the chain in the given store
+func (l *lightChainCtx) withCheckpoints(
+	checkpoints []TrustedCheckpoint,
+	store *storeWithExtensionChain,
+	tipHeight uint64) *lightChainCtx {
+
+	ctx := *l
+	ctx.checkpoints = make(map[int32]*chainhash.Hash, len(checkpoints))
+	ctx.previousCheckpoint = nil
+
+	var previousCheckpoint *TrustedCheckpoint
+	for i := range checkpoints {
+		cp := &checkpoints[i]
+		ctx.checkpoints[int32(cp.Height)] = cp.Hash.ToChainhash()
+		if cp.Height <= tipHeight && (previousCheckpoint == nil || cp.Height > previousCheckpoint.Height) {
+			previousCheckpoint = cp
+		}
+	}
+
+	if previousCheckpoint != nil {
+		// checkpoints below the base header do not constrain the chain
+		if header := store.getHeaderAtHeight(previousCheckpoint.Height); header != nil {
+			ctx.previousCheckpoint = newLightHeaderCtx(previousCheckpoint.Height, header.header, store)
+		}
+	}
+
+	return &ctx
 }
 
-// If VerifyCheckpoint returns false, this function is never called. Required by blockchain.ChainCtx interface
+// VerifyCheckpoint returns false if there is a trusted checkpoint at the given
+// height with another hash. Required by blockchain.ChainCtx interface
+func (l *lightChainCtx) VerifyCheckpoint(height int32, hash *chainhash.Hash) bool {
+	checkpoint, ok := l.checkpoints[height]
+	if !ok {
+		return true
+	}
+	return checkpoint.IsEqual(hash)
+}
+
+// FindPreviousCheckpoint returns the highest trusted checkpoint on the main
+// chain, so that forks starting below it are rejected. Required by
+// blockchain.ChainCtx interface
 func (l *lightChainCtx) FindPreviousCheckpoint() (blockchain.HeaderCtx, error) {
-	return nil, nil
+	if l.previousCheckpoint == nil {
+		// explicitly return a nil interface rather than a typed nil
+		return nil, nil
+	}
+	return l.previousCheckpoint, nil
 }
 
 type localHeaderInfo struct {
@@ -301,6 +346,7 @@ func (s *storeWithExtensionChain) getHeaderAtHeight(height uint64) *localHeaderI
 
 func (l *BtcLightClient) processNewHeadersChain(
 	store *storeWithExtensionChain,
+	chainCtx *lightChainCtx,
 	chainParent *localHeaderInfo,
 	chain []*wire.BlockHeader) error {
 	// init info about parent as current tip
@@ -310,7 +356,7 @@ func (l *BtcLightClient) processNewHeadersChain(
 		h := blockHeader
 
 		err := l.checkHeader(
-			store, parentHeaderInfo, h,
+			store, chainCtx, parentHeaderInfo, h,
 		)
 
 		if err != nil {
@@ -340,7 +386,14 @@ type InsertResult struct {
 	RollbackInfo *RollbackInfo
 }
 
-func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*wire.BlockHeader) (*InsertResult, error) {
+// InsertHeaders validates the given headers against the chain in the given
+// store and the given trusted checkpoints, and returns the headers to insert
+// together with the header to roll back to, if the headers form a new fork
+func (l *BtcLightClient) InsertHeaders(
+	readStore BtcChainReadStore,
+	headers []*wire.BlockHeader,
+	checkpoints []TrustedCheckpoint,
+) (*InsertResult, error) {
 	headersLen := len(headers)
 	if headersLen == 0 {
 		return nil, fmt.Errorf("cannot insert empty headers")
@@ -361,10 +414,11 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 	firstHeaderOfExtensionChain := headers[0]
 
 	store := newStoreWithExtensionChain(readStore, headersLen)
+	chainCtx := l.ctx.withCheckpoints(checkpoints, store, currentTip.height)
 
 	if firstHeaderOfExtensionChain.PrevBlock.IsEqual(&currentTipHash) {
 		// most common case - extending of current tip
-		if err := l.processNewHeadersChain(store, currentTip, headers); err != nil {
+		if err := l.processNewHeadersChain(store, chainCtx, currentTip, headers); err != nil {
 			return nil, err
 		}
 
@@ -383,7 +437,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 
 		forkParentInfo := toLocalInfo(forkParent)
 
-		if err := l.processNewHeadersChain(store, forkParentInfo, headers); err != nil {
+		if err := l.processNewHeadersChain(store, chainCtx, forkParentInfo, headers); err != nil {
 			return nil, err
 		}
 
@@ -410,6 +464,7 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 // in store.
 func (l *BtcLightClient) checkHeader(
 	s *storeWithExtensionChain,
+	chainCtx *lightChainCtx,
 	parentHeaderInfo *localHeaderInfo,
 	blockHeader *wire.BlockHeader,
 ) error {
@@ -419,7 +474,7 @@ func (l *BtcLightClient) checkHeader(
 
 	var emptyFlags blockchain.BehaviorFlags
 	err := blockchain.CheckBlockHeaderContext(
-		blockHeader, parentHeaderCtx, emptyFlags, l.ctx, true,
+		blockHeader, parentHeaderCtx, emptyFlags, chainCtx, false,
 	)
 	if err != nil {
 		return err
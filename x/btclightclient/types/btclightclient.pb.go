@@ -80,8 +80,230 @@ func (m *BTCHeaderInfo) GetHeight() uint64 {
 	return 0
 }
 
+// DeepReorgAlarm is raised when a BTC reorg deeper than the maximum rollback
+// depth is submitted. While the alarm is raised no new headers are processed,
+// until governance resolves it
+type DeepReorgAlarm struct {
+	// rollback_from is the tip of the main chain when the reorg was submitted
+	RollbackFrom *BTCHeaderInfo `protobuf:"bytes,1,opt,name=rollback_from,json=rollbackFrom,proto3" json:"rollback_from,omitempty"`
+	// rollback_to is the greatest common ancestor of the main chain and the fork
+	RollbackTo *BTCHeaderInfo `protobuf:"bytes,2,opt,name=rollback_to,json=rollbackTo,proto3" json:"rollback_to,omitempty"`
+	// depth is the number of main chain headers that the reorg would roll back
+	Depth uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// fork_headers are the headers of the fork, starting from the child of
+	// rollback_to
+	ForkHeaders []github_com_babylonchain_babylon_types.BTCHeaderBytes `protobuf:"bytes,4,rep,name=fork_headers,json=forkHeaders,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderBytes" json:"fork_headers,omitempty"`
+	// reporter is the address of the reporter who submitted the fork, if any
+	Reporter string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *DeepReorgAlarm) Reset()         { *m = DeepReorgAlarm{} }
+func (m *DeepReorgAlarm) String() string { return proto.CompactTextString(m) }
+func (*DeepReorgAlarm) ProtoMessage()    {}
+func (*DeepReorgAlarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{1}
+}
+func (m *DeepReorgAlarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeepReorgAlarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeepReorgAlarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeepReorgAlarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeepReorgAlarm.Merge(m, src)
+}
+func (m *DeepReorgAlarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeepReorgAlarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeepReorgAlarm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeepReorgAlarm proto.InternalMessageInfo
+
+func (m *DeepReorgAlarm) GetRollbackFrom() *BTCHeaderInfo {
+	if m != nil {
+		return m.RollbackFrom
+	}
+	return nil
+}
+
+func (m *DeepReorgAlarm) GetRollbackTo() *BTCHeaderInfo {
+	if m != nil {
+		return m.RollbackTo
+	}
+	return nil
+}
+
+func (m *DeepReorgAlarm) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *DeepReorgAlarm) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+// AccumulatorRoot is the root of the Merkle Mountain Range accumulator over the
+// main chain headers at the end of a Babylon block
+type AccumulatorRoot struct {
+	// babylon_height is the height of the Babylon block
+	BabylonHeight uint64 `protobuf:"varint,1,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	// leaf_count is the number of main chain headers in the accumulator
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// root is the root of the accumulator
+	Root []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *AccumulatorRoot) Reset()         { *m = AccumulatorRoot{} }
+func (m *AccumulatorRoot) String() string { return proto.CompactTextString(m) }
+func (*AccumulatorRoot) ProtoMessage()    {}
+func (*AccumulatorRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{2}
+}
+func (m *AccumulatorRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulatorRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulatorRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulatorRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatorRoot.Merge(m, src)
+}
+func (m *AccumulatorRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulatorRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatorRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatorRoot proto.InternalMessageInfo
+
+func (m *AccumulatorRoot) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+func (m *AccumulatorRoot) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *AccumulatorRoot) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+// HeaderInclusionProof is a proof that a BTC header is included in the
+// accumulator over the main chain headers
+type HeaderInclusionProof struct {
+	// leaf_index is the index of the header in the accumulator, i.e., the
+	// distance between the header and the base header
+	LeafIndex uint64 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// leaf_count is the number of headers in the accumulator
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// siblings are the siblings of the path from the header to the peak of
+	// its mountain, from bottom to top
+	Siblings [][]byte `protobuf:"bytes,3,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// peaks are the peaks of the other mountains, from left to right
+	Peaks [][]byte `protobuf:"bytes,4,rep,name=peaks,proto3" json:"peaks,omitempty"`
+}
+
+func (m *HeaderInclusionProof) Reset()         { *m = HeaderInclusionProof{} }
+func (m *HeaderInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*HeaderInclusionProof) ProtoMessage()    {}
+func (*HeaderInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{3}
+}
+func (m *HeaderInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderInclusionProof.Merge(m, src)
+}
+func (m *HeaderInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderInclusionProof proto.InternalMessageInfo
+
+func (m *HeaderInclusionProof) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *HeaderInclusionProof) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *HeaderInclusionProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *HeaderInclusionProof) GetPeaks() [][]byte {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.btclightclient.v1.BTCHeaderInfo")
+	proto.RegisterType((*DeepReorgAlarm)(nil), "babylon.btclightclient.v1.DeepReorgAlarm")
+	proto.RegisterType((*AccumulatorRoot)(nil), "babylon.btclightclient.v1.AccumulatorRoot")
+	proto.RegisterType((*HeaderInclusionProof)(nil), "babylon.btclightclient.v1.HeaderInclusionProof")
 }
 
 func init() {
@@ -89,25 +311,40 @@ func init() {
 }
 
 var fileDescriptor_84bf438d909b681d = []byte{
-	// 282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0x68, 0x22, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0xf5, 0x7a,
-	0x68, 0xb2, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x55, 0xfa, 0x20, 0x16, 0x44,
-	0x83, 0xd2, 0x6f, 0x46, 0x2e, 0x5e, 0xa7, 0x10, 0x67, 0x8f, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0xcf,
-	0xbc, 0xb4, 0x7c, 0xa1, 0x00, 0x2e, 0xb6, 0x0c, 0x30, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xc7,
-	0xc9, 0xe2, 0xd6, 0x3d, 0x79, 0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0xa8, 0x0d, 0xc9, 0x19, 0x89, 0x99, 0x79, 0x30, 0x8e, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0xb1,
-	0x1e, 0xdc, 0x20, 0xa7, 0xca, 0x92, 0xd4, 0xe2, 0x20, 0xa8, 0x39, 0x42, 0x01, 0x5c, 0x2c, 0x19,
-	0x89, 0xc5, 0x19, 0x12, 0x4c, 0x60, 0xf3, 0x6c, 0x6e, 0xdd, 0x93, 0xb7, 0x20, 0xd1, 0x3c, 0x8f,
-	0xc4, 0xe2, 0x0c, 0x88, 0x99, 0x60, 0x93, 0x84, 0xc4, 0x40, 0x6e, 0x04, 0x79, 0x4f, 0x82, 0x59,
-	0x81, 0x51, 0x83, 0x25, 0x08, 0xca, 0x13, 0xd2, 0xe3, 0x62, 0x29, 0xcf, 0x2f, 0xca, 0x96, 0x60,
-	0x01, 0xdb, 0x24, 0x75, 0xeb, 0x9e, 0xbc, 0x58, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x71, 0x4a,
-	0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x6e, 0x62, 0x49, 0x86, 0x5e, 0x68, 0x66, 0x5e, 0x49, 0x10, 0x58,
-	0x9d, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0x11, 0x72,
-	0x61, 0x05, 0x7a, 0x94, 0x80, 0x9d, 0x9c, 0xc4, 0x06, 0x0e, 0x56, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x13, 0xc6, 0x69, 0x40, 0xb9, 0x01, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
+	0x18, 0xc6, 0xa3, 0xc4, 0x2d, 0xad, 0x92, 0x74, 0x20, 0x42, 0xf1, 0x02, 0x73, 0x42, 0x60, 0x90,
+	0x93, 0x4d, 0xb7, 0x31, 0x7a, 0xd8, 0xa5, 0xe9, 0x18, 0xc9, 0x61, 0x10, 0x44, 0x77, 0xd9, 0x0e,
+	0x41, 0x76, 0x14, 0xdb, 0xf8, 0xcf, 0x6b, 0x24, 0xa5, 0x6b, 0xbe, 0xc0, 0xce, 0xfb, 0x3a, 0xfb,
+	0x06, 0x3b, 0xf6, 0x38, 0x72, 0x08, 0x23, 0xf9, 0x18, 0xbb, 0x0c, 0xcb, 0x8e, 0xc7, 0x02, 0xa3,
+	0x94, 0x5d, 0x8c, 0x9e, 0xc7, 0x7a, 0x7f, 0xaf, 0xf4, 0xbc, 0x08, 0xdb, 0x2e, 0x73, 0x57, 0x31,
+	0xa4, 0x8e, 0xab, 0xbc, 0x38, 0xf4, 0x83, 0xfc, 0xcb, 0x53, 0xe5, 0xdc, 0x5e, 0x1c, 0x38, 0x76,
+	0x26, 0x40, 0x01, 0x79, 0x5a, 0xee, 0xb7, 0x0f, 0xfe, 0xde, 0x5e, 0x74, 0x3b, 0x3e, 0xf8, 0xa0,
+	0x77, 0x39, 0xf9, 0xaa, 0x28, 0x18, 0xfc, 0x42, 0xb8, 0x3d, 0xba, 0xb9, 0x1e, 0x73, 0x36, 0xe7,
+	0x62, 0x92, 0x2e, 0x80, 0x4c, 0xf1, 0x71, 0xa0, 0x95, 0x89, 0xfa, 0x68, 0xd8, 0x1a, 0x5d, 0xae,
+	0x37, 0xbd, 0x57, 0x7e, 0xa8, 0x82, 0xa5, 0x6b, 0x7b, 0x90, 0x38, 0x65, 0x07, 0x2f, 0x60, 0x61,
+	0xba, 0x17, 0x8e, 0x5a, 0x65, 0x5c, 0xda, 0x15, 0x68, 0xb4, 0x52, 0x5c, 0xd2, 0x92, 0x43, 0xa6,
+	0xd8, 0x08, 0x98, 0x0c, 0xcc, 0xba, 0xe6, 0xbd, 0x59, 0x6f, 0x7a, 0x97, 0x8f, 0xe4, 0x8d, 0x99,
+	0x0c, 0x0a, 0xa6, 0x26, 0x91, 0xf3, 0xfc, 0x8c, 0xf9, 0xf5, 0xcc, 0x46, 0x1f, 0x0d, 0x0d, 0x5a,
+	0x2a, 0x62, 0x63, 0xe3, 0x33, 0x88, 0xc8, 0x34, 0x74, 0xa7, 0xee, 0x7a, 0xd3, 0x3b, 0xf7, 0x40,
+	0x26, 0x20, 0xe5, 0x3c, 0xb2, 0x43, 0x70, 0x12, 0xa6, 0x02, 0xfb, 0x43, 0x98, 0x2a, 0xaa, 0xf7,
+	0x0d, 0xbe, 0xd5, 0xf1, 0xd9, 0x5b, 0xce, 0x33, 0xca, 0x41, 0xf8, 0x57, 0x31, 0x13, 0x09, 0x79,
+	0x8f, 0xdb, 0x02, 0xe2, 0xd8, 0x65, 0x5e, 0x34, 0x5b, 0x08, 0x48, 0x74, 0x0a, 0xcd, 0x17, 0x43,
+	0xfb, 0x9f, 0xc9, 0xda, 0x7f, 0xe5, 0x47, 0x5b, 0xfb, 0xf2, 0x77, 0x02, 0x12, 0x32, 0xc1, 0xcd,
+	0x0a, 0xa7, 0xc0, 0xac, 0x3f, 0x12, 0x86, 0xf7, 0xc5, 0x37, 0x40, 0x3a, 0xf8, 0x68, 0xce, 0x33,
+	0x15, 0x94, 0x77, 0x2e, 0x04, 0xf9, 0x84, 0x5b, 0x0b, 0x10, 0xd1, 0xac, 0xc8, 0x5a, 0x9a, 0x46,
+	0xbf, 0xf1, 0x5f, 0x43, 0x6b, 0xe6, 0xb4, 0xc2, 0x90, 0xa4, 0x8b, 0x4f, 0x04, 0xcf, 0x40, 0x28,
+	0x2e, 0xcc, 0xa3, 0x3e, 0x1a, 0x9e, 0xd2, 0x4a, 0x0f, 0x22, 0xfc, 0xe4, 0xca, 0xf3, 0x96, 0xc9,
+	0x32, 0x66, 0x0a, 0x04, 0x05, 0x50, 0xe4, 0x39, 0x3e, 0x2b, 0xd9, 0xb3, 0x72, 0x3c, 0x48, 0x1f,
+	0xb5, 0x5d, 0xba, 0xe3, 0x62, 0x4a, 0xcf, 0x30, 0x8e, 0x39, 0x5b, 0xcc, 0x3c, 0x58, 0xa6, 0x4a,
+	0x47, 0x62, 0xd0, 0xd3, 0xdc, 0xb9, 0xce, 0x0d, 0x42, 0xb0, 0x21, 0x00, 0x8a, 0xd1, 0xb6, 0xa8,
+	0x5e, 0x0f, 0xbe, 0x20, 0xdc, 0xd9, 0xc7, 0xe2, 0xc5, 0x4b, 0x19, 0x42, 0x3a, 0x15, 0x00, 0x8b,
+	0x8a, 0x15, 0xa6, 0x73, 0x7e, 0x67, 0xa2, 0x3f, 0xac, 0x49, 0x6e, 0x3c, 0xd4, 0xaa, 0x8b, 0x4f,
+	0x64, 0xe8, 0xc6, 0x61, 0xea, 0x4b, 0xb3, 0x91, 0x07, 0x47, 0x2b, 0x9d, 0xc7, 0x9d, 0x71, 0x16,
+	0x95, 0x89, 0xd2, 0x42, 0x8c, 0xa6, 0xdf, 0xb7, 0x16, 0xba, 0xdf, 0x5a, 0xe8, 0xe7, 0xd6, 0x42,
+	0x5f, 0x77, 0x56, 0xed, 0x7e, 0x67, 0xd5, 0x7e, 0xec, 0xac, 0xda, 0xc7, 0xd7, 0x0f, 0xc5, 0x7d,
+	0x77, 0xf8, 0x88, 0x75, 0xfe, 0xee, 0xb1, 0x7e, 0x88, 0x2f, 0x7f, 0x0f, 0x00, 0xd6, 0x01, 0xc2,
+	0x5c, 0xeb, 0x03, 0x00, 0x00,
 }
 
 func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
@@ -137,85 +374,707 @@ func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Height != 0 {
-		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Hash != nil {
-		{
-			size := m.Hash.Size()
-			i -= size
-			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size := m.Header.Size()
+			i -= size
+			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeepReorgAlarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeepReorgAlarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeepReorgAlarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ForkHeaders) > 0 {
+		for iNdEx := len(m.ForkHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.ForkHeaders[iNdEx].Size()
+				i -= size
+				if _, err := m.ForkHeaders[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Depth != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RollbackTo != nil {
+		{
+			size, err := m.RollbackTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RollbackFrom != nil {
+		{
+			size, err := m.RollbackFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulatorRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulatorRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulatorRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtclightclient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BTCHeaderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Height))
+	}
+	if m.Work != nil {
+		l = m.Work.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *DeepReorgAlarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RollbackFrom != nil {
+		l = m.RollbackFrom.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.RollbackTo != nil {
+		l = m.RollbackTo.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Depth))
+	}
+	if len(m.ForkHeaders) > 0 {
+		for _, e := range m.ForkHeaders {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *AccumulatorRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.BabylonHeight))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovBtclightclient(uint64(m.LeafCount))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *HeaderInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafIndex != 0 {
+		n += 1 + sovBtclightclient(uint64(m.LeafIndex))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovBtclightclient(uint64(m.LeafCount))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	if len(m.Peaks) > 0 {
+		for _, b := range m.Peaks {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBtclightclient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtclightclient(x uint64) (n int) {
+	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.Header = &v
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Work = &v
+			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeepReorgAlarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeepReorgAlarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeepReorgAlarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackFrom == nil {
+				m.RollbackFrom = &BTCHeaderInfo{}
+			}
+			if err := m.RollbackFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackTo == nil {
+				m.RollbackTo = &BTCHeaderInfo{}
+			}
+			if err := m.RollbackTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkHeaders", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
+			m.ForkHeaders = append(m.ForkHeaders, v)
+			if err := m.ForkHeaders[len(m.ForkHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Header != nil {
-		{
-			size := m.Header.Size()
-			i -= size
-			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtclightclient(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BTCHeaderInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
-	}
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBtclightclient(uint64(m.Height))
-	}
-	if m.Work != nil {
-		l = m.Work.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBtclightclient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtclightclient(x uint64) (n int) {
-	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+func (m *AccumulatorRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -238,17 +1097,17 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccumulatorRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccumulatorRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
 			}
-			var byteLen int
+			m.BabylonHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -258,30 +1117,33 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BabylonHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBtclightclient
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtclightclient
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderBytes
-			m.Header = &v
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -308,17 +1170,66 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
 			}
-			m.Height = 0
+			m.LeafIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -328,14 +1239,33 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.LeafIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -362,11 +1292,40 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Uint
-			m.Work = &v
-			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Peaks = append(m.Peaks, make([]byte, postIndex-iNdEx))
+			copy(m.Peaks[len(m.Peaks)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate verifies that the information inside the DeepReorgAlarm is valid.
+func (m *DeepReorgAlarm) Validate() error {
+	if m.RollbackFrom == nil {
+		return errors.New("rollback from header is nil")
+	}
+	if err := m.RollbackFrom.Validate(); err != nil {
+		return fmt.Errorf("invalid rollback from header: %w", err)
+	}
+	if m.RollbackTo == nil {
+		return errors.New("rollback to header is nil")
+	}
+	if err := m.RollbackTo.Validate(); err != nil {
+		return fmt.Errorf("invalid rollback to header: %w", err)
+	}
+	if m.RollbackFrom.Height <= m.RollbackTo.Height {
+		return fmt.Errorf("rollback from height %d is not higher than rollback to height %d",
+			m.RollbackFrom.Height, m.RollbackTo.Height)
+	}
+	if m.Depth != m.RollbackFrom.Height-m.RollbackTo.Height {
+		return fmt.Errorf("depth %d does not match the rollback heights", m.Depth)
+	}
+	if len(m.ForkHeaders) == 0 {
+		return errors.New("empty fork headers")
+	}
+	if !m.ForkHeaders[0].ParentHash().Eq(m.RollbackTo.Hash) {
+		return errors.New("fork does not start from the rollback to header")
+	}
+	for i := 1; i < len(m.ForkHeaders); i++ {
+		if !m.ForkHeaders[i].HasParent(&m.ForkHeaders[i-1]) {
+			return errors.New("fork headers do not form a chain")
+		}
+	}
+	if m.Reporter != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Reporter); err != nil {
+			return fmt.Errorf("invalid reporter: %w", err)
+		}
+	}
+	return nil
+}
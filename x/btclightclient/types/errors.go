@@ -16,4 +16,10 @@ var (
 	ErrChainWithNotEnoughWork   = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
 	ErrUnauthorizedReporter     = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
 	ErrInvalidMessageFormat     = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrHeadersProcessingHalted  = errorsmod.Register(ModuleName, 1108, "processing of headers is halted by a deep reorg alarm")
+	ErrNoDeepReorgAlarm         = errorsmod.Register(ModuleName, 1109, "there is no deep reorg alarm to resolve")
+	ErrInvalidTrustedCheckpoint = errorsmod.Register(ModuleName, 1110, "trusted checkpoint conflicts with the main chain")
+	ErrAccumulatorRootNotFound  = errorsmod.Register(ModuleName, 1111, "accumulator root not found")
+	ErrForkRejected             = errorsmod.Register(ModuleName, 1112, "the fork is rejected by governance")
+	ErrAccumulatorNodeNotFound  = errorsmod.Register(ModuleName, 1113, "accumulator node not found")
 )
@@ -165,10 +165,106 @@ func (m *EventBTCHeaderInserted) GetHeader() *BTCHeaderInfo {
 	return nil
 }
 
+// EventBTCDeepReorgAlarm is emitted on Msg/InsertHeader when the submitted
+// fork would roll back more headers than the maximum rollback depth. The fork
+// is not applied and the processing of headers is halted until governance
+// resolves the alarm
+type EventBTCDeepReorgAlarm struct {
+	Alarm *DeepReorgAlarm `protobuf:"bytes,1,opt,name=alarm,proto3" json:"alarm,omitempty"`
+}
+
+func (m *EventBTCDeepReorgAlarm) Reset()         { *m = EventBTCDeepReorgAlarm{} }
+func (m *EventBTCDeepReorgAlarm) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDeepReorgAlarm) ProtoMessage()    {}
+func (*EventBTCDeepReorgAlarm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519f2d655b639c5a, []int{3}
+}
+func (m *EventBTCDeepReorgAlarm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDeepReorgAlarm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDeepReorgAlarm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDeepReorgAlarm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDeepReorgAlarm.Merge(m, src)
+}
+func (m *EventBTCDeepReorgAlarm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDeepReorgAlarm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDeepReorgAlarm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDeepReorgAlarm proto.InternalMessageInfo
+
+func (m *EventBTCDeepReorgAlarm) GetAlarm() *DeepReorgAlarm {
+	if m != nil {
+		return m.Alarm
+	}
+	return nil
+}
+
+// EventBTCDeepReorgResolved is emitted on Msg/ResolveDeepReorg
+type EventBTCDeepReorgResolved struct {
+	// fork_applied is true if the fork of the alarm has been applied
+	ForkApplied bool `protobuf:"varint,1,opt,name=fork_applied,json=forkApplied,proto3" json:"fork_applied,omitempty"`
+}
+
+func (m *EventBTCDeepReorgResolved) Reset()         { *m = EventBTCDeepReorgResolved{} }
+func (m *EventBTCDeepReorgResolved) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDeepReorgResolved) ProtoMessage()    {}
+func (*EventBTCDeepReorgResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_519f2d655b639c5a, []int{4}
+}
+func (m *EventBTCDeepReorgResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDeepReorgResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDeepReorgResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDeepReorgResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDeepReorgResolved.Merge(m, src)
+}
+func (m *EventBTCDeepReorgResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDeepReorgResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDeepReorgResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDeepReorgResolved proto.InternalMessageInfo
+
+func (m *EventBTCDeepReorgResolved) GetForkApplied() bool {
+	if m != nil {
+		return m.ForkApplied
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventBTCRollBack)(nil), "babylon.btclightclient.v1.EventBTCRollBack")
 	proto.RegisterType((*EventBTCRollForward)(nil), "babylon.btclightclient.v1.EventBTCRollForward")
 	proto.RegisterType((*EventBTCHeaderInserted)(nil), "babylon.btclightclient.v1.EventBTCHeaderInserted")
+	proto.RegisterType((*EventBTCDeepReorgAlarm)(nil), "babylon.btclightclient.v1.EventBTCDeepReorgAlarm")
+	proto.RegisterType((*EventBTCDeepReorgResolved)(nil), "babylon.btclightclient.v1.EventBTCDeepReorgResolved")
 }
 
 func init() {
@@ -176,7 +272,7 @@ func init() {
 }
 
 var fileDescriptor_519f2d655b639c5a = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
+	// 299 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0x65, 0x86, 0xfa, 0xa9, 0x65, 0xa9, 0x79, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
@@ -186,12 +282,16 @@ var fileDescriptor_519f2d655b639c5a = []byte{
 	0x05, 0x46, 0x0d, 0x6e, 0x23, 0x0d, 0x3d, 0x9c, 0xf6, 0xe9, 0x39, 0x85, 0x38, 0x7b, 0x80, 0xd5,
 	0x7a, 0xe6, 0xa5, 0xe5, 0x07, 0x41, 0xf5, 0x29, 0x85, 0x73, 0x09, 0x23, 0x9b, 0xea, 0x96, 0x5f,
 	0x54, 0x9e, 0x58, 0x94, 0x42, 0x05, 0x83, 0xa3, 0xb8, 0xc4, 0x60, 0x06, 0xc3, 0x64, 0x8b, 0x53,
-	0x8b, 0x4a, 0x52, 0xa9, 0x60, 0xb6, 0x53, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0x99, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x4d,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0xd0, 0x83, 0xbb, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0x1c, 0xc6, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0x0b, 0x33, 0xea,
-	0xd7, 0x01, 0x00, 0x00,
+	0x8b, 0x4a, 0x52, 0xa9, 0x61, 0x76, 0x24, 0xc2, 0x6c, 0x97, 0xd4, 0xd4, 0x82, 0xa0, 0xd4, 0xfc,
+	0xa2, 0x74, 0xc7, 0x9c, 0xc4, 0xa2, 0x5c, 0x21, 0x7b, 0x2e, 0xd6, 0x44, 0x10, 0x03, 0x6a, 0xb4,
+	0x26, 0x1e, 0xa3, 0x51, 0x75, 0x06, 0x41, 0xf4, 0x29, 0xd9, 0x71, 0x49, 0x62, 0x18, 0x1d, 0x94,
+	0x5a, 0x9c, 0x9f, 0x53, 0x96, 0x9a, 0x22, 0xa4, 0xc8, 0xc5, 0x93, 0x96, 0x5f, 0x94, 0x1d, 0x9f,
+	0x58, 0x50, 0x90, 0x93, 0x99, 0x9a, 0x02, 0xb6, 0x84, 0x23, 0x88, 0x1b, 0x24, 0xe6, 0x08, 0x11,
+	0x72, 0x0a, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27,
+	0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xb3, 0xf4, 0xcc,
+	0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0xab, 0x92, 0x33, 0x12, 0x33, 0xf3,
+	0x60, 0x1c, 0xfd, 0x0a, 0xf4, 0x94, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x7e,
+	0x63, 0xc0, 0x00, 0x7a, 0xc4, 0x2f, 0x09, 0x72, 0x02, 0x00, 0x00,
 }
 
 func (m *EventBTCRollBack) Marshal() (dAtA []byte, err error) {
@@ -299,6 +399,74 @@ func (m *EventBTCHeaderInserted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBTCDeepReorgAlarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDeepReorgAlarm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDeepReorgAlarm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Alarm != nil {
+		{
+			size, err := m.Alarm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDeepReorgResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDeepReorgResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDeepReorgResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForkApplied {
+		i--
+		if m.ForkApplied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -349,6 +517,31 @@ func (m *EventBTCHeaderInserted) Size() (n int) {
 	return n
 }
 
+func (m *EventBTCDeepReorgAlarm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Alarm != nil {
+		l = m.Alarm.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCDeepReorgResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForkApplied {
+		n += 2
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -613,6 +806,162 @@ func (m *EventBTCHeaderInserted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBTCDeepReorgAlarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDeepReorgAlarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDeepReorgAlarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alarm == nil {
+				m.Alarm = &DeepReorgAlarm{}
+			}
+			if err := m.Alarm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDeepReorgResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDeepReorgResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDeepReorgResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkApplied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForkApplied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
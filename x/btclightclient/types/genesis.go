@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func SimnetGenesisBlock() BTCHeaderInfo {
@@ -39,8 +41,14 @@ func DefaultGenesis() *GenesisState {
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The retarget interval of the Bitcoin mainnet is assumed.
 func (gs GenesisState) Validate() error {
+	return gs.ValidateWithBtcNet(&chaincfg.MainNetParams)
+}
+
+// ValidateWithBtcNet performs basic genesis state validation against the given
+// Bitcoin network parameters, returning an error upon any failure.
+func (gs GenesisState) ValidateWithBtcNet(btcNet *chaincfg.Params) error {
 	if err := gs.Params.Validate(); err != nil {
 		return fmt.Errorf("invalid params in genesis: %w", err)
 	}
@@ -57,10 +65,7 @@ func (gs GenesisState) Validate() error {
 
 	// We Require that genesis block is difficulty adjustment block, so that we can
 	// properly calculate the difficulty adjustments in the future.
-	// TODO: Even though number of block per re-target depends on the network, in reality it
-	// is always 2016. Maybe we should consider moving it to param, or try to pass
-	// it through
-	isRetarget := IsRetargetBlock(gs.BtcHeaders[0], &chaincfg.MainNetParams)
+	isRetarget := IsRetargetBlock(gs.BtcHeaders[0], btcNet)
 	if !isRetarget {
 		return fmt.Errorf("genesis block must be a difficulty adjustment block")
 	}
@@ -72,6 +77,56 @@ func (gs GenesisState) Validate() error {
 	}
 	// TODO: validate headers have proper parent-child relationships and proper proof of work
 
+	headerHashes := make(map[string]struct{}, len(gs.BtcHeaders))
+	for _, header := range gs.BtcHeaders {
+		headerHashes[header.Hash.String()] = struct{}{}
+	}
+	reportedHashes := make(map[string]struct{}, len(gs.HeaderReporters))
+	for _, entry := range gs.HeaderReporters {
+		if entry.Hash == nil {
+			return errors.New("header reporter entry without header hash")
+		}
+		hash := entry.Hash.String()
+		if _, ok := headerHashes[hash]; !ok {
+			return fmt.Errorf("reporter of unknown header %s", hash)
+		}
+		if _, ok := reportedHashes[hash]; ok {
+			return fmt.Errorf("duplicate reporter of header %s", hash)
+		}
+		reportedHashes[hash] = struct{}{}
+		if _, err := sdk.AccAddressFromBech32(entry.Reporter); err != nil {
+			return fmt.Errorf("invalid reporter of header %s: %w", hash, err)
+		}
+	}
+
+	if gs.DeepReorgAlarm != nil {
+		if err := gs.DeepReorgAlarm.Validate(); err != nil {
+			return fmt.Errorf("invalid deep reorg alarm: %w", err)
+		}
+	}
+
+	rejectedForks := make(map[string]struct{}, len(gs.RejectedForks))
+	for _, hash := range gs.RejectedForks {
+		if len(hash) != bbn.BTCHeaderHashLen {
+			return errors.New("invalid hash of rejected fork")
+		}
+		if _, ok := rejectedForks[hash.MarshalHex()]; ok {
+			return fmt.Errorf("duplicate rejected fork %s", hash.MarshalHex())
+		}
+		rejectedForks[hash.MarshalHex()] = struct{}{}
+	}
+
+	rootHeights := make(map[uint64]struct{}, len(gs.AccumulatorRoots))
+	for _, root := range gs.AccumulatorRoots {
+		if len(root.Root) != sha256.Size {
+			return fmt.Errorf("invalid accumulator root at Babylon height %d", root.BabylonHeight)
+		}
+		if _, ok := rootHeights[root.BabylonHeight]; ok {
+			return fmt.Errorf("duplicate accumulator root at Babylon height %d", root.BabylonHeight)
+		}
+		rootHeights[root.BabylonHeight] = struct{}{}
+	}
+
 	return nil
 }
 
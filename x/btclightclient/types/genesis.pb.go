@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +28,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BtcHeaders []*BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	// header_reporters are the reporters of the headers in btc_headers that
+	// were inserted through MsgInsertHeaders
+	HeaderReporters []*HeaderReporterEntry `protobuf:"bytes,3,rep,name=header_reporters,json=headerReporters,proto3" json:"header_reporters,omitempty"`
+	// deep_reorg_alarm is the pending deep reorg alarm, if any
+	DeepReorgAlarm *DeepReorgAlarm `protobuf:"bytes,4,opt,name=deep_reorg_alarm,json=deepReorgAlarm,proto3" json:"deep_reorg_alarm,omitempty"`
+	// accumulator_roots are the historical roots of the accumulator over the
+	// main chain headers
+	AccumulatorRoots []*AccumulatorRoot `protobuf:"bytes,5,rep,name=accumulator_roots,json=accumulatorRoots,proto3" json:"accumulator_roots,omitempty"`
+	// rejected_forks are the hashes of the first headers of the forks rejected
+	// by governance
+	RejectedForks []github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,6,rep,name=rejected_forks,json=rejectedForks,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"rejected_forks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,8 +88,78 @@ func (m *GenesisState) GetBtcHeaders() []*BTCHeaderInfo {
 	return nil
 }
 
+func (m *GenesisState) GetHeaderReporters() []*HeaderReporterEntry {
+	if m != nil {
+		return m.HeaderReporters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeepReorgAlarm() *DeepReorgAlarm {
+	if m != nil {
+		return m.DeepReorgAlarm
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatorRoots() []*AccumulatorRoot {
+	if m != nil {
+		return m.AccumulatorRoots
+	}
+	return nil
+}
+
+// HeaderReporterEntry is the reporter of a BTC header
+type HeaderReporterEntry struct {
+	// hash is the hash of the BTC header
+	Hash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,1,opt,name=hash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"hash,omitempty"`
+	// reporter is the address of the reporter in bech32 string
+	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *HeaderReporterEntry) Reset()         { *m = HeaderReporterEntry{} }
+func (m *HeaderReporterEntry) String() string { return proto.CompactTextString(m) }
+func (*HeaderReporterEntry) ProtoMessage()    {}
+func (*HeaderReporterEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f95902e4096217a, []int{1}
+}
+func (m *HeaderReporterEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderReporterEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderReporterEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderReporterEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderReporterEntry.Merge(m, src)
+}
+func (m *HeaderReporterEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderReporterEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderReporterEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderReporterEntry proto.InternalMessageInfo
+
+func (m *HeaderReporterEntry) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btclightclient.v1.GenesisState")
+	proto.RegisterType((*HeaderReporterEntry)(nil), "babylon.btclightclient.v1.HeaderReporterEntry")
 }
 
 func init() {
@@ -85,23 +167,36 @@ func init() {
 }
 
 var fileDescriptor_4f95902e4096217a = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x92, 0x50, 0x85, 0x7a, 0xa8, 0x0a, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x29, 0x3d, 0xdc, 0x26, 0xa3, 0x19, 0x01, 0x51,
-	0xaf, 0x86, 0x5b, 0x7d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x21, 0x4a, 0xb3, 0x18, 0xb9, 0x78,
-	0xdc, 0x21, 0x4e, 0x0b, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe7, 0x62, 0x83, 0x28, 0x90, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd4, 0xc3, 0xe9, 0x54, 0xbd, 0x00, 0xb0, 0x42, 0x27, 0x96,
-	0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xda, 0x84, 0x3c, 0xb9, 0xb8, 0x93, 0x4a, 0x92, 0xe3, 0x33,
-	0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf0, 0x98,
-	0xe2, 0x14, 0xe2, 0xec, 0x01, 0x56, 0xec, 0x99, 0x97, 0x96, 0x1f, 0xc4, 0x95, 0x54, 0x92, 0x0c,
-	0xe1, 0x16, 0x3b, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xe4, 0xe4, 0x8c, 0xc4,
-	0xcc, 0x3c, 0x18, 0x47, 0xbf, 0x02, 0xdd, 0xe3, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x5f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xed, 0x4f, 0xca, 0x25, 0xa9, 0x01, 0x00, 0x00,
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x26, 0x44, 0x70, 0x29, 0x25, 0x1c, 0x0c, 0x26, 0x83, 0x1b, 0x3a, 0x80, 0x61,
+	0xb0, 0xd5, 0x22, 0x21, 0x06, 0x24, 0x54, 0xf3, 0xaf, 0xdd, 0xa2, 0x2b, 0x12, 0x82, 0xc5, 0x3a,
+	0x5f, 0xde, 0xfa, 0x02, 0x89, 0xcf, 0xba, 0x7b, 0x53, 0x91, 0x99, 0x85, 0x91, 0x8f, 0xd5, 0xb1,
+	0x23, 0x62, 0xa8, 0x50, 0xf2, 0x45, 0x90, 0xcf, 0x0e, 0x55, 0x22, 0x62, 0x06, 0x16, 0xcb, 0xcf,
+	0xe9, 0x79, 0x7e, 0xef, 0xe3, 0xf3, 0x4b, 0x1e, 0x26, 0x3c, 0x99, 0x8d, 0x55, 0x16, 0x26, 0x28,
+	0xc6, 0xa3, 0x54, 0x16, 0x4f, 0xc8, 0x30, 0x3c, 0xdb, 0x0f, 0x53, 0xc8, 0xc0, 0x8c, 0x4c, 0x90,
+	0x6b, 0x85, 0x8a, 0xde, 0xab, 0x8c, 0xc1, 0xaa, 0x31, 0x38, 0xdb, 0xef, 0xdd, 0x4d, 0x55, 0xaa,
+	0xac, 0x2b, 0x2c, 0xde, 0xca, 0x40, 0x2f, 0xd8, 0x4c, 0x5e, 0x43, 0x94, 0xfe, 0x07, 0x9b, 0xfd,
+	0x39, 0xd7, 0x7c, 0x52, 0x15, 0xd9, 0xfb, 0xd6, 0x22, 0xdb, 0x6f, 0xcb, 0x6a, 0x27, 0xc8, 0x11,
+	0xe8, 0x0b, 0xd2, 0x2e, 0x0d, 0xae, 0xd3, 0x77, 0xfc, 0xce, 0xc1, 0xfd, 0x60, 0x63, 0xd5, 0x60,
+	0x60, 0x8d, 0x51, 0xeb, 0xfc, 0x72, 0xb7, 0xc1, 0xaa, 0x18, 0x3d, 0x26, 0x9d, 0x04, 0x45, 0x2c,
+	0x81, 0x0f, 0x41, 0x1b, 0x77, 0xab, 0xdf, 0xf4, 0x3b, 0x07, 0x7e, 0x0d, 0x25, 0x7a, 0xf7, 0xf2,
+	0xc8, 0x9a, 0x8f, 0xb3, 0x53, 0xc5, 0x48, 0x82, 0xa2, 0x94, 0x86, 0x7e, 0x20, 0xdd, 0x12, 0x13,
+	0x6b, 0xc8, 0x95, 0xc6, 0x82, 0xd7, 0xb4, 0xbc, 0xa0, 0x86, 0x57, 0xa6, 0x59, 0x95, 0x78, 0x9d,
+	0xa1, 0x9e, 0xb1, 0x5b, 0x72, 0xe5, 0xd0, 0xd0, 0x13, 0xd2, 0x1d, 0x02, 0xe4, 0xb1, 0x06, 0xa5,
+	0xd3, 0x98, 0x8f, 0xb9, 0x9e, 0xb8, 0x2d, 0xfb, 0xc1, 0x8f, 0x6a, 0xd0, 0xaf, 0x00, 0x72, 0x56,
+	0x24, 0x0e, 0x8b, 0x00, 0xdb, 0x19, 0xae, 0x68, 0xfa, 0x9e, 0xdc, 0xe6, 0x42, 0x4c, 0x27, 0xd3,
+	0x31, 0x47, 0xa5, 0x63, 0xad, 0x14, 0x1a, 0xf7, 0x9a, 0x2d, 0xfc, 0xb8, 0x86, 0x7a, 0x78, 0x95,
+	0x61, 0x4a, 0x21, 0xeb, 0xf2, 0xd5, 0x03, 0x43, 0x05, 0xd9, 0xd1, 0xf0, 0x09, 0x04, 0xc2, 0x30,
+	0x3e, 0x55, 0xfa, 0xb3, 0x71, 0xdb, 0xfd, 0xa6, 0xbf, 0x1d, 0x3d, 0xff, 0x79, 0xb9, 0xfb, 0x2c,
+	0x1d, 0xa1, 0x9c, 0x26, 0x81, 0x50, 0x93, 0xb0, 0x9a, 0x21, 0x24, 0x1f, 0x65, 0x4b, 0x11, 0xe2,
+	0x2c, 0x07, 0x73, 0x75, 0xcb, 0x47, 0xdc, 0xc8, 0x68, 0x86, 0x60, 0xd8, 0xcd, 0x25, 0xf3, 0x4d,
+	0x81, 0xdc, 0xfb, 0xea, 0x90, 0x3b, 0x7f, 0xb9, 0x3b, 0x3a, 0x20, 0x2d, 0xc9, 0x8d, 0xb4, 0xfb,
+	0xf0, 0xbf, 0x23, 0x2d, 0x89, 0xf6, 0xc8, 0xf5, 0xe5, 0x0f, 0x75, 0xb7, 0xfa, 0x8e, 0x7f, 0x83,
+	0xfd, 0xd1, 0xd1, 0xe0, 0x7c, 0xee, 0x39, 0x17, 0x73, 0xcf, 0xf9, 0x35, 0xf7, 0x9c, 0xef, 0x0b,
+	0xaf, 0x71, 0xb1, 0xf0, 0x1a, 0x3f, 0x16, 0x5e, 0xe3, 0xe3, 0xd3, 0x7f, 0x4d, 0xfd, 0xb2, 0xbe,
+	0xec, 0xb6, 0x46, 0xd2, 0xb6, 0x9b, 0xfe, 0xe4, 0xf7, 0x00, 0xbd, 0xa3, 0x64, 0x15, 0x9d, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +219,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RejectedForks) > 0 {
+		for iNdEx := len(m.RejectedForks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RejectedForks[iNdEx].Size()
+				i -= size
+				if _, err := m.RejectedForks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccumulatorRoots) > 0 {
+		for iNdEx := len(m.AccumulatorRoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatorRoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DeepReorgAlarm != nil {
+		{
+			size, err := m.DeepReorgAlarm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HeaderReporters) > 0 {
+		for iNdEx := len(m.HeaderReporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeaderReporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.BtcHeaders) > 0 {
 		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +300,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HeaderReporterEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderReporterEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderReporterEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -176,6 +367,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HeaderReporters) > 0 {
+		for _, e := range m.HeaderReporters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeepReorgAlarm != nil {
+		l = m.DeepReorgAlarm.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AccumulatorRoots) > 0 {
+		for _, e := range m.AccumulatorRoots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RejectedForks) > 0 {
+		for _, e := range m.RejectedForks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeaderReporterEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -281,6 +511,262 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderReporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderReporters = append(m.HeaderReporters, &HeaderReporterEntry{})
+			if err := m.HeaderReporters[len(m.HeaderReporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeepReorgAlarm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeepReorgAlarm == nil {
+				m.DeepReorgAlarm = &DeepReorgAlarm{}
+			}
+			if err := m.DeepReorgAlarm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatorRoots = append(m.AccumulatorRoots, &AccumulatorRoot{})
+			if err := m.AccumulatorRoots[len(m.AccumulatorRoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedForks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.RejectedForks = append(m.RejectedForks, v)
+			if err := m.RejectedForks[len(m.RejectedForks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderReporterEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderReporterEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderReporterEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,11 +3,15 @@ package types_test
 import (
 	"testing"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	reporter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -32,6 +36,71 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid genesis state with header reporter",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				gs.HeaderReporters = []*types.HeaderReporterEntry{{
+					Hash:     gs.BtcHeaders[0].Hash,
+					Reporter: reporter.String(),
+				}}
+				return gs
+			}(),
+			valid: true,
+		},
+		{
+			desc: "invalid genesis state, reporter of unknown header",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				unknownHash := bbn.BTCHeaderHashBytes(make([]byte, bbn.BTCHeaderHashLen))
+				gs.HeaderReporters = []*types.HeaderReporterEntry{{
+					Hash:     &unknownHash,
+					Reporter: reporter.String(),
+				}}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, duplicate trusted checkpoints",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				cp := types.TrustedCheckpoint{Height: 0, Hash: gs.BtcHeaders[0].Hash}
+				gs.Params.TrustedCheckpoints = []types.TrustedCheckpoint{cp, cp}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, deep reorg alarm without fork",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				gs.DeepReorgAlarm = &types.DeepReorgAlarm{
+					RollbackFrom: gs.BtcHeaders[0],
+					RollbackTo:   gs.BtcHeaders[0],
+				}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, duplicate rejected forks",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				gs.RejectedForks = []bbn.BTCHeaderHashBytes{*gs.BtcHeaders[0].Hash, *gs.BtcHeaders[0].Hash}
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state, invalid accumulator root",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesis()
+				gs.AccumulatorRoots = []*types.AccumulatorRoot{{BabylonHeight: 1, LeafCount: 1, Root: []byte{0x01}}}
+				return gs
+			}(),
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -23,9 +23,15 @@ const (
 )
 
 var (
-	HeadersObjectPrefix = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
-	HashToHeightPrefix  = []byte{0x02} // reserve this namespace mapping: Hash -> Height
-	ParamsKey           = []byte{0x03} // key for params
+	HeadersObjectPrefix     = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
+	HashToHeightPrefix      = []byte{0x02} // reserve this namespace mapping: Hash -> Height
+	ParamsKey               = []byte{0x03} // key for params
+	HashToReporterPrefix    = []byte{0x04} // reserve this namespace mapping: Hash -> Reporter address
+	DeepReorgAlarmKey       = []byte{0x05} // key for the pending deep reorg alarm
+	AccumulatorPrefix       = []byte{0x06} // reserve this namespace mapping: Position -> accumulator node
+	AccumulatorRootsPrefix  = []byte{0x07} // reserve this namespace mapping: Babylon height -> AccumulatorRoot
+	AccumulatorLeafCountKey = []byte{0x08} // key for the number of headers in the accumulator
+	RejectedForkPrefix      = []byte{0x09} // reserve this namespace mapping: Hash -> empty, for the first headers of rejected forks
 )
 
 func HeadersObjectKey(height uint64) []byte {
@@ -35,3 +41,19 @@ func HeadersObjectKey(height uint64) []byte {
 func HeadersObjectHeightKey(hash *bbn.BTCHeaderHashBytes) []byte {
 	return hash.MustMarshal()
 }
+
+func HeaderReporterKey(hash *bbn.BTCHeaderHashBytes) []byte {
+	return hash.MustMarshal()
+}
+
+func AccumulatorNodeKey(pos uint64) []byte {
+	return sdk.Uint64ToBigEndian(pos)
+}
+
+func AccumulatorRootKey(babylonHeight uint64) []byte {
+	return sdk.Uint64ToBigEndian(babylonHeight)
+}
+
+func RejectedForkKey(hash *bbn.BTCHeaderHashBytes) []byte {
+	return hash.MustMarshal()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	bbn "github.com/babylonchain/babylon/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -44,15 +45,46 @@ func ValidateAddressList(i interface{}) error {
 	return nil
 }
 
+func ValidateTrustedCheckpoints(i interface{}) error {
+	checkpoints, ok := i.([]TrustedCheckpoint)
+
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	heights := make(map[uint64]struct{}, len(checkpoints))
+	for _, cp := range checkpoints {
+		if cp.Hash == nil || cp.Hash.Size() != bbn.BTCHeaderHashLen {
+			return fmt.Errorf("invalid hash of trusted checkpoint at height %d", cp.Height)
+		}
+		if _, ok := heights[cp.Height]; ok {
+			return fmt.Errorf("duplicate trusted checkpoint at height %d", cp.Height)
+		}
+		heights[cp.Height] = struct{}{}
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := ValidateAddressList(p.InsertHeadersAllowList); err != nil {
 		return err
 	}
 
+	if err := ValidateTrustedCheckpoints(p.TrustedCheckpoints); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (p *Params) AllowAllReporters() bool {
 	return len(p.InsertHeadersAllowList) == 0
 }
+
+// IsRollbackTooDeep returns true if a reorg rolling back the given number of
+// main chain headers exceeds the maximum rollback depth
+func (p *Params) IsRollbackTooDeep(depth uint64) bool {
+	return p.MaxRollbackDepth > 0 && depth > p.MaxRollbackDepth
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -28,6 +29,15 @@ type Params struct {
 	// List of addresses which are allowed to insert headers to btc light client
 	// if the list is empty, any address can insert headers
 	InsertHeadersAllowList []string `protobuf:"bytes,1,rep,name=insert_headers_allow_list,json=insertHeadersAllowList,proto3" json:"insert_headers_allow_list,omitempty"`
+	// List of trusted BTC checkpoints. The light client never accepts a header
+	// that conflicts with a checkpoint, nor a fork that starts below the
+	// highest checkpoint on the main chain
+	TrustedCheckpoints []TrustedCheckpoint `protobuf:"bytes,2,rep,name=trusted_checkpoints,json=trustedCheckpoints,proto3" json:"trusted_checkpoints"`
+	// Maximum number of main chain headers that can be rolled back by a single
+	// reorg. A deeper reorg is not applied, halts the processing of new headers
+	// and raises an alarm that has to be resolved by governance.
+	// 0 means that reorgs of any depth are applied
+	MaxRollbackDepth uint64 `protobuf:"varint,3,opt,name=max_rollback_depth,json=maxRollbackDepth,proto3" json:"max_rollback_depth,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,8 +80,71 @@ func (m *Params) GetInsertHeadersAllowList() []string {
 	return nil
 }
 
+func (m *Params) GetTrustedCheckpoints() []TrustedCheckpoint {
+	if m != nil {
+		return m.TrustedCheckpoints
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRollbackDepth() uint64 {
+	if m != nil {
+		return m.MaxRollbackDepth
+	}
+	return 0
+}
+
+// TrustedCheckpoint is a BTC header that is known to be in the canonical chain
+type TrustedCheckpoint struct {
+	// height is the height of the header
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// hash is the hash of the header
+	Hash *github_com_babylonchain_babylon_types.BTCHeaderHashBytes `protobuf:"bytes,2,opt,name=hash,proto3,customtype=github.com/babylonchain/babylon/types.BTCHeaderHashBytes" json:"hash,omitempty"`
+}
+
+func (m *TrustedCheckpoint) Reset()         { *m = TrustedCheckpoint{} }
+func (m *TrustedCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*TrustedCheckpoint) ProtoMessage()    {}
+func (*TrustedCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4c5f7a17079e1f, []int{1}
+}
+func (m *TrustedCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedCheckpoint.Merge(m, src)
+}
+func (m *TrustedCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustedCheckpoint proto.InternalMessageInfo
+
+func (m *TrustedCheckpoint) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
+	proto.RegisterType((*TrustedCheckpoint)(nil), "babylon.btclightclient.v1.TrustedCheckpoint")
 }
 
 func init() {
@@ -79,21 +152,30 @@ func init() {
 }
 
 var fileDescriptor_1e4c5f7a17079e1f = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x92, 0x50, 0x75, 0x7a, 0xa8, 0xea, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x25, 0x4f, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x42, 0x96,
-	0x5c, 0x92, 0x99, 0x79, 0xc5, 0xa9, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x89, 0x29, 0xa9, 0x45, 0xc5,
-	0xf1, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0x9c, 0x41, 0x62, 0x10, 0x05, 0x1e, 0x10, 0x79, 0x47, 0x90, 0xb4, 0x4f, 0x66, 0x71, 0x89, 0x15,
-	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x01, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x96, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x60,
-	0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0xee, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0x1b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x30, 0xe4, 0x07, 0x29, 0xfe,
-	0x00, 0x00, 0x00,
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcd, 0x4a, 0xeb, 0x40,
+	0x14, 0xce, 0xb4, 0xa1, 0x70, 0xe7, 0xde, 0xc5, 0x75, 0x94, 0x92, 0xba, 0x48, 0x43, 0x17, 0x92,
+	0x45, 0x49, 0xa8, 0x82, 0xa8, 0xb8, 0x31, 0x75, 0xd1, 0x85, 0x8b, 0x12, 0xba, 0x72, 0x13, 0x26,
+	0xe9, 0x90, 0x09, 0x9d, 0x64, 0x42, 0x66, 0x5a, 0xdb, 0xb5, 0x2f, 0xe0, 0x23, 0xf8, 0x38, 0x5d,
+	0x76, 0x29, 0x22, 0x45, 0xda, 0x8d, 0x8f, 0x21, 0xf9, 0x51, 0xb0, 0x22, 0x6e, 0x86, 0x39, 0xf3,
+	0xfd, 0x9c, 0x6f, 0xce, 0x81, 0x47, 0x3e, 0xf6, 0x17, 0x8c, 0x27, 0xb6, 0x2f, 0x03, 0x16, 0x85,
+	0x34, 0x3f, 0x49, 0x22, 0xed, 0x59, 0xcf, 0x4e, 0x71, 0x86, 0x63, 0x61, 0xa5, 0x19, 0x97, 0x1c,
+	0xb5, 0x2a, 0x9e, 0xf5, 0x95, 0x67, 0xcd, 0x7a, 0x87, 0x07, 0x21, 0x0f, 0x79, 0xc1, 0xb2, 0xf3,
+	0x5b, 0x29, 0xe8, 0xbc, 0x00, 0xd8, 0x18, 0x16, 0x0e, 0xe8, 0x1c, 0xb6, 0xa2, 0x44, 0x90, 0x4c,
+	0x7a, 0x94, 0xe0, 0x31, 0xc9, 0x84, 0x87, 0x19, 0xe3, 0x77, 0x1e, 0x8b, 0x84, 0xd4, 0x80, 0x51,
+	0x37, 0xff, 0xb8, 0xcd, 0x92, 0x30, 0x28, 0xf1, 0xab, 0x1c, 0xbe, 0x89, 0x84, 0x44, 0x01, 0xdc,
+	0x97, 0xd9, 0x54, 0x48, 0x32, 0xf6, 0x02, 0x4a, 0x82, 0x49, 0xca, 0xa3, 0x44, 0x0a, 0xad, 0x66,
+	0xd4, 0xcd, 0xbf, 0xc7, 0x5d, 0xeb, 0xc7, 0x50, 0xd6, 0xa8, 0x54, 0xf5, 0x3f, 0x45, 0x8e, 0xba,
+	0x5c, 0xb7, 0x15, 0x17, 0xc9, 0x5d, 0x40, 0xa0, 0x2e, 0x44, 0x31, 0x9e, 0x7b, 0x19, 0x67, 0xcc,
+	0xc7, 0xc1, 0xc4, 0x1b, 0x93, 0x54, 0x52, 0xad, 0x6e, 0x00, 0x53, 0x75, 0xff, 0xc7, 0x78, 0xee,
+	0x56, 0xc0, 0x75, 0xfe, 0x7e, 0xa1, 0xbe, 0x3d, 0xb6, 0x41, 0xe7, 0x1e, 0xc0, 0xbd, 0x6f, 0x3d,
+	0x50, 0x13, 0x36, 0x28, 0xc9, 0x83, 0x68, 0xa0, 0x50, 0x57, 0x15, 0x1a, 0x42, 0x95, 0x62, 0x41,
+	0xb5, 0x9a, 0x01, 0xcc, 0x7f, 0xce, 0xe5, 0xf3, 0xba, 0x7d, 0x16, 0x46, 0x92, 0x4e, 0x7d, 0x2b,
+	0xe0, 0xb1, 0x5d, 0xfd, 0x22, 0xa0, 0x38, 0x4a, 0x3e, 0x0a, 0x5b, 0x2e, 0x52, 0x22, 0x2c, 0x67,
+	0xd4, 0x2f, 0x67, 0x32, 0xc0, 0x82, 0x3a, 0x0b, 0x49, 0x84, 0x5b, 0x38, 0x95, 0x29, 0x9c, 0xe1,
+	0x72, 0xa3, 0x83, 0xd5, 0x46, 0x07, 0xaf, 0x1b, 0x1d, 0x3c, 0x6c, 0x75, 0x65, 0xb5, 0xd5, 0x95,
+	0xa7, 0xad, 0xae, 0xdc, 0x9e, 0xfe, 0xe6, 0x3f, 0xdf, 0xdd, 0x78, 0xd1, 0xd0, 0x6f, 0x14, 0xdb,
+	0x3b, 0x79, 0x1f, 0x00, 0xa1, 0x2b, 0xdc, 0xbc, 0x18, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +205,48 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.TrustedCheckpoints) != len(that1.TrustedCheckpoints) {
+		return false
+	}
+	for i := range this.TrustedCheckpoints {
+		if !this.TrustedCheckpoints[i].Equal(&that1.TrustedCheckpoints[i]) {
+			return false
+		}
+	}
+	if this.MaxRollbackDepth != that1.MaxRollbackDepth {
+		return false
+	}
+	return true
+}
+func (this *TrustedCheckpoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TrustedCheckpoint)
+	if !ok {
+		that2, ok := that.(TrustedCheckpoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if that1.Hash == nil {
+		if this.Hash != nil {
+			return false
+		}
+	} else if !this.Hash.Equal(*that1.Hash) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +269,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRollbackDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRollbackDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TrustedCheckpoints) > 0 {
+		for iNdEx := len(m.TrustedCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.InsertHeadersAllowList) > 0 {
 		for iNdEx := len(m.InsertHeadersAllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InsertHeadersAllowList[iNdEx])
@@ -157,6 +300,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrustedCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -180,6 +363,31 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.TrustedCheckpoints) > 0 {
+		for _, e := range m.TrustedCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxRollbackDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxRollbackDepth))
+	}
+	return n
+}
+
+func (m *TrustedCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -250,6 +458,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InsertHeadersAllowList = append(m.InsertHeadersAllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedCheckpoints = append(m.TrustedCheckpoints, TrustedCheckpoint{})
+			if err := m.TrustedCheckpoints[len(m.TrustedCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRollbackDepth", wireType)
+			}
+			m.MaxRollbackDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRollbackDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -811,6 +811,214 @@ func (m *BTCHeaderInfoResponse) GetHeight() uint64 {
 	return 0
 }
 
+// QueryDeepReorgAlarmRequest is the request type for the
+// Query/DeepReorgAlarm RPC method.
+type QueryDeepReorgAlarmRequest struct {
+}
+
+func (m *QueryDeepReorgAlarmRequest) Reset()         { *m = QueryDeepReorgAlarmRequest{} }
+func (m *QueryDeepReorgAlarmRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeepReorgAlarmRequest) ProtoMessage()    {}
+func (*QueryDeepReorgAlarmRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{17}
+}
+func (m *QueryDeepReorgAlarmRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeepReorgAlarmRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeepReorgAlarmRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeepReorgAlarmRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeepReorgAlarmRequest.Merge(m, src)
+}
+func (m *QueryDeepReorgAlarmRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeepReorgAlarmRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeepReorgAlarmRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeepReorgAlarmRequest proto.InternalMessageInfo
+
+// QueryDeepReorgAlarmResponse is the response type for the
+// Query/DeepReorgAlarm RPC method.
+type QueryDeepReorgAlarmResponse struct {
+	// alarm is the pending deep reorg alarm, nil if the processing of headers
+	// is not halted
+	Alarm *DeepReorgAlarm `protobuf:"bytes,1,opt,name=alarm,proto3" json:"alarm,omitempty"`
+}
+
+func (m *QueryDeepReorgAlarmResponse) Reset()         { *m = QueryDeepReorgAlarmResponse{} }
+func (m *QueryDeepReorgAlarmResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeepReorgAlarmResponse) ProtoMessage()    {}
+func (*QueryDeepReorgAlarmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{18}
+}
+func (m *QueryDeepReorgAlarmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeepReorgAlarmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeepReorgAlarmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeepReorgAlarmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeepReorgAlarmResponse.Merge(m, src)
+}
+func (m *QueryDeepReorgAlarmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeepReorgAlarmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeepReorgAlarmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeepReorgAlarmResponse proto.InternalMessageInfo
+
+func (m *QueryDeepReorgAlarmResponse) GetAlarm() *DeepReorgAlarm {
+	if m != nil {
+		return m.Alarm
+	}
+	return nil
+}
+
+// QueryHeaderInclusionProofRequest is the request type for the
+// Query/HeaderInclusionProof RPC method.
+type QueryHeaderInclusionProofRequest struct {
+	// hash is the hex encoded hash of the header
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// babylon_height is the Babylon height of the accumulator root to prove
+	// against. 0 means the current root
+	BabylonHeight uint64 `protobuf:"varint,2,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+}
+
+func (m *QueryHeaderInclusionProofRequest) Reset()         { *m = QueryHeaderInclusionProofRequest{} }
+func (m *QueryHeaderInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderInclusionProofRequest) ProtoMessage()    {}
+func (*QueryHeaderInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{19}
+}
+func (m *QueryHeaderInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryHeaderInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryHeaderInclusionProofRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *QueryHeaderInclusionProofRequest) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+// QueryHeaderInclusionProofResponse is the response type for the
+// Query/HeaderInclusionProof RPC method.
+type QueryHeaderInclusionProofResponse struct {
+	// header is the header whose inclusion is proven
+	Header *BTCHeaderInfoResponse `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// root is the accumulator root the proof is against
+	Root *AccumulatorRoot `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// proof is the inclusion proof of the header
+	Proof *HeaderInclusionProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryHeaderInclusionProofResponse) Reset()         { *m = QueryHeaderInclusionProofResponse{} }
+func (m *QueryHeaderInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderInclusionProofResponse) ProtoMessage()    {}
+func (*QueryHeaderInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{20}
+}
+func (m *QueryHeaderInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeaderInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryHeaderInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeaderInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderInclusionProofResponse) GetHeader() *BTCHeaderInfoResponse {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *QueryHeaderInclusionProofResponse) GetRoot() *AccumulatorRoot {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *QueryHeaderInclusionProofResponse) GetProof() *HeaderInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btclightclient.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btclightclient.v1.QueryParamsResponse")
@@ -829,6 +1037,10 @@ func init() {
 	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
 	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
 	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
+	proto.RegisterType((*QueryDeepReorgAlarmRequest)(nil), "babylon.btclightclient.v1.QueryDeepReorgAlarmRequest")
+	proto.RegisterType((*QueryDeepReorgAlarmResponse)(nil), "babylon.btclightclient.v1.QueryDeepReorgAlarmResponse")
+	proto.RegisterType((*QueryHeaderInclusionProofRequest)(nil), "babylon.btclightclient.v1.QueryHeaderInclusionProofRequest")
+	proto.RegisterType((*QueryHeaderInclusionProofResponse)(nil), "babylon.btclightclient.v1.QueryHeaderInclusionProofResponse")
 }
 
 func init() {
@@ -836,65 +1048,78 @@ func init() {
 }
 
 var fileDescriptor_3961270631e52721 = []byte{
-	// 920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x89, 0xeb, 0x26, 0x2f, 0x20, 0x60, 0x48, 0x83, 0xb3, 0x02, 0x27, 0xdd, 0x92,
-	0x1f, 0x4d, 0xf1, 0x4e, 0x9c, 0x00, 0xea, 0x01, 0x09, 0xe1, 0x20, 0x30, 0x48, 0x48, 0xc6, 0x32,
-	0x1c, 0x50, 0xa5, 0x68, 0xec, 0x0c, 0xbb, 0xab, 0xc6, 0x3b, 0x5b, 0xef, 0x26, 0xc4, 0x42, 0x5c,
-	0x38, 0x70, 0x46, 0x70, 0xe3, 0xc0, 0x81, 0x0b, 0x17, 0xe0, 0xd4, 0x3f, 0xa2, 0xc7, 0x0a, 0x2e,
-	0xa8, 0x87, 0x08, 0x25, 0xfc, 0x11, 0x1c, 0xd1, 0xcc, 0xbc, 0xb5, 0xbd, 0x76, 0xea, 0xb5, 0xd5,
-	0x5c, 0xa2, 0xcc, 0xcc, 0x7b, 0xef, 0xfb, 0x79, 0xcf, 0xb3, 0xdf, 0x5d, 0x58, 0x6f, 0xf2, 0x66,
-	0xf7, 0x48, 0x06, 0xac, 0x19, 0xb7, 0x8e, 0x7c, 0xd7, 0x53, 0x7f, 0x45, 0x10, 0xb3, 0x93, 0x32,
-	0x7b, 0x70, 0x2c, 0x3a, 0x5d, 0x27, 0xec, 0xc8, 0x58, 0xd2, 0x15, 0x0c, 0x73, 0xd2, 0x61, 0xce,
-	0x49, 0xd9, 0x5a, 0x72, 0xa5, 0x2b, 0x75, 0x14, 0x53, 0xff, 0x99, 0x04, 0x6b, 0xa5, 0x25, 0xa3,
-	0xb6, 0x8c, 0x0e, 0xcc, 0x81, 0x59, 0xe0, 0xd1, 0xab, 0xae, 0x94, 0xee, 0x91, 0x60, 0x3c, 0xf4,
-	0x19, 0x0f, 0x02, 0x19, 0xf3, 0xd8, 0x97, 0x41, 0x72, 0xba, 0x6d, 0x62, 0x59, 0x93, 0x47, 0xc2,
-	0x20, 0xb0, 0x93, 0x72, 0x53, 0xc4, 0xbc, 0xcc, 0x42, 0xee, 0xfa, 0x81, 0x0e, 0xc6, 0xd8, 0x8d,
-	0xa7, 0xc3, 0x87, 0xbc, 0xc3, 0xdb, 0x58, 0xd3, 0x5e, 0x02, 0xfa, 0xa9, 0xaa, 0x54, 0xd3, 0x9b,
-	0x75, 0xf1, 0xe0, 0x58, 0x44, 0xb1, 0xfd, 0x39, 0xbc, 0x9c, 0xda, 0x8d, 0x42, 0x19, 0x44, 0x82,
-	0xbe, 0x0b, 0x79, 0x93, 0x5c, 0x20, 0x6b, 0x64, 0x6b, 0x71, 0xf7, 0xa6, 0xf3, 0xd4, 0xde, 0x1d,
-	0x93, 0x5a, 0xc9, 0x3d, 0x3a, 0x5b, 0x9d, 0xa9, 0x63, 0x9a, 0x7d, 0x0f, 0xd5, 0xaa, 0x3c, 0xf2,
-	0x44, 0xa2, 0x46, 0x3f, 0x00, 0xe8, 0xf3, 0x63, 0xe9, 0x0d, 0x07, 0x07, 0xa3, 0x9a, 0x75, 0xcc,
-	0xbc, 0xb1, 0x59, 0xa7, 0xc6, 0x5d, 0x81, 0xb9, 0xf5, 0x81, 0x4c, 0xfb, 0x21, 0x41, 0xec, 0xa4,
-	0x3c, 0x62, 0x37, 0x20, 0xef, 0xe9, 0x9d, 0x02, 0x59, 0x9b, 0xdb, 0x7a, 0xae, 0xf2, 0xce, 0x93,
-	0xb3, 0xd5, 0xbb, 0xae, 0x1f, 0x7b, 0xc7, 0x4d, 0xa7, 0x25, 0xdb, 0x0c, 0x9b, 0x68, 0x79, 0xdc,
-	0x0f, 0x92, 0x05, 0x8b, 0xbb, 0xa1, 0x88, 0x9c, 0x4a, 0x63, 0xbf, 0x2a, 0xf8, 0xa1, 0xe8, 0xa8,
-	0x92, 0x95, 0x6e, 0x2c, 0xa2, 0x3a, 0xd6, 0xa2, 0x1f, 0xa6, 0xa8, 0x67, 0x35, 0xf5, 0x66, 0x26,
-	0xb5, 0x41, 0x4a, 0x61, 0x7b, 0xb0, 0xa4, 0xa9, 0xf7, 0x65, 0x10, 0x73, 0x3f, 0xe8, 0x8d, 0xa5,
-	0x06, 0x39, 0x25, 0xa5, 0x07, 0xf2, 0xac, 0xd0, 0xba, 0x92, 0xbd, 0x07, 0x37, 0x86, 0x94, 0x70,
-	0x42, 0x16, 0xcc, 0xb7, 0x70, 0x4f, 0xcb, 0xcd, 0xd7, 0x7b, 0x6b, 0x9b, 0xc1, 0x4a, 0x2a, 0xc9,
-	0x14, 0x44, 0x46, 0x3a, 0xc8, 0x88, 0x2a, 0x77, 0xc1, 0xba, 0x2c, 0x61, 0x02, 0xa9, 0x03, 0xe4,
-	0xfb, 0x84, 0xfb, 0xc1, 0xbe, 0x6a, 0xec, 0xaa, 0x6f, 0xc8, 0xef, 0x04, 0x96, 0x87, 0x15, 0x90,
-	0xeb, 0x63, 0xb8, 0xee, 0xe9, 0xa1, 0x99, 0x5b, 0xb2, 0xb8, 0xbb, 0x33, 0xe6, 0x72, 0xf7, 0x26,
-	0xfc, 0x51, 0xf0, 0xa5, 0xec, 0xfd, 0xa8, 0x49, 0x81, 0xab, 0xbb, 0x1a, 0x2f, 0xc1, 0x0b, 0x1a,
-	0xb7, 0xe1, 0x87, 0xc9, 0xa3, 0x79, 0x0f, 0x5e, 0xec, 0x6f, 0x21, 0x7b, 0x15, 0xf2, 0x46, 0x1a,
-	0x47, 0x33, 0x3d, 0x3a, 0xe6, 0xdb, 0x05, 0x9c, 0x4f, 0x85, 0x47, 0xc2, 0x84, 0x25, 0xba, 0x2d,
-	0x78, 0x65, 0xe4, 0xe4, 0xca, 0xe5, 0x4b, 0x28, 0x62, 0x42, 0xde, 0x17, 0x61, 0xec, 0x5d, 0x76,
-	0xd3, 0x16, 0xf0, 0xa6, 0xed, 0x40, 0x61, 0x34, 0x1c, 0xa1, 0x96, 0xe0, 0xda, 0xa1, 0xda, 0xd0,
-	0x09, 0xb9, 0xba, 0x59, 0xd8, 0xbf, 0x11, 0xb8, 0x71, 0x29, 0x02, 0x7d, 0x0d, 0xc0, 0x40, 0x1c,
-	0x78, 0xe2, 0x14, 0x55, 0x16, 0xcc, 0x4e, 0x55, 0x9c, 0xd2, 0x15, 0x98, 0x57, 0x92, 0xfa, 0x70,
-	0x56, 0x1f, 0x5e, 0x57, 0x6b, 0x75, 0xb4, 0xac, 0xda, 0x57, 0x5d, 0x16, 0xe6, 0xb4, 0x14, 0xae,
-	0xe8, 0x7b, 0x90, 0xfb, 0x4a, 0x76, 0xee, 0x17, 0x72, 0x2a, 0xbc, 0x52, 0x52, 0x46, 0xf8, 0xe4,
-	0x6c, 0x75, 0xd9, 0x5c, 0x83, 0xe8, 0xf0, 0xbe, 0xe3, 0x4b, 0xd6, 0xe6, 0xb1, 0xe7, 0x7c, 0xe6,
-	0x07, 0xf1, 0x9f, 0x0f, 0x4b, 0x8b, 0x78, 0x41, 0xd4, 0xb2, 0xae, 0x53, 0x77, 0xff, 0x5b, 0x80,
-	0x6b, 0xba, 0x43, 0xfa, 0x03, 0x81, 0xbc, 0xb1, 0x54, 0x5a, 0x1a, 0x33, 0xde, 0x51, 0x2f, 0xb7,
-	0x9c, 0x49, 0xc3, 0xcd, 0x20, 0xec, 0xdb, 0xdf, 0xfe, 0xf5, 0xef, 0x8f, 0xb3, 0xb7, 0xe8, 0x4d,
-	0x96, 0xf5, 0x0a, 0xd1, 0x50, 0xc6, 0x6b, 0xb3, 0xa1, 0x52, 0x96, 0x9f, 0x0d, 0x95, 0xb6, 0xf0,
-	0x89, 0xa0, 0xd0, 0x97, 0x7f, 0x22, 0x30, 0x9f, 0x58, 0x0f, 0x65, 0x59, 0x3a, 0x43, 0xa6, 0x6b,
-	0xed, 0x4c, 0x9e, 0x80, 0x68, 0x77, 0x34, 0xda, 0x3a, 0xbd, 0x35, 0x06, 0x2d, 0x71, 0x38, 0xfa,
-	0x07, 0x81, 0xe7, 0x53, 0xbe, 0x48, 0xdf, 0x9c, 0x54, 0x70, 0xd0, 0x77, 0xad, 0xb7, 0xa6, 0xcc,
-	0x42, 0xd6, 0x1d, 0xcd, 0xba, 0x4d, 0xb7, 0x26, 0x60, 0x35, 0x78, 0x3f, 0x13, 0x58, 0xe8, 0x99,
-	0x25, 0xcd, 0x9c, 0xce, 0xb0, 0x73, 0x5b, 0xe5, 0x29, 0x32, 0x10, 0xf2, 0x0d, 0x0d, 0xb9, 0x41,
-	0x5f, 0x1f, 0x03, 0xd9, 0xe6, 0xbe, 0x79, 0xf5, 0xd1, 0xef, 0x08, 0xcc, 0x35, 0xfc, 0x90, 0x6e,
-	0x67, 0x09, 0xf5, 0x3d, 0xd4, 0xba, 0x33, 0x51, 0x2c, 0xe2, 0x6c, 0x68, 0x9c, 0x35, 0x5a, 0x1c,
-	0x83, 0x13, 0xfb, 0x21, 0xfd, 0x85, 0x00, 0xf4, 0xcd, 0x91, 0x66, 0x36, 0x3e, 0x62, 0xb1, 0xd6,
-	0xee, 0x34, 0x29, 0x48, 0x57, 0xd2, 0x74, 0x9b, 0x74, 0x7d, 0x0c, 0x9d, 0x7a, 0xe3, 0x18, 0x27,
-	0xa3, 0xbf, 0x12, 0x58, 0x1c, 0x70, 0x4b, 0x9a, 0x29, 0x39, 0xea, 0xc4, 0xd6, 0xde, 0x54, 0x39,
-	0xc8, 0xc9, 0x34, 0xe7, 0x6d, 0xba, 0x39, 0x86, 0x53, 0x5b, 0x34, 0xfb, 0x5a, 0x3d, 0xc7, 0xdf,
-	0x54, 0x6a, 0x8f, 0xce, 0x8b, 0xe4, 0xf1, 0x79, 0x91, 0xfc, 0x73, 0x5e, 0x24, 0xdf, 0x5f, 0x14,
-	0x67, 0x1e, 0x5f, 0x14, 0x67, 0xfe, 0xbe, 0x28, 0xce, 0x7c, 0xf1, 0x76, 0xd6, 0x57, 0xd0, 0xe9,
-	0x70, 0x6d, 0xfd, 0x59, 0xd4, 0xcc, 0xeb, 0x2f, 0xde, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0xd5, 0xc3, 0x8e, 0xd8, 0x0b, 0x00, 0x00,
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x89, 0x93, 0x26, 0x2f, 0x6d, 0x81, 0x69, 0x1a, 0x9c, 0xa5, 0x38, 0xc9, 0x96,
+	0xfc, 0xc6, 0xbb, 0x71, 0x0c, 0x55, 0x11, 0x15, 0x28, 0x4e, 0x01, 0x17, 0x09, 0xc9, 0xac, 0x02,
+	0x07, 0x54, 0xb0, 0xc6, 0xce, 0xd4, 0xbb, 0xaa, 0xbd, 0xb3, 0xdd, 0x5d, 0x87, 0x44, 0x88, 0x0b,
+	0x07, 0xce, 0x08, 0x6e, 0x1c, 0x38, 0x70, 0xe1, 0x02, 0x9c, 0xf2, 0x47, 0xf4, 0xc0, 0xa1, 0x82,
+	0x0b, 0xea, 0x21, 0x42, 0x09, 0x7f, 0x02, 0x67, 0x84, 0x76, 0xe6, 0xad, 0xe3, 0x5f, 0xd9, 0xb5,
+	0x69, 0x2e, 0x51, 0x66, 0xe6, 0xbd, 0xf7, 0xfd, 0xcc, 0x9b, 0xd9, 0xfd, 0x7a, 0x61, 0xa9, 0x42,
+	0x2b, 0x87, 0x75, 0xee, 0x18, 0x95, 0xa0, 0x5a, 0xb7, 0x6b, 0x56, 0xf8, 0x97, 0x39, 0x81, 0xb1,
+	0x9f, 0x33, 0x1e, 0x35, 0x99, 0x77, 0xa8, 0xbb, 0x1e, 0x0f, 0x38, 0x99, 0xc3, 0x30, 0xbd, 0x33,
+	0x4c, 0xdf, 0xcf, 0xa9, 0x33, 0x35, 0x5e, 0xe3, 0x22, 0xca, 0x08, 0xff, 0x93, 0x09, 0xea, 0x5c,
+	0x95, 0xfb, 0x0d, 0xee, 0x97, 0xe5, 0x82, 0x1c, 0xe0, 0xd2, 0x8d, 0x1a, 0xe7, 0xb5, 0x3a, 0x33,
+	0xa8, 0x6b, 0x1b, 0xd4, 0x71, 0x78, 0x40, 0x03, 0x9b, 0x3b, 0xd1, 0xea, 0xba, 0x8c, 0x35, 0x2a,
+	0xd4, 0x67, 0x12, 0xc1, 0xd8, 0xcf, 0x55, 0x58, 0x40, 0x73, 0x86, 0x4b, 0x6b, 0xb6, 0x23, 0x82,
+	0x31, 0x76, 0xf9, 0x7c, 0x78, 0x97, 0x7a, 0xb4, 0x11, 0xd5, 0xd4, 0xcf, 0x8f, 0xeb, 0xda, 0x8f,
+	0x88, 0xd7, 0x66, 0x80, 0x7c, 0x18, 0x2a, 0x97, 0x44, 0x11, 0x93, 0x3d, 0x6a, 0x32, 0x3f, 0xd0,
+	0x3e, 0x86, 0x6b, 0x1d, 0xb3, 0xbe, 0xcb, 0x1d, 0x9f, 0x91, 0xb7, 0x61, 0x42, 0x8a, 0xa5, 0x95,
+	0x05, 0x65, 0x75, 0x7a, 0x6b, 0x51, 0x3f, 0xb7, 0x57, 0xba, 0x4c, 0x2d, 0xa4, 0x1e, 0x1f, 0xcf,
+	0x8f, 0x98, 0x98, 0xa6, 0xdd, 0x47, 0xb5, 0x22, 0xf5, 0x2d, 0x16, 0xa9, 0x91, 0x77, 0x01, 0xce,
+	0xf6, 0x8b, 0xa5, 0x97, 0x75, 0x6c, 0x64, 0xd8, 0x1c, 0x5d, 0x9e, 0x0f, 0x36, 0x47, 0x2f, 0xd1,
+	0x1a, 0xc3, 0x5c, 0xb3, 0x2d, 0x53, 0x3b, 0x52, 0xe0, 0x5a, 0x47, 0x79, 0xc4, 0xde, 0x85, 0x09,
+	0x4b, 0xcc, 0xa4, 0x95, 0x85, 0xb1, 0xd5, 0xcb, 0x85, 0x3b, 0x4f, 0x8f, 0xe7, 0x6f, 0xd7, 0xec,
+	0xc0, 0x6a, 0x56, 0xf4, 0x2a, 0x6f, 0x18, 0xb8, 0x89, 0xaa, 0x45, 0x6d, 0x27, 0x1a, 0x18, 0xc1,
+	0xa1, 0xcb, 0x7c, 0xbd, 0xb0, 0xbb, 0x53, 0x64, 0x74, 0x8f, 0x79, 0x61, 0xc9, 0xc2, 0x61, 0xc0,
+	0x7c, 0x13, 0x6b, 0x91, 0xf7, 0x3a, 0xa8, 0x47, 0x05, 0xf5, 0x4a, 0x22, 0xb5, 0x44, 0xea, 0xc0,
+	0xb6, 0x60, 0x46, 0x50, 0xef, 0x70, 0x27, 0xa0, 0xb6, 0xd3, 0x6a, 0x4b, 0x09, 0x52, 0xa1, 0x94,
+	0x68, 0xc8, 0xb3, 0x42, 0x8b, 0x4a, 0x5a, 0x1e, 0xae, 0x77, 0x29, 0x61, 0x87, 0x54, 0x98, 0xac,
+	0xe2, 0x9c, 0x90, 0x9b, 0x34, 0x5b, 0x63, 0xcd, 0x80, 0xb9, 0x8e, 0x24, 0x59, 0x10, 0x19, 0x49,
+	0x3b, 0x23, 0xaa, 0xdc, 0x06, 0xb5, 0x5f, 0xc2, 0x00, 0x52, 0x65, 0xe4, 0xfb, 0x80, 0xda, 0xce,
+	0x4e, 0xb8, 0xb1, 0x8b, 0xbe, 0x21, 0xbf, 0x28, 0x30, 0xdb, 0xad, 0x80, 0x5c, 0xef, 0xc3, 0x25,
+	0x4b, 0x34, 0x4d, 0xde, 0x92, 0xe9, 0xad, 0xcd, 0x98, 0xcb, 0xdd, 0xea, 0xf0, 0x3d, 0xe7, 0x01,
+	0x6f, 0x1d, 0x6a, 0x54, 0xe0, 0xe2, 0xae, 0xc6, 0x0b, 0xf0, 0x9c, 0xc0, 0xdd, 0xb5, 0xdd, 0xe8,
+	0xd1, 0xbc, 0x0f, 0xcf, 0x9f, 0x4d, 0x21, 0x7b, 0x11, 0x26, 0xa4, 0x34, 0xb6, 0x66, 0x78, 0x74,
+	0xcc, 0xd7, 0xd2, 0xd8, 0x9f, 0x02, 0xf5, 0x99, 0x0c, 0x8b, 0x74, 0xab, 0xf0, 0x62, 0xcf, 0xca,
+	0x85, 0xcb, 0x67, 0x51, 0x44, 0x86, 0xdc, 0x65, 0x6e, 0x60, 0xf5, 0xbb, 0x69, 0x53, 0x78, 0xd3,
+	0x36, 0x21, 0xdd, 0x1b, 0x8e, 0x50, 0x33, 0x30, 0xbe, 0x17, 0x4e, 0x88, 0x84, 0x94, 0x29, 0x07,
+	0xda, 0xcf, 0x0a, 0x5c, 0xef, 0x8b, 0x40, 0x5e, 0x06, 0x90, 0x10, 0x65, 0x8b, 0x1d, 0xa0, 0xca,
+	0x94, 0x9c, 0x29, 0xb2, 0x03, 0x32, 0x07, 0x93, 0xa1, 0xa4, 0x58, 0x1c, 0x15, 0x8b, 0x97, 0xc2,
+	0x71, 0xb8, 0x34, 0x1b, 0x6e, 0x3f, 0xdc, 0x65, 0x7a, 0x4c, 0x48, 0xe1, 0x88, 0x6c, 0x43, 0xea,
+	0x73, 0xee, 0x3d, 0x4c, 0xa7, 0xc2, 0xf0, 0x42, 0x36, 0x7c, 0x11, 0x3e, 0x3d, 0x9e, 0x9f, 0x95,
+	0xd7, 0xc0, 0xdf, 0x7b, 0xa8, 0xdb, 0xdc, 0x68, 0xd0, 0xc0, 0xd2, 0x3f, 0xb2, 0x9d, 0xe0, 0xf7,
+	0xa3, 0xec, 0xb4, 0x5c, 0x11, 0x43, 0x53, 0xa4, 0x6a, 0x37, 0xf0, 0x51, 0xba, 0xcb, 0x98, 0x6b,
+	0x32, 0xee, 0xd5, 0xb6, 0xeb, 0xd4, 0x6b, 0x44, 0x47, 0xf2, 0x19, 0xbc, 0xd4, 0x77, 0xb5, 0xf5,
+	0xb6, 0x1e, 0xa7, 0xe1, 0x04, 0x9e, 0xca, 0x5a, 0xcc, 0xa9, 0x74, 0x55, 0x90, 0x79, 0xda, 0xa7,
+	0xb0, 0xd0, 0xd6, 0xde, 0x7b, 0x4e, 0xb5, 0xde, 0xf4, 0x6d, 0xee, 0x94, 0x3c, 0xce, 0x1f, 0xc4,
+	0x1c, 0x0b, 0x59, 0x82, 0xab, 0x28, 0x55, 0xc6, 0xc6, 0x8c, 0x8a, 0xc6, 0x5c, 0xc1, 0xd9, 0xa2,
+	0x98, 0xd4, 0xfe, 0x51, 0x60, 0x31, 0xa6, 0xfe, 0x45, 0x5f, 0x2e, 0xf2, 0x16, 0xa4, 0x3c, 0xce,
+	0x03, 0x7c, 0x1e, 0xd7, 0x63, 0xea, 0x6c, 0x57, 0xab, 0xcd, 0x46, 0xb3, 0x4e, 0x03, 0xee, 0x99,
+	0x9c, 0x07, 0xa6, 0xc8, 0x23, 0xef, 0xc0, 0xb8, 0x1b, 0xa2, 0x89, 0x63, 0x9e, 0xde, 0x32, 0x62,
+	0x0a, 0xf4, 0xdd, 0x91, 0xcc, 0xde, 0xfa, 0xf7, 0x32, 0x8c, 0x8b, 0x6d, 0x93, 0x6f, 0x15, 0x98,
+	0x90, 0x36, 0x49, 0xb2, 0x31, 0xc5, 0x7a, 0xfd, 0x59, 0xd5, 0x07, 0x0d, 0x97, 0x2d, 0xd0, 0xd6,
+	0xbe, 0xfa, 0xe3, 0xef, 0xef, 0x46, 0x6f, 0x92, 0x45, 0x23, 0xe9, 0x67, 0x84, 0x80, 0x92, 0xfe,
+	0x99, 0x0c, 0xd5, 0x61, 0xe3, 0xaa, 0x3e, 0x68, 0xf8, 0x10, 0x50, 0xe8, 0xb5, 0xdf, 0x2b, 0x30,
+	0x19, 0xd9, 0x09, 0x31, 0x92, 0x74, 0xba, 0x8c, 0x54, 0xdd, 0x1c, 0x3c, 0x01, 0xd1, 0x36, 0x04,
+	0xda, 0x12, 0xb9, 0x19, 0x83, 0x16, 0xb9, 0x16, 0xf9, 0x55, 0x81, 0x2b, 0x1d, 0x5e, 0x47, 0x5e,
+	0x1b, 0x54, 0xb0, 0xdd, 0x4b, 0xd5, 0xd7, 0x87, 0xcc, 0x42, 0xd6, 0x4d, 0xc1, 0xba, 0x4e, 0x56,
+	0x07, 0x60, 0x95, 0x78, 0x3f, 0x28, 0x30, 0xd5, 0x32, 0x40, 0x92, 0xd8, 0x9d, 0x6e, 0x37, 0x56,
+	0x73, 0x43, 0x64, 0x20, 0xe4, 0xab, 0x02, 0x72, 0x99, 0xbc, 0x12, 0x03, 0xd9, 0xa0, 0xb6, 0xfc,
+	0x39, 0x43, 0xbe, 0x56, 0x60, 0x6c, 0xd7, 0x76, 0xc9, 0x7a, 0x92, 0xd0, 0x99, 0x2f, 0xaa, 0x1b,
+	0x03, 0xc5, 0x22, 0xce, 0xb2, 0xc0, 0x59, 0x20, 0x99, 0x18, 0x9c, 0xc0, 0x76, 0xc9, 0x8f, 0x0a,
+	0xc0, 0x99, 0xe1, 0x91, 0xc4, 0x8d, 0xf7, 0xd8, 0xa6, 0xba, 0x35, 0x4c, 0x0a, 0xd2, 0x65, 0x05,
+	0xdd, 0x0a, 0x59, 0x8a, 0xa1, 0xab, 0x50, 0x9f, 0xe1, 0x7b, 0xed, 0x27, 0x05, 0xa6, 0xdb, 0x1c,
+	0x90, 0x24, 0x4a, 0xf6, 0xba, 0xab, 0x9a, 0x1f, 0x2a, 0x07, 0x39, 0x0d, 0xc1, 0xb9, 0x46, 0x56,
+	0x62, 0x38, 0x85, 0xed, 0x1a, 0x5f, 0x84, 0xcf, 0xf1, 0x97, 0xe4, 0x48, 0x81, 0xab, 0x9d, 0x56,
+	0x43, 0x12, 0x2f, 0x7d, 0x5f, 0xeb, 0x53, 0x6f, 0x0d, 0x9b, 0x86, 0xc8, 0x79, 0x81, 0x9c, 0x25,
+	0x1b, 0xb1, 0xc8, 0xcc, 0x2d, 0x7b, 0x61, 0x6e, 0x59, 0xf8, 0x20, 0xf9, 0x4d, 0x81, 0x99, 0x7e,
+	0x6f, 0x74, 0xf2, 0xe6, 0x60, 0x5d, 0xeb, 0xeb, 0x9c, 0xea, 0x9d, 0xff, 0x97, 0x8c, 0x1b, 0x79,
+	0x43, 0x6c, 0x24, 0x4f, 0x72, 0x31, 0x1b, 0xb1, 0xa3, 0xd4, 0xb2, 0x70, 0x1e, 0x3c, 0x85, 0x42,
+	0xe9, 0xf1, 0x49, 0x46, 0x79, 0x72, 0x92, 0x51, 0xfe, 0x3a, 0xc9, 0x28, 0xdf, 0x9c, 0x66, 0x46,
+	0x9e, 0x9c, 0x66, 0x46, 0xfe, 0x3c, 0xcd, 0x8c, 0x7c, 0x72, 0x2b, 0xe9, 0xfb, 0xe2, 0xa0, 0x5b,
+	0x45, 0x7c, 0x70, 0x54, 0x26, 0xc4, 0xb7, 0x64, 0xfe, 0xbf, 0x01, 0x00, 0x6d, 0x8e, 0x62, 0x46,
+	0x62, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -930,6 +1155,11 @@ type QueryClient interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+	// DeepReorgAlarm returns the pending deep reorg alarm, if any
+	DeepReorgAlarm(ctx context.Context, in *QueryDeepReorgAlarmRequest, opts ...grpc.CallOption) (*QueryDeepReorgAlarmResponse, error)
+	// HeaderInclusionProof returns a proof that the header is included in the
+	// accumulator over the main chain headers at the given Babylon height
+	HeaderInclusionProof(ctx context.Context, in *QueryHeaderInclusionProofRequest, opts ...grpc.CallOption) (*QueryHeaderInclusionProofResponse, error)
 }
 
 type queryClient struct {
@@ -1012,6 +1242,24 @@ func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthReque
 	return out, nil
 }
 
+func (c *queryClient) DeepReorgAlarm(ctx context.Context, in *QueryDeepReorgAlarmRequest, opts ...grpc.CallOption) (*QueryDeepReorgAlarmResponse, error) {
+	out := new(QueryDeepReorgAlarmResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/DeepReorgAlarm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeaderInclusionProof(ctx context.Context, in *QueryHeaderInclusionProofRequest, opts ...grpc.CallOption) (*QueryHeaderInclusionProofResponse, error) {
+	out := new(QueryHeaderInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeaderInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1035,6 +1283,11 @@ type QueryServer interface {
 	// HeaderDepth returns the depth of the header in main chain or error if the
 	// block is not found or it exists on fork
 	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+	// DeepReorgAlarm returns the pending deep reorg alarm, if any
+	DeepReorgAlarm(context.Context, *QueryDeepReorgAlarmRequest) (*QueryDeepReorgAlarmResponse, error)
+	// HeaderInclusionProof returns a proof that the header is included in the
+	// accumulator over the main chain headers at the given Babylon height
+	HeaderInclusionProof(context.Context, *QueryHeaderInclusionProofRequest) (*QueryHeaderInclusionProofResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1065,6 +1318,12 @@ func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseH
 func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
 }
+func (*UnimplementedQueryServer) DeepReorgAlarm(ctx context.Context, req *QueryDeepReorgAlarmRequest) (*QueryDeepReorgAlarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeepReorgAlarm not implemented")
+}
+func (*UnimplementedQueryServer) HeaderInclusionProof(ctx context.Context, req *QueryHeaderInclusionProofRequest) (*QueryHeaderInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderInclusionProof not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1214,6 +1473,42 @@ func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeepReorgAlarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeepReorgAlarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeepReorgAlarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/DeepReorgAlarm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeepReorgAlarm(ctx, req.(*QueryDeepReorgAlarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeaderInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeaderInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderInclusionProof(ctx, req.(*QueryHeaderInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1250,6 +1545,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HeaderDepth",
 			Handler:    _Query_HeaderDepth_Handler,
 		},
+		{
+			MethodName: "DeepReorgAlarm",
+			Handler:    _Query_DeepReorgAlarm_Handler,
+		},
+		{
+			MethodName: "HeaderInclusionProof",
+			Handler:    _Query_HeaderInclusionProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/query.proto",
@@ -1836,86 +2139,238 @@ func (m *BTCHeaderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDeepReorgAlarmRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryDeepReorgAlarmRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDeepReorgAlarmRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDeepReorgAlarmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryHashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Hashes) > 0 {
-		for _, e := range m.Hashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDeepReorgAlarmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryContainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDeepReorgAlarmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Alarm != nil {
+		{
+			size, err := m.Alarm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryContainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHeaderInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Root != nil {
+		{
+			size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, e := range m.Hashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Contains {
@@ -2072,6 +2527,65 @@ func (m *BTCHeaderInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDeepReorgAlarmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeepReorgAlarmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Alarm != nil {
+		l = m.Alarm.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BabylonHeight))
+	}
+	return n
+}
+
+func (m *QueryHeaderInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Root != nil {
+		l = m.Root.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3523,6 +4037,401 @@ func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDeepReorgAlarmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeepReorgAlarmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeepReorgAlarmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeepReorgAlarmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeepReorgAlarmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeepReorgAlarmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alarm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alarm == nil {
+				m.Alarm = &DeepReorgAlarm{}
+			}
+			if err := m.Alarm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfoResponse{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Root == nil {
+				m.Root = &AccumulatorRoot{}
+			}
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &HeaderInclusionProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
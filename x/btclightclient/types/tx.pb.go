@@ -211,11 +211,108 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgResolveDeepReorg defines a message for resolving a pending deep reorg
+// alarm.
+type MsgResolveDeepReorg struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// apply_fork defines whether the fork of the alarm is applied. If false, the
+	// fork is discarded and the current main chain is kept
+	ApplyFork bool `protobuf:"varint,2,opt,name=apply_fork,json=applyFork,proto3" json:"apply_fork,omitempty"`
+}
+
+func (m *MsgResolveDeepReorg) Reset()         { *m = MsgResolveDeepReorg{} }
+func (m *MsgResolveDeepReorg) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDeepReorg) ProtoMessage()    {}
+func (*MsgResolveDeepReorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{4}
+}
+func (m *MsgResolveDeepReorg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDeepReorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDeepReorg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveDeepReorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDeepReorg.Merge(m, src)
+}
+func (m *MsgResolveDeepReorg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDeepReorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDeepReorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDeepReorg proto.InternalMessageInfo
+
+func (m *MsgResolveDeepReorg) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolveDeepReorg) GetApplyFork() bool {
+	if m != nil {
+		return m.ApplyFork
+	}
+	return false
+}
+
+// MsgResolveDeepReorgResponse is the response to the MsgResolveDeepReorg
+// message.
+type MsgResolveDeepReorgResponse struct {
+}
+
+func (m *MsgResolveDeepReorgResponse) Reset()         { *m = MsgResolveDeepReorgResponse{} }
+func (m *MsgResolveDeepReorgResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveDeepReorgResponse) ProtoMessage()    {}
+func (*MsgResolveDeepReorgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f638eee60234021, []int{5}
+}
+func (m *MsgResolveDeepReorgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveDeepReorgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveDeepReorgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveDeepReorgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveDeepReorgResponse.Merge(m, src)
+}
+func (m *MsgResolveDeepReorgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveDeepReorgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveDeepReorgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveDeepReorgResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgInsertHeaders)(nil), "babylon.btclightclient.v1.MsgInsertHeaders")
 	proto.RegisterType((*MsgInsertHeadersResponse)(nil), "babylon.btclightclient.v1.MsgInsertHeadersResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btclightclient.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btclightclient.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResolveDeepReorg)(nil), "babylon.btclightclient.v1.MsgResolveDeepReorg")
+	proto.RegisterType((*MsgResolveDeepReorgResponse)(nil), "babylon.btclightclient.v1.MsgResolveDeepReorgResponse")
 }
 
 func init() {
@@ -223,35 +320,39 @@ func init() {
 }
 
 var fileDescriptor_5f638eee60234021 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0x35,
-	0x7a, 0xa8, 0x6a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xaa, 0xf4, 0x41,
-	0x2c, 0x88, 0x06, 0x29, 0xf1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0x90,
-	0x41, 0xb9, 0xc5, 0xe9, 0x50, 0x09, 0x35, 0xdc, 0xb6, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x43,
-	0xd5, 0x49, 0x42, 0x0c, 0x88, 0x87, 0x98, 0x0c, 0xe1, 0x40, 0xa4, 0x94, 0xba, 0x19, 0xb9, 0x04,
-	0x7c, 0x8b, 0xd3, 0x3d, 0xf3, 0x8a, 0x53, 0x8b, 0x4a, 0x3c, 0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a,
-	0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x33, 0xd3, 0xf3, 0x52, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38,
-	0x83, 0xa0, 0x3c, 0xa1, 0x20, 0x2e, 0xf6, 0x0c, 0x88, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x1e,
-	0x27, 0x8b, 0x5b, 0xf7, 0xe4, 0x4d, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0xa1, 0xee, 0x49, 0xce, 0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0xf5, 0x9c, 0x42, 0x9c, 0x21, 0xc6, 0x3b, 0x55, 0x96, 0xa4, 0x16, 0x07, 0xc1, 0x0c, 0xb2, 0xe2,
-	0x6e, 0x7a, 0xbe, 0x41, 0x0b, 0x6a, 0x81, 0x92, 0x14, 0x97, 0x04, 0xba, 0x63, 0x82, 0x52, 0x8b,
-	0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x66, 0x31, 0x72, 0xf1, 0xfb, 0x16, 0xa7, 0x87, 0x16, 0xa4,
-	0x24, 0x96, 0xa4, 0x06, 0x80, 0xbd, 0x27, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f,
-	0x94, 0x59, 0x52, 0x09, 0x71, 0xab, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x2f, 0x3a, 0xa6,
-	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0xd9,
-	0x73, 0xb1, 0x41, 0x02, 0x48, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x51, 0x0f, 0x67, 0x9c,
-	0xe8, 0x41, 0xac, 0x72, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xcd, 0x8a, 0x0f, 0xe4,
-	0x6a, 0x84, 0x81, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x83, 0xb9, 0xdb, 0xe8, 0x23, 0x23, 0x17,
-	0xb3, 0x6f, 0x71, 0xba, 0x50, 0x31, 0x17, 0x2f, 0x6a, 0x28, 0x6b, 0xe3, 0xb1, 0x14, 0x3d, 0x14,
-	0xa4, 0x8c, 0x49, 0x50, 0x0c, 0x0f, 0x32, 0x06, 0xa1, 0x3c, 0x2e, 0x1e, 0x94, 0x00, 0xd3, 0xc2,
-	0x6f, 0x0c, 0xb2, 0x5a, 0x29, 0x23, 0xe2, 0xd5, 0xc2, 0x6c, 0x94, 0x62, 0x6d, 0x78, 0xbe, 0x41,
-	0x8b, 0xd1, 0x29, 0xe0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x08,
-	0xa5, 0x96, 0x0a, 0xf4, 0xc4, 0x0c, 0x4e, 0x3e, 0x49, 0x6c, 0xe0, 0xe4, 0x6a, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0x60, 0x2a, 0x32, 0x61, 0x03, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0x14, 0x02, 0xb9, 0x16, 0xa8, 0x4c, 0x45, 0x1d, 0xa3, 0xba, 0xc1, 0x03, 0x8a,
+	0x82, 0xb0, 0xd5, 0x14, 0x45, 0xa8, 0x0b, 0xc2, 0x20, 0x04, 0x43, 0xa4, 0xea, 0x80, 0x85, 0xa5,
+	0xb2, 0x93, 0xe3, 0x6c, 0x35, 0xf6, 0x59, 0xf7, 0xae, 0x51, 0x8d, 0x18, 0x10, 0x2b, 0x0b, 0x33,
+	0x9f, 0xa2, 0x03, 0x1f, 0xa2, 0x63, 0xc5, 0x84, 0x18, 0x2a, 0x94, 0x0c, 0x9d, 0xf8, 0x0e, 0x28,
+	0x3e, 0xbb, 0x25, 0x2e, 0x0d, 0xb4, 0x8b, 0xe5, 0xf3, 0xfd, 0xee, 0xff, 0xff, 0xdf, 0x7b, 0x7e,
+	0xc8, 0xf2, 0x3d, 0x3f, 0x1d, 0xb0, 0xd8, 0xf1, 0x45, 0x6f, 0x10, 0xd2, 0x60, 0xf2, 0x24, 0xb1,
+	0x70, 0x86, 0x6b, 0x8e, 0xd8, 0xb5, 0x13, 0xce, 0x04, 0xd3, 0xea, 0x39, 0x63, 0x4f, 0x33, 0xf6,
+	0x70, 0xcd, 0x58, 0xa2, 0x8c, 0xb2, 0x8c, 0x72, 0x26, 0x6f, 0xf2, 0x80, 0xb1, 0xdc, 0x63, 0x10,
+	0x31, 0x70, 0x22, 0xa0, 0x13, 0xa1, 0x08, 0x68, 0xbe, 0x71, 0xf7, 0x6c, 0xb7, 0xc4, 0xe3, 0x5e,
+	0x04, 0x39, 0x57, 0x97, 0x02, 0x5b, 0x52, 0x59, 0x2e, 0xe4, 0x96, 0xf5, 0x49, 0x45, 0x8b, 0x5d,
+	0xa0, 0x2f, 0x62, 0x20, 0x5c, 0x3c, 0x27, 0x5e, 0x9f, 0x70, 0xd0, 0x6e, 0xa1, 0x2a, 0x84, 0x34,
+	0x26, 0x5c, 0x57, 0x1b, 0x6a, 0xb3, 0x86, 0xf3, 0x95, 0x86, 0xd1, 0x95, 0x40, 0x22, 0x7a, 0xa5,
+	0x31, 0xd7, 0x5c, 0x70, 0x1f, 0xfe, 0x38, 0x5c, 0x7d, 0x40, 0x43, 0x11, 0xec, 0xf8, 0x76, 0x8f,
+	0x45, 0x4e, 0x9e, 0xa7, 0x17, 0x78, 0x61, 0x5c, 0x2c, 0x1c, 0x91, 0x26, 0x04, 0x6c, 0xf7, 0xd5,
+	0x13, 0x29, 0xef, 0xa6, 0x82, 0x00, 0x2e, 0x84, 0x36, 0xe6, 0x3f, 0x1e, 0xed, 0xb5, 0x72, 0x03,
+	0xcb, 0x40, 0x7a, 0x39, 0x0c, 0x26, 0x90, 0xb0, 0x18, 0x88, 0xf5, 0x45, 0x45, 0x37, 0xba, 0x40,
+	0x5f, 0x27, 0x7d, 0x4f, 0x90, 0xcd, 0xec, 0x7a, 0x5a, 0x07, 0xd5, 0xbc, 0x1d, 0x11, 0x30, 0x1e,
+	0x8a, 0x54, 0x66, 0x75, 0xf5, 0x6f, 0x5f, 0xef, 0x2f, 0xe5, 0x57, 0x7c, 0xdc, 0xef, 0x73, 0x02,
+	0xf0, 0x52, 0xf0, 0x30, 0xa6, 0xf8, 0x04, 0xd5, 0x1e, 0xa1, 0xaa, 0x2c, 0x90, 0x5e, 0x69, 0xa8,
+	0xcd, 0xf9, 0xf6, 0x1d, 0xfb, 0xcc, 0x9e, 0xd8, 0xd2, 0xca, 0xbd, 0xb4, 0x7f, 0xb8, 0xaa, 0xe0,
+	0xfc, 0xd8, 0xc6, 0xf5, 0x49, 0xea, 0x13, 0x41, 0xab, 0x8e, 0x96, 0x4b, 0xd9, 0x8e, 0x73, 0xbf,
+	0x47, 0x37, 0xbb, 0x40, 0x31, 0x01, 0x36, 0x18, 0x92, 0xa7, 0x84, 0x24, 0x98, 0x30, 0x4e, 0x2f,
+	0x1c, 0x7d, 0x05, 0x21, 0x2f, 0x49, 0x06, 0xe9, 0xd6, 0x5b, 0xc6, 0xb7, 0xb3, 0xf8, 0x57, 0x71,
+	0x2d, 0xfb, 0xf2, 0x8c, 0xf1, 0xed, 0x53, 0xc1, 0x56, 0xd0, 0xed, 0xbf, 0xb8, 0x17, 0xe1, 0xda,
+	0xbf, 0x2a, 0x68, 0xae, 0x0b, 0x54, 0x03, 0x74, 0x6d, 0xfa, 0x17, 0xb8, 0x37, 0xa3, 0x22, 0xe5,
+	0x16, 0x19, 0xeb, 0xe7, 0x80, 0x8f, 0xeb, 0xa2, 0x68, 0x31, 0x5a, 0x98, 0xea, 0x66, 0x6b, 0xb6,
+	0xcc, 0x9f, 0xac, 0xd1, 0xfe, 0x7f, 0xb6, 0x70, 0xd4, 0xde, 0xa1, 0xc5, 0x53, 0x6d, 0xb0, 0x67,
+	0xeb, 0x94, 0x79, 0xa3, 0x73, 0x3e, 0xbe, 0xf0, 0x36, 0x2e, 0x7f, 0x38, 0xda, 0x6b, 0xa9, 0xee,
+	0xe6, 0xfe, 0xc8, 0x54, 0x0f, 0x46, 0xa6, 0xfa, 0x73, 0x64, 0xaa, 0x9f, 0xc7, 0xa6, 0x72, 0x30,
+	0x36, 0x95, 0xef, 0x63, 0x53, 0x79, 0xd3, 0xf9, 0xd7, 0x18, 0xed, 0x96, 0xa7, 0x3c, 0x9b, 0x2b,
+	0xbf, 0x9a, 0xcd, 0xf1, 0xfa, 0xef, 0x01, 0x00, 0x87, 0xf6, 0x8f, 0xdb, 0x7a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -270,6 +371,9 @@ type MsgClient interface {
 	InsertHeaders(ctx context.Context, in *MsgInsertHeaders, opts ...grpc.CallOption) (*MsgInsertHeadersResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ResolveDeepReorg defines a governance operation for resolving a deep reorg
+	// alarm and resuming the processing of headers.
+	ResolveDeepReorg(ctx context.Context, in *MsgResolveDeepReorg, opts ...grpc.CallOption) (*MsgResolveDeepReorgResponse, error)
 }
 
 type msgClient struct {
@@ -298,12 +402,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ResolveDeepReorg(ctx context.Context, in *MsgResolveDeepReorg, opts ...grpc.CallOption) (*MsgResolveDeepReorgResponse, error) {
+	out := new(MsgResolveDeepReorgResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Msg/ResolveDeepReorg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// InsertHeaders adds a batch of headers to the BTC light client chain
 	InsertHeaders(context.Context, *MsgInsertHeaders) (*MsgInsertHeadersResponse, error)
 	// UpdateParams defines a method for updating btc light client module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ResolveDeepReorg defines a governance operation for resolving a deep reorg
+	// alarm and resuming the processing of headers.
+	ResolveDeepReorg(context.Context, *MsgResolveDeepReorg) (*MsgResolveDeepReorgResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -316,6 +432,9 @@ func (*UnimplementedMsgServer) InsertHeaders(ctx context.Context, req *MsgInsert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ResolveDeepReorg(ctx context.Context, req *MsgResolveDeepReorg) (*MsgResolveDeepReorgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDeepReorg not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -357,6 +476,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResolveDeepReorg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveDeepReorg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveDeepReorg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Msg/ResolveDeepReorg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveDeepReorg(ctx, req.(*MsgResolveDeepReorg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btclightclient.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -369,6 +506,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ResolveDeepReorg",
+			Handler:    _Msg_ResolveDeepReorg_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btclightclient/v1/tx.proto",
@@ -504,6 +645,69 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgResolveDeepReorg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDeepReorg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDeepReorg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplyFork {
+		i--
+		if m.ApplyFork {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveDeepReorgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveDeepReorgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveDeepReorgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -567,6 +771,31 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgResolveDeepReorg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApplyFork {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolveDeepReorgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -905,6 +1134,158 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResolveDeepReorg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDeepReorg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDeepReorg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyFork", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyFork = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveDeepReorgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveDeepReorgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveDeepReorgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagStatuses = "statuses"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
 	// Group btcstaking queries under a subcommand
@@ -31,6 +35,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdBTCDelegationsByStakerAddr())
+	cmd.AddCommand(CmdBTCDelegationsByDelegatorBtcPk())
+	cmd.AddCommand(CmdConsumers())
+	cmd.AddCommand(CmdConsumerFinalityProvidersAtHeight())
 
 	return cmd
 }
@@ -126,7 +134,7 @@ func CmdFinalityProviders() *cobra.Command {
 func CmdBTCDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-delegations [status]",
-		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, any)",
+		Short: "retrieve all BTC delegations under the given status (pending, active, unbonded, expired, slashed, any)",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -253,6 +261,77 @@ func CmdFinalityProvidersAtHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdConsumers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers",
+		Short: "retrieve all registered consumer chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Consumers(cmd.Context(), &types.QueryConsumersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumers")
+
+	return cmd
+}
+
+func CmdConsumerFinalityProvidersAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-finality-providers-at-height [consumer_id] [height]",
+		Short: "retrieve all finality providers of a given consumer chain at a given babylon height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ConsumerFinalityProvidersAtHeight(cmd.Context(), &types.QueryConsumerFinalityProvidersAtHeightRequest{
+				ConsumerId: args[0],
+				Height:     height,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-finality-providers-at-height")
+
+	return cmd
+}
+
 func CmdFinalityProviderDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-provider-delegations [fp_pk_hex]",
@@ -268,9 +347,15 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 				return err
 			}
 
+			statuses, err := readStatusesFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.FinalityProviderDelegations(cmd.Context(), &types.QueryFinalityProviderDelegationsRequest{
 				FpBtcPkHex: args[0],
 				Pagination: pageReq,
+				Statuses:   statuses,
 			})
 			if err != nil {
 				return err
@@ -282,6 +367,104 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "finality-provider-delegations")
+	cmd.Flags().StringSlice(flagStatuses, nil, "Statuses of the queried BTC delegations (pending|active|unbonded|expired|slashed), all statuses if empty")
 
 	return cmd
 }
+
+func CmdBTCDelegationsByStakerAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-staker-addr [staker_addr]",
+		Short: "retrieve all BTC delegations of a given staker's Babylon address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statuses, err := readStatusesFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByStakerAddr(cmd.Context(), &types.QueryBTCDelegationsByStakerAddrRequest{
+				StakerAddr: args[0],
+				Statuses:   statuses,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-staker-addr")
+	cmd.Flags().StringSlice(flagStatuses, nil, "Statuses of the queried BTC delegations (pending|active|unbonded|expired|slashed), all statuses if empty")
+
+	return cmd
+}
+
+func CmdBTCDelegationsByDelegatorBtcPk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-delegator-btc-pk [del_btc_pk_hex]",
+		Short: "retrieve all BTC delegations of a given delegator's BTC public key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			statuses, err := readStatusesFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByDelegatorBtcPk(cmd.Context(), &types.QueryBTCDelegationsByDelegatorBtcPkRequest{
+				DelBtcPkHex: args[0],
+				Statuses:    statuses,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-delegator-btc-pk")
+	cmd.Flags().StringSlice(flagStatuses, nil, "Statuses of the queried BTC delegations (pending|active|unbonded|expired|slashed), all statuses if empty")
+
+	return cmd
+}
+
+// readStatusesFlag parses the BTC delegation statuses from the statuses flag
+func readStatusesFlag(cmd *cobra.Command) ([]types.BTCDelegationStatus, error) {
+	statusStrs, err := cmd.Flags().GetStringSlice(flagStatuses)
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]types.BTCDelegationStatus, 0, len(statusStrs))
+	for _, statusStr := range statusStrs {
+		status, err := types.NewBTCDelegationStatusFromString(statusStr)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
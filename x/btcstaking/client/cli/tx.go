@@ -25,6 +25,9 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 	FlagCommissionRate  = "commission-rate"
+	FlagMaxRate         = "commission-max-rate"
+	FlagMaxChangeRate   = "commission-max-change-rate"
+	FlagConsumerID      = "consumer-id"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -40,10 +43,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateFinalityProviderCmd(),
 		NewEditFinalityProviderCmd(),
+		NewRetireFinalityProviderCmd(),
+		NewRotateFinalityProviderAddrCmd(),
 		NewCreateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
 		NewBTCUndelegateCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
+		NewReportStakingOutputSpendCmd(),
+		NewAddBTCDelegationInclusionProofCmd(),
 	)
 
 	return cmd
@@ -85,6 +92,27 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 				return err
 			}
 
+			// get the optional maximum commission rate and maximum commission
+			// change rate
+			var maxRate, maxChangeRate *sdkmath.LegacyDec
+			if maxRateStr, _ := fs.GetString(FlagMaxRate); maxRateStr != "" {
+				r, err := sdkmath.LegacyNewDecFromStr(maxRateStr)
+				if err != nil {
+					return err
+				}
+				maxRate = &r
+			}
+			if maxChangeRateStr, _ := fs.GetString(FlagMaxChangeRate); maxChangeRateStr != "" {
+				r, err := sdkmath.LegacyNewDecFromStr(maxChangeRateStr)
+				if err != nil {
+					return err
+				}
+				maxChangeRate = &r
+			}
+
+			// get consumer chain ID
+			consumerID, _ := fs.GetString(FlagConsumerID)
+
 			// get BTC PK
 			btcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
 			if err != nil {
@@ -103,6 +131,10 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 				Commission:  &rate,
 				BtcPk:       btcPK,
 				Pop:         pop,
+				ConsumerId:  consumerID,
+
+				MaxCommissionRate:       maxRate,
+				MaxCommissionChangeRate: maxChangeRate,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -116,6 +148,9 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 	fs.String(FlagDetails, "", "The finality provider's (optional) details")
 	fs.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
 	fs.String(FlagCommissionRate, "0", "The initial commission rate percentage")
+	fs.String(FlagMaxRate, "", "The (optional) maximum commission rate percentage, 1 by default")
+	fs.String(FlagMaxChangeRate, "", "The (optional) maximum commission rate change percentage per change, the maximum commission rate by default")
+	fs.String(FlagConsumerID, "", "The (optional) ID of the consumer chain secured by the finality provider, empty for Babylon")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -188,6 +223,81 @@ func NewEditFinalityProviderCmd() *cobra.Command {
 	return cmd
 }
 
+func NewRetireFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-finality-provider [btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Retire a finality provider",
+		Long: strings.TrimSpace(
+			`Retire a finality provider. A retired finality provider does not accept new BTC delegations, and leaves the active set once its existing BTC delegations end.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get BTC PK
+			btcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRetireFinalityProvider{
+				Addr:  clientCtx.FromAddress.String(),
+				BtcPk: btcPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRotateFinalityProviderAddrCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-finality-provider-addr [btc_pk] [pop]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Rotate the Babylon address of a finality provider to the signer",
+		Long: strings.TrimSpace(
+			`Rotate the Babylon address of a finality provider to the signer, authorized by the proof of possession of the finality provider's BTC PK over the signer's address.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get BTC PK
+			btcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get PoP over the new address
+			pop, err := types.NewPoPBTCFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRotateFinalityProviderAddr{
+				NewAddr: clientCtx.FromAddress.String(),
+				BtcPk:   btcPK,
+				Pop:     pop,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCreateBTCDelegationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-btc-delegation [btc_pk] [pop_hex] [staking_tx_info] [fp_pk] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
@@ -447,3 +557,79 @@ func NewSelectiveSlashingEvidenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewReportStakingOutputSpendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-staking-output-spend [staking_tx_hash] [spend_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Report that the staking output of a BTC delegation is spent on Bitcoin.",
+		Long: strings.TrimSpace(
+			`Report that the staking output of a BTC delegation identified by a given staking tx hash is spent on Bitcoin. The spend tx info is the hex-encoded spending tx together with its inclusion proof. Babylon will move the BTC delegation to the state corresponding to the spending path of the staking output.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx hash
+			stakingTxHash := args[0]
+
+			// get spend tx info
+			spendTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReportStakingOutputSpend{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: stakingTxHash,
+				SpendTxInfo:   spendTxInfo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddBTCDelegationInclusionProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-btc-delegation-inclusion-proof [staking_tx_hash] [staking_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add the inclusion proof of the staking tx of a pre-staking BTC delegation.",
+		Long: strings.TrimSpace(
+			`Add the inclusion proof of the staking tx of a pre-staking BTC delegation identified by a given staking tx hash. The staking tx info is the hex-encoded staking tx together with its inclusion proof. Babylon will start the timelock of the BTC delegation, which becomes active once it has covenant quorums.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx hash
+			stakingTxHash := args[0]
+
+			// get staking tx info
+			stakingTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddBTCDelegationInclusionProof{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: stakingTxHash,
+				StakingTxInfo: stakingTxInfo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -46,6 +46,10 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 
 	// save this BTC delegation
 	k.setBTCDelegation(ctx, btcDel)
+	// index this BTC delegation under its staker address and delegator BTC PK
+	if err := k.indexBTCDelegationByDelegator(ctx, btcDel, stakingTxHash); err != nil {
+		return err
+	}
 
 	// notify subscriber
 	event := &types.EventBTCDelegationStateUpdate{
@@ -59,13 +63,20 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 	// NOTE: we don't need to record events for pending BTC delegations since these
 	// do not affect voting power distribution
 
-	// record event that the BTC delegation will become unbonded at endHeight-w
-	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+	// a pre-staking BTC delegation does not have a timelock yet, and will be
+	// removed if its inclusion proof is not submitted before the deadline
+	if !btcDel.HasInclusionProof() {
+		k.addPreStakingExpiry(ctx, btcDel.InclusionProofDeadline, stakingTxHash)
+		return nil
+	}
+
+	// record event that the BTC delegation will become expired at endHeight-w
+	expiredEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
 		StakingTxHash: stakingTxHash.String(),
-		NewState:      types.BTCDelegationStatus_UNBONDED,
+		NewState:      types.BTCDelegationStatus_EXPIRED,
 	})
 	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
-	k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-wValue, unbondedEvent)
+	k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-wValue, expiredEvent)
 
 	return nil
 }
@@ -91,6 +102,20 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 
 	k.setBTCDelegation(ctx, btcDel)
 
+	// If reaching the covenant quorum after this msg, a pre-staking BTC
+	// delegation becomes verified and waits for its inclusion proof
+	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) && !btcDel.HasInclusionProof() {
+		// notify subscriber
+		event := &types.EventBTCDelegationStateUpdate{
+			StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+			NewState:      types.BTCDelegationStatus_VERIFIED,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new verified BTC delegation: %w", err))
+		}
+		return
+	}
+
 	// If reaching the covenant quorum after this msg, the BTC delegation becomes
 	// active. Then, record and emit this event
 	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) {
@@ -117,7 +142,9 @@ func (k Keeper) btcUndelegate(
 	btcDel *types.BTCDelegation,
 	unbondingTxSig *bbn.BIP340Signature,
 ) {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	btcDel.BtcUndelegation.DelegatorUnbondingSig = unbondingTxSig
+	btcDel.BtcUndelegation.DelegatorUnbondingBtcHeight = btcTip.Height
 	k.setBTCDelegation(ctx, btcDel)
 
 	// notify subscriber about this unbonded BTC delegation
@@ -132,10 +159,36 @@ func (k Keeper) btcUndelegate(
 
 	// record event that the BTC delegation becomes unbonded at this height
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
-	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
 }
 
+// reportStakingOutputSpend records the spend of the staking output of the
+// given BTC delegation on Bitcoin, and the resulting state update that
+// removes the BTC delegation's voting power
+func (k Keeper) reportStakingOutputSpend(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	spend *types.StakingOutputSpend,
+) {
+	btcDel.StakingOutputSpend = spend
+	k.setBTCDelegation(ctx, btcDel)
+
+	// notify subscriber about the new state of this BTC delegation
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      spend.SpendPath.DelegationStatus(),
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the spent BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation loses its voting power at this height
+	spentEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, spentEvent)
+}
+
 func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
 	store := k.btcDelegationStore(ctx)
 	stakingTxHash := btcDel.MustGetStakingTxHash()
@@ -169,6 +222,39 @@ func (k Keeper) getBTCDelegation(ctx context.Context, stakingTxHash chainhash.Ha
 	return &btcDel
 }
 
+// getBTCDelegationStatus returns the status of the given BTC delegation,
+// including whether it is slashed due to any of its restaked finality providers.
+// The given map caches the finality providers loaded so far, keyed by their
+// BTC PK hex, so that they are not repeatedly loaded across BTC delegations.
+func (k Keeper) getBTCDelegationStatus(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	fps map[string]*types.FinalityProvider,
+	btcHeight uint64,
+	w uint64,
+	covenantQuorum uint32,
+) types.BTCDelegationStatus {
+	slashedFps := []*types.FinalityProvider{}
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fpBTCPKHex := fpBTCPK.MarshalHex()
+		fp, ok := fps[fpBTCPKHex]
+		if !ok {
+			var err error
+			fp, err = k.GetFinalityProvider(ctx, fpBTCPK)
+			if err != nil {
+				// a BTC delegation can only restake to existing finality providers
+				panic(fmt.Errorf("failed to get finality provider %s of a BTC delegation: %w", fpBTCPKHex, err))
+			}
+			fps[fpBTCPKHex] = fp
+		}
+		if fp.IsSlashed() {
+			slashedFps = append(slashedFps, fp)
+		}
+	}
+
+	return btcDel.GetStatusWithSlashedFps(slashedFps, btcHeight, w, covenantQuorum)
+}
+
 // btcDelegationStore returns the KVStore of the BTC delegations
 // prefix: BTCDelegationKey
 // key: BTC delegation's staking tx hash
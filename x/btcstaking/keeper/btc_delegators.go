@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -77,3 +79,37 @@ func (k Keeper) btcDelegatorStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BTCDelegatorKey)
 }
+
+// indexBTCDelegationByDelegator indexes the staking tx hash of the given BTC
+// delegation under its staker's Babylon address and its delegator's BTC PK
+func (k Keeper) indexBTCDelegationByDelegator(ctx context.Context, btcDel *types.BTCDelegation, stakingTxHash chainhash.Hash) error {
+	stakerAddr, err := sdk.AccAddressFromBech32(btcDel.StakerAddr)
+	if err != nil {
+		return err
+	}
+	k.btcDelegationStakerAddrStore(ctx, stakerAddr).Set(stakingTxHash[:], []byte{})
+	k.btcDelegationDelBTCPKStore(ctx, btcDel.BtcPk).Set(stakingTxHash[:], []byte{})
+	return nil
+}
+
+// btcDelegationStakerAddrStore returns the KVStore of the BTC delegations
+// indexed by the given staker's Babylon address
+// prefix: BTCDelegationByStakerAddrKey || length-prefixed staker address
+// key: BTC delegation's staking tx hash
+// value: empty
+func (k Keeper) btcDelegationStakerAddrStore(ctx context.Context, stakerAddr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, types.BTCDelegationByStakerAddrKey)
+	return prefix.NewStore(indexStore, address.MustLengthPrefix(stakerAddr))
+}
+
+// btcDelegationDelBTCPKStore returns the KVStore of the BTC delegations
+// indexed by the given delegator's BTC PK
+// prefix: BTCDelegationByDelBTCPKKey || delegator's Bitcoin secp256k1 PK
+// key: BTC delegation's staking tx hash
+// value: empty
+func (k Keeper) btcDelegationDelBTCPKStore(ctx context.Context, delBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, types.BTCDelegationByDelBTCPKKey)
+	return prefix.NewStore(indexStore, delBTCPK.MustMarshal())
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// scheduleCommissionChange schedules a change of the given finality
+// provider's commission rate, which takes effect after the commission change
+// delay. The change has to satisfy that
+//   - the new commission rate is at most the finality provider's maximum
+//     commission rate,
+//   - the change w.r.t. the commission rate in effect is at most the finality
+//     provider's maximum commission change rate, and
+//   - the last commission change of the finality provider is at least the
+//     minimum commission change interval ago.
+//
+// A previously scheduled change that has not taken effect yet is replaced.
+// The caller is responsible for setting the finality provider back to the store
+func (k Keeper) scheduleCommissionChange(ctx context.Context, fp *types.FinalityProvider, commission math.LegacyDec) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	params := k.GetParams(ctx)
+
+	if maxRate := fp.GetMaxCommissionRate(); commission.GT(maxRate) {
+		return types.ErrCommissionGTFpMaxRate.Wrapf("max commission rate: %s", maxRate)
+	}
+	if maxChangeRate := fp.GetMaxCommissionChangeRate(); commission.Sub(*fp.Commission).Abs().GT(maxChangeRate) {
+		return types.ErrCommissionChangeGTMaxRate.Wrapf("current commission rate: %s, max commission change rate: %s", fp.Commission, maxChangeRate)
+	}
+	if nextHeight := fp.CommissionUpdateHeight + params.MinCommissionChangeInterval; height < nextHeight {
+		return types.ErrCommissionUpdateTooSoon.Wrapf("the next commission change is allowed since height %d", nextHeight)
+	}
+
+	// the voting power distribution cache of the current height is already
+	// recorded, thus the change takes effect at the next height at the earliest
+	delay := params.CommissionChangeDelay
+	if delay == 0 {
+		delay = 1
+	}
+
+	store := k.commissionChangeStore(ctx)
+	if fp.HasPendingCommission() {
+		store.Delete(commissionChangeKey(fp.PendingCommissionHeight, fp.BtcPk))
+	}
+	fp.PendingCommission = &commission
+	fp.PendingCommissionHeight = height + delay
+	fp.CommissionUpdateHeight = height
+	store.Set(commissionChangeKey(fp.PendingCommissionHeight, fp.BtcPk), []byte{})
+
+	return nil
+}
+
+// cancelCommissionChange cancels the pending commission change of the given
+// finality provider. The minimum commission change interval still counts from
+// the cancelled change, such that cancelling cannot be used for bypassing it.
+// The caller is responsible for setting the finality provider back to the store
+func (k Keeper) cancelCommissionChange(ctx context.Context, fp *types.FinalityProvider) {
+	k.commissionChangeStore(ctx).Delete(commissionChangeKey(fp.PendingCommissionHeight, fp.BtcPk))
+	fp.PendingCommission = nil
+	fp.PendingCommissionHeight = 0
+}
+
+// ApplyScheduledCommissionChanges applies the commission changes of finality
+// providers that take effect at the current height, and returns the new
+// commission rates keyed by the finality providers' BTC PK hex. This is
+// called in `BeginBlocker` before the voting power distribution cache of the
+// current height is recorded
+func (k Keeper) ApplyScheduledCommissionChanges(ctx context.Context) map[string]math.LegacyDec {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+
+	// collect the keys of all commission changes that take effect at or
+	// before the current height
+	store := k.commissionChangeStore(ctx)
+	keys := [][]byte{}
+	func() {
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	commissions := map[string]math.LegacyDec{}
+	for _, key := range keys {
+		store.Delete(key)
+
+		fpBTCPK := bbn.BIP340PubKey(key[8:])
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			// a scheduled commission change of a non-existing finality
+			// provider is a programming error
+			panic(err)
+		}
+		if !fp.HasPendingCommission() {
+			continue
+		}
+		fp.Commission = fp.PendingCommission
+		fp.PendingCommission = nil
+		fp.PendingCommissionHeight = 0
+		k.SetFinalityProvider(ctx, fp)
+
+		commissions[fpBTCPK.MarshalHex()] = *fp.Commission
+	}
+
+	return commissions
+}
+
+func commissionChangeKey(height uint64, fpBTCPK *bbn.BIP340PubKey) []byte {
+	return append(sdk.Uint64ToBigEndian(height), fpBTCPK.MustMarshal()...)
+}
+
+// commissionChangeStore returns the KVStore of the scheduled commission
+// changes of finality providers
+// prefix: CommissionChangeKey
+// key: (Babylon height at which the change takes effect || finality provider BTC PK)
+// value: empty
+func (k Keeper) commissionChangeStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.CommissionChangeKey)
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+/* consumer chain registry */
+
+// RegisterConsumer registers a new consumer chain
+func (k Keeper) RegisterConsumer(ctx context.Context, consumer *types.ConsumerRegister) error {
+	if err := consumer.ValidateBasic(); err != nil {
+		return err
+	}
+	if k.HasConsumer(ctx, consumer.ConsumerId) {
+		return types.ErrConsumerAlreadyRegistered.Wrapf("consumer ID: %s", consumer.ConsumerId)
+	}
+	k.setConsumer(ctx, consumer)
+	return nil
+}
+
+func (k Keeper) setConsumer(ctx context.Context, consumer *types.ConsumerRegister) {
+	store := k.consumerStore(ctx)
+	store.Set([]byte(consumer.ConsumerId), k.cdc.MustMarshal(consumer))
+}
+
+// HasConsumer checks if the consumer chain with the given ID is registered
+func (k Keeper) HasConsumer(ctx context.Context, consumerID string) bool {
+	store := k.consumerStore(ctx)
+	return store.Has([]byte(consumerID))
+}
+
+// GetConsumer gets the consumer chain register with the given ID
+func (k Keeper) GetConsumer(ctx context.Context, consumerID string) (*types.ConsumerRegister, error) {
+	store := k.consumerStore(ctx)
+	consumerBytes := store.Get([]byte(consumerID))
+	if len(consumerBytes) == 0 {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", consumerID)
+	}
+	var consumer types.ConsumerRegister
+	k.cdc.MustUnmarshal(consumerBytes, &consumer)
+	return &consumer, nil
+}
+
+// GetAllConsumerIDs gets the IDs of all registered consumer chains
+func (k Keeper) GetAllConsumerIDs(ctx context.Context) []string {
+	iter := k.consumerStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	consumerIDs := []string{}
+	for ; iter.Valid(); iter.Next() {
+		consumerIDs = append(consumerIDs, string(iter.Key()))
+	}
+	return consumerIDs
+}
+
+// consumerStore returns the KVStore of the consumer chain registers
+// prefix: ConsumerRegisterKey
+// key: consumer chain ID
+// value: ConsumerRegister
+func (k Keeper) consumerStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ConsumerRegisterKey)
+}
+
+/* consumer voting power table */
+
+func (k Keeper) SetConsumerVotingPower(ctx context.Context, consumerID string, fpBTCPK []byte, height uint64, power uint64) {
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, height)
+	store.Set(fpBTCPK, sdk.Uint64ToBigEndian(power))
+}
+
+// GetConsumerVotingPower gets the voting power of a given consumer finality
+// provider at a given Babylon height
+func (k Keeper) GetConsumerVotingPower(ctx context.Context, consumerID string, fpBTCPK []byte, height uint64) uint64 {
+	recordedHeight, ok := k.getConsumerVotingPowerRecordedHeight(ctx, consumerID, height)
+	if !ok {
+		return 0
+	}
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, recordedHeight)
+	powerBytes := store.Get(fpBTCPK)
+	if len(powerBytes) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(powerBytes)
+}
+
+// GetConsumerVotingPowerTable gets the voting power table, i.e., finality
+// provider set of the given consumer chain at a given height
+func (k Keeper) GetConsumerVotingPowerTable(ctx context.Context, consumerID string, height uint64) map[string]uint64 {
+	recordedHeight, ok := k.getConsumerVotingPowerRecordedHeight(ctx, consumerID, height)
+	if !ok {
+		return nil
+	}
+	store := k.consumerVotingPowerBbnBlockHeightStore(ctx, consumerID, recordedHeight)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	// if no finality provider at this height, return nil
+	if !iter.Valid() {
+		return nil
+	}
+
+	// get all finality providers at this height
+	fpSet := map[string]uint64{}
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		fpSet[fpBTCPK.MarshalHex()] = sdk.BigEndianToUint64(iter.Value())
+	}
+
+	return fpSet
+}
+
+// consumerVotingPowerBbnBlockHeightStore returns the KVStore of the consumer
+// finality providers' voting power
+// prefix: (ConsumerVotingPowerKey || length-prefixed consumer chain ID || Babylon block height)
+// key: Bitcoin secp256k1 PK
+// value: voting power quantified in Satoshi
+func (k Keeper) consumerVotingPowerBbnBlockHeightStore(ctx context.Context, consumerID string, height uint64) prefix.Store {
+	store := k.consumerVotingPowerStore(ctx, consumerID)
+	return prefix.NewStore(store, sdk.Uint64ToBigEndian(height))
+}
+
+// consumerVotingPowerStore returns the KVStore of the consumer finality
+// providers' voting power
+// prefix: (ConsumerVotingPowerKey || length-prefixed consumer chain ID)
+// key: Babylon block height || Bitcoin secp256k1 PK
+// value: voting power quantified in Satoshi
+func (k Keeper) consumerVotingPowerStore(ctx context.Context, consumerID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ConsumerVotingPowerKey)
+	return prefix.NewStore(store, address.MustLengthPrefix([]byte(consumerID)))
+}
+
+/* consumer voting power distribution cache */
+
+func (k Keeper) setConsumerVotingPowerDistCache(ctx context.Context, consumerID string, height uint64, dc *types.VotingPowerDistCache) {
+	store := k.consumerVotingPowerDistCacheStore(ctx, consumerID)
+	store.Set(sdk.Uint64ToBigEndian(height), k.cdc.MustMarshal(dc))
+}
+
+// getConsumerVotingPowerDistCache gets the voting power distribution cache of
+// the given consumer chain in effect at the given Babylon height, i.e., the
+// one recorded at the latest height no later than the given height
+func (k Keeper) getConsumerVotingPowerDistCache(ctx context.Context, consumerID string, height uint64) *types.VotingPowerDistCache {
+	recordedHeight, ok := k.getConsumerVotingPowerRecordedHeight(ctx, consumerID, height)
+	if !ok {
+		return nil
+	}
+	store := k.consumerVotingPowerDistCacheStore(ctx, consumerID)
+	dcBytes := store.Get(sdk.Uint64ToBigEndian(recordedHeight))
+	var dc types.VotingPowerDistCache
+	k.cdc.MustUnmarshal(dcBytes, &dc)
+	return &dc
+}
+
+// getConsumerVotingPowerRecordedHeight returns the latest Babylon height no
+// later than the given height at which the voting power table and
+// distribution cache of the given consumer chain are recorded. They are only
+// recorded upon changes, so that they remain in effect until the next
+// recorded height
+func (k Keeper) getConsumerVotingPowerRecordedHeight(ctx context.Context, consumerID string, height uint64) (uint64, bool) {
+	store := k.consumerVotingPowerDistCacheStore(ctx, consumerID)
+	iter := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iter.Key()), true
+}
+
+// consumerVotingPowerDistCacheStore returns the KVStore of the voting power
+// distribution cache of the given consumer chain
+// prefix: ConsumerVotingPowerDistCacheKey || length-prefixed consumer chain ID
+// key: Babylon block height
+// value: VotingPowerDistCache
+func (k Keeper) consumerVotingPowerDistCacheStore(ctx context.Context, consumerID string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.ConsumerVotingPowerDistCacheKey)
+	return prefix.NewStore(store, address.MustLengthPrefix([]byte(consumerID)))
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzRestakingToConsumers(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		/*
+			register a consumer chain
+		*/
+		consumer := &types.ConsumerRegister{
+			ConsumerId:          datagen.GenRandomHexStr(r, 10),
+			ConsumerName:        datagen.GenRandomHexStr(r, 5),
+			ConsumerDescription: datagen.GenRandomHexStr(r, 15),
+		}
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		// registering via a non-governance account is rejected
+		_, err = h.MsgServer.RegisterConsumer(h.Ctx, &types.MsgRegisterConsumer{
+			Authority: datagen.GenRandomAccount().Address,
+			Consumer:  consumer,
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+		_, err = h.MsgServer.RegisterConsumer(h.Ctx, &types.MsgRegisterConsumer{
+			Authority: authority,
+			Consumer:  consumer,
+		})
+		require.NoError(t, err)
+		// duplicate registration is rejected
+		_, err = h.MsgServer.RegisterConsumer(h.Ctx, &types.MsgRegisterConsumer{
+			Authority: authority,
+			Consumer:  consumer,
+		})
+		require.ErrorIs(t, err, types.ErrConsumerAlreadyRegistered)
+
+		consumersResp, err := h.BTCStakingKeeper.Consumers(h.Ctx, &types.QueryConsumersRequest{})
+		require.NoError(t, err)
+		require.Len(t, consumersResp.Consumers, 1)
+		require.Equal(t, consumer, consumersResp.Consumers[0])
+
+		/*
+			create finality providers
+		*/
+		// finality provider securing an unregistered consumer chain is rejected
+		fpSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
+		require.NoError(t, err)
+		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+			Addr:        fp.Addr,
+			Description: fp.Description,
+			Commission:  fp.Commission,
+			BtcPk:       fp.BtcPk,
+			Pop:         fp.Pop,
+			ConsumerId:  datagen.GenRandomHexStr(r, 10),
+		})
+		require.ErrorIs(t, err, types.ErrConsumerNotRegistered)
+
+		_, bbnFPPK, bbnFP := h.CreateFinalityProvider(r)
+		_, bbnFPPK2, _ := h.CreateFinalityProvider(r)
+		_, consumerFPPK, consumerFP := h.CreateConsumerFinalityProvider(r, consumer.ConsumerId)
+		_, consumerFPPK2, _ := h.CreateConsumerFinalityProvider(r, consumer.ConsumerId)
+		actualConsumerFP, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *consumerFP.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, consumer.ConsumerId, actualConsumerFP.ConsumerId)
+
+		/*
+			BTC delegations restaking to multiple finality providers
+			of the same chain are rejected
+		*/
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+		stakingValue := int64(2 * 10e8)
+		for _, fpPKs := range [][]*btcec.PublicKey{
+			{bbnFPPK, bbnFPPK2},
+			{bbnFPPK, consumerFPPK, consumerFPPK2},
+		} {
+			_, _, _, _, err = h.CreateRestakedDelegationCustom(
+				r,
+				fpPKs,
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+				stakingValue-1000,
+				uint16(minUnbondingTime)+1,
+			)
+			require.ErrorIs(t, err, types.ErrInvalidFpChains)
+		}
+
+		/*
+			BTC delegation restaking to a Babylon finality provider and a
+			consumer finality provider
+		*/
+		stakingTxHash, _, _, msgCreateBTCDel, err := h.CreateRestakedDelegationCustom(
+			r,
+			[]*btcec.PublicKey{bbnFPPK, consumerFPPK},
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+		)
+		require.NoError(t, err)
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		// give it a quorum number of covenant signatures
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(bsParams.CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+
+		// execute BeginBlock
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+
+		// the voting power table of Babylon only includes the Babylon finality provider
+		require.Equal(t, map[string]uint64{
+			bbnFP.BtcPk.MarshalHex(): uint64(stakingValue),
+		}, h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, babylonHeight))
+		// the voting power table of the consumer chain only includes the
+		// consumer finality provider
+		require.Equal(t, map[string]uint64{
+			consumerFP.BtcPk.MarshalHex(): uint64(stakingValue),
+		}, h.BTCStakingKeeper.GetConsumerVotingPowerTable(h.Ctx, consumer.ConsumerId, babylonHeight))
+
+		resp, err := h.BTCStakingKeeper.ConsumerFinalityProvidersAtHeight(h.Ctx, &types.QueryConsumerFinalityProvidersAtHeightRequest{
+			ConsumerId: consumer.ConsumerId,
+			Height:     babylonHeight,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.FinalityProviders, 1)
+		require.Equal(t, consumerFP.BtcPk, resp.FinalityProviders[0].BtcPk)
+		require.Equal(t, uint64(stakingValue), resp.FinalityProviders[0].VotingPower)
+
+		// the consumer voting power table remains in effect at the next height
+		// if there are no new events, without being recorded again
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetConsumerVotingPower(h.Ctx, consumer.ConsumerId, consumerFP.BtcPk.MustMarshal(), babylonHeight))
+		resp, err = h.BTCStakingKeeper.ConsumerFinalityProvidersAtHeight(h.Ctx, &types.QueryConsumerFinalityProvidersAtHeightRequest{
+			ConsumerId: consumer.ConsumerId,
+			Height:     babylonHeight,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.FinalityProviders, 1)
+
+		/*
+			the consumer chain and its voting power tables are exported and
+			imported via genesis
+		*/
+		gs, err := h.BTCStakingKeeper.ExportGenesis(h.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, []*types.ConsumerRegister{consumer}, gs.Consumers)
+		require.Len(t, gs.ConsumerVotingPowers, 1)
+		require.Len(t, gs.ConsumerVpDstCache, 1)
+
+		h2 := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		err = h2.BTCStakingKeeper.InitGenesis(h2.Ctx, *gs)
+		require.NoError(t, err)
+		require.True(t, h2.BTCStakingKeeper.HasConsumer(h2.Ctx, consumer.ConsumerId))
+		require.Equal(t,
+			h.BTCStakingKeeper.GetConsumerVotingPowerTable(h.Ctx, consumer.ConsumerId, babylonHeight),
+			h2.BTCStakingKeeper.GetConsumerVotingPowerTable(h2.Ctx, consumer.ConsumerId, babylonHeight),
+		)
+		gs2, err := h2.BTCStakingKeeper.ExportGenesis(h2.Ctx)
+		require.NoError(t, err)
+		require.Equal(t, gs.ConsumerVotingPowers, gs2.ConsumerVotingPowers)
+		require.Equal(t, gs.ConsumerVpDstCache, gs2.ConsumerVpDstCache)
+
+		/*
+			slashing the consumer finality provider slashes the BTC delegation,
+			which then loses its voting power under the Babylon finality
+			provider as well
+		*/
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, consumerFP.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, bbnFP.BtcPk.MustMarshal(), babylonHeight))
+		require.Empty(t, h.BTCStakingKeeper.GetConsumerVotingPowerTable(h.Ctx, consumer.ConsumerId, babylonHeight))
+		delResp, err := h.BTCStakingKeeper.BTCDelegation(h.Ctx, &types.QueryBTCDelegationRequest{StakingTxHashHex: stakingTxHash})
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_SLASHED.String(), delResp.BtcDelegation.StatusDesc)
+	})
+}
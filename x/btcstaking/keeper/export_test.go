@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// SetBTCDelegationWithoutIndexes stores the given BTC delegation without
+// indexing it by staker address and delegator BTC PK, as done before version 2
+func (k Keeper) SetBTCDelegationWithoutIndexes(ctx context.Context, btcDel *types.BTCDelegation) {
+	k.setBTCDelegation(ctx, btcDel)
+}
@@ -66,6 +66,139 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	return nil
 }
 
+// JailFinalityProvider jails a finality provider with the given PK
+// A jailed finality provider will not have voting power until it is unjailed
+func (k Keeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed or jailed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.IsJailed() {
+		return types.ErrFpAlreadyJailed
+	}
+
+	// set finality provider to be jailed
+	fp.Jailed = true
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record jailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithJailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider with the given PK
+func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is jailed and not slashed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if !fp.IsJailed() {
+		return types.ErrFpNotJailed
+	}
+
+	// set finality provider to be unjailed
+	fp.Jailed = false
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record unjailed event. The next `BeginBlock` will consume this
+	// event for updating the finality provider set
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithUnjailedFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// retireFinalityProvider retires the given finality provider, such that it
+// does not accept new BTC delegations and leaves the active set from the next
+// height on. Its existing BTC delegations remain until they end
+func (k Keeper) retireFinalityProvider(ctx context.Context, fp *types.FinalityProvider) error {
+	// ensure finality provider is not slashed or retired yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+	if fp.IsRetired() {
+		return types.ErrFpRetired
+	}
+
+	// set finality provider to be retired
+	fp.RetiredBabylonHeight = uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record retired event. The next `BeginBlock` will consume this
+	// event for removing the finality provider from the active set, such
+	// that it has no voting power and thus no obligations of committing
+	// public randomness or voting
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithRetiredFP(fp.BtcPk)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
+// rotateFinalityProviderAddr binds the given finality provider to the given
+// new Babylon address, which receives the commissions of the finality
+// provider from the next height on
+func (k Keeper) rotateFinalityProviderAddr(
+	ctx context.Context,
+	fp *types.FinalityProvider,
+	newAddr string,
+	pop *types.ProofOfPossessionBTC,
+) error {
+	// ensure the new address is never bound to the finality provider, such
+	// that a proof of possession of a previous rotation cannot be replayed
+	if fp.HasBoundAddr(newAddr) {
+		return types.ErrFpAddrUsed.Wrapf("address: %s", newAddr)
+	}
+
+	// set the new address of the finality provider
+	fp.PreviousAddrs = append(fp.PreviousAddrs, fp.Addr)
+	fp.Addr = newAddr
+	fp.Pop = pop
+	k.SetFinalityProvider(ctx, fp)
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// record rotated event. The next `BeginBlock` will consume this
+	// event for updating the finality provider's address in the voting
+	// power distribution
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithRotatedFPAddr(fp.BtcPk, newAddr)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, powerUpdateEvent)
+
+	return nil
+}
+
 // finalityProviderStore returns the KVStore of the finality provider set
 // prefix: FinalityProviderKey
 // key: Bitcoin secp256k1 PK
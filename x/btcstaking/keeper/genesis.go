@@ -20,12 +20,36 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
+	for _, consumer := range gs.Consumers {
+		if err := k.RegisterConsumer(ctx, consumer); err != nil {
+			return err
+		}
+	}
+
 	for _, fp := range gs.FinalityProviders {
 		k.SetFinalityProvider(ctx, fp)
+		// the index of scheduled commission changes is derived from the
+		// finality providers
+		if fp.HasPendingCommission() {
+			k.commissionChangeStore(ctx).Set(commissionChangeKey(fp.PendingCommissionHeight, fp.BtcPk), []byte{})
+		}
 	}
 
 	for _, btcDel := range gs.BtcDelegations {
 		k.setBTCDelegation(ctx, btcDel)
+		// the indexes by staker address and delegator BTC PK are derived
+		// from BTC delegations, thus are not part of the genesis state
+		stakingTxHash, err := btcDel.GetStakingTxHash()
+		if err != nil {
+			return err
+		}
+		if err := k.indexBTCDelegationByDelegator(ctx, btcDel, stakingTxHash); err != nil {
+			return err
+		}
+		// so is the expiry of pre-staking BTC delegations
+		if !btcDel.HasInclusionProof() {
+			k.addPreStakingExpiry(ctx, btcDel.InclusionProofDeadline, stakingTxHash)
+		}
 	}
 
 	for _, fpVP := range gs.VotingPowers {
@@ -55,6 +79,16 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
+	for _, cVP := range gs.ConsumerVotingPowers {
+		fpVP := cVP.VotingPowerFp
+		k.SetConsumerVotingPower(ctx, cVP.ConsumerId, *fpVP.FpBtcPk, fpVP.BlockHeight, fpVP.VotingPower)
+	}
+
+	for _, cVPCache := range gs.ConsumerVpDstCache {
+		vpCache := cVPCache.VpDstCache
+		k.setConsumerVotingPowerDistCache(ctx, cVPCache.ConsumerId, vpCache.BlockHeight, vpCache.VpDistribution)
+	}
+
 	return nil
 }
 
@@ -90,15 +124,33 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	consumers, err := k.consumers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerVpFps, err := k.consumerFpVotingPowers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerVpsCache, err := k.consumerVotingPowersDistCacheBlkHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetAllParams(ctx),
-		FinalityProviders: fps,
-		BtcDelegations:    dels,
-		VotingPowers:      vpFps,
-		BlockHeightChains: k.blockHeightChains(ctx),
-		BtcDelegators:     btcDels,
-		Events:            evts,
-		VpDstCache:        vpsCache,
+		Params:               k.GetAllParams(ctx),
+		FinalityProviders:    fps,
+		BtcDelegations:       dels,
+		VotingPowers:         vpFps,
+		BlockHeightChains:    k.blockHeightChains(ctx),
+		BtcDelegators:        btcDels,
+		Events:               evts,
+		VpDstCache:           vpsCache,
+		Consumers:            consumers,
+		ConsumerVotingPowers: consumerVpFps,
+		ConsumerVpDstCache:   consumerVpsCache,
 	}, nil
 }
 
@@ -246,6 +298,86 @@ func (k Keeper) votingPowersDistCacheBlkHeight(ctx context.Context) ([]*types.Vo
 	return vps, nil
 }
 
+func (k Keeper) consumers(ctx context.Context) ([]*types.ConsumerRegister, error) {
+	consumers := make([]*types.ConsumerRegister, 0)
+	iter := k.consumerStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var consumer types.ConsumerRegister
+		if err := consumer.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		consumers = append(consumers, &consumer)
+	}
+
+	return consumers, nil
+}
+
+// consumerFpVotingPowers gets the voting power of each consumer finality
+// provider at each Babylon height
+func (k Keeper) consumerFpVotingPowers(ctx context.Context) ([]*types.ConsumerVotingPowerFP, error) {
+	cVpFps := make([]*types.ConsumerVotingPowerFP, 0)
+
+	for _, consumerID := range k.GetAllConsumerIDs(ctx) {
+		if err := func() error {
+			iter := k.consumerVotingPowerStore(ctx, consumerID).Iterator(nil, nil)
+			defer iter.Close()
+
+			for ; iter.Valid(); iter.Next() {
+				blkHeight, fpBTCPK, err := btcstk.ParseBlkHeightAndPubKeyFromStoreKey(iter.Key())
+				if err != nil {
+					return err
+				}
+
+				cVpFps = append(cVpFps, &types.ConsumerVotingPowerFP{
+					ConsumerId: consumerID,
+					VotingPowerFp: &types.VotingPowerFP{
+						BlockHeight: blkHeight,
+						FpBtcPk:     fpBTCPK,
+						VotingPower: sdk.BigEndianToUint64(iter.Value()),
+					},
+				})
+			}
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cVpFps, nil
+}
+
+func (k Keeper) consumerVotingPowersDistCacheBlkHeight(ctx context.Context) ([]*types.ConsumerVotingPowerDistCacheBlkHeight, error) {
+	cVps := make([]*types.ConsumerVotingPowerDistCacheBlkHeight, 0)
+
+	for _, consumerID := range k.GetAllConsumerIDs(ctx) {
+		if err := func() error {
+			iter := k.consumerVotingPowerDistCacheStore(ctx, consumerID).Iterator(nil, nil)
+			defer iter.Close()
+
+			for ; iter.Valid(); iter.Next() {
+				var dc types.VotingPowerDistCache
+				if err := dc.Unmarshal(iter.Value()); err != nil {
+					return err
+				}
+				cVps = append(cVps, &types.ConsumerVotingPowerDistCacheBlkHeight{
+					ConsumerId: consumerID,
+					VpDstCache: &types.VotingPowerDistCacheBlkHeight{
+						BlockHeight:    sdk.BigEndianToUint64(iter.Key()),
+						VpDistribution: &dc,
+					},
+				})
+			}
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cVps, nil
+}
+
 func (k Keeper) setBlockHeightChains(ctx context.Context, blocks *types.BlockHeightBbnToBtc) {
 	store := k.btcHeightStore(ctx)
 	store.Set(sdk.Uint64ToBigEndian(blocks.BlockHeightBbn), sdk.Uint64ToBigEndian(blocks.BlockHeightBtc))
@@ -89,10 +89,10 @@ func TestExportGenesis(t *testing.T) {
 				DelBtcPk: del.BtcPk,
 			}
 
-			// record event that the BTC delegation will become unbonded at endHeight-w
-			unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+			// record event that the BTC delegation will become expired at endHeight-w
+			expiredEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
 				StakingTxHash: stakingTxHash.String(),
-				NewState:      types.BTCDelegationStatus_UNBONDED,
+				NewState:      types.BTCDelegationStatus_EXPIRED,
 			})
 
 			// events
@@ -100,7 +100,7 @@ func TestExportGenesis(t *testing.T) {
 			eventsIdx[idxEvent] = &types.EventIndex{
 				Idx:            idxEvent,
 				BlockHeightBtc: del.EndHeight - wValue,
-				Event:          unbondedEvent,
+				Event:          expiredEvent,
 			}
 		}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -93,13 +94,14 @@ func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegatio
 	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 
 	store := k.btcDelegationStore(ctx)
+	fps := map[string]*types.FinalityProvider{}
 	var btcDels []*types.BTCDelegationResponse
 	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
 		var btcDel types.BTCDelegation
 		k.cdc.MustUnmarshal(value, &btcDel)
 
 		// hit if the queried status is ANY or matches the BTC delegation status
-		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		status := k.getBTCDelegationStatus(ctx, &btcDel, fps, btcTipHeight, wValue, covenantQuorum)
 		if req.Status == types.BTCDelegationStatus_ANY || status == req.Status {
 			if accumulate {
 				resp := types.NewBTCDelegationResponse(&btcDel, status)
@@ -191,6 +193,7 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 				VotingPower:          votingPower,
 				SlashedBabylonHeight: finalityProvider.SlashedBabylonHeight,
 				SlashedBtcHeight:     finalityProvider.SlashedBtcHeight,
+				Jailed:               finalityProvider.Jailed,
 			}
 			finalityProvidersWithMeta = append(finalityProvidersWithMeta, &finalityProviderWithMeta)
 		}
@@ -219,6 +222,77 @@ func (k Keeper) ActivatedHeight(ctx context.Context, req *types.QueryActivatedHe
 	return &types.QueryActivatedHeightResponse{Height: activatedHeight}, nil
 }
 
+// Consumers returns all registered consumer chains
+func (k Keeper) Consumers(ctx context.Context, req *types.QueryConsumersRequest) (*types.QueryConsumersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.consumerStore(sdkCtx)
+
+	var consumers []*types.ConsumerRegister
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var consumer types.ConsumerRegister
+		k.cdc.MustUnmarshal(value, &consumer)
+		consumers = append(consumers, &consumer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumersResponse{Consumers: consumers, Pagination: pageRes}, nil
+}
+
+// ConsumerFinalityProvidersAtHeight returns the active finality providers of
+// the given consumer chain at the given Babylon height
+func (k Keeper) ConsumerFinalityProvidersAtHeight(ctx context.Context, req *types.QueryConsumerFinalityProvidersAtHeightRequest) (*types.QueryConsumerFinalityProvidersAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.HasConsumer(sdkCtx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+	// the voting power table is only recorded upon changes, thus use the one
+	// in effect at the given height
+	recordedHeight, ok := k.getConsumerVotingPowerRecordedHeight(sdkCtx, req.ConsumerId, req.Height)
+	if !ok {
+		return &types.QueryConsumerFinalityProvidersAtHeightResponse{}, nil
+	}
+	store := k.consumerVotingPowerBbnBlockHeightStore(sdkCtx, req.ConsumerId, recordedHeight)
+
+	var finalityProvidersWithMeta []*types.FinalityProviderWithMeta
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		finalityProvider, err := k.GetFinalityProvider(sdkCtx, key)
+		if err != nil {
+			return err
+		}
+
+		votingPower := sdk.BigEndianToUint64(value)
+		if votingPower > 0 {
+			finalityProviderWithMeta := types.FinalityProviderWithMeta{
+				BtcPk:                finalityProvider.BtcPk,
+				Height:               req.Height,
+				VotingPower:          votingPower,
+				SlashedBabylonHeight: finalityProvider.SlashedBabylonHeight,
+				SlashedBtcHeight:     finalityProvider.SlashedBtcHeight,
+				Jailed:               finalityProvider.Jailed,
+			}
+			finalityProvidersWithMeta = append(finalityProvidersWithMeta, &finalityProviderWithMeta)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConsumerFinalityProvidersAtHeightResponse{FinalityProviders: finalityProvidersWithMeta, Pagination: pageRes}, nil
+}
+
 // FinalityProviderDelegations returns all the delegations of the provided finality provider filtered by the provided status.
 func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.QueryFinalityProviderDelegationsRequest) (*types.QueryFinalityProviderDelegationsResponse, error) {
 	if req == nil {
@@ -242,29 +316,45 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
 	covenantQuorum := k.GetParams(ctx).CovenantQuorum
 
+	isQueriedStatus := newStatusFilter(req.Statuses)
+	fps := map[string]*types.FinalityProvider{}
 	btcDels := []*types.BTCDelegatorDelegationsResponse{}
-	pageRes, err := query.Paginate(btcDelStore, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(btcDelStore, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
 		delBTCPK, err := bbn.NewBIP340PubKey(key)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		curBTCDels := k.getBTCDelegatorDelegations(sdkCtx, fpPK, delBTCPK)
 
-		btcDelsResp := make([]*types.BTCDelegationResponse, len(curBTCDels.Dels))
-		for i, btcDel := range curBTCDels.Dels {
-			status := btcDel.GetStatus(
+		btcDelsResp := make([]*types.BTCDelegationResponse, 0, len(curBTCDels.Dels))
+		for _, btcDel := range curBTCDels.Dels {
+			status := k.getBTCDelegationStatus(
+				sdkCtx,
+				btcDel,
+				fps,
 				btcHeight,
 				currentWValue,
 				covenantQuorum,
 			)
-			btcDelsResp[i] = types.NewBTCDelegationResponse(btcDel, status)
+			// skip if the BTC delegation status is not queried
+			if !isQueriedStatus(status) {
+				continue
+			}
+			btcDelsResp = append(btcDelsResp, types.NewBTCDelegationResponse(btcDel, status))
 		}
 
-		btcDels = append(btcDels, &types.BTCDelegatorDelegationsResponse{
-			Dels: btcDelsResp,
-		})
-		return nil
+		// skip the BTC delegator if none of its BTC delegations is queried
+		if len(btcDelsResp) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			btcDels = append(btcDels, &types.BTCDelegatorDelegationsResponse{
+				Dels: btcDelsResp,
+			})
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
@@ -292,7 +382,10 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 	}
 
 	currentWValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
-	status := btcDel.GetStatus(
+	status := k.getBTCDelegationStatus(
+		ctx,
+		btcDel,
+		map[string]*types.FinalityProvider{},
 		k.btclcKeeper.GetTipInfo(ctx).Height,
 		currentWValue,
 		k.GetParams(ctx).CovenantQuorum,
@@ -302,3 +395,113 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// BTCDelegationsByStakerAddr returns all BTC delegations of the given staker's
+// Babylon address, filtered by the provided statuses
+func (k Keeper) BTCDelegationsByStakerAddr(ctx context.Context, req *types.QueryBTCDelegationsByStakerAddrRequest) (*types.QueryBTCDelegationsByStakerAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakerAddr, err := sdk.AccAddressFromBech32(req.StakerAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker address: %v", err)
+	}
+
+	store := k.btcDelegationStakerAddrStore(ctx, stakerAddr)
+	btcDels, pageRes, err := k.indexedBTCDelegations(ctx, store, req.Statuses, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByStakerAddrResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BTCDelegationsByDelegatorBtcPk returns all BTC delegations of the given
+// delegator's BTC PK, filtered by the provided statuses
+func (k Keeper) BTCDelegationsByDelegatorBtcPk(ctx context.Context, req *types.QueryBTCDelegationsByDelegatorBtcPkRequest) (*types.QueryBTCDelegationsByDelegatorBtcPkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.DelBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal delegator BTC PK hex: %v", err)
+	}
+
+	store := k.btcDelegationDelBTCPKStore(ctx, delBTCPK)
+	btcDels, pageRes, err := k.indexedBTCDelegations(ctx, store, req.Statuses, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByDelegatorBtcPkResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// indexedBTCDelegations paginates over the given index store of staking tx
+// hashes, and returns the indexed BTC delegations under the queried statuses
+func (k Keeper) indexedBTCDelegations(
+	ctx context.Context,
+	indexStore prefix.Store,
+	statuses []types.BTCDelegationStatus,
+	pagination *query.PageRequest,
+) ([]*types.BTCDelegationResponse, *query.PageResponse, error) {
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	isQueriedStatus := newStatusFilter(statuses)
+	fps := map[string]*types.FinalityProvider{}
+	btcDels := []*types.BTCDelegationResponse{}
+	pageRes, err := query.FilteredPaginate(indexStore, pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		stakingTxHash, err := chainhash.NewHash(key)
+		if err != nil {
+			return false, err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return false, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash)
+		}
+
+		status := k.getBTCDelegationStatus(ctx, btcDel, fps, btcTipHeight, wValue, covenantQuorum)
+		if !isQueriedStatus(status) {
+			return false, nil
+		}
+		if accumulate {
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return btcDels, pageRes, nil
+}
+
+// newStatusFilter returns a function checking whether a BTC delegation status
+// is among the given queried statuses. Empty queried statuses or the ANY
+// status mean all statuses are queried.
+func newStatusFilter(statuses []types.BTCDelegationStatus) func(types.BTCDelegationStatus) bool {
+	queriedStatuses := map[types.BTCDelegationStatus]struct{}{}
+	for _, status := range statuses {
+		if status == types.BTCDelegationStatus_ANY {
+			return func(types.BTCDelegationStatus) bool { return true }
+		}
+		queriedStatuses[status] = struct{}{}
+	}
+
+	return func(status types.BTCDelegationStatus) bool {
+		if len(queriedStatuses) == 0 {
+			return true
+		}
+		_, ok := queriedStatuses[status]
+		return ok
+	}
+}
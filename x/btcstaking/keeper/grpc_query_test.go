@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"errors"
 	"math/rand"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
@@ -599,6 +601,329 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 	})
 }
 
+func FuzzBTCDelegationsWithStatus(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		wValue := btcctypes.DefaultParams().CheckpointFinalizationTimeout
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		// the BTC tip is high enough such that the unbonding timelock of BTC
+		// delegations unbonded at the beginning has expired
+		startHeight := datagen.RandomInt(r, 100) + wValue + 2
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// Generate a random number of BTC delegations under each status
+		expectedStatuses := map[string]types.BTCDelegationStatus{}
+		// unbonded BTC delegations whose unbonding output can still be slashed
+		slashableUnbonded := map[string]struct{}{}
+		for _, status := range []types.BTCDelegationStatus{
+			types.BTCDelegationStatus_PENDING,
+			types.BTCDelegationStatus_ACTIVE,
+			types.BTCDelegationStatus_UNBONDED,
+			types.BTCDelegationStatus_EXPIRED,
+		} {
+			numBTCDels := datagen.RandomInt(r, 5) + 1
+			for j := uint64(0); j < numBTCDels; j++ {
+				endHeight := datagen.RandomInt(r, 1000) + startHeight + wValue + 1
+				if status == types.BTCDelegationStatus_EXPIRED {
+					// less than w BTC blocks left in the timelock
+					endHeight = startHeight + wValue - 1
+				}
+				delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+				btcDel, err := datagen.GenRandomBTCDelegation(
+					r,
+					t,
+					net,
+					[]bbn.BIP340PubKey{*fp.BtcPk},
+					delSK,
+					covenantSKs,
+					covenantPKs,
+					covenantQuorum,
+					slashingAddress.EncodeAddress(),
+					startHeight, endHeight, 10000,
+					slashingRate,
+					slashingChangeLockTime,
+				)
+				require.NoError(t, err)
+				switch status {
+				case types.BTCDelegationStatus_PENDING:
+					btcDel.CovenantSigs = nil
+				case types.BTCDelegationStatus_UNBONDED:
+					unbondingSig, err := bbn.NewBIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+					require.NoError(t, err)
+					btcDel.BtcUndelegation.DelegatorUnbondingSig = unbondingSig
+					if r.Intn(2) == 0 {
+						// unbonded recently, the unbonding timelock has not expired
+						btcDel.BtcUndelegation.DelegatorUnbondingBtcHeight = startHeight
+						slashableUnbonded[btcDel.MustGetStakingTxHash().String()] = struct{}{}
+					} else {
+						// unbonded long ago, the unbonding timelock has expired
+						btcDel.BtcUndelegation.DelegatorUnbondingBtcHeight = startHeight - uint64(btcDel.UnbondingTime)
+					}
+				}
+				err = keeper.AddBTCDelegation(ctx, btcDel)
+				require.NoError(t, err)
+				expectedStatuses[btcDel.MustGetStakingTxHash().String()] = status
+			}
+		}
+
+		assertStatuses := func(expectedStatuses map[string]types.BTCDelegationStatus) {
+			for _, status := range []types.BTCDelegationStatus{
+				types.BTCDelegationStatus_PENDING,
+				types.BTCDelegationStatus_ACTIVE,
+				types.BTCDelegationStatus_UNBONDED,
+				types.BTCDelegationStatus_EXPIRED,
+				types.BTCDelegationStatus_SLASHED,
+			} {
+				expectedTxHashes := map[string]struct{}{}
+				for txHash, expectedStatus := range expectedStatuses {
+					if expectedStatus == status {
+						expectedTxHashes[txHash] = struct{}{}
+					}
+				}
+
+				// BTC delegations under the given status
+				resp, err := keeper.BTCDelegations(ctx, &types.QueryBTCDelegationsRequest{Status: status})
+				require.NoError(t, err)
+				require.Len(t, resp.BtcDelegations, len(expectedTxHashes))
+				for _, btcDel := range resp.BtcDelegations {
+					require.Equal(t, status.String(), btcDel.StatusDesc)
+				}
+
+				// BTC delegations of the finality provider under the given status
+				fpResp, err := keeper.FinalityProviderDelegations(ctx, &types.QueryFinalityProviderDelegationsRequest{
+					FpBtcPkHex: fp.BtcPk.MarshalHex(),
+					Statuses:   []types.BTCDelegationStatus{status},
+				})
+				require.NoError(t, err)
+				require.Len(t, fpResp.BtcDelegatorDelegations, len(expectedTxHashes))
+				for _, btcDels := range fpResp.BtcDelegatorDelegations {
+					for _, btcDel := range btcDels.Dels {
+						require.Equal(t, status.String(), btcDel.StatusDesc)
+					}
+				}
+			}
+
+			// BTC delegations of the finality provider under all statuses
+			fpResp, err := keeper.FinalityProviderDelegations(ctx, &types.QueryFinalityProviderDelegationsRequest{
+				FpBtcPkHex: fp.BtcPk.MarshalHex(),
+			})
+			require.NoError(t, err)
+			require.Len(t, fpResp.BtcDelegatorDelegations, len(expectedStatuses))
+		}
+		assertStatuses(expectedStatuses)
+
+		// slash the finality provider, then all BTC delegations with covenant
+		// quorums and unexpired staking or unbonding timelock become slashed,
+		// while BTC delegations whose unbonding timelock has expired remain
+		// unbonded
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+		err = keeper.SlashFinalityProvider(ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		for txHash, status := range expectedStatuses {
+			_, slashable := slashableUnbonded[txHash]
+			if status == types.BTCDelegationStatus_ACTIVE || slashable {
+				expectedStatuses[txHash] = types.BTCDelegationStatus_SLASHED
+			}
+		}
+		assertStatuses(expectedStatuses)
+	})
+}
+
+func FuzzBTCDelegationsByDelegator(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		k, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		k.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// Generate a random number of delegators, each of which has a random
+		// number of BTC delegations, some of which are pending
+		numDelegators := datagen.RandomInt(r, 5) + 1
+		stakerAddrs := make([]sdk.AccAddress, 0, numDelegators)
+		delBTCPKs := make([]*bbn.BIP340PubKey, 0, numDelegators)
+		expectedTxHashes := map[string]map[string]types.BTCDelegationStatus{}
+		for i := uint64(0); i < numDelegators; i++ {
+			stakerAddr := datagen.GenRandomAccount().GetAddress()
+			delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			delBTCPK := bbn.NewBIP340PubKeyFromBTCPK(delPK)
+			stakerAddrs = append(stakerAddrs, stakerAddr)
+			delBTCPKs = append(delBTCPKs, delBTCPK)
+			expectedTxHashes[stakerAddr.String()] = map[string]types.BTCDelegationStatus{}
+
+			numBTCDels := datagen.RandomInt(r, 5) + 1
+			for j := uint64(0); j < numBTCDels; j++ {
+				btcDel, err := datagen.GenRandomBTCDelegation(
+					r,
+					t,
+					net,
+					[]bbn.BIP340PubKey{*fp.BtcPk},
+					delSK,
+					covenantSKs,
+					covenantPKs,
+					covenantQuorum,
+					slashingAddress.EncodeAddress(),
+					startHeight, endHeight, 10000+j,
+					slashingRate,
+					slashingChangeLockTime,
+				)
+				require.NoError(t, err)
+				btcDel.StakerAddr = stakerAddr.String()
+				status := types.BTCDelegationStatus_ACTIVE
+				if datagen.RandomInt(r, 2) == 1 {
+					btcDel.CovenantSigs = nil
+					status = types.BTCDelegationStatus_PENDING
+				}
+				err = k.AddBTCDelegation(ctx, btcDel)
+				require.NoError(t, err)
+				expectedTxHashes[stakerAddr.String()][hex.EncodeToString(btcDel.StakingTx)] = status
+			}
+		}
+
+		assertQueries := func(keeper *keeper.Keeper, ctx sdk.Context) {
+			for i := range stakerAddrs {
+				expected := expectedTxHashes[stakerAddrs[i].String()]
+				for _, statuses := range [][]types.BTCDelegationStatus{
+					nil,
+					{types.BTCDelegationStatus_ACTIVE},
+					{types.BTCDelegationStatus_PENDING},
+				} {
+					isQueried := func(status types.BTCDelegationStatus) bool {
+						return len(statuses) == 0 || statuses[0] == status
+					}
+					numExpected := 0
+					for _, status := range expected {
+						if isQueried(status) {
+							numExpected++
+						}
+					}
+
+					// query by staker address with pagination
+					limit := datagen.RandomInt(r, len(expected)) + 1
+					pagination := constructRequestWithLimit(r, limit)
+					btcDelsByStaker := []*types.BTCDelegationResponse{}
+					for {
+						resp, err := keeper.BTCDelegationsByStakerAddr(ctx, &types.QueryBTCDelegationsByStakerAddrRequest{
+							StakerAddr: stakerAddrs[i].String(),
+							Statuses:   statuses,
+							Pagination: pagination,
+						})
+						require.NoError(t, err)
+						require.LessOrEqual(t, len(resp.BtcDelegations), int(limit))
+						btcDelsByStaker = append(btcDelsByStaker, resp.BtcDelegations...)
+						if resp.Pagination.NextKey == nil {
+							break
+						}
+						pagination = constructRequestWithKeyAndLimit(r, resp.Pagination.NextKey, limit)
+					}
+					require.Len(t, btcDelsByStaker, numExpected)
+					for _, btcDel := range btcDelsByStaker {
+						require.Equal(t, stakerAddrs[i].String(), btcDel.StakerAddr)
+						require.True(t, isQueried(expected[btcDel.StakingTxHex]))
+					}
+
+					// query by delegator BTC PK
+					resp, err := keeper.BTCDelegationsByDelegatorBtcPk(ctx, &types.QueryBTCDelegationsByDelegatorBtcPkRequest{
+						DelBtcPkHex: delBTCPKs[i].MarshalHex(),
+						Statuses:    statuses,
+					})
+					require.NoError(t, err)
+					require.Len(t, resp.BtcDelegations, numExpected)
+					for _, btcDel := range resp.BtcDelegations {
+						require.True(t, delBTCPKs[i].Equals(btcDel.BtcPk))
+						require.True(t, isQueried(expected[btcDel.StakingTxHex]))
+					}
+				}
+			}
+
+			// query a non-existing delegator
+			resp, err := keeper.BTCDelegationsByStakerAddr(ctx, &types.QueryBTCDelegationsByStakerAddrRequest{
+				StakerAddr: datagen.GenRandomAccount().Address,
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.BtcDelegations)
+
+			// query with invalid arguments
+			_, err = keeper.BTCDelegationsByStakerAddr(ctx, &types.QueryBTCDelegationsByStakerAddrRequest{StakerAddr: "invalid"})
+			require.Error(t, err)
+			_, err = keeper.BTCDelegationsByDelegatorBtcPk(ctx, &types.QueryBTCDelegationsByDelegatorBtcPkRequest{DelBtcPkHex: "invalid"})
+			require.Error(t, err)
+		}
+		assertQueries(k, ctx)
+
+		// the indexes are rebuilt upon importing the genesis
+		gs, err := k.ExportGenesis(ctx)
+		require.NoError(t, err)
+		newKeeper, newCtx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		err = newKeeper.InitGenesis(newCtx, *gs)
+		require.NoError(t, err)
+		assertQueries(newKeeper, newCtx)
+
+		// the indexes of the BTC delegations stored before version 2 are
+		// backfilled by the migration
+		oldKeeper, oldCtx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		oldKeeper.SetFinalityProvider(oldCtx, fp)
+		for _, btcDel := range gs.BtcDelegations {
+			oldKeeper.SetBTCDelegationWithoutIndexes(oldCtx, btcDel)
+		}
+		resp, err := oldKeeper.BTCDelegationsByStakerAddr(oldCtx, &types.QueryBTCDelegationsByStakerAddrRequest{
+			StakerAddr: stakerAddrs[0].String(),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+		err = keeper.NewMigrator(*oldKeeper).Migrate1to2(oldCtx)
+		require.NoError(t, err)
+		assertQueries(oldKeeper, oldCtx)
+	})
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
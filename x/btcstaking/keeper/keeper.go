@@ -67,8 +67,12 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	k.IndexBTCHeight(ctx)
 	// update voting power distribution
 	k.UpdatePowerDist(ctx)
+	// remove pre-staking BTC delegations that are not included in Bitcoin in time
+	return k.RemoveExpiredPreStakingDelegations(ctx)
+}
 
-	return nil
+func (k Keeper) GetCurrentEpoch(ctx context.Context) uint64 {
+	return k.ckptKeeper.GetEpoch(ctx).EpochNumber
 }
 
 func (k Keeper) GetLastFinalizedEpoch(ctx context.Context) uint64 {
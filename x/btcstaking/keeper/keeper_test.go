@@ -123,16 +123,22 @@ func CreateFinalityProvider(r *rand.Rand, t *testing.T) *types.FinalityProvider
 }
 
 func (h *Helper) CreateFinalityProvider(r *rand.Rand) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
+	return h.CreateConsumerFinalityProvider(r, "")
+}
+
+func (h *Helper) CreateConsumerFinalityProvider(r *rand.Rand, consumerID string) (*btcec.PrivateKey, *btcec.PublicKey, *types.FinalityProvider) {
 	fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
 	h.NoError(err)
+	fp.ConsumerId = consumerID
 	msgNewFp := types.MsgCreateFinalityProvider{
 		Addr:        fp.Addr,
 		Description: fp.Description,
 		Commission:  fp.Commission,
 		BtcPk:       fp.BtcPk,
 		Pop:         fp.Pop,
+		ConsumerId:  consumerID,
 	}
 
 	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &msgNewFp)
@@ -149,6 +155,55 @@ func (h *Helper) CreateDelegationCustom(
 	unbondingValue int64,
 	unbondingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	return h.CreateRestakedDelegationCustom(
+		r,
+		[]*btcec.PublicKey{fpPK},
+		changeAddress,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+	)
+}
+
+func (h *Helper) CreateRestakedDelegationCustom(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	changeAddress string,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	stakingTxHash, delSK, delPK, msgCreateBTCDel := h.GenRestakedDelegationMsg(
+		r,
+		fpPKs,
+		changeAddress,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+	)
+
+	_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+}
+
+// GenRestakedDelegationMsg generates a valid MsgCreateBTCDelegation whose
+// staking tx is included in a mocked k-deep BTC header, without submitting it
+func (h *Helper) GenRestakedDelegationMsg(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	changeAddress string,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation) {
 	delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	stakingTimeBlocks := stakingTime
@@ -161,7 +216,7 @@ func (h *Helper) CreateDelegationCustom(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		stakingTimeBlocks,
@@ -218,7 +273,7 @@ func (h *Helper) CreateDelegationCustom(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		wire.NewOutPoint(&stkTxHash, stkOutputIdx),
@@ -237,11 +292,10 @@ func (h *Helper) CreateDelegationCustom(
 	h.NoError(err)
 
 	// all good, construct and send MsgCreateBTCDelegation message
-	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
 	msgCreateBTCDel := &types.MsgCreateBTCDelegation{
 		StakerAddr:                    staker.String(),
 		BtcPk:                         stPk,
-		FpBtcPkList:                   []bbn.BIP340PubKey{*fpBTCPK},
+		FpBtcPkList:                   bbn.NewBIP340PKsFromBTCPKs(fpPKs),
 		Pop:                           pop,
 		StakingTime:                   uint32(stakingTimeBlocks),
 		StakingValue:                  stakingValue,
@@ -255,12 +309,7 @@ func (h *Helper) CreateDelegationCustom(
 		DelegatorUnbondingSlashingSig: delSlashingTxSig,
 	}
 
-	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
-	if err != nil {
-		return "", nil, nil, nil, err
-	}
-
-	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+	return stakingTxHash, delSK, delPK, msgCreateBTCDel
 }
 
 func (h *Helper) CreateDelegation(
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, i.e., it sets the params
+// introduced for pre-staking BTC delegations and commission changes to their
+// default values if they are unset, and indexes the existing BTC delegations
+// by staker address and delegator BTC PK.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaultParams := types.DefaultParams()
+	if params.PreStakingInclusionTimeout == 0 {
+		params.PreStakingInclusionTimeout = defaultParams.PreStakingInclusionTimeout
+	}
+	if params.MinCommissionChangeInterval == 0 {
+		params.MinCommissionChangeInterval = defaultParams.MinCommissionChangeInterval
+	}
+	if params.CommissionChangeDelay == 0 {
+		params.CommissionChangeDelay = defaultParams.CommissionChangeDelay
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	// collect the BTC delegations first, so that the store is not written
+	// while being iterated
+	btcDels, err := m.keeper.btcDelegations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, btcDel := range btcDels {
+		stakingTxHash, err := btcDel.GetStakingTxHash()
+		if err != nil {
+			return err
+		}
+		if err := m.keeper.indexBTCDelegationByDelegator(ctx, btcDel, stakingTxHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
@@ -50,6 +52,24 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// RegisterConsumer registers a consumer chain, such that finality providers
+// can be created to secure it
+func (ms msgServer) RegisterConsumer(goCtx context.Context, req *types.MsgRegisterConsumer) (*types.MsgRegisterConsumerResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrapf("invalid consumer register: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.RegisterConsumer(ctx, req.Consumer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterConsumerResponse{}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
@@ -86,13 +106,25 @@ func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.Msg
 		return nil, types.ErrFpRegistered
 	}
 
+	// ensure the consumer chain is registered if the finality provider
+	// secures a consumer chain rather than Babylon
+	if len(req.ConsumerId) > 0 && !ms.HasConsumer(ctx, req.ConsumerId) {
+		return nil, types.ErrConsumerNotRegistered.Wrapf("consumer ID: %s", req.ConsumerId)
+	}
+
 	// all good, add this finality provider
+	// NOTE: the commission rates are ensured to be valid in ValidateBasic
+	maxRate, maxChangeRate := req.GetMaxCommissionRates()
 	fp := types.FinalityProvider{
-		Description: req.Description,
-		Commission:  req.Commission,
-		Addr:        fpAddr.String(),
-		BtcPk:       req.BtcPk,
-		Pop:         req.Pop,
+		Description:             req.Description,
+		Commission:              req.Commission,
+		Addr:                    fpAddr.String(),
+		BtcPk:                   req.BtcPk,
+		Pop:                     req.Pop,
+		ConsumerId:              req.ConsumerId,
+		MaxCommissionRate:       &maxRate,
+		MaxCommissionChangeRate: &maxChangeRate,
+		CommissionUpdateHeight:  uint64(ctx.HeaderInfo().Height),
 	}
 	ms.SetFinalityProvider(ctx, &fp)
 
@@ -141,12 +173,98 @@ func (ms msgServer) EditFinalityProvider(ctx context.Context, req *types.MsgEdit
 
 	// all good, update the finality provider and set back
 	fp.Description = req.Description
-	fp.Commission = req.Commission
+	// a change of the commission rate is rate-limited and scheduled to take
+	// effect later, such that delegators are aware of it in advance
+	latestCommission := fp.Commission
+	if fp.HasPendingCommission() {
+		latestCommission = fp.PendingCommission
+	}
+	switch {
+	case req.Commission.Equal(*latestCommission):
+		// the commission rate is unchanged
+	case fp.HasPendingCommission() && req.Commission.Equal(*fp.Commission):
+		// resubmitting the commission rate in effect cancels the pending change
+		ms.cancelCommissionChange(ctx, fp)
+	default:
+		if err := ms.scheduleCommissionChange(ctx, fp, *req.Commission); err != nil {
+			return nil, err
+		}
+	}
 	ms.SetFinalityProvider(ctx, fp)
 
 	return &types.MsgEditFinalityProviderResponse{}, nil
 }
 
+// RetireFinalityProvider retires an existing finality provider
+func (ms msgServer) RetireFinalityProvider(ctx context.Context, req *types.MsgRetireFinalityProvider) (*types.MsgRetireFinalityProviderResponse, error) {
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// find the finality provider with the given BTC PK
+	fp, err := ms.GetFinalityProvider(ctx, *req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	fpAddr, err := sdk.AccAddressFromBech32(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Addr, err)
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	if !strings.EqualFold(fpAddr.String(), fp.Addr) {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	// all good, retire the finality provider
+	if err := ms.retireFinalityProvider(ctx, fp); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetireFinalityProviderResponse{}, nil
+}
+
+// RotateFinalityProviderAddr rotates the Babylon address bound to an existing
+// finality provider to the signer, authorized by the proof of possession of
+// the finality provider's BTC PK over the signer
+func (ms msgServer) RotateFinalityProviderAddr(goCtx context.Context, req *types.MsgRotateFinalityProviderAddr) (*types.MsgRotateFinalityProviderAddrResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	newAddr, err := sdk.AccAddressFromBech32(req.NewAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.NewAddr, err)
+	}
+
+	// verify proof of possession over the new address
+	if err := req.Pop.Verify(newAddr, req.BtcPk, ms.btcNet); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid proof of possession: %v", err)
+	}
+
+	// find the finality provider with the given BTC PK
+	fp, err := ms.GetFinalityProvider(ctx, *req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+	// a slashed finality provider will not receive any rewards anymore
+	if fp.IsSlashed() {
+		return nil, types.ErrFpAlreadySlashed
+	}
+
+	// all good, rotate the address of the finality provider
+	if err := ms.rotateFinalityProviderAddr(ctx, fp, newAddr.String(), req.Pop); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateFinalityProviderAddrResponse{}, nil
+}
+
 // caluculateMinimumUnbondingValue calculates minimum unbonding value basend on current staking output value
 // and params.MinUnbondingRate
 func caluculateMinimumUnbondingValue(
@@ -160,6 +278,42 @@ func caluculateMinimumUnbondingValue(
 	return minUnbondingOutputValue
 }
 
+// validateStakingTxInclusion verifies that the given staking tx is included
+// in a k-deep BTC header, and that its timelock of the given staking time
+// has more than w BTC blocks left. It returns the start and end heights of
+// the staking tx's timelock
+func (ms msgServer) validateStakingTxInclusion(
+	ctx context.Context,
+	stakingTx *btcctypes.TransactionInfo,
+	stakingTime uint32,
+	kValue, wValue uint64,
+) (uint64, uint64, error) {
+	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, stakingTx.Key.Hash)
+	if stakingTxHeader == nil {
+		return 0, 0, fmt.Errorf("header that includes the staking tx is not found")
+	}
+	startHeight := stakingTxHeader.Height
+	endHeight := stakingTxHeader.Height + uint64(stakingTime)
+
+	// ensure staking tx is k-deep
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
+	if stakingTxDepth < kValue {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
+	}
+	// ensure staking tx's timelock has more than w BTC blocks left
+	if btcTip.Height+wValue >= endHeight {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
+	}
+
+	// verify staking tx info, i.e., inclusion proof
+	if err := stakingTx.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	return startHeight, endHeight, nil
+}
+
 // CreateBTCDelegation creates a BTC delegation
 // TODO: refactor this handler. It's now too convoluted
 func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (*types.MsgCreateBTCDelegationResponse, error) {
@@ -200,8 +354,9 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidProofOfPossession.Wrapf("error while validating proof of posession: %v", err)
 	}
 
-	// Ensure all finality providers are known to Babylon, are not slashed,
-	// and their registered epochs are finalised
+	// Ensure all finality providers are known to Babylon, are not slashed
+	// or retired, and their registered epochs are finalised
+	fps := make([]*types.FinalityProvider, 0, len(req.FpBtcPkList))
 	for _, fpBTCPK := range req.FpBtcPkList {
 		// get this finality provider
 		fp, err := ms.GetFinalityProvider(ctx, fpBTCPK)
@@ -212,6 +367,16 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		if fp.IsSlashed() {
 			return nil, types.ErrFpAlreadySlashed
 		}
+		// ensure the finality provider does not retire
+		if fp.IsRetired() {
+			return nil, types.ErrFpRetired.Wrapf("finality provider %s", fp.BtcPk.MarshalHex())
+		}
+		fps = append(fps, fp)
+	}
+	// Ensure the finality providers secure distinct chains, i.e., at most one
+	// finality provider of Babylon and of each consumer chain
+	if err := types.ValidateConsumerChains(fps); err != nil {
+		return nil, err
 	}
 
 	// Parse staking tx
@@ -258,28 +423,21 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	}
 
 	// Check staking tx timelock has correct values
-	// get startheight and endheight of the timelock
-	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.StakingTx.Key.Hash)
-	if stakingTxHeader == nil {
-		return nil, fmt.Errorf("header that includes the staking tx is not found")
-	}
-	startHeight := stakingTxHeader.Height
-	endHeight := stakingTxHeader.Height + uint64(req.StakingTime)
-
-	// ensure staking tx is k-deep
-	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
-	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
-	if stakingTxDepth < kValue {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
-	}
-	// ensure staking tx's timelock has more than w BTC blocks left
-	if btcTip.Height+wValue >= endHeight {
-		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
-	}
-
-	// verify staking tx info, i.e., inclusion proof
-	if err := req.StakingTx.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	// get startheight and endheight of the timelock. A pre-staking BTC
+	// delegation does not have them until its inclusion proof is submitted,
+	// and expires if the inclusion proof is not submitted in time
+	var startHeight, endHeight, inclusionProofDeadline uint64
+	if req.IsPreStaking() {
+		if vp.Params.PreStakingInclusionTimeout == 0 {
+			return nil, types.ErrPreStakingDisabled
+		}
+		btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+		inclusionProofDeadline = btcTip.Height + uint64(vp.Params.PreStakingInclusionTimeout)
+	} else {
+		startHeight, endHeight, err = ms.validateStakingTxInclusion(ctx, req.StakingTx, req.StakingTime, kValue, wValue)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// check slashing tx and its consistency with staking tx
@@ -331,21 +489,23 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// have voting power only when 1) its corresponding staking tx is k-deep,
 	// and 2) it receives a covenant signature
 	newBTCDel := &types.BTCDelegation{
-		StakerAddr:       stakerAddr.String(),
-		BtcPk:            req.BtcPk,
-		Pop:              req.Pop,
-		FpBtcPkList:      req.FpBtcPkList,
-		StartHeight:      startHeight,
-		EndHeight:        endHeight,
-		TotalSat:         uint64(stakingInfo.StakingOutput.Value),
-		StakingTx:        req.StakingTx.Transaction,
-		StakingOutputIdx: stakingOutputIdx,
-		SlashingTx:       req.SlashingTx,
-		DelegatorSig:     req.DelegatorSlashingSig,
-		UnbondingTime:    uint32(validatedUnbondingTime),
-		CovenantSigs:     nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
-		BtcUndelegation:  nil,        // this will be constructed in below code
-		ParamsVersion:    vp.Version, // version of the params against delegations was validated
+		StakerAddr:             stakerAddr.String(),
+		BtcPk:                  req.BtcPk,
+		Pop:                    req.Pop,
+		FpBtcPkList:            req.FpBtcPkList,
+		StartHeight:            startHeight,
+		EndHeight:              endHeight,
+		TotalSat:               uint64(stakingInfo.StakingOutput.Value),
+		StakingTx:              req.StakingTx.Transaction,
+		StakingOutputIdx:       stakingOutputIdx,
+		SlashingTx:             req.SlashingTx,
+		DelegatorSig:           req.DelegatorSlashingSig,
+		UnbondingTime:          uint32(validatedUnbondingTime),
+		CovenantSigs:           nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
+		BtcUndelegation:        nil,        // this will be constructed in below code
+		ParamsVersion:          vp.Version, // version of the params against delegations was validated
+		StakingTimeBlocks:      req.StakingTime,
+		InclusionProofDeadline: inclusionProofDeadline,
 	}
 
 	/*
@@ -677,6 +837,117 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 	return &types.MsgBTCUndelegateResponse{}, nil
 }
 
+// ReportStakingOutputSpend handles the report that the staking output of an
+// active BTC delegation is spent on Bitcoin, and moves the BTC delegation to
+// the state corresponding to the spending path. Anyone can submit the report
+// as long as the spending tx is k-deep in the Bitcoin chain
+func (ms msgServer) ReportStakingOutputSpend(goCtx context.Context, req *types.MsgReportStakingOutputSpend) (*types.MsgReportStakingOutputSpendResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyReportStakingOutputSpend)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, bsParams, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the BTC delegation with the given staking tx hash is active
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	btccParams := ms.btccKeeper.GetParams(ctx)
+	kValue, wValue := btccParams.BtcConfirmationDepth, btccParams.CheckpointFinalizationTimeout
+	if btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+		return nil, types.ErrInvalidStakingOutputSpend.Wrap("cannot report the staking output spend of an inactive BTC delegation")
+	}
+
+	// ensure the spend tx is k-deep and included in the Bitcoin chain
+	spendTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.SpendTxInfo.Key.Hash)
+	if spendTxHeader == nil {
+		return nil, types.ErrInvalidStakingOutputSpend.Wrap("header that includes the spend tx is not found")
+	}
+	spendTxDepth := btcTip.Height - spendTxHeader.Height
+	if spendTxDepth < kValue {
+		return nil, types.ErrInvalidStakingOutputSpend.Wrapf("not k-deep: k=%d; depth=%d", kValue, spendTxDepth)
+	}
+	if err := req.SpendTxInfo.VerifyInclusion(spendTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return nil, types.ErrInvalidStakingOutputSpend.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	// identify the spending path of the staking output
+	spendMsgTx, err := bbn.NewBTCTxFromBytes(req.SpendTxInfo.Transaction)
+	if err != nil {
+		return nil, types.ErrInvalidStakingOutputSpend.Wrapf("cannot parse spend tx: %v", err)
+	}
+	stakingInfo, err := btcDel.GetStakingInfo(bsParams, ms.btcNet)
+	if err != nil {
+		panic(fmt.Errorf("failed to get staking info from a verified delegation: %w", err))
+	}
+	spendPath, err := btcDel.GetStakingOutputSpendPath(stakingInfo, spendMsgTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// all good, record the spend and update the BTC delegation's state
+	ms.reportStakingOutputSpend(ctx, btcDel, &types.StakingOutputSpend{
+		SpendTx:          req.SpendTxInfo.Transaction,
+		SpendTxBtcHeight: spendTxHeader.Height,
+		SpendPath:        spendPath,
+	})
+
+	return &types.MsgReportStakingOutputSpendResponse{}, nil
+}
+
+// AddBTCDelegationInclusionProof adds the inclusion proof of the staking tx
+// of a pre-staking BTC delegation, upon which the timelock of the BTC
+// delegation starts
+func (ms msgServer) AddBTCDelegationInclusionProof(goCtx context.Context, req *types.MsgAddBTCDelegationInclusionProof) (*types.MsgAddBTCDelegationInclusionProofResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddInclusionProof)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, bsParams, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the BTC delegation is a pre-staking BTC delegation that has not
+	// expired or been unbonded
+	if btcDel.HasInclusionProof() {
+		return nil, types.ErrInclusionProofExists
+	}
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	btccParams := ms.btccKeeper.GetParams(ctx)
+	kValue, wValue := btccParams.BtcConfirmationDepth, btccParams.CheckpointFinalizationTimeout
+	btcDelStatus := btcDel.GetStatus(btcTip.Height, wValue, bsParams.CovenantQuorum)
+	if btcDelStatus != types.BTCDelegationStatus_PENDING && btcDelStatus != types.BTCDelegationStatus_VERIFIED {
+		return nil, types.ErrInvalidDelegationState.Wrapf("cannot add the inclusion proof to a BTC delegation with status %s", btcDelStatus.String())
+	}
+
+	// ensure the given tx is the staking tx of the BTC delegation
+	if !bytes.Equal(req.StakingTxInfo.Transaction, btcDel.StakingTx) {
+		return nil, types.ErrInvalidStakingTx.Wrap("the given tx is not the staking tx of the BTC delegation")
+	}
+
+	// ensure the staking tx is k-deep, included in the Bitcoin chain, and its
+	// timelock has more than w BTC blocks left
+	startHeight, endHeight, err := ms.validateStakingTxInclusion(ctx, req.StakingTxInfo, btcDel.StakingTimeBlocks, kValue, wValue)
+	if err != nil {
+		return nil, err
+	}
+
+	// all good, start the timelock of the BTC delegation
+	ms.addInclusionProofToBTCDelegation(ctx, btcDel, startHeight, endHeight, bsParams)
+
+	return &types.MsgAddBTCDelegationInclusionProofResponse{}, nil
+}
+
 // SelectiveSlashingEvidence handles the evidence that a finality provider has
 // selectively slashed a BTC delegation
 func (ms msgServer) SelectiveSlashingEvidence(goCtx context.Context, req *types.MsgSelectiveSlashingEvidence) (*types.MsgSelectiveSlashingEvidenceResponse, error) {
@@ -17,11 +17,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -100,23 +102,81 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 
 		// updated commission and description
 		newCommission := datagen.GenRandomCommission(r)
+		for newCommission.Equal(*fp.Commission) {
+			newCommission = datagen.GenRandomCommission(r)
+		}
 		newDescription := datagen.GenRandomDescription(r)
-
-		// scenario 1: editing finality provider should succeed
+		params := bsKeeper.GetParams(h.Ctx)
 		msg := &types.MsgEditFinalityProvider{
 			Addr:        fp.Addr,
 			BtcPk:       *fp.BtcPk,
 			Description: newDescription,
 			Commission:  &newCommission,
 		}
+
+		// scenario 1: changing commission before the minimum commission
+		// change interval should fail
+		fp.CommissionUpdateHeight = uint64(h.Ctx.HeaderInfo().Height)
+		h.AddFinalityProvider(fp)
 		_, err = msgSrvr.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionUpdateTooSoon)
+
+		// scenario 2: editing finality provider should succeed after the
+		// minimum commission change interval, where the description is
+		// updated immediately and the commission change is scheduled
+		ctx := datagen.WithCtxHeight(h.Ctx, fp.CommissionUpdateHeight+params.MinCommissionChangeInterval)
+		_, err = msgSrvr.EditFinalityProvider(ctx, msg)
 		h.NoError(err)
-		editedFp, err := bsKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		editedFp, err := bsKeeper.GetFinalityProvider(ctx, *fp.BtcPk)
 		h.NoError(err)
-		require.Equal(t, newCommission, *editedFp.Commission)
+		require.Equal(t, *fp.Commission, *editedFp.Commission)
+		require.Equal(t, newCommission, *editedFp.PendingCommission)
+		require.Equal(t, uint64(ctx.HeaderInfo().Height)+params.CommissionChangeDelay, editedFp.PendingCommissionHeight)
 		require.Equal(t, newDescription, editedFp.Description)
 
-		// scenario 2: message from an unauthorised signer should fail
+		// resubmitting the commission rate in effect cancels the pending
+		// change, even before the minimum commission change interval
+		cancelCtx, _ := ctx.CacheContext()
+		cancelMsg := *msg
+		cancelMsg.Commission = fp.Commission
+		_, err = msgSrvr.EditFinalityProvider(cancelCtx, &cancelMsg)
+		h.NoError(err)
+		cancelledFp, err := bsKeeper.GetFinalityProvider(cancelCtx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, *fp.Commission, *cancelledFp.Commission)
+		require.False(t, cancelledFp.HasPendingCommission())
+		cancelCtx = datagen.WithCtxHeight(cancelCtx, editedFp.PendingCommissionHeight)
+		require.Empty(t, bsKeeper.ApplyScheduledCommissionChanges(cancelCtx))
+
+		// the commission change takes effect at the scheduled height
+		ctx = datagen.WithCtxHeight(ctx, editedFp.PendingCommissionHeight-1)
+		require.Empty(t, bsKeeper.ApplyScheduledCommissionChanges(ctx))
+		ctx = datagen.WithCtxHeight(ctx, editedFp.PendingCommissionHeight)
+		commissions := bsKeeper.ApplyScheduledCommissionChanges(ctx)
+		require.Equal(t, newCommission, commissions[fp.BtcPk.MarshalHex()])
+		editedFp, err = bsKeeper.GetFinalityProvider(ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, newCommission, *editedFp.Commission)
+		require.False(t, editedFp.HasPendingCommission())
+
+		// scenario 3: changing commission beyond the maximum commission rate
+		// or the maximum commission change rate should fail
+		maxRate := sdkmath.LegacyMustNewDecFromStr("0.6")
+		maxChangeRate := sdkmath.LegacyMustNewDecFromStr("0.01")
+		editedFp.MaxCommissionRate = &maxRate
+		editedFp.MaxCommissionChangeRate = &maxChangeRate
+		editedFp.CommissionUpdateHeight = 0
+		h.AddFinalityProvider(editedFp)
+		tooHighCommission := sdkmath.LegacyMustNewDecFromStr("0.7")
+		msg.Commission = &tooHighCommission
+		_, err = msgSrvr.EditFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionGTFpMaxRate)
+		tooLargeChangeCommission := newCommission.Add(sdkmath.LegacyMustNewDecFromStr("0.02"))
+		msg.Commission = &tooLargeChangeCommission
+		_, err = msgSrvr.EditFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionChangeGTMaxRate)
+
+		// scenario 4: message from an unauthorised signer should fail
 		newCommission = datagen.GenRandomCommission(r)
 		newDescription = datagen.GenRandomDescription(r)
 		invalidAddr := datagen.GenRandomAccount().Address
@@ -133,6 +193,105 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 	})
 }
 
+func FuzzRetireAndRotateFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider, together with an
+		// existing BTC delegation
+		fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+		stakingValue := int64(2 * 10e8)
+		h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), stakingValue, 1000)
+
+		// retiring the finality provider from an unauthorised signer should fail
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, &types.MsgRetireFinalityProvider{
+			Addr:  datagen.GenRandomAccount().Address,
+			BtcPk: fpBTCPK,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// retiring the finality provider should succeed, and only once
+		msgRetire := &types.MsgRetireFinalityProvider{
+			Addr:  fp.Addr,
+			BtcPk: fpBTCPK,
+		}
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, msgRetire)
+		h.NoError(err)
+		retiredFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fpBTCPK)
+		h.NoError(err)
+		require.True(t, retiredFp.IsRetired())
+		require.Equal(t, uint64(h.Ctx.HeaderInfo().Height), retiredFp.RetiredBabylonHeight)
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, msgRetire)
+		require.ErrorIs(t, err, types.ErrFpRetired)
+
+		// the retired finality provider does not accept new BTC delegations
+		_, _, _, _, err = h.CreateDelegationCustom(r, fpPK, changeAddress.EncodeAddress(), stakingValue, 1000, stakingValue-1000, 1000)
+		require.ErrorIs(t, err, types.ErrFpRetired)
+
+		// rotating the address with a PoP over another address should fail
+		newAddr := datagen.GenRandomAccount().GetAddress()
+		otherPop, err := types.NewPoPBTC(datagen.GenRandomAccount().GetAddress(), fpSK)
+		h.NoError(err)
+		_, err = h.MsgServer.RotateFinalityProviderAddr(h.Ctx, &types.MsgRotateFinalityProviderAddr{
+			NewAddr: newAddr.String(),
+			BtcPk:   fpBTCPK,
+			Pop:     otherPop,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// rotating the address with a PoP over the new address should succeed
+		pop, err := types.NewPoPBTC(newAddr, fpSK)
+		h.NoError(err)
+		_, err = h.MsgServer.RotateFinalityProviderAddr(h.Ctx, &types.MsgRotateFinalityProviderAddr{
+			NewAddr: newAddr.String(),
+			BtcPk:   fpBTCPK,
+			Pop:     pop,
+		})
+		h.NoError(err)
+		rotatedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fpBTCPK)
+		h.NoError(err)
+		require.Equal(t, newAddr.String(), rotatedFp.Addr)
+		require.Equal(t, []string{fp.Addr}, rotatedFp.PreviousAddrs)
+		require.Equal(t, pop, rotatedFp.Pop)
+
+		// replaying the PoP over the previous address should fail
+		_, err = h.MsgServer.RotateFinalityProviderAddr(h.Ctx, &types.MsgRotateFinalityProviderAddr{
+			NewAddr: fp.Addr,
+			BtcPk:   fpBTCPK,
+			Pop:     fp.Pop,
+		})
+		require.ErrorIs(t, err, types.ErrFpAddrUsed)
+
+		// the rotated address is applied to the voting power distribution
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		dc := types.NewVotingPowerDistCache()
+		fpDistInfo := types.NewFinalityProviderDistInfo(fp)
+		fpDistInfo.AddBTCDelDistInfo(&types.BTCDelDistInfo{VotingPower: uint64(stakingValue)})
+		dc.AddFinalityProviderDistInfo(fpDistInfo)
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, btcTip.Height, btcTip.Height)
+		newDc := h.BTCStakingKeeper.ProcessAllPowerDistUpdateEvents(h.Ctx, dc, events, 100)
+		require.Len(t, newDc.FinalityProviders, 1)
+		require.Equal(t, newAddr.String(), newDc.FinalityProviders[0].Addr)
+	})
+}
+
 func FuzzCreateBTCDelegation(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -384,6 +543,274 @@ func FuzzBTCUndelegate(f *testing.F) {
 	})
 }
 
+func FuzzReportStakingOutputSpend(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// add covenant signatures to this BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// ensure the BTC delegation is bonded right now
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		status := actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, status)
+
+		// spendTxInfo returns the given tx whose staking output input is
+		// spent via the given script path, together with its inclusion proof
+		spendTxInfo := func(tx *wire.MsgTx, spendInfo *btcstaking.SpendInfo) *btcctypes.TransactionInfo {
+			// the signatures are not verified by Babylon, thus random ones
+			// suffice for constructing the witness
+			sigs := [][]byte{datagen.GenRandomByteArray(r, 64)}
+			witness, err := btcstaking.CreateWitness(spendInfo, sigs)
+			h.NoError(err)
+			tx.TxIn[0].Witness = witness
+
+			prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+			btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, tx)
+			btcHeader := btcHeaderWithProof.HeaderBytes
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(&btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: 10}).AnyTimes()
+			serializedTx, err := bbn.SerializeBTCTx(tx)
+			h.NoError(err)
+			return btcctypes.NewTransactionInfo(&btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()}, serializedTx, btcHeaderWithProof.SpvProof.MerkleNodes)
+		}
+
+		stakingInfo, err := actualDel.GetStakingInfo(&bsParams, h.Net)
+		h.NoError(err)
+
+		// the staking output is spent either via the unbonding path or the
+		// slashing path
+		var spendTx *wire.MsgTx
+		var spendInfo *btcstaking.SpendInfo
+		var expectedStatus types.BTCDelegationStatus
+		if datagen.OneInN(r, 2) {
+			spendTx, err = bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
+			h.NoError(err)
+			spendInfo, err = stakingInfo.UnbondingPathSpendInfo()
+			h.NoError(err)
+			expectedStatus = types.BTCDelegationStatus_UNBONDED
+		} else {
+			spendTx, err = actualDel.SlashingTx.ToMsgTx()
+			h.NoError(err)
+			spendInfo, err = stakingInfo.SlashingPathSpendInfo()
+			h.NoError(err)
+			expectedStatus = types.BTCDelegationStatus_SLASHED
+		}
+
+		// reporting a spend via a script not in the staking output fails
+		bogusSpendInfo := *spendInfo
+		bogusSpendInfo.RevealedLeaf.Script = datagen.GenRandomByteArray(r, 32)
+		bogusMsg := &types.MsgReportStakingOutputSpend{
+			Signer:        datagen.GenRandomAccount().Address,
+			StakingTxHash: stakingTxHash,
+			SpendTxInfo:   spendTxInfo(spendTx.Copy(), &bogusSpendInfo),
+		}
+		_, err = h.MsgServer.ReportStakingOutputSpend(h.Ctx, bogusMsg)
+		require.ErrorIs(t, err, types.ErrInvalidStakingOutputSpend)
+
+		// reporting a tx that does not spend the staking output fails
+		bogusTx := spendTx.Copy()
+		bogusTx.TxIn[0].PreviousOutPoint.Index++
+		bogusMsg.SpendTxInfo = spendTxInfo(bogusTx, spendInfo)
+		_, err = h.MsgServer.ReportStakingOutputSpend(h.Ctx, bogusMsg)
+		require.ErrorIs(t, err, types.ErrInvalidStakingOutputSpend)
+
+		// report the spend
+		msg := &types.MsgReportStakingOutputSpend{
+			Signer:        datagen.GenRandomAccount().Address,
+			StakingTxHash: stakingTxHash,
+			SpendTxInfo:   spendTxInfo(spendTx, spendInfo),
+		}
+		_, err = h.MsgServer.ReportStakingOutputSpend(h.Ctx, msg)
+		h.NoError(err)
+
+		// ensure the BTC delegation is in the state of the spending path and
+		// has no voting power
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.IsStakingOutputSpent())
+		require.Equal(t, msg.SpendTxInfo.Transaction, actualDel.StakingOutputSpend.SpendTx)
+		status = actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
+		require.Equal(t, expectedStatus, status)
+		require.Zero(t, actualDel.VotingPower(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// reporting the spend again fails as the BTC delegation is not active
+		_, err = h.MsgServer.ReportStakingOutputSpend(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidStakingOutputSpend)
+	})
+}
+
+func FuzzPreStakingBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		btccParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		wValue := btccParams.CheckpointFinalizationTimeout
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, btccParams)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// genPreStakingMsg generates a MsgCreateBTCDelegation without the
+		// inclusion proof, together with the staking tx info with the proof
+		stakingValue := int64(2 * 10e8)
+		genPreStakingMsg := func() (string, *types.MsgCreateBTCDelegation, *btcctypes.TransactionInfo) {
+			stakingTxHash, _, _, msg := h.GenRestakedDelegationMsg(
+				r,
+				[]*btcec.PublicKey{fpPK},
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+				stakingValue-1000,
+				uint16(minUnbondingTime)+1,
+			)
+			stakingTxInfo := msg.StakingTx
+			msg.StakingTx = &btcctypes.TransactionInfo{Transaction: stakingTxInfo.Transaction}
+			require.True(t, msg.IsPreStaking())
+			return stakingTxHash, msg, stakingTxInfo
+		}
+
+		// pre-staking registration is rejected if it is disabled
+		_, msgCreateBTCDel, _ := genPreStakingMsg()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.ErrorIs(t, err, types.ErrPreStakingDisabled)
+
+		// enable pre-staking registration
+		bsParams.PreStakingInclusionTimeout = uint32(datagen.RandomInt(r, 100)) + 10
+		err = h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		require.NoError(t, err)
+
+		// register a pre-staking BTC delegation
+		stakingTxHash, msgCreateBTCDel, stakingTxInfo := genPreStakingMsg()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.NoError(t, err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.False(t, actualDel.HasInclusionProof())
+		require.Equal(t, btcTip+uint64(bsParams.PreStakingInclusionTimeout), actualDel.InclusionProofDeadline)
+		require.Equal(t, uint16(1000), actualDel.GetStakingTime())
+		require.Equal(t, types.BTCDelegationStatus_PENDING, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// the pre-staking BTC delegation becomes verified upon covenant quorums,
+		// but does not have voting power yet
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.Equal(t, types.BTCDelegationStatus_VERIFIED, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+		require.Zero(t, actualDel.VotingPower(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// the inclusion proof of a tx other than the staking tx is rejected
+		_, _, otherStakingTxInfo := genPreStakingMsg()
+		msgAddInclusionProof := &types.MsgAddBTCDelegationInclusionProof{
+			Signer:        datagen.GenRandomAccount().Address,
+			StakingTxHash: stakingTxHash,
+			StakingTxInfo: otherStakingTxInfo,
+		}
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msgAddInclusionProof)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+
+		// the BTC delegation becomes active upon the inclusion proof
+		msgAddInclusionProof.StakingTxInfo = stakingTxInfo
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msgAddInclusionProof)
+		require.NoError(t, err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.True(t, actualDel.HasInclusionProof())
+		require.Equal(t, uint64(1000), actualDel.EndHeight-actualDel.StartHeight)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+
+		// the inclusion proof cannot be added twice
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, msgAddInclusionProof)
+		require.ErrorIs(t, err, types.ErrInclusionProofExists)
+
+		// register another pre-staking BTC delegation, whose inclusion proof
+		// is never submitted
+		expiredStakingTxHash, msgCreateBTCDel, _ := genPreStakingMsg()
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.NoError(t, err)
+		expiredDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, expiredStakingTxHash)
+		require.NoError(t, err)
+
+		// the BTC delegation is not removed before its deadline
+		ctx := datagen.WithCtxHeight(h.Ctx, 100)
+		deadline := expiredDel.InclusionProofDeadline
+		tipHeight := deadline - 1
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(ctx)).DoAndReturn(func(_ interface{}) *btclctypes.BTCHeaderInfo {
+			return &btclctypes.BTCHeaderInfo{Height: tipHeight}
+		}).AnyTimes()
+		err = h.BTCStakingKeeper.RemoveExpiredPreStakingDelegations(ctx)
+		require.NoError(t, err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(ctx, expiredStakingTxHash)
+		require.NoError(t, err)
+
+		// the BTC delegation is removed once the deadline is reached, while
+		// the active one is kept
+		tipHeight = deadline
+		require.Equal(t, types.BTCDelegationStatus_EXPIRED, expiredDel.GetStatus(tipHeight, wValue, bsParams.CovenantQuorum))
+		err = h.BTCStakingKeeper.RemoveExpiredPreStakingDelegations(ctx)
+		require.NoError(t, err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(ctx, expiredStakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(ctx, stakingTxHash)
+		require.NoError(t, err)
+		resp, err := h.BTCStakingKeeper.BTCDelegationsByStakerAddr(ctx, &types.QueryBTCDelegationsByStakerAddrRequest{
+			StakerAddr: expiredDel.StakerAddr,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+	})
+}
+
 func FuzzSelectiveSlashing(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -1,13 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"sort"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +24,11 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	btcTipHeight := k.GetCurrentBTCHeight(ctx)
 	maxActiveFps := k.GetParams(ctx).MaxActiveFinalityProviders
 
+	// apply the commission changes that take effect at this height, so that
+	// the voting power distribution cache of this height, which is used for
+	// distributing rewards, has the commission rates in effect
+	commissions := k.ApplyScheduledCommissionChanges(ctx)
+
 	// get the power dist cache in the last height
 	dc := k.getVotingPowerDistCache(ctx, height-1)
 	// get all power distribution update events during the previous tip
@@ -28,11 +36,16 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	lastBTCTipHeight := k.GetBTCHeightAtBabylonHeight(ctx, height-1)
 	events := k.GetAllPowerDistUpdateEvents(ctx, lastBTCTipHeight, btcTipHeight)
 
+	// update the voting power distribution of each registered consumer chain
+	// before the events are cleared
+	k.updateConsumersPowerDist(ctx, events, maxActiveFps, commissions)
+
 	// if no event exists, then map previous voting power and
 	// cache to the current height
 	if len(events) == 0 {
 		if dc != nil {
 			// map everything in prev height to this height
+			dc.UpdateCommissions(commissions)
 			k.recordVotingPowerAndCache(ctx, dc, maxActiveFps)
 		}
 		return
@@ -53,6 +66,7 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	// reconcile old voting power distribution cache and new events
 	// to construct the new distribution
 	newDc := k.ProcessAllPowerDistUpdateEvents(ctx, dc, events, maxActiveFps)
+	newDc.UpdateCommissions(commissions)
 
 	// record voting power and cache for this height
 	k.recordVotingPowerAndCache(ctx, newDc, maxActiveFps)
@@ -60,6 +74,60 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	k.recordMetrics(newDc, maxActiveFps)
 }
 
+// updateConsumersPowerDist updates the voting power table and distribution
+// cache of each registered consumer chain, using the same events and
+// commission changes that update the voting power distribution of Babylon.
+// They are only recorded when changed, and otherwise the ones recorded at an
+// earlier height remain in effect
+func (k Keeper) updateConsumersPowerDist(
+	ctx context.Context,
+	events []*types.EventPowerDistUpdate,
+	maxActiveFps uint32,
+	commissions map[string]sdkmath.LegacyDec,
+) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+
+	for _, consumerID := range k.GetAllConsumerIDs(ctx) {
+		// get the consumer's power dist cache in effect at the last height
+		dc := k.getConsumerVotingPowerDistCache(ctx, consumerID, height-1)
+		if dc == nil {
+			if len(events) == 0 {
+				// no BTC staker and no new event
+				continue
+			}
+			// no BTC staker at the prior height
+			dc = types.NewVotingPowerDistCache()
+		}
+		dcBytes := k.cdc.MustMarshal(dc)
+
+		newDc := dc
+		if len(events) > 0 {
+			newDc = k.processPowerDistUpdateEvents(ctx, consumerID, dc, events, maxActiveFps)
+		}
+		newDc.UpdateCommissions(commissions)
+
+		// skip recording if neither events nor commission changes affect
+		// this consumer chain
+		if bytes.Equal(dcBytes, k.cdc.MustMarshal(newDc)) {
+			continue
+		}
+		k.recordConsumerVotingPowerAndCache(ctx, consumerID, newDc, maxActiveFps)
+	}
+}
+
+func (k Keeper) recordConsumerVotingPowerAndCache(ctx context.Context, consumerID string, dc *types.VotingPowerDistCache, maxActiveFps uint32) {
+	babylonTipHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+
+	// set voting power table of the consumer for this height
+	for i := uint32(0); i < dc.GetNumActiveFPs(maxActiveFps); i++ {
+		fp := dc.FinalityProviders[i]
+		k.SetConsumerVotingPower(ctx, consumerID, fp.BtcPk.MustMarshal(), babylonTipHeight, fp.TotalVotingPower)
+	}
+
+	// set the consumer's voting power distribution cache of the current height
+	k.setConsumerVotingPowerDistCache(ctx, consumerID, babylonTipHeight, dc)
+}
+
 func (k Keeper) recordVotingPowerAndCache(ctx context.Context, dc *types.VotingPowerDistCache, maxActiveFps uint32) {
 	babylonTipHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
 
@@ -95,13 +163,31 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // voting power distribution and returns a new distribution cache.
 // The following events will affect the voting power distribution:
 // - newly active BTC delegations
-// - newly unbonded BTC delegations
-// - slashed finality providers
+// - newly unbonded, expired or slashed BTC delegations
+// - slashed finality providers, whose BTC delegations lose voting power under
+// all of their restaked finality providers
+// - jailed or unjailed finality providers
+// - retired finality providers
+// - finality providers with rotated addresses
+// Only finality providers securing Babylon are included in the distribution.
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *types.VotingPowerDistCache,
 	events []*types.EventPowerDistUpdate,
 	maxActiveFps uint32,
+) *types.VotingPowerDistCache {
+	return k.processPowerDistUpdateEvents(ctx, "", dc, events, maxActiveFps)
+}
+
+// processPowerDistUpdateEvents processes all events that affect voting power
+// distribution of the chain with the given consumer ID, where the empty ID
+// refers to Babylon itself, and returns a new distribution cache
+func (k Keeper) processPowerDistUpdateEvents(
+	ctx context.Context,
+	consumerID string,
+	dc *types.VotingPowerDistCache,
+	events []*types.EventPowerDistUpdate,
+	maxActiveFps uint32,
 ) *types.VotingPowerDistCache {
 	// a map where key is finality provider's BTC PK hex and value is a list
 	// of BTC delegations that newly become active under this provider
@@ -110,6 +196,14 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
 	slashedFPs := map[string]struct{}{}
+	// a map where key is jailed or unjailed finality providers' BTC PK and
+	// value is whether the finality provider is jailed after the events
+	jailedFPs := map[string]bool{}
+	// a map where key is retired finality providers' BTC PK
+	retiredFPs := map[string]struct{}{}
+	// a map where key is finality providers' BTC PK and value is their
+	// rotated addresses
+	rotatedFPAddrs := map[string]string{}
 
 	/*
 		filter and classify all events into new/expired BTC delegations and slashed FPs
@@ -129,16 +223,38 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 					fpBTCPKHex := fpBTCPK.MarshalHex()
 					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
 				}
-			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED {
-				// add the expired BTC delegation to the map
+			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED ||
+				delEvent.NewState == types.BTCDelegationStatus_EXPIRED ||
+				delEvent.NewState == types.BTCDelegationStatus_SLASHED {
+				// add the unbonded, expired or slashed BTC delegation to the map
 				unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
 			}
 		case *types.EventPowerDistUpdate_SlashedFp:
 			// slashed finality providers
 			slashedFPs[typedEvent.SlashedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_JailedFp:
+			// jailed finality providers
+			jailedFPs[typedEvent.JailedFp.Pk.MarshalHex()] = true
+		case *types.EventPowerDistUpdate_UnjailedFp:
+			// unjailed finality providers
+			jailedFPs[typedEvent.UnjailedFp.Pk.MarshalHex()] = false
+		case *types.EventPowerDistUpdate_RetiredFp:
+			// retired finality providers
+			retiredFPs[typedEvent.RetiredFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_RotatedFpAddr:
+			// finality providers with rotated addresses
+			rotatedFPAddrs[typedEvent.RotatedFpAddr.Pk.MarshalHex()] = typedEvent.RotatedFpAddr.NewAddr
 		}
 	}
 
+	// a BTC delegation restaked to a slashed finality provider is slashed,
+	// thus loses its voting power under all of its restaked finality
+	// providers, including those securing other chains
+	slashedBTCDels := map[string]struct{}{}
+	for fpBTCPKHex := range slashedFPs {
+		k.collectBTCDelsOfFp(ctx, fpBTCPKHex, slashedBTCDels)
+	}
+
 	/*
 		At this point, there is voting power update.
 		Then, construct a voting power dist cache by reconciling the previous
@@ -164,18 +280,41 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			continue
 		}
 
+		// update the jailing status of this finality provider
+		if isJailed, ok := jailedFPs[fpBTCPKHex]; ok {
+			fp.IsJailed = isJailed
+		}
+
+		// a retired finality provider leaves the active set, while its
+		// remaining BTC delegations stay in the cache until they end
+		if _, ok := retiredFPs[fpBTCPKHex]; ok {
+			fp.IsRetired = true
+		}
+
+		// update the address of this finality provider
+		if newAddr, ok := rotatedFPAddrs[fpBTCPKHex]; ok {
+			fp.Addr = newAddr
+		}
+
 		// add all BTC delegations that are not unbonded to the new finality provider
 		for j := range dc.FinalityProviders[i].BtcDels {
 			btcDel := *dc.FinalityProviders[i].BtcDels[j]
-			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; !ok {
-				fp.AddBTCDelDistInfo(&btcDel)
+			if _, ok := unbondedBTCDels[btcDel.StakingTxHash]; ok {
+				continue
 			}
+			if _, ok := slashedBTCDels[btcDel.StakingTxHash]; ok {
+				continue
+			}
+			fp.AddBTCDelDistInfo(&btcDel)
 		}
 
 		// process all new BTC delegations under this finality provider
 		if fpActiveBTCDels, ok := activeBTCDels[fpBTCPKHex]; ok {
 			// handle new BTC delegations for this finality provider
 			for _, d := range fpActiveBTCDels {
+				if _, ok := slashedBTCDels[d.MustGetStakingTxHash().String()]; ok {
+					continue
+				}
 				fp.AddBTCDel(d)
 			}
 			// remove the finality provider entry in activeBTCDels map, so that
@@ -212,11 +351,18 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		if err != nil {
 			panic(err) // only programming error
 		}
+		// skip finality providers securing other chains
+		if newFP.ConsumerId != consumerID {
+			continue
+		}
 		fpDistInfo := types.NewFinalityProviderDistInfo(newFP)
 
 		// add each BTC delegation
 		fpActiveBTCDels := activeBTCDels[fpBTCPKHex]
 		for _, d := range fpActiveBTCDels {
+			if _, ok := slashedBTCDels[d.MustGetStakingTxHash().String()]; ok {
+				continue
+			}
 			fpDistInfo.AddBTCDel(d)
 		}
 
@@ -233,6 +379,29 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	return newDc
 }
 
+// collectBTCDelsOfFp adds the staking tx hashes of all BTC delegations
+// restaked to the given finality provider to the given set
+func (k Keeper) collectBTCDelsOfFp(ctx context.Context, fpBTCPKHex string, btcDels map[string]struct{}) {
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				// failing to unmarshal hash bytes in DB's BTC delegation index is a programming error
+				panic(err)
+			}
+			btcDels[stakingTxHash.String()] = struct{}{}
+		}
+	}
+}
+
 /* voting power distribution update event store */
 
 // addPowerDistUpdateEvent appends an event that affect voting power distribution
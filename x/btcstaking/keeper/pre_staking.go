@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// addInclusionProofToBTCDelegation sets the timelock of the given pre-staking
+// BTC delegation, whose staking tx is proven to be included in Bitcoin. The
+// BTC delegation becomes active if it has covenant quorums already
+func (k Keeper) addInclusionProofToBTCDelegation(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	startHeight uint64,
+	endHeight uint64,
+	params *types.Params,
+) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+
+	// the BTC delegation will not expire due to the missing inclusion proof
+	k.removePreStakingExpiry(ctx, btcDel.InclusionProofDeadline, stakingTxHash)
+
+	btcDel.StartHeight = startHeight
+	btcDel.EndHeight = endHeight
+	k.setBTCDelegation(ctx, btcDel)
+
+	// record event that the BTC delegation will become expired at endHeight-w
+	expiredEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash.String(),
+		NewState:      types.BTCDelegationStatus_EXPIRED,
+	})
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-wValue, expiredEvent)
+
+	// the BTC delegation has received covenant quorums before the inclusion
+	// proof, thus becomes active now. Record and emit this event
+	if btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		event := &types.EventBTCDelegationStateUpdate{
+			StakingTxHash: stakingTxHash.String(),
+			NewState:      types.BTCDelegationStatus_ACTIVE,
+		}
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
+		}
+
+		// record event that the BTC delegation becomes active at this height
+		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+		btcTip := k.btclcKeeper.GetTipInfo(ctx)
+		k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+	}
+}
+
+// RemoveExpiredPreStakingDelegations removes all pre-staking BTC delegations
+// whose inclusion proofs are not submitted before the current BTC tip. This
+// is called in `BeginBlocker`
+func (k Keeper) RemoveExpiredPreStakingDelegations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return nil
+	}
+
+	// collect the keys of all expired pre-staking BTC delegations, i.e., whose
+	// deadline is no larger than the current BTC tip
+	store := k.preStakingExpiryStore(ctx)
+	keys := [][]byte{}
+	func() {
+		iter := store.Iterator(nil, sdk.Uint64ToBigEndian(btcTip.Height+1))
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		stakingTxHash, err := chainhash.NewHash(key[8:])
+		if err != nil {
+			// failing to unmarshal hash bytes in DB's expiry index is a programming error
+			panic(err)
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil || btcDel.HasInclusionProof() {
+			continue
+		}
+		if err := k.removeBTCDelegation(ctx, btcDel, *stakingTxHash); err != nil {
+			return err
+		}
+
+		// notify subscriber about this expired BTC delegation
+		event := &types.EventBTCDelegationStateUpdate{
+			StakingTxHash: stakingTxHash.String(),
+			NewState:      types.BTCDelegationStatus_EXPIRED,
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the expired pre-staking BTC delegation: %w", err))
+		}
+	}
+
+	return nil
+}
+
+// removeBTCDelegation removes the given BTC delegation and all of its indexes
+// from the KV store
+func (k Keeper) removeBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, stakingTxHash chainhash.Hash) error {
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fpBTCPK := fpBTCPK // remove when update to go1.22
+		btcDelIndex := k.getBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk)
+		if btcDelIndex == nil {
+			continue
+		}
+		btcDelIndex.Remove(stakingTxHash)
+		if len(btcDelIndex.StakingTxHashList) == 0 {
+			k.btcDelegatorFpStore(ctx, &fpBTCPK).Delete(*btcDel.BtcPk)
+		} else {
+			k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
+		}
+	}
+
+	stakerAddr, err := sdk.AccAddressFromBech32(btcDel.StakerAddr)
+	if err != nil {
+		return err
+	}
+	k.btcDelegationStakerAddrStore(ctx, stakerAddr).Delete(stakingTxHash[:])
+	k.btcDelegationDelBTCPKStore(ctx, btcDel.BtcPk).Delete(stakingTxHash[:])
+	k.btcDelegationStore(ctx).Delete(stakingTxHash[:])
+
+	return nil
+}
+
+// addPreStakingExpiry records that the pre-staking BTC delegation with the
+// given staking tx hash expires at the given BTC height
+func (k Keeper) addPreStakingExpiry(ctx context.Context, btcHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.preStakingExpiryStore(ctx)
+	store.Set(preStakingExpiryKey(btcHeight, stakingTxHash), []byte{})
+}
+
+func (k Keeper) removePreStakingExpiry(ctx context.Context, btcHeight uint64, stakingTxHash chainhash.Hash) {
+	store := k.preStakingExpiryStore(ctx)
+	store.Delete(preStakingExpiryKey(btcHeight, stakingTxHash))
+}
+
+func preStakingExpiryKey(btcHeight uint64, stakingTxHash chainhash.Hash) []byte {
+	return append(sdk.Uint64ToBigEndian(btcHeight), stakingTxHash[:]...)
+}
+
+// preStakingExpiryStore returns the KVStore of the expiry of pre-staking BTC
+// delegations
+// prefix: PreStakingExpiryKey
+// key: (BTC height of the inclusion proof deadline || staking tx hash)
+// value: empty
+func (k Keeper) preStakingExpiryStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PreStakingExpiryKey)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 )
 
@@ -26,14 +27,39 @@ func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, er
 		return BTCDelegationStatus_ACTIVE, nil
 	case "unbonded":
 		return BTCDelegationStatus_UNBONDED, nil
+	case "expired":
+		return BTCDelegationStatus_EXPIRED, nil
+	case "slashed":
+		return BTCDelegationStatus_SLASHED, nil
+	case "verified":
+		return BTCDelegationStatus_VERIFIED, nil
 	case "any":
 		return BTCDelegationStatus_ANY, nil
 	default:
-		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, any}")
+		return -1, fmt.Errorf("invalid status string; should be one of {pending, verified, active, unbonded, expired, slashed, any}")
+	}
+}
+
+// DelegationStatus returns the status of a BTC delegation whose staking output
+// is spent via the spending path on Bitcoin
+func (p StakingOutputSpendPath) DelegationStatus() BTCDelegationStatus {
+	switch p {
+	case StakingOutputSpendPath_UNBONDING_PATH:
+		return BTCDelegationStatus_UNBONDED
+	case StakingOutputSpendPath_SLASHING_PATH:
+		return BTCDelegationStatus_SLASHED
+	default:
+		// the timelock path can only be used after the staking timelock expires
+		return BTCDelegationStatus_EXPIRED
 	}
 }
 
 func (d *BTCDelegation) GetStakingTime() uint16 {
+	// the timelock of a pre-staking BTC delegation has not started yet
+	if !d.HasInclusionProof() {
+		return uint16(d.StakingTimeBlocks)
+	}
+
 	diff := d.EndHeight - d.StartHeight
 
 	if diff > math.MaxUint16 {
@@ -76,6 +102,80 @@ func (d *BTCDelegation) GetCovSlashingAdaptorSig(
 	return nil, ErrInvalidCovenantPK.Wrap("covenant PK is not found")
 }
 
+// HasInclusionProof returns whether the staking tx of the BTC delegation is
+// proven to be included in Bitcoin. A pre-staking BTC delegation does not
+// have the inclusion proof until MsgAddBTCDelegationInclusionProof, thus
+// its timelock has not started yet
+func (d *BTCDelegation) HasInclusionProof() bool {
+	// the end height of a BTC delegation with a timelock is always positive
+	return d.EndHeight > 0
+}
+
+// IsStakingOutputSpent returns whether the staking output of the BTC
+// delegation is reported to be spent on Bitcoin
+func (d *BTCDelegation) IsStakingOutputSpent() bool {
+	return d.StakingOutputSpend != nil
+}
+
+// GetStakingOutputSpendPath returns the spending path of the staking output
+// used by the given tx. The tx has to spend the staking output of the BTC
+// delegation via one of the script paths of the given staking info
+func (d *BTCDelegation) GetStakingOutputSpendPath(
+	stakingInfo *btcstaking.StakingInfo,
+	spendTx *wire.MsgTx,
+) (StakingOutputSpendPath, error) {
+	stakingTxHash, err := d.GetStakingTxHash()
+	if err != nil {
+		return 0, err
+	}
+	stakingOutPoint := wire.NewOutPoint(&stakingTxHash, d.StakingOutputIdx)
+
+	// find the input spending the staking output
+	var witness wire.TxWitness
+	found := false
+	for _, txIn := range spendTx.TxIn {
+		if txIn.PreviousOutPoint == *stakingOutPoint {
+			witness = txIn.Witness
+			found = true
+			break
+		}
+	}
+	if !found {
+		return 0, ErrInvalidStakingOutputSpend.Wrap("the tx does not spend the staking output")
+	}
+
+	// a taproot script path spend reveals the script as the second last
+	// witness element, followed by the control block and the optional annex
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 &&
+		witness[len(witness)-1][0] == txscript.TaprootAnnexTag {
+		witness = witness[:len(witness)-1]
+	}
+	if len(witness) < 2 {
+		return 0, ErrInvalidStakingOutputSpend.Wrap("the staking output is not spent via a script path")
+	}
+	revealedScript := witness[len(witness)-2]
+
+	spendPaths := []struct {
+		path      StakingOutputSpendPath
+		spendInfo func() (*btcstaking.SpendInfo, error)
+	}{
+		{StakingOutputSpendPath_TIMELOCK_PATH, stakingInfo.TimeLockPathSpendInfo},
+		{StakingOutputSpendPath_UNBONDING_PATH, stakingInfo.UnbondingPathSpendInfo},
+		{StakingOutputSpendPath_SLASHING_PATH, stakingInfo.SlashingPathSpendInfo},
+	}
+	for _, sp := range spendPaths {
+		spendInfo, err := sp.spendInfo()
+		if err != nil {
+			return 0, err
+		}
+		if bytes.Equal(revealedScript, spendInfo.GetPkScriptPath()) {
+			return sp.path, nil
+		}
+	}
+
+	return 0, ErrInvalidStakingOutputSpend.Wrap("the revealed script does not match any script path of the staking output")
+}
+
 // IsUnbondedEarly returns whether the delegator has signed unbonding signature.
 // Signing unbonding signature means the delegator wants to unbond early, and
 // Babylon will consider this BTC delegation unbonded directly
@@ -86,16 +186,45 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 // GetStatus returns the status of the BTC Delegation based on BTC height, w value, and covenant quorum
 // Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures
 // Active: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
-// Unbonded: the BTC height is larger than `endHeight-w` or the BTC delegation has received a signature on unbonding tx from the delegator
+// Verified: the BTC delegation is a pre-staking BTC delegation without inclusion proof, and has quorum number of signatures from covenant committee
+// Unbonded: the BTC delegation has received a signature on unbonding tx from the delegator
+// Expired: the BTC height is larger than `endHeight-w`
+// If the staking output is reported to be spent on Bitcoin, then the status is
+// determined by the spending path of the staking output.
+// NOTE: the slashed status due to slashed restaked finality providers is
+// determined by GetStatusWithSlashedFps
 func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uint32) BTCDelegationStatus {
+	if d.IsStakingOutputSpent() {
+		return d.StakingOutputSpend.SpendPath.DelegationStatus()
+	}
+
 	if d.IsUnbondedEarly() {
 		return BTCDelegationStatus_UNBONDED
 	}
 
-	if btcHeight < d.StartHeight || btcHeight+w > d.EndHeight {
-		// staking tx's timelock has not begun, or is less than w BTC
-		// blocks left, or is expired
-		return BTCDelegationStatus_UNBONDED
+	if !d.HasInclusionProof() {
+		if btcHeight >= d.InclusionProofDeadline {
+			// the inclusion proof of the pre-staking BTC delegation is not
+			// submitted in time
+			return BTCDelegationStatus_EXPIRED
+		}
+		if d.HasCovenantQuorums(covenantQuorum) {
+			// the pre-staking BTC delegation has covenant quorums and waits
+			// for the inclusion proof
+			return BTCDelegationStatus_VERIFIED
+		}
+		return BTCDelegationStatus_PENDING
+	}
+
+	if btcHeight+w > d.EndHeight {
+		// staking tx's timelock has less than w BTC blocks left, or is expired
+		return BTCDelegationStatus_EXPIRED
+	}
+
+	if btcHeight < d.StartHeight {
+		// staking tx's timelock has not begun, e.g., due to a BTC reorg,
+		// thus the BTC delegation cannot be active yet
+		return BTCDelegationStatus_PENDING
 	}
 
 	// at this point, BTC delegation has an active timelock, and Babylon is not
@@ -110,6 +239,60 @@ func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uin
 	return BTCDelegationStatus_PENDING
 }
 
+// GetUnbondingBtcHeight returns the BTC height at which the BTC delegation is
+// unbonded, and whether it is unbonded at all. The BTC delegation is unbonded
+// either when Babylon receives the delegator's unbonding signature, or when
+// its staking output is reported to be spent via the unbonding path
+func (d *BTCDelegation) GetUnbondingBtcHeight() (uint64, bool) {
+	if d.IsStakingOutputSpent() && d.StakingOutputSpend.SpendPath == StakingOutputSpendPath_UNBONDING_PATH {
+		return d.StakingOutputSpend.SpendTxBtcHeight, true
+	}
+	if d.IsUnbondedEarly() {
+		return d.BtcUndelegation.DelegatorUnbondingBtcHeight, true
+	}
+	return 0, false
+}
+
+// IsSlashedBy returns whether the BTC delegation is slashed due to the given
+// finality provider, i.e., the BTC delegation restakes to the finality provider,
+// has covenant quorums so that its slashing tx is fully signed, and the finality
+// provider is slashed while the BTC delegation can still be slashed. That is,
+// before the delegation is unbonded, its staking timelock has not expired, and
+// after the delegation is unbonded, its unbonding timelock has not expired.
+func (d *BTCDelegation) IsSlashedBy(fp *FinalityProvider, w uint64, covenantQuorum uint32) bool {
+	if !fp.IsSlashed() || d.GetFpIdx(fp.BtcPk) == -1 {
+		return false
+	}
+	if !d.HasCovenantQuorums(covenantQuorum) {
+		return false
+	}
+	unbondingHeight, unbonded := d.GetUnbondingBtcHeight()
+	if unbonded && fp.SlashedBtcHeight >= unbondingHeight {
+		// the staking output is already spent by the unbonding tx, and the
+		// unbonding output can be slashed until its timelock expires
+		return fp.SlashedBtcHeight < unbondingHeight+uint64(d.UnbondingTime)
+	}
+	return fp.SlashedBtcHeight+w <= d.EndHeight
+}
+
+// GetStatusWithSlashedFps returns the status of the BTC delegation, given the
+// slashed finality providers among its restaked ones. A BTC delegation that is
+// slashed by any of them is in the slashed status. Otherwise, the status is
+// determined by GetStatus.
+func (d *BTCDelegation) GetStatusWithSlashedFps(
+	slashedFps []*FinalityProvider,
+	btcHeight uint64,
+	w uint64,
+	covenantQuorum uint32,
+) BTCDelegationStatus {
+	for _, fp := range slashedFps {
+		if d.IsSlashedBy(fp, w, covenantQuorum) {
+			return BTCDelegationStatus_SLASHED
+		}
+	}
+	return d.GetStatus(btcHeight, w, covenantQuorum)
+}
+
 // VotingPower returns the voting power of the BTC delegation at a given BTC height
 // and a given w value.
 // The BTC delegation d has voting power iff it is active.
@@ -453,6 +636,16 @@ func (i *BTCDelegatorDelegationIndex) Add(stakingTxHash chainhash.Hash) error {
 	return nil
 }
 
+// Remove removes the given staking tx hash from the index, if it exists
+func (i *BTCDelegatorDelegationIndex) Remove(stakingTxHash chainhash.Hash) {
+	for idx, hash := range i.StakingTxHashList {
+		if bytes.Equal(stakingTxHash[:], hash) {
+			i.StakingTxHashList = append(i.StakingTxHashList[:idx], i.StakingTxHashList[idx+1:]...)
+			return
+		}
+	}
+}
+
 // VotingPower calculates the total voting power of all BTC delegations
 func (dels *BTCDelegatorDelegations) VotingPower(btcHeight uint64, w uint64, covenantQuorum uint32) uint64 {
 	power := uint64(0)
@@ -15,6 +15,75 @@ func (fp *FinalityProvider) IsSlashed() bool {
 	return fp.SlashedBabylonHeight > 0
 }
 
+func (fp *FinalityProvider) IsJailed() bool {
+	return fp.Jailed
+}
+
+func (fp *FinalityProvider) IsRetired() bool {
+	return fp.RetiredBabylonHeight > 0
+}
+
+// HasBoundAddr returns whether the given Babylon address is or was bound to
+// the finality provider
+func (fp *FinalityProvider) HasBoundAddr(addr string) bool {
+	if fp.Addr == addr {
+		return true
+	}
+	for _, prevAddr := range fp.PreviousAddrs {
+		if prevAddr == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// SecuresBabylon returns whether the finality provider provides finality for
+// Babylon rather than a consumer chain
+func (fp *FinalityProvider) SecuresBabylon() bool {
+	return len(fp.ConsumerId) == 0
+}
+
+// GetMaxCommissionRate returns the maximum commission rate of the finality
+// provider, which is 1 if the finality provider does not specify it
+func (fp *FinalityProvider) GetMaxCommissionRate() math.LegacyDec {
+	if fp.MaxCommissionRate == nil {
+		return math.LegacyOneDec()
+	}
+	return *fp.MaxCommissionRate
+}
+
+// GetMaxCommissionChangeRate returns the maximum change of the commission
+// rate in a single commission change, which is the maximum commission rate
+// if the finality provider does not specify it
+func (fp *FinalityProvider) GetMaxCommissionChangeRate() math.LegacyDec {
+	if fp.MaxCommissionChangeRate == nil {
+		return fp.GetMaxCommissionRate()
+	}
+	return *fp.MaxCommissionChangeRate
+}
+
+// HasPendingCommission returns whether the finality provider has a
+// scheduled commission change that has not taken effect yet
+func (fp *FinalityProvider) HasPendingCommission() bool {
+	return fp.PendingCommission != nil
+}
+
+// ValidateCommissionRates checks that
+// - 0 <= commission <= maxRate <= 1, and
+// - 0 <= maxChangeRate <= maxRate
+func ValidateCommissionRates(commission, maxRate, maxChangeRate math.LegacyDec) error {
+	if maxRate.IsNegative() || maxRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max commission rate has to be in [0, 1]")
+	}
+	if commission.IsNegative() || commission.GT(maxRate) {
+		return fmt.Errorf("commission rate has to be in [0, max commission rate]")
+	}
+	if maxChangeRate.IsNegative() || maxChangeRate.GT(maxRate) {
+		return fmt.Errorf("max commission change rate has to be in [0, max commission rate]")
+	}
+	return nil
+}
+
 func (fp *FinalityProvider) ValidateBasic() error {
 	// ensure fields are non-empty and well-formatted
 	if _, err := sdk.AccAddressFromBech32(fp.Addr); err != nil {
@@ -32,14 +101,27 @@ func (fp *FinalityProvider) ValidateBasic() error {
 	if err := fp.Pop.ValidateBasic(); err != nil {
 		return fmt.Errorf("PoP is not valid: %w", err)
 	}
+	if fp.Commission != nil {
+		if err := ValidateCommissionRates(*fp.Commission, fp.GetMaxCommissionRate(), fp.GetMaxCommissionChangeRate()); err != nil {
+			return err
+		}
+	}
+	for _, prevAddr := range fp.PreviousAddrs {
+		if _, err := sdk.AccAddressFromBech32(prevAddr); err != nil {
+			return fmt.Errorf("invalid previous finality provider address: %s - %w", prevAddr, err)
+		}
+	}
 
 	return nil
 }
 
-// SortFinalityProviders sorts the finality providers slice,
-// from higher to lower voting power
+// SortFinalityProviders sorts the finality providers such that non-jailed
+// finality providers come first, in descending order of voting power
 func SortFinalityProviders(fps []*FinalityProviderDistInfo) {
 	sort.SliceStable(fps, func(i, j int) bool {
+		if fps[i].IsInactive() != fps[j].IsInactive() {
+			return !fps[i].IsInactive()
+		}
 		return fps[i].TotalVotingPower > fps[j].TotalVotingPower
 	})
 }
@@ -27,10 +27,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StakingOutputSpendPath is the spending path of the staking output of a
+// BTC delegation
+type StakingOutputSpendPath int32
+
+const (
+	// TIMELOCK_PATH is the path where the staker withdraws the staking
+	// output after the staking timelock expires
+	StakingOutputSpendPath_TIMELOCK_PATH StakingOutputSpendPath = 0
+	// UNBONDING_PATH is the path where the staking output is spent by the
+	// unbonding tx with signatures of the staker and the covenant committee
+	StakingOutputSpendPath_UNBONDING_PATH StakingOutputSpendPath = 1
+	// SLASHING_PATH is the path where the staking output is spent by the
+	// slashing tx with signatures of the staker, a finality provider and the
+	// covenant committee
+	StakingOutputSpendPath_SLASHING_PATH StakingOutputSpendPath = 2
+)
+
+var StakingOutputSpendPath_name = map[int32]string{
+	0: "TIMELOCK_PATH",
+	1: "UNBONDING_PATH",
+	2: "SLASHING_PATH",
+}
+
+var StakingOutputSpendPath_value = map[string]int32{
+	"TIMELOCK_PATH":  0,
+	"UNBONDING_PATH": 1,
+	"SLASHING_PATH":  2,
+}
+
+func (x StakingOutputSpendPath) String() string {
+	return proto.EnumName(StakingOutputSpendPath_name, int32(x))
+}
+
+func (StakingOutputSpendPath) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{0}
+}
+
 // BTCDelegationStatus is the status of a delegation. The state transition path is
-// PENDING -> ACTIVE -> UNBONDED with two possibilities:
+// PENDING -> ACTIVE -> {UNBONDED, EXPIRED, SLASHED} with three possibilities:
 // 1. the typical path when timelock of staking transaction expires.
 // 2. the path when staker requests early undelegation through MsgBTCUndelegate message.
+// 3. the path when one of the restaked finality providers is slashed.
 type BTCDelegationStatus int32
 
 const (
@@ -38,12 +76,21 @@ const (
 	BTCDelegationStatus_PENDING BTCDelegationStatus = 0
 	// ACTIVE defines a delegation that has voting power
 	BTCDelegationStatus_ACTIVE BTCDelegationStatus = 1
-	// UNBONDED defines a delegation no longer has voting power:
-	// - either reaching the end of staking transaction timelock
-	// - or receiving unbonding tx with signatures from staker and covenant committee
+	// UNBONDED defines a delegation no longer has voting power
+	// by receiving unbonding tx with signatures from staker and covenant committee
 	BTCDelegationStatus_UNBONDED BTCDelegationStatus = 2
 	// ANY is any of the above status
 	BTCDelegationStatus_ANY BTCDelegationStatus = 3
+	// EXPIRED defines a delegation no longer has voting power
+	// by reaching the end of staking transaction timelock
+	BTCDelegationStatus_EXPIRED BTCDelegationStatus = 4
+	// SLASHED defines a delegation no longer has voting power
+	// since one of its restaked finality providers is slashed
+	BTCDelegationStatus_SLASHED BTCDelegationStatus = 5
+	// VERIFIED defines a pre-staking delegation that has received covenant
+	// signatures and waits for the inclusion proof of its staking tx to
+	// become active
+	BTCDelegationStatus_VERIFIED BTCDelegationStatus = 6
 )
 
 var BTCDelegationStatus_name = map[int32]string{
@@ -51,6 +98,9 @@ var BTCDelegationStatus_name = map[int32]string{
 	1: "ACTIVE",
 	2: "UNBONDED",
 	3: "ANY",
+	4: "EXPIRED",
+	5: "SLASHED",
+	6: "VERIFIED",
 }
 
 var BTCDelegationStatus_value = map[string]int32{
@@ -58,6 +108,9 @@ var BTCDelegationStatus_value = map[string]int32{
 	"ACTIVE":   1,
 	"UNBONDED": 2,
 	"ANY":      3,
+	"EXPIRED":  4,
+	"SLASHED":  5,
+	"VERIFIED": 6,
 }
 
 func (x BTCDelegationStatus) String() string {
@@ -65,7 +118,7 @@ func (x BTCDelegationStatus) String() string {
 }
 
 func (BTCDelegationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{0}
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
 }
 
 // FinalityProvider defines a finality provider
@@ -90,6 +143,42 @@ type FinalityProvider struct {
 	// the finality provider is slashed.
 	// if it's 0 then the finality provider is not slashed
 	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// consumer_id is the ID of the consumer chain the finality provider
+	// provides finality for. If it's empty then the finality provider
+	// provides finality for Babylon
+	ConsumerId string `protobuf:"bytes,8,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// jailed defines whether the finality provider is jailed due to
+	// missing too many finality votes. A jailed finality provider has
+	// no voting power until it is unjailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// max_commission_rate is the maximum commission rate that the finality
+	// provider can ever charge. It cannot be changed after registration
+	MaxCommissionRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate,omitempty"`
+	// max_commission_change_rate is the maximum change of the commission
+	// rate in a single commission change. It cannot be changed after
+	// registration
+	MaxCommissionChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate,omitempty"`
+	// commission_update_height is the Babylon height of the last commission
+	// change request, or of the registration if there is none
+	CommissionUpdateHeight uint64 `protobuf:"varint,12,opt,name=commission_update_height,json=commissionUpdateHeight,proto3" json:"commission_update_height,omitempty"`
+	// pending_commission is the commission rate that is scheduled to take
+	// effect at pending_commission_height. It is nil if there is no
+	// scheduled commission change
+	PendingCommission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=pending_commission,json=pendingCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pending_commission,omitempty"`
+	// pending_commission_height is the Babylon height at which
+	// pending_commission takes effect
+	PendingCommissionHeight uint64 `protobuf:"varint,14,opt,name=pending_commission_height,json=pendingCommissionHeight,proto3" json:"pending_commission_height,omitempty"`
+	// retired_babylon_height indicates the Babylon height when the finality
+	// provider is retired. A retired finality provider does not accept new
+	// BTC delegations, and leaves the active set from the next height on,
+	// while its existing BTC delegations remain until they end.
+	// if it's 0 then the finality provider is not retired
+	RetiredBabylonHeight uint64 `protobuf:"varint,15,opt,name=retired_babylon_height,json=retiredBabylonHeight,proto3" json:"retired_babylon_height,omitempty"`
+	// previous_addrs are the Babylon addresses that were bound to the
+	// finality provider before address rotations. An address cannot be
+	// bound to the finality provider again once rotated away, such that
+	// the proof of possession of each rotation is fresh
+	PreviousAddrs []string `protobuf:"bytes,16,rep,name=previous_addrs,json=previousAddrs,proto3" json:"previous_addrs,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -160,6 +249,113 @@ func (m *FinalityProvider) GetSlashedBtcHeight() uint64 {
 	return 0
 }
 
+func (m *FinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *FinalityProvider) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *FinalityProvider) GetCommissionUpdateHeight() uint64 {
+	if m != nil {
+		return m.CommissionUpdateHeight
+	}
+	return 0
+}
+
+func (m *FinalityProvider) GetPendingCommissionHeight() uint64 {
+	if m != nil {
+		return m.PendingCommissionHeight
+	}
+	return 0
+}
+
+func (m *FinalityProvider) GetRetiredBabylonHeight() uint64 {
+	if m != nil {
+		return m.RetiredBabylonHeight
+	}
+	return 0
+}
+
+func (m *FinalityProvider) GetPreviousAddrs() []string {
+	if m != nil {
+		return m.PreviousAddrs
+	}
+	return nil
+}
+
+// ConsumerRegister is the registration of a consumer chain that receives
+// finality from finality providers restaked by BTC delegations
+type ConsumerRegister struct {
+	// consumer_id is the ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// consumer_name is the name of the consumer chain
+	ConsumerName string `protobuf:"bytes,2,opt,name=consumer_name,json=consumerName,proto3" json:"consumer_name,omitempty"`
+	// consumer_description is a description of the consumer chain
+	ConsumerDescription string `protobuf:"bytes,3,opt,name=consumer_description,json=consumerDescription,proto3" json:"consumer_description,omitempty"`
+}
+
+func (m *ConsumerRegister) Reset()         { *m = ConsumerRegister{} }
+func (m *ConsumerRegister) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRegister) ProtoMessage()    {}
+func (*ConsumerRegister) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+}
+func (m *ConsumerRegister) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRegister) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRegister.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRegister) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRegister.Merge(m, src)
+}
+func (m *ConsumerRegister) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRegister) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRegister.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRegister proto.InternalMessageInfo
+
+func (m *ConsumerRegister) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetConsumerName() string {
+	if m != nil {
+		return m.ConsumerName
+	}
+	return ""
+}
+
+func (m *ConsumerRegister) GetConsumerDescription() string {
+	if m != nil {
+		return m.ConsumerDescription
+	}
+	return ""
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -177,13 +373,15 @@ type FinalityProviderWithMeta struct {
 	// the finality provider is slashed.
 	// if it's 0 then the finality provider is not slashed
 	SlashedBtcHeight uint64 `protobuf:"varint,5,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
 }
 
 func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMeta{} }
 func (m *FinalityProviderWithMeta) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderWithMeta) ProtoMessage()    {}
 func (*FinalityProviderWithMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+	return fileDescriptor_3851ae95ccfaf7db, []int{2}
 }
 func (m *FinalityProviderWithMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,6 +438,13 @@ func (m *FinalityProviderWithMeta) GetSlashedBtcHeight() uint64 {
 	return 0
 }
 
+func (m *FinalityProviderWithMeta) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
 // BTCDelegation defines a BTC delegation
 type BTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -286,13 +491,25 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// staking_output_spend is the reported spend of the staking output on
+	// Bitcoin. It is nil if no spend has been reported
+	StakingOutputSpend *StakingOutputSpend `protobuf:"bytes,16,opt,name=staking_output_spend,json=stakingOutputSpend,proto3" json:"staking_output_spend,omitempty"`
+	// staking_time_blocks is the staking timelock of the staking tx in BTC
+	// blocks. The timelock of a pre-staking BTC delegation starts once the
+	// inclusion proof of its staking tx is submitted
+	StakingTimeBlocks uint32 `protobuf:"varint,17,opt,name=staking_time_blocks,json=stakingTimeBlocks,proto3" json:"staking_time_blocks,omitempty"`
+	// inclusion_proof_deadline is the BTC height at which a pre-staking BTC
+	// delegation expires if the inclusion proof of its staking tx has not
+	// been submitted. It is 0 if the BTC delegation is registered together
+	// with the inclusion proof
+	InclusionProofDeadline uint64 `protobuf:"varint,18,opt,name=inclusion_proof_deadline,json=inclusionProofDeadline,proto3" json:"inclusion_proof_deadline,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
 func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegation) ProtoMessage()    {}
 func (*BTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+	return fileDescriptor_3851ae95ccfaf7db, []int{3}
 }
 func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,6 +615,92 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetStakingOutputSpend() *StakingOutputSpend {
+	if m != nil {
+		return m.StakingOutputSpend
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetStakingTimeBlocks() uint32 {
+	if m != nil {
+		return m.StakingTimeBlocks
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetInclusionProofDeadline() uint64 {
+	if m != nil {
+		return m.InclusionProofDeadline
+	}
+	return 0
+}
+
+// StakingOutputSpend is a spend of the staking output of a BTC delegation
+// on Bitcoin
+type StakingOutputSpend struct {
+	// spend_tx is the tx that spends the staking output
+	SpendTx []byte `protobuf:"bytes,1,opt,name=spend_tx,json=spendTx,proto3" json:"spend_tx,omitempty"`
+	// spend_tx_btc_height is the height of the BTC block including spend_tx
+	SpendTxBtcHeight uint64 `protobuf:"varint,2,opt,name=spend_tx_btc_height,json=spendTxBtcHeight,proto3" json:"spend_tx_btc_height,omitempty"`
+	// spend_path is the spending path of the staking output used by spend_tx
+	SpendPath StakingOutputSpendPath `protobuf:"varint,3,opt,name=spend_path,json=spendPath,proto3,enum=babylon.btcstaking.v1.StakingOutputSpendPath" json:"spend_path,omitempty"`
+}
+
+func (m *StakingOutputSpend) Reset()         { *m = StakingOutputSpend{} }
+func (m *StakingOutputSpend) String() string { return proto.CompactTextString(m) }
+func (*StakingOutputSpend) ProtoMessage()    {}
+func (*StakingOutputSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+}
+func (m *StakingOutputSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakingOutputSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakingOutputSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakingOutputSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakingOutputSpend.Merge(m, src)
+}
+func (m *StakingOutputSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakingOutputSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakingOutputSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakingOutputSpend proto.InternalMessageInfo
+
+func (m *StakingOutputSpend) GetSpendTx() []byte {
+	if m != nil {
+		return m.SpendTx
+	}
+	return nil
+}
+
+func (m *StakingOutputSpend) GetSpendTxBtcHeight() uint64 {
+	if m != nil {
+		return m.SpendTxBtcHeight
+	}
+	return 0
+}
+
+func (m *StakingOutputSpend) GetSpendPath() StakingOutputSpendPath {
+	if m != nil {
+		return m.SpendPath
+	}
+	return StakingOutputSpendPath_TIMELOCK_PATH
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -426,13 +729,17 @@ type BTCUndelegation struct {
 	// by covenant members
 	// It must be provided after processing undelegate message by Babylon
 	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,6,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// delegator_unbonding_btc_height is the BTC tip height when Babylon
+	// receives delegator_unbonding_sig. The unbonding output can be slashed
+	// until unbonding_time BTC blocks after this height
+	DelegatorUnbondingBtcHeight uint64 `protobuf:"varint,7,opt,name=delegator_unbonding_btc_height,json=delegatorUnbondingBtcHeight,proto3" json:"delegator_unbonding_btc_height,omitempty"`
 }
 
 func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
 func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegation) ProtoMessage()    {}
 func (*BTCUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
 }
 func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,6 +789,13 @@ func (m *BTCUndelegation) GetCovenantUnbondingSigList() []*SignatureInfo {
 	return nil
 }
 
+func (m *BTCUndelegation) GetDelegatorUnbondingBtcHeight() uint64 {
+	if m != nil {
+		return m.DelegatorUnbondingBtcHeight
+	}
+	return 0
+}
+
 // BTCDelegatorDelegations is a collection of BTC delegations from the same delegator.
 type BTCDelegatorDelegations struct {
 	Dels []*BTCDelegation `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
@@ -491,7 +805,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +850,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +896,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +938,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +997,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{10}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,10 +1041,13 @@ func (m *SelectiveSlashingEvidence) GetRecoveredFpBtcSk() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.StakingOutputSpendPath", StakingOutputSpendPath_name, StakingOutputSpendPath_value)
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
+	proto.RegisterType((*ConsumerRegister)(nil), "babylon.btcstaking.v1.ConsumerRegister")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
 	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
+	proto.RegisterType((*StakingOutputSpend)(nil), "babylon.btcstaking.v1.StakingOutputSpend")
 	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
 	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
 	proto.RegisterType((*BTCDelegatorDelegationIndex)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationIndex")
@@ -744,82 +1061,110 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0xda, 0x8e, 0x43, 0x5e, 0xdb, 0xc4, 0x0c, 0x21, 0x2c, 0x44, 0xbf, 0x24, 0x3f, 0x97,
-	0xa2, 0xa8, 0x25, 0x36, 0x04, 0x5a, 0x95, 0x43, 0x0f, 0x71, 0x1c, 0x4a, 0x04, 0x04, 0x77, 0xed,
-	0x50, 0xb5, 0x95, 0xba, 0x1a, 0xef, 0x4e, 0xd6, 0x23, 0xdb, 0x3b, 0xdb, 0x9d, 0xb1, 0xeb, 0x7c,
-	0x83, 0x5e, 0x2a, 0xf5, 0xda, 0x7b, 0x3f, 0x02, 0x9f, 0xa1, 0xe2, 0x88, 0x38, 0x55, 0x39, 0x44,
-	0x15, 0x9c, 0xfb, 0x1d, 0xaa, 0x99, 0x59, 0xef, 0xae, 0x29, 0xa1, 0x40, 0x72, 0xf3, 0xbc, 0xff,
-	0x9e, 0x77, 0xde, 0xe7, 0xf1, 0x3b, 0x0b, 0xd7, 0x3b, 0xb8, 0x73, 0xd8, 0x67, 0x7e, 0xad, 0x23,
-	0x1c, 0x2e, 0x70, 0x8f, 0xfa, 0x5e, 0x6d, 0x74, 0x2b, 0x75, 0xaa, 0x06, 0x21, 0x13, 0x0c, 0x5d,
-	0x8a, 0xe2, 0xaa, 0x29, 0xcf, 0xe8, 0xd6, 0xd5, 0x45, 0x8f, 0x79, 0x4c, 0x45, 0xd4, 0xe4, 0x2f,
-	0x1d, 0x7c, 0xf5, 0x8a, 0xc3, 0xf8, 0x80, 0x71, 0x5b, 0x3b, 0xf4, 0x21, 0x72, 0x5d, 0xd3, 0xa7,
-	0x5a, 0x82, 0xd5, 0x21, 0x02, 0xdf, 0xaa, 0x4d, 0xa1, 0x5d, 0x5d, 0x7d, 0x73, 0x57, 0x01, 0x0b,
-	0x74, 0x40, 0xe5, 0x59, 0x16, 0xca, 0xf7, 0xa8, 0x8f, 0xfb, 0x54, 0x1c, 0x36, 0x43, 0x36, 0xa2,
-	0x2e, 0x09, 0xd1, 0x0d, 0xc8, 0x61, 0xd7, 0x0d, 0x4d, 0x63, 0xcd, 0x58, 0x9f, 0xaf, 0x9b, 0x2f,
-	0x9e, 0x6e, 0x2c, 0x46, 0xd8, 0x5b, 0xae, 0x1b, 0x12, 0xce, 0x5b, 0x22, 0xa4, 0xbe, 0x67, 0xa9,
-	0x28, 0xb4, 0x03, 0x05, 0x97, 0x70, 0x27, 0xa4, 0x81, 0xa0, 0xcc, 0x37, 0x33, 0x6b, 0xc6, 0x7a,
-	0x61, 0xf3, 0xa3, 0x6a, 0x94, 0x91, 0xdc, 0x51, 0xf5, 0x57, 0x6d, 0x24, 0xa1, 0x56, 0x3a, 0x0f,
-	0x3d, 0x02, 0x70, 0xd8, 0x60, 0x40, 0x39, 0x97, 0x55, 0xb2, 0x0a, 0x7a, 0xe3, 0xe8, 0x78, 0x75,
-	0x59, 0x17, 0xe2, 0x6e, 0xaf, 0x4a, 0x59, 0x6d, 0x80, 0x45, 0xb7, 0xfa, 0x90, 0x78, 0xd8, 0x39,
-	0x6c, 0x10, 0xe7, 0xc5, 0xd3, 0x0d, 0x88, 0x70, 0x1a, 0xc4, 0xb1, 0x52, 0x05, 0xd0, 0x23, 0xc8,
-	0x77, 0x84, 0x63, 0x07, 0x3d, 0x33, 0xb7, 0x66, 0xac, 0x17, 0xeb, 0x9f, 0x1f, 0x1d, 0xaf, 0x6e,
-	0x7a, 0x54, 0x74, 0x87, 0x9d, 0xaa, 0xc3, 0x06, 0xb5, 0x68, 0x30, 0x4e, 0x17, 0x53, 0x7f, 0x72,
-	0xa8, 0x89, 0xc3, 0x80, 0xf0, 0x6a, 0x7d, 0xb7, 0x79, 0xfb, 0xce, 0xcd, 0xe6, 0xb0, 0xf3, 0x80,
-	0x1c, 0x5a, 0xb3, 0x1d, 0xe1, 0x34, 0x7b, 0xe8, 0x4b, 0xc8, 0x06, 0x2c, 0x30, 0x67, 0xd5, 0xe5,
-	0x3e, 0xad, 0xbe, 0x91, 0xc4, 0x6a, 0x33, 0x64, 0xec, 0xe0, 0xf1, 0x41, 0x93, 0x71, 0x4e, 0x54,
-	0x17, 0xf5, 0xf6, 0xb6, 0x25, 0xf3, 0xd0, 0x1d, 0x58, 0xe2, 0x7d, 0xcc, 0xbb, 0xc4, 0xb5, 0xa3,
-	0x54, 0xbb, 0x4b, 0xa8, 0xd7, 0x15, 0x66, 0x7e, 0xcd, 0x58, 0xcf, 0x59, 0x8b, 0x91, 0xb7, 0xae,
-	0x9d, 0xf7, 0x95, 0x0f, 0xdd, 0x00, 0x14, 0x67, 0x09, 0x67, 0x92, 0x31, 0xa7, 0x32, 0xca, 0x93,
-	0x0c, 0xe1, 0xe8, 0xe8, 0xca, 0xcf, 0x19, 0x30, 0x5f, 0xa7, 0xf2, 0x1b, 0x2a, 0xba, 0x8f, 0x88,
-	0xc0, 0xa9, 0x71, 0x18, 0x67, 0x31, 0x8e, 0x25, 0xc8, 0x47, 0xdd, 0x64, 0x54, 0x37, 0xd1, 0x09,
-	0xfd, 0x1f, 0x8a, 0x23, 0x26, 0xa8, 0xef, 0xd9, 0x01, 0xfb, 0x89, 0x84, 0x8a, 0xc6, 0x9c, 0x55,
-	0xd0, 0xb6, 0xa6, 0x34, 0xbd, 0x65, 0x14, 0xb9, 0xf7, 0x1e, 0xc5, 0xec, 0x09, 0xa3, 0xf8, 0x3b,
-	0x0f, 0xa5, 0x7a, 0x7b, 0xbb, 0x41, 0xfa, 0xc4, 0xc3, 0x4a, 0x5d, 0x77, 0xa1, 0x20, 0x89, 0x22,
-	0xa1, 0xfd, 0x4e, 0xca, 0x06, 0x1d, 0x2c, 0x8d, 0xa9, 0xd1, 0x65, 0xce, 0x50, 0x49, 0xd9, 0x0f,
-	0x54, 0xd2, 0xf7, 0x70, 0xfe, 0x20, 0xb0, 0x75, 0x43, 0x76, 0x9f, 0x72, 0x39, 0xb6, 0xec, 0x29,
-	0xba, 0x2a, 0x1c, 0x04, 0x75, 0xd9, 0xd7, 0x43, 0xca, 0x15, 0x7d, 0x5c, 0xe0, 0x50, 0x4c, 0xcf,
-	0xb7, 0xa0, 0x6c, 0x11, 0x11, 0xff, 0x03, 0x20, 0xbe, 0x3b, 0xad, 0xde, 0x79, 0xe2, 0xbb, 0x91,
-	0x7b, 0x19, 0xe6, 0x05, 0x13, 0xb8, 0x6f, 0x73, 0x3c, 0x51, 0xea, 0x39, 0x65, 0x68, 0x61, 0x95,
-	0x1b, 0xdd, 0xd1, 0x16, 0x63, 0xf3, 0x9c, 0x9c, 0xa6, 0x35, 0x1f, 0x59, 0xda, 0x63, 0xc5, 0x71,
-	0xe4, 0x66, 0x43, 0x11, 0x0c, 0x85, 0x4d, 0xdd, 0xb1, 0x39, 0xbf, 0x66, 0xac, 0x97, 0xac, 0x72,
-	0xe4, 0x79, 0xac, 0x1c, 0xbb, 0xee, 0x18, 0x6d, 0x42, 0x41, 0xf1, 0x1e, 0x55, 0x03, 0xc5, 0xcd,
-	0x85, 0xa3, 0xe3, 0x55, 0xc9, 0x7c, 0x2b, 0xf2, 0xb4, 0xc7, 0x16, 0xf0, 0xf8, 0x37, 0xfa, 0x01,
-	0x4a, 0xae, 0xd6, 0x04, 0x0b, 0x6d, 0x4e, 0x3d, 0xb3, 0xa0, 0xb2, 0xee, 0x1e, 0x1d, 0xaf, 0x7e,
-	0xf6, 0x3e, 0xb3, 0x6b, 0x51, 0xcf, 0xc7, 0x62, 0x18, 0x12, 0xab, 0x18, 0xd7, 0x6b, 0x51, 0x0f,
-	0xed, 0x43, 0xc9, 0x61, 0x23, 0xe2, 0x63, 0x5f, 0xc8, 0xf2, 0xdc, 0x2c, 0xae, 0x65, 0xd7, 0x0b,
-	0x9b, 0x37, 0x4f, 0x60, 0x79, 0x3b, 0x8a, 0xdd, 0x72, 0x71, 0xa0, 0x2b, 0xe8, 0xaa, 0xdc, 0x2a,
-	0x4e, 0xca, 0xb4, 0xa8, 0xc7, 0xd1, 0xc7, 0x70, 0x7e, 0xe8, 0x77, 0x98, 0xef, 0xaa, 0xbb, 0xd2,
-	0x01, 0x31, 0x4b, 0x6a, 0x28, 0xa5, 0xd8, 0xda, 0xa6, 0x03, 0x82, 0xbe, 0x86, 0xb2, 0xd4, 0xc5,
-	0xd0, 0x77, 0x63, 0xdd, 0x9b, 0xe7, 0x95, 0xcc, 0xae, 0x9f, 0xd0, 0x40, 0xbd, 0xbd, 0xbd, 0x9f,
-	0x8a, 0xb6, 0x16, 0x3a, 0xc2, 0x49, 0x1b, 0x24, 0x72, 0x80, 0x43, 0x3c, 0xe0, 0xf6, 0x88, 0x84,
-	0x6a, 0x31, 0x2f, 0x68, 0x64, 0x6d, 0x7d, 0xa2, 0x8d, 0x95, 0xdf, 0x72, 0xb0, 0xf0, 0x5a, 0x2d,
-	0xa9, 0xa5, 0x54, 0xd3, 0x63, 0xbd, 0x77, 0xac, 0x42, 0xd2, 0xf2, 0xbf, 0x28, 0xcc, 0xbc, 0x0b,
-	0x85, 0x3f, 0xc2, 0xe5, 0x84, 0xc2, 0x04, 0x40, 0x92, 0x99, 0x3d, 0x2d, 0x99, 0x97, 0xe2, 0xca,
-	0xfb, 0x93, 0xc2, 0x92, 0x55, 0x06, 0x4b, 0x29, 0xd5, 0x4c, 0x1a, 0x96, 0x88, 0xb9, 0xd3, 0x22,
-	0x2e, 0x26, 0xf2, 0x89, 0xea, 0x4a, 0xc0, 0x03, 0x58, 0x4a, 0x64, 0x94, 0xc2, 0xe3, 0xe6, 0xec,
-	0x07, 0xea, 0x69, 0x31, 0xd6, 0x53, 0x02, 0xc3, 0x91, 0x03, 0xcb, 0x31, 0xce, 0xd4, 0x28, 0xf5,
-	0x62, 0xc9, 0x2b, 0xb0, 0x6b, 0x27, 0x80, 0xc5, 0xd5, 0x77, 0xfd, 0x03, 0x66, 0x99, 0x93, 0x42,
-	0xe9, 0xc9, 0xc9, 0x9d, 0x52, 0x69, 0xc1, 0xe5, 0x64, 0x15, 0xb3, 0x30, 0xd9, 0xc9, 0x1c, 0x7d,
-	0x01, 0x39, 0x97, 0xf4, 0xb9, 0x69, 0xbc, 0x15, 0x68, 0x6a, 0x91, 0x5b, 0x2a, 0xa3, 0xb2, 0x07,
-	0xcb, 0x6f, 0x2e, 0xba, 0xeb, 0xbb, 0x64, 0x8c, 0x6a, 0xb0, 0x98, 0x2c, 0x1a, 0xbb, 0x8b, 0x79,
-	0x57, 0xdf, 0x48, 0x02, 0x15, 0xad, 0x0b, 0xf1, 0xca, 0xb9, 0x8f, 0x79, 0x57, 0x35, 0xf9, 0xbb,
-	0x01, 0xa5, 0xa9, 0x0b, 0xa1, 0x7b, 0x90, 0x39, 0xf5, 0x63, 0x99, 0x09, 0x7a, 0xe8, 0x01, 0x64,
-	0xa5, 0x52, 0x32, 0xa7, 0x55, 0x8a, 0xac, 0x52, 0xf9, 0xc5, 0x80, 0x2b, 0x27, 0x92, 0x2c, 0x1f,
-	0x2a, 0x87, 0x8d, 0xce, 0xe0, 0x8d, 0x77, 0xd8, 0xa8, 0xd9, 0x93, 0x7f, 0x60, 0xac, 0x31, 0xb4,
-	0xf6, 0x32, 0x6a, 0x78, 0x05, 0x1c, 0xe3, 0xf2, 0xca, 0x1f, 0x06, 0x5c, 0x69, 0x91, 0x3e, 0x71,
-	0x04, 0x1d, 0x91, 0x89, 0xb4, 0x76, 0xe4, 0x97, 0x87, 0xef, 0x10, 0x74, 0x1d, 0x16, 0x5e, 0x63,
-	0x41, 0xbf, 0xbb, 0x56, 0x69, 0x8a, 0x00, 0x64, 0xc1, 0x7c, 0xfc, 0xa4, 0x9d, 0xf2, 0x8d, 0x9d,
-	0x8b, 0x5e, 0x33, 0xb4, 0x01, 0x17, 0x43, 0x22, 0x35, 0x19, 0x12, 0xd7, 0x8e, 0xaa, 0xf3, 0x9e,
-	0x5e, 0x11, 0x56, 0x39, 0x76, 0xdd, 0x93, 0xe1, 0xad, 0xde, 0x27, 0x3b, 0x70, 0x71, 0x4a, 0x66,
-	0x2d, 0x81, 0xc5, 0x90, 0xa3, 0x02, 0xcc, 0x35, 0x77, 0xf6, 0x1a, 0xbb, 0x7b, 0x5f, 0x95, 0x67,
-	0x10, 0x40, 0x7e, 0x6b, 0xbb, 0xbd, 0xfb, 0x64, 0xa7, 0x6c, 0xa0, 0x22, 0x9c, 0xdb, 0xdf, 0xab,
-	0x3f, 0xde, 0x6b, 0xec, 0x34, 0xca, 0x19, 0x34, 0x07, 0xd9, 0xad, 0xbd, 0x6f, 0xcb, 0xd9, 0xfa,
-	0xc3, 0x67, 0x2f, 0x57, 0x8c, 0xe7, 0x2f, 0x57, 0x8c, 0xbf, 0x5e, 0xae, 0x18, 0xbf, 0xbe, 0x5a,
-	0x99, 0x79, 0xfe, 0x6a, 0x65, 0xe6, 0xcf, 0x57, 0x2b, 0x33, 0xdf, 0xfd, 0xe7, 0x65, 0xc6, 0xe9,
-	0x4f, 0x74, 0x75, 0xb3, 0x4e, 0x5e, 0x7d, 0xa2, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xc9,
-	0xf5, 0x0c, 0xdf, 0x5b, 0x0c, 0x00, 0x00,
+	// 1642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x49, 0x6f, 0x1b, 0xc9,
+	0x15, 0x56, 0x93, 0x14, 0x25, 0x3d, 0x92, 0x12, 0x55, 0xd2, 0xc8, 0x2d, 0x0b, 0x91, 0x14, 0xce,
+	0xc4, 0x50, 0x26, 0x23, 0x72, 0xac, 0x71, 0x82, 0x4c, 0x80, 0x20, 0x10, 0x17, 0xc7, 0x84, 0x65,
+	0x99, 0x69, 0x52, 0xce, 0x32, 0x49, 0x1a, 0xc5, 0xee, 0x12, 0x59, 0x43, 0xb2, 0xbb, 0xd3, 0x55,
+	0x64, 0xa8, 0xdf, 0x10, 0x04, 0xc8, 0x2d, 0xd7, 0x1c, 0x72, 0xcc, 0xd1, 0xbf, 0x21, 0xc8, 0x71,
+	0x30, 0xa7, 0xc0, 0x07, 0x21, 0xb0, 0xff, 0x48, 0x50, 0xd5, 0xd5, 0x0b, 0x45, 0xc9, 0x63, 0x4b,
+	0xbe, 0xb1, 0xde, 0xf6, 0xbd, 0x7e, 0xcb, 0x57, 0x05, 0xc2, 0x83, 0x2e, 0xee, 0x5e, 0x0c, 0x5d,
+	0xa7, 0xd2, 0xe5, 0x16, 0xe3, 0x78, 0x40, 0x9d, 0x5e, 0x65, 0xf2, 0x30, 0x71, 0x2a, 0x7b, 0xbe,
+	0xcb, 0x5d, 0xf4, 0x91, 0xb2, 0x2b, 0x27, 0x34, 0x93, 0x87, 0xf7, 0x37, 0x7b, 0x6e, 0xcf, 0x95,
+	0x16, 0x15, 0xf1, 0x2b, 0x30, 0xbe, 0xbf, 0x6d, 0xb9, 0x6c, 0xe4, 0x32, 0x33, 0x50, 0x04, 0x07,
+	0xa5, 0xfa, 0x24, 0x38, 0x55, 0x62, 0xac, 0x2e, 0xe1, 0xf8, 0x61, 0x65, 0x06, 0xed, 0xfe, 0xde,
+	0xf5, 0x59, 0x79, 0xae, 0x17, 0x18, 0x94, 0xfe, 0xbe, 0x0c, 0xc5, 0xc7, 0xd4, 0xc1, 0x43, 0xca,
+	0x2f, 0x5a, 0xbe, 0x3b, 0xa1, 0x36, 0xf1, 0xd1, 0x67, 0x90, 0xc1, 0xb6, 0xed, 0xeb, 0xda, 0xbe,
+	0x76, 0xb0, 0x52, 0xd5, 0xbf, 0x7d, 0x79, 0xb8, 0xa9, 0xb0, 0x8f, 0x6d, 0xdb, 0x27, 0x8c, 0xb5,
+	0xb9, 0x4f, 0x9d, 0x9e, 0x21, 0xad, 0x50, 0x03, 0x72, 0x36, 0x61, 0x96, 0x4f, 0x3d, 0x4e, 0x5d,
+	0x47, 0x4f, 0xed, 0x6b, 0x07, 0xb9, 0xa3, 0x8f, 0xcb, 0xca, 0x23, 0xfe, 0x46, 0x99, 0x5f, 0xb9,
+	0x1e, 0x9b, 0x1a, 0x49, 0x3f, 0xf4, 0x0c, 0xc0, 0x72, 0x47, 0x23, 0xca, 0x98, 0x88, 0x92, 0x96,
+	0xd0, 0x87, 0xaf, 0x2e, 0xf7, 0x76, 0x82, 0x40, 0xcc, 0x1e, 0x94, 0xa9, 0x5b, 0x19, 0x61, 0xde,
+	0x2f, 0x9f, 0x90, 0x1e, 0xb6, 0x2e, 0xea, 0xc4, 0xfa, 0xf6, 0xe5, 0x21, 0x28, 0x9c, 0x3a, 0xb1,
+	0x8c, 0x44, 0x00, 0xf4, 0x0c, 0xb2, 0x5d, 0x6e, 0x99, 0xde, 0x40, 0xcf, 0xec, 0x6b, 0x07, 0xf9,
+	0xea, 0x4f, 0x5e, 0x5d, 0xee, 0x1d, 0xf5, 0x28, 0xef, 0x8f, 0xbb, 0x65, 0xcb, 0x1d, 0x55, 0x54,
+	0x61, 0xac, 0x3e, 0xa6, 0x4e, 0x78, 0xa8, 0xf0, 0x0b, 0x8f, 0xb0, 0x72, 0xb5, 0xd9, 0xfa, 0xe2,
+	0xd1, 0xe7, 0xad, 0x71, 0xf7, 0x29, 0xb9, 0x30, 0x16, 0xbb, 0xdc, 0x6a, 0x0d, 0xd0, 0xcf, 0x21,
+	0xed, 0xb9, 0x9e, 0xbe, 0x28, 0x3f, 0xee, 0x47, 0xe5, 0x6b, 0x9b, 0x58, 0x6e, 0xf9, 0xae, 0x7b,
+	0xfe, 0xfc, 0xbc, 0xe5, 0x32, 0x46, 0x64, 0x16, 0xd5, 0x4e, 0xcd, 0x10, 0x7e, 0xe8, 0x11, 0x6c,
+	0xb1, 0x21, 0x66, 0x7d, 0x62, 0x9b, 0xca, 0xd5, 0xec, 0x13, 0xda, 0xeb, 0x73, 0x3d, 0xbb, 0xaf,
+	0x1d, 0x64, 0x8c, 0x4d, 0xa5, 0xad, 0x06, 0xca, 0x27, 0x52, 0x87, 0x3e, 0x03, 0x14, 0x79, 0x71,
+	0x2b, 0xf4, 0x58, 0x92, 0x1e, 0xc5, 0xd0, 0x83, 0x5b, 0xca, 0x7a, 0x0f, 0x72, 0x96, 0xeb, 0xb0,
+	0xf1, 0x88, 0xf8, 0x26, 0xb5, 0xf5, 0x65, 0x51, 0x41, 0x03, 0x42, 0x51, 0xd3, 0x46, 0x5b, 0x90,
+	0xfd, 0x1a, 0xd3, 0x21, 0xb1, 0xf5, 0x95, 0x7d, 0xed, 0x60, 0xd9, 0x50, 0x27, 0xf4, 0x07, 0xd8,
+	0x18, 0xe1, 0xa9, 0x19, 0x17, 0xcf, 0xf4, 0x31, 0x27, 0x3a, 0xdc, 0xa6, 0x05, 0xeb, 0x23, 0x3c,
+	0xad, 0x45, 0x81, 0x0c, 0xcc, 0x09, 0xfa, 0x1a, 0xee, 0x5f, 0x09, 0x6f, 0xf5, 0xb1, 0xd3, 0x23,
+	0x01, 0x4a, 0xee, 0x36, 0x28, 0xf7, 0x66, 0x50, 0x6a, 0x32, 0x9c, 0xc4, 0xfa, 0x29, 0xe8, 0x09,
+	0x9c, 0xb1, 0x67, 0x63, 0x4e, 0xc2, 0xba, 0xe5, 0x65, 0xdd, 0xb6, 0x62, 0xfd, 0x99, 0x54, 0xab,
+	0xea, 0xfd, 0x1e, 0x90, 0x47, 0x1c, 0x9b, 0x3a, 0xbd, 0x44, 0xa6, 0x7a, 0xe1, 0x56, 0x35, 0x50,
+	0x81, 0xe2, 0x0c, 0xd1, 0xcf, 0x60, 0x7b, 0x3e, 0x7a, 0x98, 0xd8, 0xaa, 0x4c, 0xec, 0xde, 0x9c,
+	0x97, 0xca, 0xec, 0x11, 0x6c, 0xf9, 0x84, 0x53, 0x7f, 0x7e, 0x76, 0xd6, 0x82, 0xd9, 0x51, 0xda,
+	0xd9, 0xd9, 0xf9, 0x05, 0xac, 0x7a, 0x3e, 0x99, 0x50, 0x77, 0xcc, 0x4c, 0xb1, 0xa6, 0x4c, 0x2f,
+	0xee, 0xa7, 0xdf, 0xba, 0xcd, 0x85, 0xd0, 0x5e, 0x88, 0x59, 0xe9, 0x2f, 0x1a, 0x14, 0x6b, 0x6a,
+	0x78, 0x0c, 0xd2, 0xa3, 0x8c, 0x13, 0xff, 0xea, 0x8c, 0x69, 0x73, 0x33, 0xf6, 0x31, 0x14, 0x22,
+	0x03, 0x07, 0x8f, 0x88, 0xa4, 0x83, 0x15, 0x23, 0x1f, 0x0a, 0x4f, 0xf1, 0x88, 0xa0, 0x87, 0xb0,
+	0x19, 0x19, 0x25, 0xa9, 0x43, 0x2e, 0xbd, 0xb1, 0x11, 0xea, 0x12, 0x54, 0x51, 0xfa, 0x47, 0x0a,
+	0xf4, 0xab, 0x3c, 0xf5, 0x6b, 0xca, 0xfb, 0xcf, 0x08, 0xc7, 0x89, 0x5d, 0xd7, 0x3e, 0xc4, 0xae,
+	0x6f, 0x41, 0x56, 0x15, 0x38, 0x25, 0x0b, 0xac, 0x4e, 0xe8, 0xfb, 0x90, 0x9f, 0xb8, 0x5c, 0xf4,
+	0xd0, 0x73, 0xff, 0x4c, 0x7c, 0x99, 0x6e, 0xc6, 0xc8, 0x05, 0xb2, 0x96, 0x10, 0xbd, 0x65, 0xcf,
+	0x33, 0xef, 0xbd, 0xe7, 0x8b, 0x37, 0xec, 0x79, 0xbc, 0xc6, 0xd9, 0xe4, 0x1a, 0x97, 0x5e, 0x2e,
+	0x43, 0xa1, 0xda, 0xa9, 0xd5, 0xc9, 0x90, 0xf4, 0xb0, 0xa4, 0xd4, 0x2f, 0x21, 0x27, 0xd8, 0x89,
+	0xf8, 0xe6, 0x3b, 0xd1, 0x39, 0x04, 0xc6, 0x42, 0x98, 0x28, 0x69, 0xea, 0x03, 0xd2, 0x67, 0xfa,
+	0x96, 0xf4, 0xf9, 0x15, 0xac, 0x9e, 0x7b, 0x66, 0x90, 0x90, 0x39, 0xa4, 0x4c, 0x94, 0x33, 0x7d,
+	0x87, 0xac, 0x72, 0xe7, 0x5e, 0x55, 0xe4, 0x75, 0x42, 0x99, 0x6c, 0x2b, 0xe3, 0xd8, 0xe7, 0xb3,
+	0x75, 0xcf, 0x49, 0x99, 0x2a, 0xf9, 0xf7, 0x00, 0x88, 0x63, 0xcf, 0x52, 0xf6, 0x0a, 0x71, 0x6c,
+	0xa5, 0xde, 0x81, 0x15, 0xee, 0x72, 0x3c, 0x34, 0x19, 0x0e, 0xe9, 0x79, 0x59, 0x0a, 0xda, 0x58,
+	0xfa, 0xaa, 0x6f, 0x34, 0xf9, 0x54, 0xb2, 0x72, 0xde, 0x58, 0x51, 0x92, 0xce, 0x54, 0xf6, 0x5e,
+	0xa9, 0xdd, 0x31, 0xf7, 0xc6, 0xdc, 0xa4, 0xf6, 0x54, 0x12, 0x74, 0xc1, 0x28, 0x2a, 0xcd, 0x73,
+	0xa9, 0x68, 0xda, 0x53, 0x74, 0x04, 0x39, 0x39, 0x0f, 0x2a, 0x1a, 0xc8, 0xde, 0xac, 0xbf, 0xba,
+	0xdc, 0x13, 0x9d, 0x6f, 0x2b, 0x4d, 0x67, 0x6a, 0x00, 0x8b, 0x7e, 0xa3, 0x3f, 0x42, 0xc1, 0x0e,
+	0x66, 0xc2, 0xf5, 0x4d, 0x46, 0x7b, 0x92, 0x72, 0xf3, 0xd5, 0x2f, 0x5f, 0x5d, 0xee, 0xfd, 0xf8,
+	0x7d, 0x6a, 0xd7, 0xa6, 0x3d, 0x07, 0xf3, 0xb1, 0x4f, 0x8c, 0x7c, 0x14, 0xaf, 0x4d, 0x7b, 0xe8,
+	0x4c, 0xac, 0xfc, 0x84, 0x38, 0xd8, 0xe1, 0x22, 0x3c, 0xd3, 0xf3, 0xfb, 0xe9, 0x83, 0xdc, 0xd1,
+	0xe7, 0x37, 0x74, 0xb9, 0xa6, 0x6c, 0x8f, 0x6d, 0xec, 0x05, 0x11, 0x82, 0xa8, 0x4c, 0x90, 0x44,
+	0xa0, 0x6a, 0xd3, 0x1e, 0x43, 0x3f, 0x80, 0xd5, 0xb1, 0xd3, 0x75, 0x03, 0xd2, 0xe4, 0x74, 0x44,
+	0x24, 0x19, 0x17, 0x8c, 0x42, 0x24, 0xed, 0xd0, 0x11, 0x41, 0xbf, 0x82, 0xa2, 0x98, 0x8b, 0xb1,
+	0x63, 0x47, 0x73, 0x2f, 0x09, 0x35, 0x77, 0xf4, 0xe0, 0x86, 0x04, 0xaa, 0x9d, 0xda, 0x59, 0xc2,
+	0xda, 0x58, 0xeb, 0x72, 0x2b, 0x29, 0x10, 0xc8, 0x1e, 0xf6, 0xf1, 0x88, 0x99, 0x13, 0xe2, 0xcb,
+	0x6b, 0x60, 0x2d, 0x40, 0x0e, 0xa4, 0x2f, 0x02, 0x21, 0xfa, 0x0a, 0x36, 0xaf, 0x74, 0x8e, 0x09,
+	0x0a, 0xd7, 0x8b, 0x12, 0xfd, 0x87, 0x37, 0xa0, 0xb7, 0x93, 0x2d, 0x6d, 0x0b, 0x07, 0x03, 0xb1,
+	0x39, 0x19, 0x2a, 0xc3, 0x46, 0x34, 0x35, 0x74, 0x44, 0xcc, 0xee, 0xd0, 0xb5, 0x06, 0x4c, 0x5f,
+	0x97, 0x89, 0xac, 0x87, 0xe3, 0x43, 0x47, 0xa4, 0x2a, 0x15, 0xe2, 0xe2, 0xa3, 0x8e, 0x35, 0x1c,
+	0xcb, 0x7b, 0xc5, 0x13, 0x8b, 0x64, 0xda, 0x04, 0xdb, 0x43, 0xea, 0x10, 0x1d, 0x05, 0x17, 0x5f,
+	0xa4, 0x97, 0x7b, 0x56, 0x57, 0xda, 0xd2, 0xbf, 0x34, 0x40, 0xf3, 0x49, 0xa1, 0x6d, 0x58, 0x96,
+	0x9f, 0x23, 0xc6, 0x4c, 0xb2, 0xaa, 0xb1, 0x24, 0xcf, 0x9d, 0x29, 0x3a, 0x84, 0x8d, 0x50, 0x95,
+	0xe4, 0xab, 0x94, 0xe2, 0xab, 0xc0, 0x2a, 0xe6, 0xab, 0x13, 0x80, 0xc0, 0xdc, 0xc3, 0xbc, 0x2f,
+	0x29, 0x60, 0xf5, 0xe8, 0xf0, 0x9d, 0xab, 0xd3, 0xc2, 0xbc, 0x6f, 0xac, 0xb0, 0xf0, 0x67, 0xe9,
+	0x32, 0x03, 0x6b, 0x57, 0x3a, 0x28, 0x36, 0x38, 0x31, 0x2a, 0x61, 0xbe, 0xb9, 0x78, 0x50, 0xe6,
+	0x16, 0x27, 0xf5, 0x2e, 0x8b, 0xf3, 0x27, 0xb8, 0x17, 0x2f, 0x4e, 0x0c, 0x20, 0x56, 0x28, 0x7d,
+	0xd7, 0x15, 0xfa, 0x28, 0x8a, 0x7c, 0x16, 0x06, 0x16, 0xbb, 0xe4, 0xc2, 0x56, 0x0c, 0x19, 0x25,
+	0x2c, 0x10, 0x33, 0x77, 0x45, 0xdc, 0x8c, 0x97, 0x56, 0xc5, 0x15, 0x80, 0xe7, 0xb0, 0x15, 0x2f,
+	0x6f, 0x02, 0x8f, 0xe9, 0x8b, 0xb7, 0xdc, 0xe2, 0xcd, 0x68, 0x8b, 0x63, 0x18, 0x86, 0x2c, 0xd8,
+	0x89, 0x70, 0x66, 0x4a, 0x19, 0xd0, 0x79, 0x56, 0x82, 0x7d, 0x72, 0xd3, 0x54, 0x84, 0xd1, 0x9b,
+	0xce, 0xb9, 0x6b, 0xe8, 0x61, 0xa0, 0x64, 0xe5, 0x24, 0x93, 0xd7, 0x60, 0xf7, 0xba, 0x86, 0xcd,
+	0xbd, 0x9d, 0x77, 0xe6, 0x8b, 0x1f, 0x8d, 0x6b, 0xa9, 0x0d, 0xf7, 0xe2, 0x5b, 0xd4, 0xf5, 0xe3,
+	0xeb, 0x54, 0x2c, 0x59, 0xc6, 0x26, 0x43, 0xa6, 0x6b, 0x6f, 0xcd, 0x76, 0xe6, 0x0e, 0x36, 0xa4,
+	0x47, 0xe9, 0x14, 0x76, 0xae, 0x0f, 0xda, 0x74, 0x6c, 0x32, 0x45, 0x95, 0x98, 0x4a, 0xf8, 0xd4,
+	0xec, 0x63, 0xd6, 0x0f, 0xca, 0x22, 0x80, 0xf2, 0xf1, 0xba, 0x4f, 0x9f, 0x60, 0xd6, 0x17, 0x5f,
+	0x5a, 0xfa, 0xa7, 0x06, 0x85, 0x99, 0xaa, 0xa0, 0xc7, 0x90, 0xba, 0xf3, 0xfb, 0x27, 0xe5, 0x0d,
+	0xd0, 0x53, 0x48, 0x8b, 0x71, 0x4b, 0xdd, 0x75, 0xdc, 0x44, 0x94, 0xd2, 0x5f, 0x35, 0xd8, 0xbe,
+	0x71, 0x52, 0xc4, 0x1b, 0xc3, 0x72, 0x27, 0x1f, 0xe0, 0xd9, 0x66, 0xb9, 0x93, 0xd6, 0x40, 0xb0,
+	0x00, 0x0e, 0x30, 0x82, 0x01, 0x4e, 0xc9, 0xe2, 0xe5, 0x70, 0x84, 0xcb, 0x4a, 0xff, 0xd6, 0x60,
+	0xbb, 0x4d, 0x86, 0xc4, 0xe2, 0x74, 0x42, 0xc2, 0xf9, 0x6c, 0x88, 0xc7, 0xa4, 0x63, 0x11, 0xf4,
+	0x00, 0xd6, 0xae, 0x74, 0x41, 0x3d, 0x70, 0x0b, 0x33, 0x0d, 0x40, 0x06, 0xac, 0x44, 0xaf, 0x91,
+	0x3b, 0x3e, 0x8f, 0x96, 0xd4, 0x43, 0x44, 0x70, 0xaa, 0x4f, 0xc4, 0x60, 0x8b, 0x67, 0xbe, 0x8a,
+	0xce, 0x06, 0x01, 0xcf, 0x18, 0xc5, 0x48, 0xf5, 0x58, 0x98, 0xb7, 0x07, 0x9f, 0x1a, 0xb0, 0x75,
+	0x3d, 0x55, 0xa2, 0x75, 0x28, 0x74, 0x9a, 0xcf, 0x1a, 0x27, 0xcf, 0x6b, 0x4f, 0xcd, 0xd6, 0x71,
+	0xe7, 0x49, 0x71, 0x01, 0x21, 0x58, 0x3d, 0x3b, 0xad, 0x3e, 0x3f, 0xad, 0x37, 0x4f, 0x7f, 0x19,
+	0xc8, 0x34, 0x61, 0xd6, 0x3e, 0x39, 0x6e, 0x3f, 0x89, 0x44, 0xa9, 0x4f, 0x47, 0xb0, 0x31, 0x33,
+	0xba, 0x6d, 0x8e, 0xf9, 0x98, 0xa1, 0x1c, 0x2c, 0xb5, 0x1a, 0xd2, 0xb7, 0xb8, 0x80, 0x00, 0xb2,
+	0xc7, 0xb5, 0x4e, 0xf3, 0x45, 0xa3, 0xa8, 0xa1, 0x3c, 0x2c, 0x07, 0x61, 0x1b, 0xf5, 0x62, 0x0a,
+	0x2d, 0x41, 0xfa, 0xf8, 0xf4, 0xb7, 0xc5, 0xb4, 0xb0, 0x6f, 0xfc, 0xa6, 0xd5, 0x34, 0x1a, 0xf5,
+	0x62, 0x46, 0x1c, 0x24, 0x4c, 0xa3, 0x5e, 0x5c, 0x14, 0x0e, 0x2f, 0x1a, 0x46, 0xf3, 0x71, 0xb3,
+	0x51, 0x2f, 0x66, 0xab, 0x27, 0xff, 0x79, 0xbd, 0xab, 0x7d, 0xf3, 0x7a, 0x57, 0xfb, 0xdf, 0xeb,
+	0x5d, 0xed, 0x6f, 0x6f, 0x76, 0x17, 0xbe, 0x79, 0xb3, 0xbb, 0xf0, 0xdf, 0x37, 0xbb, 0x0b, 0xbf,
+	0xfb, 0xce, 0x42, 0x4e, 0x93, 0x7f, 0x67, 0xc8, 0xaa, 0x76, 0xb3, 0xf2, 0xef, 0x8c, 0x2f, 0xfe,
+	0x3f, 0x00, 0x2b, 0xba, 0x4e, 0x2d, 0x87, 0x11, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -842,6 +1187,85 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PreviousAddrs) > 0 {
+		for iNdEx := len(m.PreviousAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousAddrs[iNdEx])
+			copy(dAtA[i:], m.PreviousAddrs[iNdEx])
+			i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.PreviousAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RetiredBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RetiredBabylonHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.PendingCommissionHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.PendingCommissionHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PendingCommission != nil {
+		{
+			size := m.PendingCommission.Size()
+			i -= size
+			if _, err := m.PendingCommission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CommissionUpdateHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.CommissionUpdateHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxCommissionChangeRate != nil {
+		{
+			size := m.MaxCommissionChangeRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxCommissionRate != nil {
+		{
+			size := m.MaxCommissionRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.SlashedBtcHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
 		i--
@@ -910,6 +1334,50 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerRegister) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRegister) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRegister) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerDescription) > 0 {
+		i -= len(m.ConsumerDescription)
+		copy(dAtA[i:], m.ConsumerDescription)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerDescription)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerName) > 0 {
+		i -= len(m.ConsumerName)
+		copy(dAtA[i:], m.ConsumerName)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FinalityProviderWithMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -930,6 +1398,16 @@ func (m *FinalityProviderWithMeta) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SlashedBtcHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
 		i--
@@ -985,6 +1463,34 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InclusionProofDeadline != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.InclusionProofDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.StakingTimeBlocks != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.StakingTimeBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.StakingOutputSpend != nil {
+		{
+			size, err := m.StakingOutputSpend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -1120,7 +1626,7 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCUndelegation) Marshal() (dAtA []byte, err error) {
+func (m *StakingOutputSpend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1130,19 +1636,64 @@ func (m *BTCUndelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCUndelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *StakingOutputSpend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StakingOutputSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	if m.SpendPath != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SpendPath))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpendTxBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SpendTxBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SpendTx) > 0 {
+		i -= len(m.SpendTx)
+		copy(dAtA[i:], m.SpendTx)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.SpendTx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.DelegatorUnbondingBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -1466,6 +2017,61 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.SlashedBtcHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
 	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.MaxCommissionChangeRate != nil {
+		l = m.MaxCommissionChangeRate.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.CommissionUpdateHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.CommissionUpdateHeight))
+	}
+	if m.PendingCommission != nil {
+		l = m.PendingCommission.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.PendingCommissionHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.PendingCommissionHeight))
+	}
+	if m.RetiredBabylonHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.RetiredBabylonHeight))
+	}
+	if len(m.PreviousAddrs) > 0 {
+		for _, s := range m.PreviousAddrs {
+			l = len(s)
+			n += 2 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerRegister) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.ConsumerName)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.ConsumerDescription)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -1491,6 +2097,9 @@ func (m *FinalityProviderWithMeta) Size() (n int) {
 	if m.SlashedBtcHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.SlashedBtcHeight))
 	}
+	if m.Jailed {
+		n += 2
+	}
 	return n
 }
 
@@ -1558,6 +2167,35 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	if m.StakingOutputSpend != nil {
+		l = m.StakingOutputSpend.Size()
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if m.StakingTimeBlocks != 0 {
+		n += 2 + sovBtcstaking(uint64(m.StakingTimeBlocks))
+	}
+	if m.InclusionProofDeadline != 0 {
+		n += 2 + sovBtcstaking(uint64(m.InclusionProofDeadline))
+	}
+	return n
+}
+
+func (m *StakingOutputSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpendTx)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.SpendTxBtcHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SpendTxBtcHeight))
+	}
+	if m.SpendPath != 0 {
+		n += 1 + sovBtcstaking(uint64(m.SpendPath))
+	}
 	return n
 }
 
@@ -1595,6 +2233,9 @@ func (m *BTCUndelegation) Size() (n int) {
 			n += 1 + l + sovBtcstaking(uint64(l))
 		}
 	}
+	if m.DelegatorUnbondingBtcHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.DelegatorUnbondingBtcHeight))
+	}
 	return n
 }
 
@@ -1859,11 +2500,416 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionChangeRate = &v
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateHeight", wireType)
+			}
+			m.CommissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PendingCommission = &v
+			if err := m.PendingCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionHeight", wireType)
+			}
+			m.PendingCommissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCommissionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBabylonHeight", wireType)
+			}
+			m.RetiredBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddrs = append(m.PreviousAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRegister) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRegister: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRegister: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1873,33 +2919,29 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBtcstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDescription", wireType)
 			}
-			m.SlashedBabylonHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtcstaking
@@ -1909,30 +2951,24 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
 			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBtcstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ConsumerDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2094,6 +3130,26 @@ func (m *FinalityProviderWithMeta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2570,6 +3626,202 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingOutputSpend == nil {
+				m.StakingOutputSpend = &StakingOutputSpend{}
+			}
+			if err := m.StakingOutputSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTimeBlocks", wireType)
+			}
+			m.StakingTimeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTimeBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionProofDeadline", wireType)
+			}
+			m.InclusionProofDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionProofDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingOutputSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingOutputSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingOutputSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendTx = append(m.SpendTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SpendTx == nil {
+				m.SpendTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendTxBtcHeight", wireType)
+			}
+			m.SpendTxBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendTxBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendPath", wireType)
+			}
+			m.SpendPath = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendPath |= StakingOutputSpendPath(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -2827,6 +4079,25 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingBtcHeight", wireType)
+			}
+			m.DelegatorUnbondingBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatorUnbondingBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -14,6 +14,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddCovenantSigs{}, "btcstaking/MsgAddCovenantSigs", nil)
 	cdc.RegisterConcrete(&MsgBTCUndelegate{}, "btcstaking/MsgBTCUndelegate", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterConsumer{}, "btcstaking/MsgRegisterConsumer", nil)
+	cdc.RegisterConcrete(&MsgReportStakingOutputSpend{}, "btcstaking/MsgReportStakingOutputSpend", nil)
+	cdc.RegisterConcrete(&MsgAddBTCDelegationInclusionProof{}, "btcstaking/MsgAddBTCDelegationInclusionProof", nil)
+	cdc.RegisterConcrete(&MsgRetireFinalityProvider{}, "btcstaking/MsgRetireFinalityProvider", nil)
+	cdc.RegisterConcrete(&MsgRotateFinalityProviderAddr{}, "btcstaking/MsgRotateFinalityProviderAddr", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +31,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddCovenantSigs{},
 		&MsgBTCUndelegate{},
 		&MsgUpdateParams{},
+		&MsgRegisterConsumer{},
+		&MsgReportStakingOutputSpend{},
+		&MsgAddBTCDelegationInclusionProof{},
+		&MsgRetireFinalityProvider{},
+		&MsgRotateFinalityProviderAddr{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
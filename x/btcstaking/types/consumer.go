@@ -0,0 +1,36 @@
+package types
+
+// MaxConsumerIDLength is the maximum length of a consumer chain ID, such that
+// the consumer chain ID can be length-prefixed in store keys
+const MaxConsumerIDLength = 255
+
+// ValidateBasic performs stateless validation of a consumer chain register
+func (cr *ConsumerRegister) ValidateBasic() error {
+	if len(cr.ConsumerId) == 0 {
+		return ErrInvalidConsumerRegister.Wrap("empty consumer ID")
+	}
+	if len(cr.ConsumerId) > MaxConsumerIDLength {
+		return ErrInvalidConsumerRegister.Wrapf("consumer ID is longer than %d", MaxConsumerIDLength)
+	}
+	if len(cr.ConsumerName) == 0 {
+		return ErrInvalidConsumerRegister.Wrap("empty consumer name")
+	}
+	return nil
+}
+
+// ValidateConsumerChains ensures the given finality providers secure distinct
+// chains, i.e., there is at most one finality provider securing Babylon and at
+// most one finality provider securing each consumer chain
+func ValidateConsumerChains(fps []*FinalityProvider) error {
+	chains := make(map[string]struct{}, len(fps))
+	for _, fp := range fps {
+		if _, ok := chains[fp.ConsumerId]; ok {
+			if fp.SecuresBabylon() {
+				return ErrInvalidFpChains.Wrap("more than one finality provider securing Babylon")
+			}
+			return ErrInvalidFpChains.Wrapf("more than one finality provider securing consumer chain %s", fp.ConsumerId)
+		}
+		chains[fp.ConsumerId] = struct{}{}
+	}
+	return nil
+}
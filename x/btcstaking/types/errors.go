@@ -31,4 +31,18 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrConsumerNotRegistered        = errorsmod.Register(ModuleName, 1125, "the consumer chain is not registered")
+	ErrConsumerAlreadyRegistered    = errorsmod.Register(ModuleName, 1126, "the consumer chain has already been registered")
+	ErrInvalidConsumerRegister      = errorsmod.Register(ModuleName, 1127, "the consumer chain register is not valid")
+	ErrInvalidFpChains              = errorsmod.Register(ModuleName, 1128, "the staking request restakes to multiple finality providers of the same chain")
+	ErrFpAlreadyJailed              = errorsmod.Register(ModuleName, 1129, "the finality provider has been jailed")
+	ErrFpNotJailed                  = errorsmod.Register(ModuleName, 1130, "the finality provider is not jailed")
+	ErrInvalidStakingOutputSpend    = errorsmod.Register(ModuleName, 1131, "the staking output spend is not valid")
+	ErrInclusionProofExists         = errorsmod.Register(ModuleName, 1132, "the BTC delegation already has the inclusion proof of its staking tx")
+	ErrPreStakingDisabled           = errorsmod.Register(ModuleName, 1133, "pre-staking BTC delegation registration is disabled")
+	ErrCommissionGTFpMaxRate        = errorsmod.Register(ModuleName, 1134, "commission cannot be more than the finality provider's max rate")
+	ErrCommissionChangeGTMaxRate    = errorsmod.Register(ModuleName, 1135, "commission change cannot be more than the finality provider's max change rate")
+	ErrCommissionUpdateTooSoon      = errorsmod.Register(ModuleName, 1136, "commission cannot be changed more than once within the minimum interval")
+	ErrFpRetired                    = errorsmod.Register(ModuleName, 1137, "the finality provider is retired")
+	ErrFpAddrUsed                   = errorsmod.Register(ModuleName, 1138, "the address is or was bound to the finality provider")
 )
@@ -21,3 +21,44 @@ func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPower
 		},
 	}
 }
+
+func NewEventPowerDistUpdateWithJailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_JailedFp{
+			JailedFp: &EventPowerDistUpdate_EventJailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithUnjailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_UnjailedFp{
+			UnjailedFp: &EventPowerDistUpdate_EventUnjailedFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithRotatedFPAddr(fpBTCPK *bbn.BIP340PubKey, newAddr string) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_RotatedFpAddr{
+			RotatedFpAddr: &EventPowerDistUpdate_EventRotatedFinalityProviderAddr{
+				Pk:      fpBTCPK,
+				NewAddr: newAddr,
+			},
+		},
+	}
+}
+
+func NewEventPowerDistUpdateWithRetiredFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_RetiredFp{
+			RetiredFp: &EventPowerDistUpdate_EventRetiredFinalityProvider{
+				Pk: fpBTCPK,
+			},
+		},
+	}
+}
@@ -28,6 +28,29 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	consumers := make(map[string]struct{}, len(gs.Consumers))
+	for _, consumer := range gs.Consumers {
+		if consumer == nil {
+			return fmt.Errorf("null consumer register")
+		}
+		if err := consumer.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := consumers[consumer.ConsumerId]; ok {
+			return fmt.Errorf("duplicate consumer ID: %s", consumer.ConsumerId)
+		}
+		consumers[consumer.ConsumerId] = struct{}{}
+	}
+
+	for _, fp := range gs.FinalityProviders {
+		if fp.SecuresBabylon() {
+			continue
+		}
+		if _, ok := consumers[fp.ConsumerId]; !ok {
+			return fmt.Errorf("finality provider %s secures unregistered consumer %s", fp.BtcPk.MarshalHex(), fp.ConsumerId)
+		}
+	}
 	return nil
 }
 
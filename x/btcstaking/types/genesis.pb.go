@@ -43,6 +43,14 @@ type GenesisState struct {
 	// vp_dst_cache is the table of all providers voting power with the total at one specific block.
 	// TODO: remove this after not storing in the keeper store it anymore.
 	VpDstCache []*VotingPowerDistCacheBlkHeight `protobuf:"bytes,8,rep,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+	// consumers are all the registered consumer chains.
+	Consumers []*ConsumerRegister `protobuf:"bytes,9,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// consumer_voting_powers the voting power of every consumer finality provider
+	// at every block height.
+	ConsumerVotingPowers []*ConsumerVotingPowerFP `protobuf:"bytes,10,rep,name=consumer_voting_powers,json=consumerVotingPowers,proto3" json:"consumer_voting_powers,omitempty"`
+	// consumer_vp_dst_cache is the voting power distribution cache of every
+	// consumer chain at every block height.
+	ConsumerVpDstCache []*ConsumerVotingPowerDistCacheBlkHeight `protobuf:"bytes,11,rep,name=consumer_vp_dst_cache,json=consumerVpDstCache,proto3" json:"consumer_vp_dst_cache,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +142,27 @@ func (m *GenesisState) GetVpDstCache() []*VotingPowerDistCacheBlkHeight {
 	return nil
 }
 
+func (m *GenesisState) GetConsumers() []*ConsumerRegister {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerVotingPowers() []*ConsumerVotingPowerFP {
+	if m != nil {
+		return m.ConsumerVotingPowers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerVpDstCache() []*ConsumerVotingPowerDistCacheBlkHeight {
+	if m != nil {
+		return m.ConsumerVpDstCache
+	}
+	return nil
+}
+
 // VotingPowerFP contains the information about the voting power
 // of an finality provider in a specific block height.
 type VotingPowerFP struct {
@@ -247,6 +276,118 @@ func (m *VotingPowerDistCacheBlkHeight) GetVpDistribution() *VotingPowerDistCach
 	return nil
 }
 
+// ConsumerVotingPowerFP contains the information about the voting power
+// of a consumer finality provider in a specific block height.
+type ConsumerVotingPowerFP struct {
+	// consumer_id is the ID of the consumer chain.
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// voting_power_fp is the voting power of the finality provider at the block height.
+	VotingPowerFp *VotingPowerFP `protobuf:"bytes,2,opt,name=voting_power_fp,json=votingPowerFp,proto3" json:"voting_power_fp,omitempty"`
+}
+
+func (m *ConsumerVotingPowerFP) Reset()         { *m = ConsumerVotingPowerFP{} }
+func (m *ConsumerVotingPowerFP) String() string { return proto.CompactTextString(m) }
+func (*ConsumerVotingPowerFP) ProtoMessage()    {}
+func (*ConsumerVotingPowerFP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{3}
+}
+func (m *ConsumerVotingPowerFP) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerVotingPowerFP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerVotingPowerFP.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerVotingPowerFP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerVotingPowerFP.Merge(m, src)
+}
+func (m *ConsumerVotingPowerFP) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerVotingPowerFP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerVotingPowerFP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerVotingPowerFP proto.InternalMessageInfo
+
+func (m *ConsumerVotingPowerFP) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerVotingPowerFP) GetVotingPowerFp() *VotingPowerFP {
+	if m != nil {
+		return m.VotingPowerFp
+	}
+	return nil
+}
+
+// ConsumerVotingPowerDistCacheBlkHeight the voting power distribution cache of
+// a consumer chain at one specific block height
+type ConsumerVotingPowerDistCacheBlkHeight struct {
+	// consumer_id is the ID of the consumer chain.
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// vp_dst_cache is the voting power distribution cache at the block height.
+	VpDstCache *VotingPowerDistCacheBlkHeight `protobuf:"bytes,2,opt,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) Reset()         { *m = ConsumerVotingPowerDistCacheBlkHeight{} }
+func (m *ConsumerVotingPowerDistCacheBlkHeight) String() string { return proto.CompactTextString(m) }
+func (*ConsumerVotingPowerDistCacheBlkHeight) ProtoMessage()    {}
+func (*ConsumerVotingPowerDistCacheBlkHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{4}
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerVotingPowerDistCacheBlkHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerVotingPowerDistCacheBlkHeight.Merge(m, src)
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerVotingPowerDistCacheBlkHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerVotingPowerDistCacheBlkHeight proto.InternalMessageInfo
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) GetVpDstCache() *VotingPowerDistCacheBlkHeight {
+	if m != nil {
+		return m.VpDstCache
+	}
+	return nil
+}
+
 // BlockHeightBbnToBtc stores the btc <-> bbn block.
 type BlockHeightBbnToBtc struct {
 	// block_height_bbn is the height of the block in the babylon chain.
@@ -259,7 +400,7 @@ func (m *BlockHeightBbnToBtc) Reset()         { *m = BlockHeightBbnToBtc{} }
 func (m *BlockHeightBbnToBtc) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightBbnToBtc) ProtoMessage()    {}
 func (*BlockHeightBbnToBtc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{3}
+	return fileDescriptor_85d7b95fa5620238, []int{5}
 }
 func (m *BlockHeightBbnToBtc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +457,7 @@ func (m *BTCDelegator) Reset()         { *m = BTCDelegator{} }
 func (m *BTCDelegator) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegator) ProtoMessage()    {}
 func (*BTCDelegator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{4}
+	return fileDescriptor_85d7b95fa5620238, []int{6}
 }
 func (m *BTCDelegator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +507,7 @@ func (m *EventIndex) Reset()         { *m = EventIndex{} }
 func (m *EventIndex) String() string { return proto.CompactTextString(m) }
 func (*EventIndex) ProtoMessage()    {}
 func (*EventIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{5}
+	return fileDescriptor_85d7b95fa5620238, []int{7}
 }
 func (m *EventIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,6 +561,8 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btcstaking.v1.GenesisState")
 	proto.RegisterType((*VotingPowerFP)(nil), "babylon.btcstaking.v1.VotingPowerFP")
 	proto.RegisterType((*VotingPowerDistCacheBlkHeight)(nil), "babylon.btcstaking.v1.VotingPowerDistCacheBlkHeight")
+	proto.RegisterType((*ConsumerVotingPowerFP)(nil), "babylon.btcstaking.v1.ConsumerVotingPowerFP")
+	proto.RegisterType((*ConsumerVotingPowerDistCacheBlkHeight)(nil), "babylon.btcstaking.v1.ConsumerVotingPowerDistCacheBlkHeight")
 	proto.RegisterType((*BlockHeightBbnToBtc)(nil), "babylon.btcstaking.v1.BlockHeightBbnToBtc")
 	proto.RegisterType((*BTCDelegator)(nil), "babylon.btcstaking.v1.BTCDelegator")
 	proto.RegisterType((*EventIndex)(nil), "babylon.btcstaking.v1.EventIndex")
@@ -430,51 +573,59 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0xd4, 0x4e,
-	0x18, 0xc6, 0x29, 0xbb, 0x2c, 0xfc, 0x67, 0x97, 0x05, 0x86, 0xbf, 0x49, 0x43, 0xc2, 0x0a, 0xc5,
-	0x8f, 0x8d, 0x26, 0x5d, 0x59, 0xd0, 0xc4, 0x43, 0xcb, 0x8a, 0xe2, 0x47, 0xd2, 0xd4, 0x95, 0x03,
-	0x4e, 0x9a, 0x4e, 0x3b, 0xdb, 0x9d, 0x6c, 0x99, 0x69, 0x3a, 0x43, 0x65, 0xaf, 0xc1, 0x13, 0x0f,
-	0xbd, 0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x07, 0xc6, 0xc0, 0x1d, 0x78, 0x01, 0xc6, 0x74, 0x5a,
-	0x68, 0xc1, 0x5d, 0xc0, 0x18, 0xcf, 0x3a, 0x93, 0xe7, 0xfd, 0xcd, 0xfb, 0xbc, 0xf3, 0x4c, 0x0a,
-	0xd6, 0x90, 0x83, 0x86, 0x01, 0xa3, 0x2d, 0x24, 0x5c, 0x2e, 0x9c, 0x01, 0xa1, 0x7e, 0x2b, 0x5e,
-	0x6f, 0xf9, 0x98, 0x62, 0x4e, 0xb8, 0x1e, 0x46, 0x4c, 0x30, 0x78, 0x23, 0x13, 0xe9, 0xb9, 0x48,
-	0x8f, 0xd7, 0x97, 0xfe, 0xf7, 0x99, 0xcf, 0xa4, 0xa2, 0x95, 0x7c, 0xa5, 0xe2, 0x25, 0x6d, 0x34,
-	0x31, 0x74, 0x22, 0x67, 0x3f, 0x03, 0x2e, 0xdd, 0x19, 0xad, 0x29, 0xe0, 0x53, 0xdd, 0xed, 0xd1,
-	0x3a, 0x42, 0x5d, 0x4c, 0x05, 0x89, 0xf1, 0xe5, 0x47, 0xe2, 0x18, 0x53, 0x91, 0x1d, 0xa9, 0xfd,
-	0x28, 0x83, 0xda, 0xb3, 0xd4, 0xd5, 0x1b, 0xe1, 0x08, 0x0c, 0x1f, 0x82, 0x4a, 0xda, 0x93, 0xaa,
-	0xac, 0x94, 0x9a, 0xd5, 0xf6, 0xb2, 0x3e, 0xd2, 0xa5, 0x6e, 0x4a, 0x91, 0x95, 0x89, 0xe1, 0x2e,
-	0x80, 0x3d, 0x42, 0x9d, 0x80, 0x88, 0xa1, 0x1d, 0x46, 0x2c, 0x26, 0x1e, 0x8e, 0xb8, 0x3a, 0x29,
-	0x11, 0x77, 0xc7, 0x20, 0xb6, 0xb3, 0x02, 0x33, 0xd3, 0x5b, 0x0b, 0xbd, 0x0b, 0x3b, 0x1c, 0xbe,
-	0x06, 0x73, 0x48, 0xb8, 0xb6, 0x87, 0x03, 0xec, 0x3b, 0x82, 0x30, 0xca, 0xd5, 0x92, 0x84, 0xde,
-	0x1a, 0x03, 0x35, 0xba, 0x5b, 0x9d, 0x33, 0xb1, 0x55, 0x47, 0xc2, 0xcd, 0x97, 0x1c, 0xee, 0x80,
-	0xd9, 0x98, 0x09, 0x42, 0x7d, 0x3b, 0x64, 0xef, 0x92, 0x0e, 0xcb, 0x97, 0xc2, 0x76, 0xa5, 0xd6,
-	0x4c, 0xa4, 0xdb, 0xa6, 0x55, 0x8b, 0xf3, 0x25, 0x87, 0x7b, 0x60, 0x11, 0x05, 0xcc, 0x1d, 0xd8,
-	0x7d, 0x4c, 0xfc, 0xbe, 0xb0, 0xdd, 0xbe, 0x43, 0x28, 0x57, 0xa7, 0x24, 0xf0, 0xde, 0xb8, 0xee,
-	0x92, 0x8a, 0xe7, 0xb2, 0xc0, 0x40, 0xb4, 0xcb, 0x0c, 0xe1, 0x5a, 0x0b, 0x28, 0xdf, 0xdc, 0x92,
-	0x10, 0xf8, 0x02, 0xd4, 0x0b, 0xae, 0x59, 0xc4, 0xd5, 0x8a, 0xc4, 0xae, 0x5d, 0x69, 0x9a, 0x45,
-	0xd6, 0x6c, 0xee, 0x99, 0x45, 0x1c, 0x3e, 0x06, 0x95, 0xf4, 0xc6, 0xd5, 0x69, 0xc9, 0x58, 0x1d,
-	0xc3, 0x78, 0x9a, 0x88, 0x76, 0xa8, 0x87, 0x0f, 0xad, 0xac, 0x00, 0xee, 0x82, 0x5a, 0x1c, 0xda,
-	0x1e, 0x17, 0xb6, 0xeb, 0xb8, 0x7d, 0xac, 0xce, 0x48, 0xc0, 0xe6, 0xd5, 0xc3, 0xea, 0x10, 0x2e,
-	0xb6, 0x92, 0x12, 0x23, 0xc8, 0x8c, 0x59, 0x20, 0x0e, 0x3b, 0xd9, 0xa6, 0xf6, 0x49, 0x01, 0xb3,
-	0xe7, 0x46, 0x0b, 0x57, 0x41, 0xad, 0x38, 0x4c, 0x55, 0x59, 0x51, 0x9a, 0x65, 0xab, 0x5a, 0x98,
-	0x0c, 0xb4, 0xc0, 0x7f, 0xbd, 0xd0, 0x4e, 0xc6, 0x12, 0x0e, 0xd4, 0xc9, 0x15, 0xa5, 0x59, 0x33,
-	0x1e, 0x7d, 0xfd, 0x76, 0xb3, 0xed, 0x13, 0xd1, 0x3f, 0x40, 0xba, 0xcb, 0xf6, 0x5b, 0x59, 0x5f,
-	0xf2, 0x26, 0x4e, 0x17, 0x2d, 0x31, 0x0c, 0x31, 0xd7, 0x8d, 0x1d, 0x73, 0x63, 0xf3, 0x81, 0x79,
-	0x80, 0x5e, 0xe2, 0xa1, 0x35, 0xdd, 0x0b, 0x0d, 0xe1, 0x9a, 0x83, 0xe4, 0xd8, 0x62, 0x1c, 0xd4,
-	0x52, 0x7a, 0x6c, 0xe1, 0x9e, 0xb5, 0x8f, 0x0a, 0x58, 0xbe, 0xd4, 0xd9, 0x75, 0x7a, 0xef, 0x82,
-	0xb9, 0x64, 0x90, 0x84, 0x8b, 0x88, 0xa0, 0x83, 0x24, 0x8a, 0xd2, 0x41, 0xb5, 0x7d, 0xff, 0x0f,
-	0x66, 0x69, 0xd5, 0xe3, 0xb0, 0x53, 0x40, 0x68, 0x04, 0x2c, 0x8e, 0xc8, 0x13, 0x6c, 0x82, 0xf9,
-	0x73, 0xc1, 0x44, 0x88, 0x66, 0x3d, 0xd5, 0xd1, 0x39, 0xf9, 0xef, 0x4a, 0xe1, 0xca, 0xbe, 0x2e,
-	0x28, 0x85, 0xab, 0xfd, 0x54, 0x40, 0xad, 0x18, 0x32, 0xd8, 0x01, 0x25, 0xe2, 0x1d, 0x4a, 0x6e,
-	0xb5, 0xdd, 0xbe, 0x46, 0x2c, 0xf3, 0x57, 0x98, 0x66, 0x2c, 0x29, 0xff, 0x27, 0x77, 0xda, 0x05,
-	0xc0, 0xc3, 0xc1, 0x29, 0xb4, 0xf4, 0x57, 0xd0, 0x19, 0x0f, 0x07, 0x92, 0xaa, 0xbd, 0x57, 0x00,
-	0xc8, 0x5f, 0x08, 0x9c, 0xcf, 0xed, 0x97, 0x53, 0x2b, 0xd7, 0x9e, 0x25, 0x7c, 0x02, 0xa6, 0xe4,
-	0xfb, 0x92, 0xbd, 0x8d, 0x8f, 0x80, 0x3c, 0xed, 0x2c, 0x01, 0x6f, 0x43, 0xcf, 0x11, 0xd8, 0x4a,
-	0x2b, 0x8d, 0x57, 0x9f, 0x8f, 0x1b, 0xca, 0xd1, 0x71, 0x43, 0xf9, 0x7e, 0xdc, 0x50, 0x3e, 0x9c,
-	0x34, 0x26, 0x8e, 0x4e, 0x1a, 0x13, 0x5f, 0x4e, 0x1a, 0x13, 0x7b, 0x57, 0xba, 0x3c, 0x2c, 0xfe,
-	0x0d, 0xa4, 0x65, 0x54, 0x91, 0xbf, 0x82, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe9,
-	0x80, 0x92, 0xf5, 0x06, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0x6f, 0xb6, 0xbb, 0xcd, 0xcb, 0x8f, 0x6d, 0xa7, 0x5d, 0x64, 0xad, 0xd4, 0x74, 0xeb,
+	0x52, 0x88, 0x00, 0x25, 0x34, 0x2d, 0x48, 0x48, 0x5c, 0x70, 0xd2, 0x85, 0x40, 0x91, 0xa2, 0x21,
+	0xec, 0xa1, 0x17, 0xcb, 0x63, 0x4f, 0x9c, 0x51, 0xb2, 0x1e, 0xcb, 0x33, 0x31, 0x9b, 0x7f, 0x80,
+	0x0b, 0x12, 0xe2, 0xc8, 0x91, 0x2b, 0xff, 0x09, 0xc7, 0x1e, 0x11, 0x07, 0x84, 0x76, 0xff, 0x0f,
+	0x84, 0x3c, 0x76, 0x62, 0xa7, 0x9b, 0x5f, 0x68, 0xd5, 0x9b, 0x67, 0xf4, 0xbd, 0xef, 0x7d, 0xdf,
+	0x7b, 0x6f, 0x9e, 0x0c, 0x8f, 0x89, 0x4d, 0xa6, 0x63, 0xee, 0x37, 0x89, 0x74, 0x84, 0xb4, 0x47,
+	0xcc, 0xf7, 0x9a, 0xd1, 0xd3, 0xa6, 0x47, 0x7d, 0x2a, 0x98, 0x68, 0x04, 0x21, 0x97, 0x1c, 0x1d,
+	0xa5, 0xa0, 0x46, 0x06, 0x6a, 0x44, 0x4f, 0x8f, 0xef, 0x7b, 0xdc, 0xe3, 0x0a, 0xd1, 0x8c, 0xbf,
+	0x12, 0xf0, 0xb1, 0xb1, 0x9c, 0x31, 0xb0, 0x43, 0xfb, 0x3c, 0x25, 0x3c, 0x7e, 0x6f, 0x39, 0x26,
+	0x47, 0x9f, 0xe0, 0x9e, 0x2c, 0xc7, 0x31, 0xdf, 0xa1, 0xbe, 0x64, 0x11, 0x5d, 0x9f, 0x92, 0x46,
+	0xd4, 0x97, 0x69, 0x4a, 0xe3, 0xe7, 0x03, 0x28, 0x7f, 0x99, 0xb8, 0xfa, 0x4e, 0xda, 0x92, 0xa2,
+	0x4f, 0x60, 0x3f, 0xd1, 0xa4, 0x6b, 0x27, 0x85, 0x7a, 0xa9, 0xf5, 0xa0, 0xb1, 0xd4, 0x65, 0xa3,
+	0xa7, 0x40, 0x38, 0x05, 0xa3, 0x33, 0x40, 0x03, 0xe6, 0xdb, 0x63, 0x26, 0xa7, 0x56, 0x10, 0xf2,
+	0x88, 0xb9, 0x34, 0x14, 0xfa, 0xae, 0xa2, 0x78, 0x7f, 0x05, 0xc5, 0x69, 0x1a, 0xd0, 0x4b, 0xf1,
+	0xf8, 0xee, 0xe0, 0x8d, 0x1b, 0x81, 0xbe, 0x85, 0x43, 0x22, 0x1d, 0xcb, 0xa5, 0x63, 0xea, 0xd9,
+	0x92, 0x71, 0x5f, 0xe8, 0x05, 0x45, 0xfa, 0xee, 0x0a, 0x52, 0xb3, 0xdf, 0xee, 0xcc, 0xc1, 0xb8,
+	0x4a, 0xa4, 0x93, 0x1d, 0x05, 0xea, 0x42, 0x25, 0xe2, 0x92, 0xf9, 0x9e, 0x15, 0xf0, 0x1f, 0x62,
+	0x85, 0x7b, 0x6b, 0xc9, 0xce, 0x14, 0xb6, 0x17, 0x43, 0x4f, 0x7b, 0xb8, 0x1c, 0x65, 0x47, 0x81,
+	0x5e, 0xc1, 0x3d, 0x32, 0xe6, 0xce, 0xc8, 0x1a, 0x52, 0xe6, 0x0d, 0xa5, 0xe5, 0x0c, 0x6d, 0xe6,
+	0x0b, 0xfd, 0x96, 0x22, 0xfc, 0x60, 0x95, 0xba, 0x38, 0xe2, 0x2b, 0x15, 0x60, 0x12, 0xbf, 0xcf,
+	0x4d, 0xe9, 0xe0, 0xbb, 0x24, 0xbb, 0x6c, 0x2b, 0x12, 0xf4, 0x35, 0x54, 0x73, 0xae, 0x79, 0x28,
+	0xf4, 0x7d, 0x45, 0xfb, 0x78, 0xa3, 0x69, 0x1e, 0xe2, 0x4a, 0xe6, 0x99, 0x87, 0x02, 0x7d, 0x06,
+	0xfb, 0x49, 0xc7, 0xf5, 0x03, 0xc5, 0xf1, 0x68, 0x05, 0xc7, 0x8b, 0x18, 0xd4, 0xf5, 0x5d, 0x7a,
+	0x81, 0xd3, 0x00, 0x74, 0x06, 0xe5, 0x28, 0xb0, 0x5c, 0x21, 0x2d, 0xc7, 0x76, 0x86, 0x54, 0xbf,
+	0xad, 0x08, 0x9e, 0x6f, 0x2e, 0x56, 0x87, 0x09, 0xd9, 0x8e, 0x43, 0xcc, 0x71, 0x6a, 0x0c, 0x43,
+	0x14, 0x74, 0xd2, 0x4b, 0xf4, 0x02, 0x8a, 0x0e, 0xf7, 0xc5, 0xe4, 0x3c, 0xee, 0x40, 0x71, 0xed,
+	0x8c, 0xb4, 0x53, 0x1c, 0xa6, 0x1e, 0x13, 0x92, 0x86, 0x38, 0x8b, 0x44, 0x04, 0xde, 0x99, 0x1d,
+	0xac, 0xc5, 0xae, 0x82, 0xe2, 0xfc, 0x68, 0x03, 0xe7, 0x62, 0x77, 0xef, 0x3b, 0xd7, 0xaf, 0x05,
+	0xe2, 0x70, 0x94, 0xe5, 0xc8, 0xd7, 0xa2, 0xa4, 0x52, 0x7c, 0xbe, 0x7d, 0x8a, 0x25, 0x35, 0x41,
+	0xf3, 0x94, 0xf3, 0xda, 0x18, 0xbf, 0x6b, 0x50, 0x59, 0x10, 0x86, 0x1e, 0x41, 0x39, 0x3f, 0x68,
+	0xba, 0x76, 0xa2, 0xd5, 0xf7, 0x70, 0x29, 0x37, 0x35, 0x08, 0x43, 0x71, 0x10, 0x58, 0xf1, 0xc8,
+	0x04, 0x23, 0x7d, 0xf7, 0x44, 0xab, 0x97, 0xcd, 0x4f, 0xff, 0xfa, 0xfb, 0x61, 0xcb, 0x63, 0x72,
+	0x38, 0x21, 0x0d, 0x87, 0x9f, 0x37, 0x53, 0x9d, 0x6a, 0x4a, 0x67, 0x87, 0xa6, 0x9c, 0x06, 0x54,
+	0x34, 0xcc, 0x6e, 0xef, 0xd9, 0xf3, 0x8f, 0x7b, 0x13, 0xf2, 0x0d, 0x9d, 0xe2, 0x83, 0x41, 0x60,
+	0x4a, 0xa7, 0x37, 0x8a, 0xd3, 0xe6, 0x8b, 0xaa, 0x17, 0x92, 0xb4, 0xb9, 0x37, 0x60, 0xfc, 0xaa,
+	0xc1, 0x83, 0xb5, 0x0e, 0xb7, 0xd1, 0xde, 0x87, 0xc3, 0xb8, 0xb0, 0x4c, 0xc8, 0x90, 0x91, 0x49,
+	0xfc, 0x4c, 0x95, 0x83, 0x52, 0xeb, 0xc3, 0xff, 0x31, 0x67, 0xb8, 0x1a, 0x05, 0x9d, 0x1c, 0x85,
+	0xf1, 0xa3, 0x06, 0x47, 0x4b, 0xfb, 0x8c, 0x1e, 0x42, 0x69, 0xde, 0x51, 0xe6, 0x2a, 0x45, 0x45,
+	0x0c, 0xb3, 0xab, 0xae, 0x8b, 0x5e, 0xc2, 0x61, 0xde, 0xb8, 0x35, 0x08, 0x52, 0x41, 0xdb, 0x6d,
+	0x89, 0x4a, 0xae, 0x42, 0xa7, 0x81, 0xf1, 0x9b, 0x06, 0x4f, 0xb6, 0x9a, 0x86, 0xcd, 0xc2, 0xde,
+	0x7c, 0x8e, 0x89, 0xaa, 0x1b, 0x3f, 0x47, 0x83, 0xc1, 0xbd, 0x25, 0x7b, 0x09, 0xd5, 0xe1, 0xce,
+	0xc2, 0x82, 0x23, 0xc4, 0x4f, 0xfb, 0x57, 0x25, 0x0b, 0xf0, 0xeb, 0x48, 0xe9, 0xe8, 0xbb, 0xd7,
+	0x91, 0xd2, 0x31, 0xfe, 0xd5, 0xa0, 0x9c, 0x5f, 0x56, 0xa8, 0x03, 0x05, 0xe6, 0x5e, 0x28, 0xde,
+	0x52, 0xab, 0xb5, 0xc5, 0x7a, 0xcb, 0xb6, 0x79, 0xb2, 0xab, 0xe2, 0xf0, 0xb7, 0x32, 0xff, 0x7d,
+	0x00, 0x97, 0x8e, 0x67, 0xa4, 0x85, 0x1b, 0x91, 0xde, 0x76, 0xe9, 0x58, 0xb1, 0x1a, 0x3f, 0x69,
+	0x00, 0xd9, 0xa6, 0x45, 0x77, 0x32, 0xfb, 0x7b, 0x89, 0x95, 0xad, 0x6b, 0x89, 0xbe, 0x80, 0x5b,
+	0x6a, 0x4f, 0xeb, 0x85, 0xb5, 0xcf, 0x45, 0x65, 0x9b, 0x8f, 0xc1, 0xf7, 0x81, 0x6b, 0x4b, 0x8a,
+	0x93, 0x48, 0xf3, 0xe5, 0x1f, 0x97, 0x35, 0xed, 0xf5, 0x65, 0x4d, 0xfb, 0xe7, 0xb2, 0xa6, 0xfd,
+	0x72, 0x55, 0xdb, 0x79, 0x7d, 0x55, 0xdb, 0xf9, 0xf3, 0xaa, 0xb6, 0xf3, 0x6a, 0xa3, 0xcb, 0x8b,
+	0xfc, 0x5f, 0x85, 0xb2, 0x4c, 0xf6, 0xd5, 0x2f, 0xc5, 0xb3, 0xff, 0x06, 0x00, 0xbd, 0x2c, 0x79,
+	0x5e, 0x3d, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -497,6 +648,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerVpDstCache) > 0 {
+		for iNdEx := len(m.ConsumerVpDstCache) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerVpDstCache[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ConsumerVotingPowers) > 0 {
+		for iNdEx := len(m.ConsumerVotingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerVotingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.VpDstCache) > 0 {
 		for iNdEx := len(m.VpDstCache) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -697,6 +890,90 @@ func (m *VotingPowerDistCacheBlkHeight) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumerVotingPowerFP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerVotingPowerFP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerVotingPowerFP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPowerFp != nil {
+		{
+			size, err := m.VotingPowerFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VpDstCache != nil {
+		{
+			size, err := m.VpDstCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockHeightBbnToBtc) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -899,6 +1176,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerVotingPowers) > 0 {
+		for _, e := range m.ConsumerVotingPowers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerVpDstCache) > 0 {
+		for _, e := range m.ConsumerVpDstCache {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -937,6 +1232,40 @@ func (m *VotingPowerDistCacheBlkHeight) Size() (n int) {
 	return n
 }
 
+func (m *ConsumerVotingPowerFP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VotingPowerFp != nil {
+		l = m.VotingPowerFp.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerVotingPowerDistCacheBlkHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.VpDstCache != nil {
+		l = m.VpDstCache.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockHeightBbnToBtc) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1299,31 +1628,133 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			m.Consumers = append(m.Consumers, &ConsumerRegister{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerVotingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerVotingPowers = append(m.ConsumerVotingPowers, &ConsumerVotingPowerFP{})
+			if err := m.ConsumerVotingPowers[len(m.ConsumerVotingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerVpDstCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerVpDstCache = append(m.ConsumerVpDstCache, &ConsumerVotingPowerDistCacheBlkHeight{})
+			if err := m.ConsumerVpDstCache[len(m.ConsumerVpDstCache)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingPowerFP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -1548,6 +1979,242 @@ func (m *VotingPowerDistCacheBlkHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsumerVotingPowerFP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerVotingPowerFP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerVotingPowerFP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPowerFp == nil {
+				m.VotingPowerFp = &VotingPowerFP{}
+			}
+			if err := m.VotingPowerFp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerVotingPowerDistCacheBlkHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerVotingPowerDistCacheBlkHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerVotingPowerDistCacheBlkHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpDstCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VpDstCache == nil {
+				m.VpDstCache = &VotingPowerDistCacheBlkHeight{}
+			}
+			if err := m.VpDstCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockHeightBbnToBtc) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -68,6 +68,30 @@ func TestGenesisState_Validate(t *testing.T) {
 				}},
 			valid: false,
 		},
+		{
+			desc: "duplicate consumers in genesis",
+			genState: &types.GenesisState{
+				Params: types.DefaultGenesis().Params,
+				Consumers: []*types.ConsumerRegister{
+					{ConsumerId: "consumer", ConsumerName: "consumer"},
+					{ConsumerId: "consumer", ConsumerName: "consumer"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "finality provider securing unregistered consumer in genesis",
+			genState: &types.GenesisState{
+				Params: types.DefaultGenesis().Params,
+				Consumers: []*types.ConsumerRegister{
+					{ConsumerId: "consumer", ConsumerName: "consumer"},
+				},
+				FinalityProviders: []*types.FinalityProvider{
+					{BtcPk: &types.DefaultParams().CovenantPks[0], ConsumerId: "unknown"},
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
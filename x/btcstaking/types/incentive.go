@@ -34,8 +34,18 @@ func (dc *VotingPowerDistCache) ApplyActiveFinalityProviders(maxActiveFPs uint32
 	}
 }
 
+// GetNumActiveFPs returns the number of active finality providers, i.e., the
+// top N non-jailed and non-retired finality providers. It assumes the finality
+// providers are sorted via SortFinalityProviders
 func (dc *VotingPowerDistCache) GetNumActiveFPs(maxActiveFPs uint32) uint32 {
-	return min(maxActiveFPs, uint32(len(dc.FinalityProviders)))
+	numFPs := uint32(0)
+	for _, fp := range dc.FinalityProviders {
+		if fp.IsInactive() {
+			break
+		}
+		numFPs++
+	}
+	return min(maxActiveFPs, numFPs)
 }
 
 // GetActiveFinalityProviders returns the list of active finality providers
@@ -65,6 +75,21 @@ func (dc *VotingPowerDistCache) FilterVotedDistCache(maxActiveFPs uint32, voterB
 	}
 }
 
+// UpdateCommissions updates the commission rates of the finality providers
+// in the cache, where the given map is keyed by the finality providers' BTC
+// PK hex
+func (dc *VotingPowerDistCache) UpdateCommissions(commissions map[string]sdkmath.LegacyDec) {
+	if len(commissions) == 0 {
+		return
+	}
+	for _, fp := range dc.FinalityProviders {
+		if commission, ok := commissions[fp.BtcPk.MarshalHex()]; ok {
+			commission := commission
+			fp.Commission = &commission
+		}
+	}
+}
+
 // GetFinalityProviderPortion returns the portion of a finality provider's voting power out of the total voting power
 func (dc *VotingPowerDistCache) GetFinalityProviderPortion(v *FinalityProviderDistInfo) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDec(int64(v.TotalVotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(dc.TotalVotingPower)))
@@ -77,9 +102,17 @@ func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo
 		Commission:       fp.Commission,
 		TotalVotingPower: 0,
 		BtcDels:          []*BTCDelDistInfo{},
+		IsJailed:         fp.Jailed,
+		IsRetired:        fp.IsRetired(),
 	}
 }
 
+// IsInactive returns true if the finality provider cannot be in the active
+// set regardless of its voting power, i.e., it is jailed or retired
+func (v *FinalityProviderDistInfo) IsInactive() bool {
+	return v.IsJailed || v.IsRetired
+}
+
 func (v *FinalityProviderDistInfo) GetAddress() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(v.Addr)
 }
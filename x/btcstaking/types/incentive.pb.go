@@ -94,6 +94,12 @@ type FinalityProviderDistInfo struct {
 	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 	// btc_dels is a list of BTC delegations' voting power information under this finality provider
 	BtcDels []*BTCDelDistInfo `protobuf:"bytes,5,rep,name=btc_dels,json=btcDels,proto3" json:"btc_dels,omitempty"`
+	// is_jailed indicates whether the finality provider is jailed, if so,
+	// it is not counted as an active finality provider
+	IsJailed bool `protobuf:"varint,6,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+	// is_retired indicates whether the finality provider is retired, if so,
+	// it is not counted as an active finality provider
+	IsRetired bool `protobuf:"varint,7,opt,name=is_retired,json=isRetired,proto3" json:"is_retired,omitempty"`
 }
 
 func (m *FinalityProviderDistInfo) Reset()         { *m = FinalityProviderDistInfo{} }
@@ -150,6 +156,20 @@ func (m *FinalityProviderDistInfo) GetBtcDels() []*BTCDelDistInfo {
 	return nil
 }
 
+func (m *FinalityProviderDistInfo) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
+	}
+	return false
+}
+
+func (m *FinalityProviderDistInfo) GetIsRetired() bool {
+	if m != nil {
+		return m.IsRetired
+	}
+	return false
+}
+
 // BTCDelDistInfo contains the information related to reward distribution for a BTC delegation
 type BTCDelDistInfo struct {
 	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
@@ -228,39 +248,41 @@ func init() {
 }
 
 var fileDescriptor_ac354c3bd6d7a66b = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xae, 0x1b, 0xe0, 0x8e, 0x7f, 0x56, 0x91, 0xc2, 0x90, 0xb2, 0x52, 0x69, 0xa8,
-	0x87, 0x35, 0x61, 0x1b, 0x42, 0xe2, 0x06, 0x59, 0x84, 0x98, 0xd8, 0xa4, 0x28, 0x4c, 0x1c, 0x38,
-	0x10, 0x39, 0x8e, 0x9b, 0x58, 0x4d, 0xec, 0x2a, 0xf6, 0x42, 0xf3, 0x2d, 0xf8, 0x10, 0x7c, 0x84,
-	0x7d, 0x08, 0x8e, 0xd3, 0x0e, 0x08, 0xed, 0x30, 0xa1, 0x56, 0x7c, 0x0f, 0x14, 0x27, 0xb0, 0x82,
-	0x5a, 0xc1, 0x81, 0x9b, 0xed, 0xe7, 0x79, 0xfd, 0xbe, 0xcf, 0x4f, 0x7a, 0xc1, 0x56, 0x80, 0x82,
-	0x22, 0xe1, 0xcc, 0x0a, 0x24, 0x16, 0x12, 0x8d, 0x28, 0x8b, 0xac, 0x7c, 0xc7, 0xa2, 0x0c, 0x13,
-	0x26, 0x69, 0x4e, 0xcc, 0x71, 0xc6, 0x25, 0x87, 0xf7, 0x6a, 0x9b, 0x79, 0x65, 0x33, 0xf3, 0x9d,
-	0x8d, 0x4e, 0xc4, 0x23, 0xae, 0x1c, 0x56, 0x79, 0xaa, 0xcc, 0x1b, 0xf7, 0x31, 0x17, 0x29, 0x17,
-	0x7e, 0x25, 0x54, 0x97, 0x4a, 0xea, 0x7d, 0xd2, 0x40, 0xe7, 0x2d, 0x97, 0x94, 0x45, 0x2e, 0xff,
-	0x40, 0x32, 0x87, 0x0a, 0xb9, 0x8f, 0x70, 0x4c, 0xe0, 0x36, 0x80, 0x92, 0x4b, 0x94, 0xf8, 0xb9,
-	0x52, 0xfd, 0x71, 0x29, 0xeb, 0x5a, 0x57, 0xeb, 0xb7, 0xbc, 0x3b, 0x4a, 0x99, 0x2b, 0x83, 0xef,
-	0x01, 0x1c, 0x52, 0x86, 0x12, 0x2a, 0x8b, 0xb2, 0x4b, 0x4e, 0x43, 0x92, 0x09, 0xbd, 0xd9, 0x5d,
-	0xe9, 0xb7, 0x77, 0x2d, 0x73, 0xe1, 0xac, 0xe6, 0xcb, 0xba, 0xc0, 0xad, 0xfd, 0x65, 0xef, 0x03,
-	0x36, 0xe4, 0xde, 0xdd, 0xe1, 0x1f, 0x8a, 0xe8, 0x7d, 0x69, 0x02, 0x7d, 0x99, 0x1f, 0x1e, 0x81,
-	0xb5, 0x40, 0x62, 0x7f, 0x3c, 0x52, 0xe3, 0xad, 0xdb, 0x4f, 0x2f, 0x2e, 0x37, 0x77, 0x23, 0x2a,
-	0xe3, 0x93, 0xc0, 0xc4, 0x3c, 0xb5, 0xea, 0xf6, 0x38, 0x46, 0x94, 0xfd, 0xbc, 0x58, 0xb2, 0x18,
-	0x13, 0x61, 0xda, 0x07, 0xee, 0xde, 0x93, 0xc7, 0xee, 0x49, 0xf0, 0x9a, 0x14, 0xde, 0x6a, 0x20,
-	0xb1, 0x3b, 0x82, 0xdb, 0xa0, 0x85, 0xc2, 0x30, 0xd3, 0x9b, 0x5d, 0xad, 0x7f, 0xc3, 0xd6, 0xcf,
-	0x4f, 0x07, 0x9d, 0x1a, 0xd9, 0x8b, 0x30, 0xcc, 0x88, 0x10, 0x6f, 0x64, 0x46, 0x59, 0xe4, 0x29,
-	0x17, 0x3c, 0x02, 0x00, 0xf3, 0x34, 0xa5, 0x42, 0x50, 0xce, 0xf4, 0x15, 0x55, 0x33, 0xb8, 0xb8,
-	0xdc, 0x7c, 0x50, 0xd5, 0x88, 0x70, 0x64, 0x52, 0x6e, 0xa5, 0x48, 0xc6, 0xe6, 0x21, 0x89, 0x10,
-	0x2e, 0x1c, 0x82, 0xcf, 0x4f, 0x07, 0xa0, 0xfe, 0xd2, 0x21, 0xd8, 0x9b, 0xfb, 0x60, 0x09, 0xf6,
-	0xd6, 0x12, 0xec, 0xcf, 0xc1, 0xf5, 0x32, 0x79, 0x48, 0x12, 0xa1, 0xaf, 0x2a, 0xd8, 0x5b, 0x4b,
-	0x60, 0xdb, 0xc7, 0xfb, 0x0e, 0x49, 0x7e, 0x21, 0xbe, 0x16, 0x48, 0xec, 0x90, 0x44, 0xf4, 0xbe,
-	0x6b, 0xe0, 0xd6, 0xef, 0xda, 0xff, 0xc6, 0xf9, 0x0c, 0xb4, 0xcb, 0x39, 0x48, 0xe6, 0xff, 0x13,
-	0x55, 0x50, 0x99, 0xcb, 0x47, 0xf8, 0x08, 0xdc, 0xae, 0x23, 0xf8, 0x72, 0xe2, 0xc7, 0x48, 0xc4,
-	0x15, 0x60, 0xef, 0x66, 0xfd, 0x7c, 0x3c, 0x79, 0x85, 0x44, 0x0c, 0x1f, 0x82, 0xf5, 0x05, 0xb8,
-	0xda, 0xf9, 0x15, 0x29, 0xfb, 0xf0, 0xf3, 0xd4, 0xd0, 0xce, 0xa6, 0x86, 0xf6, 0x6d, 0x6a, 0x68,
-	0x1f, 0x67, 0x46, 0xe3, 0x6c, 0x66, 0x34, 0xbe, 0xce, 0x8c, 0xc6, 0xbb, 0xbf, 0x46, 0x9b, 0xcc,
-	0xaf, 0xa2, 0xca, 0x19, 0xac, 0xa9, 0xe5, 0xd9, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6b,
-	0x62, 0xab, 0xad, 0x03, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9b, 0x34, 0x4d, 0x36, 0xe5, 0x6f, 0x15, 0x24, 0xd3, 0x0a, 0x37, 0x44, 0x2a, 0xca,
+	0xa1, 0xb1, 0x69, 0x8b, 0x90, 0xb8, 0x41, 0x1a, 0x21, 0x0a, 0xad, 0x14, 0x99, 0x8a, 0x03, 0x07,
+	0xac, 0xf5, 0x7a, 0x63, 0x2f, 0x71, 0xbc, 0x91, 0x67, 0x6b, 0x92, 0xb7, 0x40, 0x3c, 0x03, 0x8f,
+	0xd0, 0x87, 0xe0, 0x58, 0xf5, 0x84, 0x7a, 0xa8, 0x50, 0x22, 0xde, 0x03, 0x79, 0x6d, 0x68, 0x40,
+	0x8d, 0xe0, 0xd0, 0xdb, 0xce, 0x7c, 0xdf, 0xcc, 0xec, 0xf7, 0x8d, 0x06, 0x6d, 0xba, 0xc4, 0x9d,
+	0x84, 0x22, 0xb2, 0x5c, 0x49, 0x41, 0x92, 0x01, 0x8f, 0x7c, 0x2b, 0xd9, 0xb6, 0x78, 0x44, 0x59,
+	0x24, 0x79, 0xc2, 0xcc, 0x51, 0x2c, 0xa4, 0xc0, 0x77, 0x73, 0x9a, 0x79, 0x49, 0x33, 0x93, 0xed,
+	0xb5, 0xba, 0x2f, 0x7c, 0xa1, 0x18, 0x56, 0xfa, 0xca, 0xc8, 0x6b, 0xf7, 0xa8, 0x80, 0xa1, 0x00,
+	0x27, 0x03, 0xb2, 0x20, 0x83, 0x9a, 0x5f, 0x34, 0x54, 0x7f, 0x2b, 0x24, 0x8f, 0xfc, 0x9e, 0xf8,
+	0xc8, 0xe2, 0x2e, 0x07, 0xb9, 0x47, 0x68, 0xc0, 0xf0, 0x16, 0xc2, 0x52, 0x48, 0x12, 0x3a, 0x89,
+	0x42, 0x9d, 0x51, 0x0a, 0xeb, 0x5a, 0x43, 0x6b, 0x95, 0xec, 0xdb, 0x0a, 0x99, 0x2b, 0xc3, 0xef,
+	0x11, 0xee, 0xf3, 0x88, 0x84, 0x5c, 0x4e, 0xd2, 0x29, 0x09, 0xf7, 0x58, 0x0c, 0xfa, 0x52, 0xa3,
+	0xd8, 0xaa, 0xed, 0x58, 0xe6, 0x95, 0x7f, 0x35, 0x5f, 0xe4, 0x05, 0xbd, 0x9c, 0x9f, 0xce, 0xde,
+	0x8f, 0xfa, 0xc2, 0xbe, 0xd3, 0xff, 0x0b, 0x81, 0xe6, 0xe7, 0x22, 0xd2, 0x17, 0xf1, 0xf1, 0x21,
+	0x2a, 0xbb, 0x92, 0x3a, 0xa3, 0x81, 0xfa, 0xde, 0x6a, 0xe7, 0xc9, 0xf9, 0xc5, 0xc6, 0x8e, 0xcf,
+	0x65, 0x70, 0xec, 0x9a, 0x54, 0x0c, 0xad, 0x7c, 0x3c, 0x0d, 0x08, 0x8f, 0x7e, 0x05, 0x96, 0x9c,
+	0x8c, 0x18, 0x98, 0x9d, 0xfd, 0xde, 0xee, 0xe3, 0x47, 0xbd, 0x63, 0xf7, 0x35, 0x9b, 0xd8, 0xcb,
+	0xae, 0xa4, 0xbd, 0x01, 0xde, 0x42, 0x25, 0xe2, 0x79, 0xb1, 0xbe, 0xd4, 0xd0, 0x5a, 0xd5, 0x8e,
+	0x7e, 0x76, 0xd2, 0xae, 0xe7, 0x96, 0x3d, 0xf7, 0xbc, 0x98, 0x01, 0xbc, 0x91, 0x31, 0x8f, 0x7c,
+	0x5b, 0xb1, 0xf0, 0x21, 0x42, 0x54, 0x0c, 0x87, 0x1c, 0x80, 0x8b, 0x48, 0x2f, 0xaa, 0x9a, 0xf6,
+	0xf9, 0xc5, 0xc6, 0x7a, 0x56, 0x03, 0xde, 0xc0, 0xe4, 0xc2, 0x1a, 0x12, 0x19, 0x98, 0x07, 0xcc,
+	0x27, 0x74, 0xd2, 0x65, 0xf4, 0xec, 0xa4, 0x8d, 0xf2, 0x96, 0x5d, 0x46, 0xed, 0xb9, 0x06, 0x0b,
+	0x6c, 0x2f, 0x2d, 0xb0, 0xfd, 0x19, 0xaa, 0xa4, 0xca, 0x3d, 0x16, 0x82, 0xbe, 0xac, 0xcc, 0xde,
+	0x5c, 0x60, 0x76, 0xe7, 0x68, 0xaf, 0xcb, 0xc2, 0xdf, 0x16, 0xaf, 0xb8, 0x92, 0x76, 0x59, 0x08,
+	0x78, 0x1d, 0x55, 0x39, 0x38, 0x1f, 0x08, 0x0f, 0x99, 0xa7, 0x97, 0x1b, 0x5a, 0xab, 0x62, 0x57,
+	0x38, 0xbc, 0x52, 0x31, 0xbe, 0x8f, 0x10, 0x07, 0x27, 0x66, 0x92, 0xc7, 0xcc, 0xd3, 0x57, 0x14,
+	0x5a, 0xe5, 0x60, 0x67, 0x89, 0xe6, 0x0f, 0x0d, 0xdd, 0xfc, 0xb3, 0xef, 0x75, 0xaf, 0xe2, 0x29,
+	0xaa, 0xa5, 0x1a, 0x58, 0xec, 0xfc, 0xd7, 0x46, 0x50, 0x46, 0x4e, 0x93, 0xf8, 0x21, 0xba, 0x95,
+	0xcb, 0x77, 0xe4, 0xd8, 0x09, 0x08, 0x04, 0xd9, 0x72, 0xec, 0x1b, 0x79, 0xfa, 0x68, 0xfc, 0x92,
+	0x40, 0x80, 0x1f, 0xa0, 0xd5, 0x2b, 0xac, 0xae, 0x25, 0x97, 0x2e, 0x77, 0x0e, 0xbe, 0x4e, 0x0d,
+	0xed, 0x74, 0x6a, 0x68, 0xdf, 0xa7, 0x86, 0xf6, 0x69, 0x66, 0x14, 0x4e, 0x67, 0x46, 0xe1, 0xdb,
+	0xcc, 0x28, 0xbc, 0xfb, 0xa7, 0xb4, 0xf1, 0xfc, 0x19, 0x2b, 0x9d, 0x6e, 0x59, 0x1d, 0xde, 0xee,
+	0xcf, 0x01, 0x00, 0x68, 0xf9, 0x56, 0x06, 0xe9, 0x03, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -325,6 +347,26 @@ func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.IsRetired {
+		i--
+		if m.IsRetired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BtcDels) > 0 {
 		for iNdEx := len(m.BtcDels) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -488,6 +530,12 @@ func (m *FinalityProviderDistInfo) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if m.IsJailed {
+		n += 2
+	}
+	if m.IsRetired {
+		n += 2
+	}
 	return n
 }
 
@@ -809,6 +857,46 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRetired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRetired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "btcstaking"
@@ -23,4 +27,23 @@ var (
 	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
 	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
 	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+
+	BTCDelegationByStakerAddrKey = []byte{0x09} // key prefix for the BTC delegations indexed by staker address
+	BTCDelegationByDelBTCPKKey   = []byte{0x0a} // key prefix for the BTC delegations indexed by delegator BTC PK
+
+	ConsumerRegisterKey             = []byte{0x0b} // key prefix for the consumer chain registers
+	ConsumerVotingPowerKey          = []byte{0x0c} // key prefix for the voting power of consumer finality providers
+	ConsumerVotingPowerDistCacheKey = []byte{0x0d} // key prefix for voting power distribution cache of consumer chains
+
+	PreStakingExpiryKey = []byte{0x0e} // key prefix for the expiry of pre-staking BTC delegations
+	CommissionChangeKey = []byte{0x0f} // key prefix for the scheduled commission changes of finality providers
 )
+
+// GetVotingPowerKey returns the key of the voting power of the given finality
+// provider at the given height in the BTC staking store, i.e.,
+// (VotingPowerKey || height || finality provider BTC PK)
+func GetVotingPowerKey(height uint64, fpBTCPK []byte) []byte {
+	key := append([]byte{}, VotingPowerKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBTCPK...)
+}
@@ -12,6 +12,8 @@ const (
 	MetricsKeyAddCovenantSigs           = "add_covenant_sigs"
 	MetricsKeyBTCUndelegate             = "btc_undelegate"
 	MetricsKeySelectiveSlashingEvidence = "selective_slashing_evidence"
+	MetricsKeyReportStakingOutputSpend  = "report_staking_output_spend"
+	MetricsKeyAddInclusionProof         = "add_btc_delegation_inclusion_proof"
 )
 
 // Metrics for monitoring finality providers and BTC delegations
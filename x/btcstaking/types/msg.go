@@ -4,6 +4,7 @@ import (
 	"fmt"
 	math "math"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -18,6 +19,11 @@ var (
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
+	_ sdk.Msg = &MsgRegisterConsumer{}
+	_ sdk.Msg = &MsgReportStakingOutputSpend{}
+	_ sdk.Msg = &MsgAddBTCDelegationInclusionProof{}
+	_ sdk.Msg = &MsgRetireFinalityProvider{}
+	_ sdk.Msg = &MsgRotateFinalityProviderAddr{}
 )
 
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
@@ -45,9 +51,32 @@ func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
 		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
 	}
+	if len(m.ConsumerId) > MaxConsumerIDLength {
+		return fmt.Errorf("consumer ID is longer than %d", MaxConsumerIDLength)
+	}
+	maxRate, maxChangeRate := m.GetMaxCommissionRates()
+	if err := ValidateCommissionRates(*m.Commission, maxRate, maxChangeRate); err != nil {
+		return err
+	}
 	return m.Pop.ValidateBasic()
 }
 
+// GetMaxCommissionRates returns the maximum commission rate and the maximum
+// commission change rate of the finality provider, where the maximum
+// commission rate defaults to 1 and the maximum commission change rate
+// defaults to the maximum commission rate
+func (m *MsgCreateFinalityProvider) GetMaxCommissionRates() (sdkmath.LegacyDec, sdkmath.LegacyDec) {
+	maxRate := sdkmath.LegacyOneDec()
+	if m.MaxCommissionRate != nil {
+		maxRate = *m.MaxCommissionRate
+	}
+	maxChangeRate := maxRate
+	if m.MaxCommissionChangeRate != nil {
+		maxChangeRate = *m.MaxCommissionChangeRate
+	}
+	return maxRate, maxChangeRate
+}
+
 func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	if m.Commission == nil {
 		return fmt.Errorf("empty commission")
@@ -71,6 +100,37 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgRetireFinalityProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
+		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
+	}
+	if m.BtcPk == nil {
+		return fmt.Errorf("empty BTC public key")
+	}
+	if _, err := m.BtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key: %v", err)
+	}
+
+	return nil
+}
+
+func (m *MsgRotateFinalityProviderAddr) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.NewAddr); err != nil {
+		return fmt.Errorf("invalid new FP addr: %s - %v", m.NewAddr, err)
+	}
+	if m.BtcPk == nil {
+		return fmt.Errorf("empty BTC public key")
+	}
+	if _, err := m.BtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key: %v", err)
+	}
+	if m.Pop == nil {
+		return fmt.Errorf("empty proof of possession")
+	}
+
+	return m.Pop.ValidateBasic()
+}
+
 func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.StakerAddr); err != nil {
 		return fmt.Errorf("invalid staker addr %s: %w", m.StakerAddr, err)
@@ -117,7 +177,11 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	}
 
 	// staking tx should be correctly formatted
-	if err := m.StakingTx.ValidateBasic(); err != nil {
+	if m.IsPreStaking() {
+		if m.StakingTx.Transaction == nil {
+			return fmt.Errorf("transaction in TransactionInfo is nil")
+		}
+	} else if err := m.StakingTx.ValidateBasic(); err != nil {
 		return err
 	}
 	if err := m.Pop.ValidateBasic(); err != nil {
@@ -159,6 +223,13 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	return nil
 }
 
+// IsPreStaking returns whether the BTC delegation is registered without the
+// inclusion proof of its staking tx, which will be submitted later via
+// MsgAddBTCDelegationInclusionProof
+func (m *MsgCreateBTCDelegation) IsPreStaking() bool {
+	return m.StakingTx != nil && m.StakingTx.Key == nil && len(m.StakingTx.Proof) == 0
+}
+
 func (m *MsgAddCovenantSigs) ValidateBasic() error {
 	if m.Pk == nil {
 		return fmt.Errorf("empty BTC covenant public key")
@@ -204,3 +275,45 @@ func (m *MsgBTCUndelegate) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgRegisterConsumer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %s - %v", m.Authority, err)
+	}
+	if m.Consumer == nil {
+		return fmt.Errorf("empty consumer register")
+	}
+	return m.Consumer.ValidateBasic()
+}
+
+func (m *MsgReportStakingOutputSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer addr %s: %w", m.Signer, err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.SpendTxInfo == nil {
+		return fmt.Errorf("empty spend tx info")
+	}
+	if err := m.SpendTxInfo.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid spend tx info: %w", err)
+	}
+	return nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer addr %s: %w", m.Signer, err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.StakingTxInfo == nil {
+		return fmt.Errorf("empty staking tx info")
+	}
+	if err := m.StakingTxInfo.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid staking tx info: %w", err)
+	}
+	return nil
+}
@@ -17,6 +17,10 @@ import (
 
 const (
 	defaultMaxActiveFinalityProviders uint32 = 100
+	defaultPreStakingInclusionTimeout uint32 = 1008
+	// about a day with 10-second Babylon blocks
+	defaultMinCommissionChangeInterval uint64 = 8640
+	defaultCommissionChangeDelay       uint64 = 8640
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -66,6 +70,13 @@ func DefaultParams() Params {
 		MinUnbondingTime: 0,
 		// By default unbonding value is 0.8
 		MinUnbondingRate: sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		// By default the inclusion proof of a pre-staking BTC delegation has to
+		// be submitted within 1008 BTC blocks, i.e., about a week
+		PreStakingInclusionTimeout: defaultPreStakingInclusionTimeout,
+		// By default the commission of a finality provider can be changed
+		// at most once per day, and the change takes effect after a day
+		MinCommissionChangeInterval: defaultMinCommissionChangeInterval,
+		CommissionChangeDelay:       defaultCommissionChangeDelay,
 	}
 }
 
@@ -56,6 +56,21 @@ type Params struct {
 	// must be at least 90% of staking output, for staking request to be considered
 	// valid
 	MinUnbondingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_unbonding_rate,json=minUnbondingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_unbonding_rate"`
+	// pre_staking_inclusion_timeout is the number of BTC blocks within which
+	// the inclusion proof of a pre-staking BTC delegation's staking tx has to
+	// be submitted after its registration. Pre-staking BTC delegations without
+	// inclusion proofs after the timeout expire and are removed.
+	// If it's 0 then pre-staking registration is disabled
+	PreStakingInclusionTimeout uint32 `protobuf:"varint,10,opt,name=pre_staking_inclusion_timeout,json=preStakingInclusionTimeout,proto3" json:"pre_staking_inclusion_timeout,omitempty"`
+	// min_commission_change_interval is the minimum number of Babylon blocks
+	// between two commission changes of a finality provider, counting from
+	// its registration or its last commission change
+	MinCommissionChangeInterval uint64 `protobuf:"varint,11,opt,name=min_commission_change_interval,json=minCommissionChangeInterval,proto3" json:"min_commission_change_interval,omitempty"`
+	// commission_change_delay is the number of Babylon blocks after which a
+	// commission change of a finality provider takes effect, such that
+	// delegators are aware of the change in advance. If it's 0 then the
+	// change takes effect at the next Babylon block
+	CommissionChangeDelay uint64 `protobuf:"varint,12,opt,name=commission_change_delay,json=commissionChangeDelay,proto3" json:"commission_change_delay,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -125,6 +140,27 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+func (m *Params) GetPreStakingInclusionTimeout() uint32 {
+	if m != nil {
+		return m.PreStakingInclusionTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMinCommissionChangeInterval() uint64 {
+	if m != nil {
+		return m.MinCommissionChangeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeDelay() uint64 {
+	if m != nil {
+		return m.CommissionChangeDelay
+	}
+	return 0
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -191,42 +227,46 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xdf, 0xfa, 0xeb, 0x98, 0xd7, 0xb1, 0x61, 0x98, 0x16, 0x36, 0x2d, 0xad, 0xc6,
+	0x81, 0x22, 0x41, 0xc2, 0xfe, 0x68, 0x07, 0x38, 0xad, 0x9b, 0x26, 0x4d, 0xec, 0x50, 0xd2, 0x81,
+	0x04, 0x17, 0xcb, 0x71, 0xbc, 0xd4, 0x6a, 0x6d, 0x97, 0xd8, 0x89, 0xda, 0x77, 0xc1, 0x91, 0x23,
+	0xaf, 0x01, 0xf1, 0x22, 0x76, 0x9c, 0x38, 0xa1, 0x1d, 0x26, 0xb4, 0xbd, 0x11, 0x14, 0x27, 0x29,
+	0xac, 0x20, 0x81, 0xb8, 0xc5, 0xcf, 0xf3, 0x79, 0xbe, 0x7e, 0xfe, 0xf8, 0x09, 0xd8, 0x08, 0x70,
+	0x30, 0x1e, 0x48, 0xe1, 0x05, 0x9a, 0x28, 0x8d, 0xfb, 0x4c, 0x44, 0x5e, 0xba, 0xe9, 0x0d, 0x71,
+	0x8c, 0xb9, 0x72, 0x87, 0xb1, 0xd4, 0x12, 0x2e, 0x17, 0x8c, 0xfb, 0x83, 0x71, 0xd3, 0xcd, 0xd5,
+	0x7b, 0x91, 0x8c, 0xa4, 0x21, 0xbc, 0xec, 0x2b, 0x87, 0x57, 0xef, 0x13, 0xa9, 0xb8, 0x54, 0x28,
+	0x77, 0xe4, 0x87, 0xdc, 0xb5, 0xf1, 0xa9, 0x06, 0x6a, 0x1d, 0x23, 0x0c, 0xdf, 0x80, 0x3a, 0x91,
+	0x29, 0x15, 0x58, 0x68, 0x34, 0xec, 0x2b, 0xdb, 0x6a, 0xce, 0xb4, 0xea, 0xed, 0xdd, 0x8b, 0xcb,
+	0xc6, 0x56, 0xc4, 0x74, 0x2f, 0x09, 0x5c, 0x22, 0xb9, 0x57, 0xdc, 0x4b, 0x7a, 0x98, 0x89, 0xf2,
+	0xe0, 0xe9, 0xf1, 0x90, 0x2a, 0xb7, 0x7d, 0xd4, 0xd9, 0xde, 0x79, 0xda, 0x49, 0x82, 0x17, 0x74,
+	0xec, 0xcf, 0x97, 0x5a, 0x9d, 0xbe, 0x82, 0x0f, 0xc1, 0xe2, 0x44, 0xfa, 0x5d, 0x22, 0xe3, 0x84,
+	0xdb, 0xff, 0x35, 0xad, 0xd6, 0x82, 0x7f, 0xbb, 0x34, 0xbf, 0x34, 0x56, 0xf8, 0x08, 0x2c, 0xa9,
+	0x01, 0x56, 0x3d, 0x26, 0x22, 0x84, 0xc3, 0x30, 0xa6, 0x4a, 0xd9, 0x33, 0x4d, 0xab, 0x35, 0xe7,
+	0x2f, 0x96, 0xf6, 0xbd, 0xdc, 0x0c, 0x77, 0xc0, 0x0a, 0x67, 0x02, 0x4d, 0x70, 0x3d, 0x42, 0xa7,
+	0x94, 0x22, 0x85, 0xb5, 0x5d, 0x6d, 0x5a, 0xad, 0x19, 0xff, 0x2e, 0x67, 0xa2, 0x5b, 0x78, 0x4f,
+	0x46, 0x87, 0x94, 0x76, 0xb1, 0x86, 0x5d, 0x90, 0x99, 0x11, 0x91, 0x9c, 0x33, 0xa5, 0x98, 0x14,
+	0x28, 0xc6, 0x9a, 0xda, 0xff, 0x67, 0x77, 0xb4, 0x1f, 0x9c, 0x5d, 0x36, 0x2a, 0x17, 0x97, 0x8d,
+	0xb5, 0xbc, 0x45, 0x2a, 0xec, 0xbb, 0x4c, 0x7a, 0x1c, 0xeb, 0x9e, 0x7b, 0x4c, 0x23, 0x4c, 0xc6,
+	0x07, 0x94, 0xf8, 0x77, 0x38, 0x13, 0xfb, 0x93, 0x70, 0x1f, 0x6b, 0x0a, 0x5f, 0x83, 0x85, 0x49,
+	0x1a, 0x46, 0xae, 0x66, 0xe4, 0x36, 0xff, 0x42, 0xee, 0xcb, 0xe7, 0x27, 0xa0, 0x18, 0x48, 0x26,
+	0x5e, 0x2f, 0x75, 0x8c, 0xee, 0x1e, 0x58, 0xe7, 0x78, 0x84, 0x30, 0xd1, 0x2c, 0xa5, 0xe8, 0x94,
+	0x09, 0x3c, 0x60, 0x7a, 0x9c, 0x8d, 0x31, 0x65, 0x21, 0x8d, 0x95, 0x3d, 0x6b, 0x9a, 0xb8, 0xca,
+	0xf1, 0x68, 0xcf, 0x30, 0x87, 0x05, 0xd2, 0x29, 0x09, 0xf8, 0x18, 0xc0, 0xac, 0xde, 0x44, 0x04,
+	0x52, 0x84, 0xa6, 0x4d, 0x8c, 0x53, 0xfb, 0x96, 0x89, 0x5b, 0xe2, 0x4c, 0xbc, 0x2a, 0x1d, 0x27,
+	0x8c, 0x53, 0x88, 0xa6, 0x69, 0x53, 0xcd, 0xdc, 0xbf, 0x56, 0x73, 0xe3, 0x82, 0xb2, 0xa2, 0x61,
+	0x4c, 0x51, 0xf1, 0x62, 0x11, 0x13, 0x64, 0x90, 0x98, 0x29, 0x64, 0x69, 0xc9, 0x44, 0xdb, 0x20,
+	0xaf, 0x68, 0x18, 0xd3, 0x6e, 0xce, 0x1c, 0x95, 0xc8, 0x49, 0x4e, 0xc0, 0x7d, 0xe0, 0x4c, 0x4d,
+	0x90, 0xf4, 0xb0, 0x88, 0x28, 0x62, 0x42, 0xd3, 0x38, 0xc5, 0x03, 0x7b, 0xbe, 0x69, 0xb5, 0xaa,
+	0xfe, 0xda, 0x8d, 0x39, 0xed, 0x1b, 0xe6, 0xa8, 0x40, 0xe0, 0x2e, 0x58, 0xf9, 0x55, 0x20, 0xa4,
+	0x03, 0x3c, 0xb6, 0xeb, 0x26, 0x7a, 0x99, 0x4c, 0x85, 0x1e, 0x64, 0xce, 0x67, 0xd5, 0x0f, 0x1f,
+	0x1b, 0x95, 0x0d, 0x0a, 0xea, 0x5d, 0x2d, 0x63, 0x1a, 0x16, 0x9b, 0x63, 0x83, 0xd9, 0x94, 0xc6,
+	0x19, 0x6b, 0x5b, 0x26, 0xff, 0xf2, 0x08, 0x9f, 0x83, 0x5a, 0xbe, 0xb6, 0xe6, 0xbd, 0xcf, 0x6f,
+	0xad, 0xbb, 0xbf, 0xdd, 0x5b, 0x37, 0x17, 0x6a, 0x57, 0xb3, 0x1e, 0xfb, 0x45, 0x48, 0xfb, 0xf8,
+	0xec, 0xca, 0xb1, 0xce, 0xaf, 0x1c, 0xeb, 0xdb, 0x95, 0x63, 0xbd, 0xbf, 0x76, 0x2a, 0xe7, 0xd7,
+	0x4e, 0xe5, 0xeb, 0xb5, 0x53, 0x79, 0xfb, 0xc7, 0x85, 0x1c, 0xfd, 0xfc, 0xef, 0x30, 0xdb, 0x19,
+	0xd4, 0xcc, 0xc2, 0x6f, 0x7f, 0x1f, 0x00, 0x90, 0x36, 0xa5, 0xee, 0x5e, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +289,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionChangeDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommissionChangeDelay))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinCommissionChangeInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCommissionChangeInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PreStakingInclusionTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PreStakingInclusionTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.MinUnbondingRate.Size()
 		i -= size
@@ -406,6 +461,15 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinUnbondingRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.PreStakingInclusionTimeout != 0 {
+		n += 1 + sovParams(uint64(m.PreStakingInclusionTimeout))
+	}
+	if m.MinCommissionChangeInterval != 0 {
+		n += 1 + sovParams(uint64(m.MinCommissionChangeInterval))
+	}
+	if m.CommissionChangeDelay != 0 {
+		n += 1 + sovParams(uint64(m.CommissionChangeDelay))
+	}
 	return n
 }
 
@@ -703,6 +767,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreStakingInclusionTimeout", wireType)
+			}
+			m.PreStakingInclusionTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreStakingInclusionTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionChangeInterval", wireType)
+			}
+			m.MinCommissionChangeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCommissionChangeInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeDelay", wireType)
+			}
+			m.CommissionChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionChangeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
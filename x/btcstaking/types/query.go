@@ -59,14 +59,23 @@ func (ud *BTCUndelegation) ToResponse() (resp *BTCUndelegationResponse) {
 // NewFinalityProviderResponse creates a new finality provider response based on the finaliny provider and his voting power.
 func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPower uint64) *FinalityProviderResponse {
 	return &FinalityProviderResponse{
-		Description:          f.Description,
-		Commission:           f.Commission,
-		Addr:                 f.Addr,
-		BtcPk:                f.BtcPk,
-		Pop:                  f.Pop,
-		SlashedBabylonHeight: f.SlashedBabylonHeight,
-		SlashedBtcHeight:     f.SlashedBtcHeight,
-		Height:               bbnBlockHeight,
-		VotingPower:          votingPower,
+		Description:             f.Description,
+		Commission:              f.Commission,
+		Addr:                    f.Addr,
+		BtcPk:                   f.BtcPk,
+		Pop:                     f.Pop,
+		SlashedBabylonHeight:    f.SlashedBabylonHeight,
+		SlashedBtcHeight:        f.SlashedBtcHeight,
+		Height:                  bbnBlockHeight,
+		VotingPower:             votingPower,
+		ConsumerId:              f.ConsumerId,
+		Jailed:                  f.Jailed,
+		MaxCommissionRate:       f.MaxCommissionRate,
+		MaxCommissionChangeRate: f.MaxCommissionChangeRate,
+		CommissionUpdateHeight:  f.CommissionUpdateHeight,
+		PendingCommission:       f.PendingCommission,
+		PendingCommissionHeight: f.PendingCommissionHeight,
+		RetiredBabylonHeight:    f.RetiredBabylonHeight,
+		PreviousAddrs:           f.PreviousAddrs,
 	}
 }
@@ -950,6 +950,9 @@ type QueryFinalityProviderDelegationsRequest struct {
 	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// statuses are the queried statuses for BTC delegations
+	// if empty, BTC delegations under all statuses are returned
+	Statuses []BTCDelegationStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"statuses,omitempty"`
 }
 
 func (m *QueryFinalityProviderDelegationsRequest) Reset() {
@@ -1001,6 +1004,13 @@ func (m *QueryFinalityProviderDelegationsRequest) GetPagination() *query.PageReq
 	return nil
 }
 
+func (m *QueryFinalityProviderDelegationsRequest) GetStatuses() []BTCDelegationStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 // QueryFinalityProviderDelegationsResponse is the response type for the
 // Query/FinalityProviderDelegations RPC method.
 type QueryFinalityProviderDelegationsResponse struct {
@@ -1153,64 +1163,32 @@ func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// staker_addr is the address to receive rewards from BTC delegation.
+// QueryBTCDelegationsByStakerAddrRequest is the request type for the
+// Query/BTCDelegationsByStakerAddr RPC method.
+type QueryBTCDelegationsByStakerAddrRequest struct {
+	// staker_addr is the Babylon address of the staker
 	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,6,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,7,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,8,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,9,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,10,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,11,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,13,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,14,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,15,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// statuses are the queried statuses for BTC delegations
+	// if empty, BTC delegations under all statuses are returned
+	Statuses []BTCDelegationStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"statuses,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBTCDelegationsByStakerAddrRequest) Reset() {
+	*m = QueryBTCDelegationsByStakerAddrRequest{}
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerAddrRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerAddrRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_74d49d26f7429697, []int{22}
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,156 +1198,126 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStakerAddr() string {
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetStakerAddr() string {
 	if m != nil {
 		return m.StakerAddr
 	}
 	return ""
 }
 
-func (m *BTCDelegationResponse) GetStartHeight() uint64 {
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetStatuses() []BTCDelegationStatus {
 	if m != nil {
-		return m.StartHeight
+		return m.Statuses
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetEndHeight() uint64 {
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.EndHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
-	if m != nil {
-		return m.TotalSat
-	}
-	return 0
+// QueryBTCDelegationsByStakerAddrResponse is the response type for the
+// Query/BTCDelegationsByStakerAddr RPC method.
+type QueryBTCDelegationsByStakerAddrResponse struct {
+	// btc_delegations contains all the queried BTC delegations of the staker
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
-	if m != nil {
-		return m.StakingTxHex
-	}
-	return ""
+func (m *QueryBTCDelegationsByStakerAddrResponse) Reset() {
+	*m = QueryBTCDelegationsByStakerAddrResponse{}
 }
-
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
-	if m != nil {
-		return m.SlashingTxHex
-	}
-	return ""
+func (m *QueryBTCDelegationsByStakerAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerAddrResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{23}
 }
-
-func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
-	if m != nil {
-		return m.DelegatorSlashSigHex
-	}
-	return ""
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
-	if m != nil {
-		return m.CovenantSigs
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
-	if m != nil {
-		return m.StakingOutputIdx
-	}
-	return 0
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.Merge(m, src)
 }
-
-func (m *BTCDelegationResponse) GetActive() bool {
-	if m != nil {
-		return m.Active
-	}
-	return false
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *BTCDelegationResponse) GetStatusDesc() string {
-	if m != nil {
-		return m.StatusDesc
-	}
-	return ""
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
-	if m != nil {
-		return m.UnbondingTime
-	}
-	return 0
-}
+var xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+func (m *QueryBTCDelegationsByStakerAddrResponse) GetBtcDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.UndelegationResponse
+		return m.BtcDelegations
 	}
 	return nil
 }
 
-func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+func (m *QueryBTCDelegationsByStakerAddrResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.ParamsVersion
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// BTCUndelegationResponse provides all necessary info about the undeleagation
-type BTCUndelegationResponse struct {
-	// unbonding_tx is the transaction which will transfer the funds from staking
-	// output to unbonding output. Unbonding output will usually have lower timelock
-	// than staking output. The unbonding tx as string hex.
-	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
-	// delegator_unbonding_sig is the signature on the unbonding tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It effectively proves that the delegator wants to unbond and thus
-	// Babylon will consider this BTC delegation unbonded. Delegator's BTC
-	// on Bitcoin will be unbonded after timelock. The unbonding delegator sig as string hex.
-	DelegatorUnbondingSigHex string `protobuf:"bytes,2,opt,name=delegator_unbonding_sig_hex,json=delegatorUnbondingSigHex,proto3" json:"delegator_unbonding_sig_hex,omitempty"`
-	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
-	// by covenant members
-	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,3,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
-	// slashingTxHex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,4,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slashing_sig is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It will be a part of the witness for the unbonding tx output.
-	// The delegator slashing sig as string hex.
-	DelegatorSlashingSigHex string `protobuf:"bytes,5,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
-	// covenant_slashing_sigs is a list of adaptor signatures on the
-	// unbonding slashing tx by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,6,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+// QueryBTCDelegationsByDelegatorBtcPkRequest is the request type for the
+// Query/BTCDelegationsByDelegatorBtcPk RPC method.
+type QueryBTCDelegationsByDelegatorBtcPkRequest struct {
+	// del_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the BTC delegator
+	// the PK follows encoding in BIP-340 spec
+	DelBtcPkHex string `protobuf:"bytes,1,opt,name=del_btc_pk_hex,json=delBtcPkHex,proto3" json:"del_btc_pk_hex,omitempty"`
+	// statuses are the queried statuses for BTC delegations
+	// if empty, BTC delegations under all statuses are returned
+	Statuses []BTCDelegationStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"statuses,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
-func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCUndelegationResponse) ProtoMessage()    {}
-func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) Reset() {
+	*m = QueryBTCDelegationsByDelegatorBtcPkRequest{}
 }
-func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationsByDelegatorBtcPkRequest) ProtoMessage() {}
+func (*QueryBTCDelegationsByDelegatorBtcPkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{24}
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1379,77 +1327,118 @@ func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkRequest.Merge(m, src)
 }
-func (m *BTCUndelegationResponse) XXX_Size() int {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkRequest proto.InternalMessageInfo
 
-func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) GetDelBtcPkHex() string {
 	if m != nil {
-		return m.UnbondingTxHex
+		return m.DelBtcPkHex
 	}
 	return ""
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorUnbondingSigHex() string {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) GetStatuses() []BTCDelegationStatus {
 	if m != nil {
-		return m.DelegatorUnbondingSigHex
+		return m.Statuses
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.CovenantUnbondingSigList
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
-	if m != nil {
-		return m.SlashingTxHex
+// QueryBTCDelegationsByDelegatorBtcPkResponse is the response type for the
+// Query/BTCDelegationsByDelegatorBtcPk RPC method.
+type QueryBTCDelegationsByDelegatorBtcPkResponse struct {
+	// btc_delegations contains all the queried BTC delegations of the delegator
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) Reset() {
+	*m = QueryBTCDelegationsByDelegatorBtcPkResponse{}
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationsByDelegatorBtcPkResponse) ProtoMessage() {}
+func (*QueryBTCDelegationsByDelegatorBtcPkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{25}
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkResponse.DiscardUnknown(m)
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+var xxx_messageInfo_QueryBTCDelegationsByDelegatorBtcPkResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) GetBtcDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.DelegatorSlashingSigHex
+		return m.BtcDelegations
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.CovenantSlashingSigs
+		return m.Pagination
 	}
 	return nil
 }
 
-// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
-type BTCDelegatorDelegationsResponse struct {
-	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+// QueryConsumersRequest is the request type for the
+// Query/Consumers RPC method.
+type QueryConsumersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
-func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
-func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
+func (m *QueryConsumersRequest) Reset()         { *m = QueryConsumersRequest{} }
+func (m *QueryConsumersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersRequest) ProtoMessage()    {}
+func (*QueryConsumersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryConsumersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryConsumersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryConsumersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1459,65 +1448,46 @@ func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+func (m *QueryConsumersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersRequest.Merge(m, src)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+func (m *QueryConsumersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+func (m *QueryConsumersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryConsumersRequest proto.InternalMessageInfo
 
-func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+func (m *QueryConsumersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Dels
+		return m.Pagination
 	}
 	return nil
 }
 
-// FinalityProviderResponse defines a finality provider with voting power information.
-type FinalityProviderResponse struct {
-	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the commission rate of the finality provider.
-	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
-	// addr is the address to receive commission from delegations.
-	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// pop is the proof of possession of the BTC_PK by the fp addr.
-	// Essentially is the signature where the BTC SK sigs the fp addr.
-	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
-	// slashed_babylon_height indicates the Babylon height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
-	// slashed_btc_height indicates the BTC height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
-	// height is the queried Babylon height
-	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	// voting_power is the voting power of this finality provider at the given height
-	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+// QueryConsumersResponse is the response type for the
+// Query/Consumers RPC method.
+type QueryConsumersResponse struct {
+	// consumers contains all the registered consumer chains
+	Consumers []*ConsumerRegister `protobuf:"bytes,1,rep,name=consumers,proto3" json:"consumers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
-func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
-func (*FinalityProviderResponse) ProtoMessage()    {}
-func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+func (m *QueryConsumersResponse) Reset()         { *m = QueryConsumersResponse{} }
+func (m *QueryConsumersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersResponse) ProtoMessage()    {}
+func (*QueryConsumersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
-func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryConsumersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryConsumersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryConsumersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1527,1347 +1497,1475 @@ func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+func (m *QueryConsumersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersResponse.Merge(m, src)
 }
-func (m *FinalityProviderResponse) XXX_Size() int {
+func (m *QueryConsumersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+func (m *QueryConsumersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryConsumersResponse proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
+func (m *QueryConsumersResponse) GetConsumers() []*ConsumerRegister {
 	if m != nil {
-		return m.Description
+		return m.Consumers
 	}
 	return nil
 }
 
-func (m *FinalityProviderResponse) GetAddr() string {
+func (m *QueryConsumersResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Addr
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
-	if m != nil {
-		return m.Pop
-	}
-	return nil
+// QueryConsumerFinalityProvidersAtHeightRequest is the request type for the
+// Query/ConsumerFinalityProvidersAtHeight RPC method.
+type QueryConsumerFinalityProvidersAtHeightRequest struct {
+	// consumer_id is the ID of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// height defines at which Babylon height to query the finality providers info.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
-	if m != nil {
-		return m.SlashedBabylonHeight
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) Reset() {
+	*m = QueryConsumerFinalityProvidersAtHeightRequest{}
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerFinalityProvidersAtHeightRequest) ProtoMessage() {}
+func (*QueryConsumerFinalityProvidersAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightRequest.Merge(m, src)
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightRequest.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint64 {
+var xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) GetConsumerId() string {
 	if m != nil {
-		return m.SlashedBtcHeight
+		return m.ConsumerId
 	}
-	return 0
+	return ""
 }
 
-func (m *FinalityProviderResponse) GetHeight() uint64 {
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) GetHeight() uint64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-func (m *FinalityProviderResponse) GetVotingPower() uint64 {
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.VotingPower
+		return m.Pagination
 	}
-	return 0
-}
-
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
-	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
-	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
-}
-
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1870 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x6c, 0xdb, 0xc8,
-	0x19, 0x0e, 0x6d, 0x45, 0x89, 0x7f, 0xd9, 0x8e, 0x33, 0xeb, 0x24, 0x8c, 0x1c, 0xdb, 0x09, 0x9b,
-	0x4d, 0x9c, 0x97, 0x18, 0x2b, 0xde, 0x14, 0xed, 0x76, 0x37, 0xb1, 0xec, 0xdd, 0x24, 0xbb, 0x31,
-	0xa2, 0xd2, 0x49, 0x0b, 0x74, 0x8b, 0x12, 0x14, 0x39, 0xa2, 0x88, 0x58, 0x24, 0xc3, 0x19, 0xb9,
-	0x32, 0x02, 0x5f, 0x7a, 0xe8, 0xa5, 0x28, 0x50, 0xa0, 0xbd, 0xf6, 0xdc, 0x02, 0x3d, 0x36, 0xa7,
-	0x02, 0xbd, 0x6f, 0x6f, 0x8b, 0xec, 0xa1, 0xc5, 0x1e, 0x82, 0x22, 0x29, 0x5a, 0xa0, 0x40, 0xaf,
-	0x3d, 0x17, 0x9c, 0x19, 0x8a, 0x94, 0x44, 0xea, 0x61, 0xbb, 0x37, 0x71, 0xe6, 0x7f, 0x3f, 0xbe,
-	0x99, 0xf9, 0x05, 0x97, 0x6a, 0x46, 0x6d, 0x6f, 0xc7, 0x73, 0xd5, 0x1a, 0x35, 0x09, 0x35, 0x9e,
-	0x3b, 0xae, 0xad, 0xee, 0xae, 0xaa, 0x2f, 0x5a, 0x38, 0xd8, 0x2b, 0xf9, 0x81, 0x47, 0x3d, 0x74,
-	0x46, 0x90, 0x94, 0x62, 0x92, 0xd2, 0xee, 0x6a, 0x71, 0xde, 0xf6, 0x6c, 0x8f, 0x51, 0xa8, 0xe1,
-	0x2f, 0x4e, 0x5c, 0xbc, 0x60, 0x7b, 0x9e, 0xbd, 0x83, 0x55, 0xc3, 0x77, 0x54, 0xc3, 0x75, 0x3d,
-	0x6a, 0x50, 0xc7, 0x73, 0x89, 0xd8, 0x3d, 0x6f, 0x7a, 0xa4, 0xe9, 0x11, 0x9d, 0xb3, 0xf1, 0x0f,
-	0xb1, 0x75, 0x99, 0x7f, 0xa9, 0xb1, 0x11, 0x35, 0x4c, 0x8d, 0xd5, 0xe8, 0x5b, 0x50, 0x5d, 0x17,
-	0x54, 0x35, 0x83, 0x60, 0x6e, 0x64, 0x87, 0xd0, 0x37, 0x6c, 0xc7, 0x65, 0xda, 0x04, 0xad, 0x92,
-	0xee, 0x9a, 0x6f, 0x04, 0x46, 0x33, 0xd2, 0x7a, 0x25, 0x9d, 0x26, 0xe1, 0x29, 0xa7, 0x5b, 0xce,
-	0x90, 0xe5, 0xf9, 0x9c, 0x40, 0x99, 0x07, 0xf4, 0xfd, 0xd0, 0x9c, 0x2a, 0x93, 0xae, 0xe1, 0x17,
-	0x2d, 0x4c, 0xa8, 0xa2, 0xc1, 0x7b, 0x5d, 0xab, 0xc4, 0xf7, 0x5c, 0x82, 0xd1, 0x87, 0x90, 0xe7,
-	0x56, 0xc8, 0xd2, 0x45, 0x69, 0xa5, 0x50, 0x5e, 0x2c, 0xa5, 0x86, 0xb8, 0xc4, 0xd9, 0x2a, 0xb9,
-	0x2f, 0xdf, 0x2c, 0x1f, 0xd3, 0x04, 0x8b, 0xf2, 0x6d, 0x58, 0x48, 0xc8, 0xac, 0xec, 0xfd, 0x00,
-	0x07, 0xc4, 0xf1, 0x5c, 0xa1, 0x12, 0xc9, 0x70, 0x62, 0x97, 0xaf, 0x30, 0xe1, 0x33, 0x5a, 0xf4,
-	0xa9, 0x7c, 0x01, 0x17, 0xd2, 0x19, 0x8f, 0xc2, 0x2a, 0x1b, 0x16, 0x99, 0xf0, 0x4f, 0x1d, 0xd7,
-	0xd8, 0x71, 0xe8, 0x5e, 0x35, 0xf0, 0x76, 0x1d, 0x0b, 0x07, 0x51, 0x28, 0xd0, 0xa7, 0x00, 0x71,
-	0x86, 0x84, 0x86, 0x2b, 0x25, 0x51, 0x02, 0x61, 0x3a, 0x4b, 0xbc, 0xe6, 0x44, 0x3a, 0x4b, 0x55,
-	0xc3, 0xc6, 0x82, 0x57, 0x4b, 0x70, 0x2a, 0x7f, 0x91, 0x60, 0x29, 0x4b, 0x93, 0x70, 0xe4, 0x27,
-	0x80, 0xea, 0x62, 0x33, 0xac, 0x34, 0xbe, 0x2b, 0x4b, 0x17, 0x27, 0x57, 0x0a, 0x65, 0x35, 0xc3,
-	0xa9, 0x5e, 0x69, 0x91, 0x30, 0xed, 0x74, 0xbd, 0x57, 0x0f, 0x7a, 0xd0, 0xe5, 0xca, 0x04, 0x73,
-	0xe5, 0xea, 0x50, 0x57, 0x84, 0xbc, 0xa4, 0x2f, 0xeb, 0x22, 0x23, 0xfd, 0xca, 0x79, 0xcc, 0x2e,
-	0xc1, 0x4c, 0xdd, 0xd7, 0x6b, 0xd4, 0xd4, 0xfd, 0xe7, 0x7a, 0x03, 0xb7, 0x59, 0xd8, 0xa6, 0x34,
-	0xa8, 0xfb, 0x15, 0x6a, 0x56, 0x9f, 0x3f, 0xc4, 0x6d, 0x65, 0x3f, 0x23, 0xee, 0x9d, 0x60, 0xfc,
-	0x18, 0x4e, 0xf7, 0x05, 0x43, 0x84, 0x7f, 0xec, 0x58, 0xcc, 0xf5, 0xc6, 0x42, 0xf9, 0xbd, 0x04,
-	0x45, 0xa6, 0xbf, 0xf2, 0x74, 0x63, 0x13, 0xef, 0x60, 0x9b, 0xb7, 0x7b, 0xe4, 0x40, 0x05, 0xf2,
-	0x84, 0x1a, 0xb4, 0xc5, 0x4b, 0x6a, 0xb6, 0x7c, 0x3d, 0x43, 0x63, 0x17, 0xf7, 0x36, 0xe3, 0xd0,
-	0x04, 0x67, 0x4f, 0xe1, 0x4c, 0x1c, 0xb8, 0x70, 0xfe, 0x2c, 0x89, 0xc6, 0xe9, 0x35, 0x55, 0x04,
-	0xea, 0x19, 0x9c, 0x0a, 0x23, 0x6d, 0xc5, 0x5b, 0xa2, 0x64, 0x6e, 0x8e, 0x62, 0x74, 0x27, 0x46,
-	0xb3, 0x35, 0x6a, 0x26, 0xc4, 0x1f, 0x5d, 0xb1, 0xd4, 0xe1, 0x5a, 0x6a, 0xa6, 0xab, 0xde, 0x4f,
-	0x71, 0xb0, 0x4e, 0x1f, 0x62, 0xc7, 0x6e, 0xd0, 0xd1, 0x2b, 0x07, 0x9d, 0x85, 0x7c, 0x83, 0xf1,
-	0x30, 0xa3, 0x72, 0x9a, 0xf8, 0x52, 0x9e, 0xc0, 0xf5, 0x51, 0xf4, 0x88, 0xa8, 0x5d, 0x82, 0xe9,
-	0x5d, 0x8f, 0x3a, 0xae, 0xad, 0xfb, 0xe1, 0x3e, 0xd3, 0x93, 0xd3, 0x0a, 0x7c, 0x8d, 0xb1, 0x28,
-	0x5b, 0xb0, 0x92, 0x2a, 0x70, 0xa3, 0x15, 0x04, 0xd8, 0xa5, 0x8c, 0x68, 0x8c, 0x8a, 0xcf, 0x8a,
-	0x43, 0xb7, 0x38, 0x61, 0x5e, 0xec, 0xa4, 0x94, 0x74, 0xb2, 0xcf, 0xec, 0x89, 0x7e, 0xb3, 0x7f,
-	0x29, 0xc1, 0x0d, 0xa6, 0x68, 0xdd, 0xa4, 0xce, 0x2e, 0xee, 0x83, 0x9b, 0xde, 0x90, 0x67, 0xa9,
-	0x3a, 0xaa, 0xfa, 0xfd, 0xab, 0x04, 0x37, 0x47, 0xb3, 0xe7, 0x08, 0x61, 0xf0, 0x87, 0x0e, 0x6d,
-	0x6c, 0x61, 0x6a, 0xfc, 0x5f, 0x61, 0x70, 0x51, 0x34, 0x26, 0x73, 0xcc, 0xa0, 0xd8, 0xea, 0x0a,
-	0xac, 0x72, 0x57, 0xa0, 0x64, 0xdf, 0xf6, 0xe0, 0x1c, 0x2b, 0xbf, 0x91, 0xe0, 0x6a, 0x6a, 0xa5,
-	0xa4, 0x00, 0xd5, 0x08, 0xfd, 0x72, 0x54, 0x79, 0xfc, 0x97, 0x94, 0xd1, 0x0f, 0x69, 0xa0, 0x14,
-	0xc0, 0xf9, 0x04, 0x28, 0x79, 0x41, 0x0a, 0x3c, 0xdd, 0x1d, 0x0a, 0x4f, 0x5e, 0x9a, 0x68, 0xed,
-	0x5c, 0x0c, 0x54, 0x5d, 0x04, 0x47, 0x97, 0xd7, 0xcf, 0xe0, 0x7c, 0x3f, 0xe0, 0x46, 0x11, 0xbf,
-	0x05, 0xef, 0x09, 0x63, 0x75, 0xda, 0xd6, 0x1b, 0x06, 0x69, 0x24, 0xe2, 0x3e, 0x27, 0xb6, 0x9e,
-	0xb6, 0x1f, 0x1a, 0xa4, 0x11, 0x76, 0xfd, 0x8b, 0xb4, 0x73, 0xa6, 0x13, 0xa6, 0x6d, 0x98, 0xed,
-	0xc6, 0x6e, 0x71, 0xc2, 0x8d, 0x07, 0xdd, 0x33, 0x5d, 0xd0, 0xad, 0x7c, 0x9d, 0x87, 0x33, 0xe9,
-	0xea, 0xbe, 0x03, 0x85, 0x50, 0x18, 0x0e, 0x74, 0xc3, 0xb2, 0x38, 0xe6, 0x4d, 0x55, 0xe4, 0xd7,
-	0xaf, 0x6e, 0xcd, 0x8b, 0x28, 0xad, 0x5b, 0x56, 0x80, 0x09, 0xd9, 0xa6, 0x81, 0xe3, 0xda, 0x1a,
-	0x70, 0xe2, 0x70, 0x11, 0x6d, 0x41, 0x9e, 0x57, 0x19, 0x0b, 0xec, 0x74, 0xe5, 0xee, 0x37, 0x6f,
-	0x96, 0xcb, 0xb6, 0x43, 0x1b, 0xad, 0x5a, 0xc9, 0xf4, 0x9a, 0xaa, 0xb0, 0xd7, 0x6c, 0x18, 0x8e,
-	0x1b, 0x7d, 0xa8, 0x74, 0xcf, 0xc7, 0xa4, 0x54, 0x79, 0x54, 0xbd, 0xb3, 0x76, 0xbb, 0xda, 0xaa,
-	0x7d, 0x8e, 0xf7, 0xb4, 0xe3, 0xb5, 0xb0, 0x2e, 0xd1, 0x17, 0x30, 0x1b, 0xd7, 0xed, 0x8e, 0x43,
-	0xa8, 0x3c, 0x79, 0x71, 0xf2, 0x10, 0x62, 0x0b, 0xa2, 0xe0, 0x1f, 0x3b, 0xac, 0x29, 0xa6, 0x09,
-	0x35, 0x02, 0xaa, 0x8b, 0xf6, 0xca, 0x71, 0x90, 0x64, 0x6b, 0xbc, 0x07, 0xd1, 0x22, 0x00, 0x76,
-	0xad, 0x88, 0xe0, 0x38, 0x23, 0x98, 0xc2, 0xae, 0x68, 0x51, 0xb4, 0x00, 0x53, 0xd4, 0xa3, 0xc6,
-	0x8e, 0x4e, 0x0c, 0x2a, 0xe7, 0xd9, 0xee, 0x49, 0xb6, 0xb0, 0x6d, 0x50, 0x74, 0x19, 0x66, 0x93,
-	0x15, 0x80, 0xdb, 0xf2, 0x09, 0x96, 0xfc, 0xe9, 0x38, 0xf9, 0xb8, 0x8d, 0xae, 0xc0, 0x29, 0xb2,
-	0x63, 0x90, 0x46, 0x82, 0xec, 0x24, 0x23, 0x9b, 0x89, 0x96, 0x39, 0xdd, 0x07, 0x70, 0x2e, 0xee,
-	0x12, 0xb6, 0xa5, 0x13, 0xc7, 0x66, 0xf4, 0x53, 0x8c, 0x7e, 0xbe, 0xb3, 0xbd, 0x1d, 0xee, 0x6e,
-	0x3b, 0x76, 0xc8, 0xf6, 0x0c, 0x66, 0x4c, 0x6f, 0x17, 0xbb, 0x86, 0x4b, 0x43, 0x7a, 0x22, 0x03,
-	0x6b, 0xaa, 0xdb, 0x19, 0x85, 0xb3, 0x21, 0x68, 0xd7, 0x2d, 0xc3, 0x0f, 0x25, 0x39, 0xb6, 0x6b,
-	0xd0, 0x56, 0x80, 0x89, 0x36, 0x1d, 0x89, 0xd9, 0x76, 0x6c, 0x82, 0x6e, 0x02, 0x8a, 0x7c, 0xf3,
-	0x5a, 0xd4, 0x6f, 0x51, 0xdd, 0xb1, 0xda, 0x72, 0x81, 0x5d, 0xc8, 0xa3, 0xe2, 0x7e, 0xc2, 0x36,
-	0x1e, 0x59, 0xec, 0x28, 0x36, 0x18, 0xa8, 0xcb, 0xd3, 0x17, 0xa5, 0x95, 0x93, 0x9a, 0xf8, 0x42,
-	0xcb, 0xac, 0xce, 0x68, 0x8b, 0xe8, 0x16, 0x26, 0xa6, 0x3c, 0xc3, 0x31, 0x89, 0x2f, 0x6d, 0x62,
-	0x62, 0xa2, 0xf7, 0x61, 0xb6, 0xe5, 0xd6, 0x3c, 0xd7, 0x62, 0xd1, 0x71, 0x9a, 0x58, 0x9e, 0x65,
-	0x2a, 0x66, 0x3a, 0xab, 0x4f, 0x9d, 0x26, 0x46, 0x26, 0x9c, 0x69, 0xb9, 0x71, 0x73, 0xe8, 0x81,
-	0x28, 0x64, 0xf9, 0x14, 0xeb, 0x92, 0x52, 0x76, 0x97, 0x3c, 0x4b, 0xb0, 0x75, 0xfa, 0x64, 0xbe,
-	0x95, 0xb2, 0x1a, 0xda, 0xc2, 0xdf, 0x02, 0x7a, 0xf4, 0xfe, 0x98, 0xe3, 0xb6, 0xf0, 0x55, 0xf1,
-	0xda, 0x50, 0x5e, 0x4d, 0xc2, 0xb9, 0x0c, 0xc1, 0x68, 0x05, 0xe6, 0x12, 0xee, 0xb4, 0x13, 0x80,
-	0x10, 0xbb, 0xc9, 0xb3, 0xfd, 0x11, 0x2c, 0xc4, 0xd9, 0x8e, 0x79, 0xa2, 0x8c, 0x4f, 0x30, 0x26,
-	0xb9, 0x43, 0xf2, 0x2c, 0xa2, 0x10, 0x59, 0x37, 0x61, 0xa1, 0x93, 0xf5, 0x6e, 0xee, 0x4e, 0x0f,
-	0x15, 0xca, 0x97, 0x33, 0xc2, 0xd2, 0x49, 0xfa, 0x23, 0xb7, 0xee, 0x69, 0x72, 0x24, 0x28, 0xa9,
-	0x83, 0xb5, 0x4f, 0x4a, 0xe5, 0xe6, 0xd2, 0x2a, 0xf7, 0x43, 0x28, 0xf6, 0x54, 0x6e, 0xd2, 0x95,
-	0xe3, 0x8c, 0xe5, 0x5c, 0x77, 0xf1, 0xc6, 0x9e, 0xd4, 0xe1, 0x6c, 0x5c, 0xbf, 0x09, 0x5e, 0x22,
-	0xe7, 0x0f, 0x58, 0xc8, 0xf3, 0x9d, 0x42, 0x8e, 0x35, 0x11, 0xc5, 0x84, 0xe5, 0x21, 0x07, 0x0a,
-	0xba, 0x0f, 0x39, 0x0b, 0xef, 0x1c, 0xec, 0xd6, 0xcc, 0x38, 0x95, 0x5f, 0xe4, 0x40, 0xce, 0x7c,
-	0xc8, 0x7c, 0x02, 0x85, 0xb0, 0x0b, 0x02, 0xc7, 0x4f, 0x00, 0xfc, 0xb7, 0xa2, 0x73, 0x29, 0xd6,
-	0xc0, 0x0f, 0xa5, 0xcd, 0x98, 0x54, 0x4b, 0xf2, 0xa1, 0x2d, 0x00, 0xd3, 0x6b, 0x36, 0x1d, 0x42,
-	0xa2, 0xd3, 0x6d, 0xaa, 0x72, 0xeb, 0x9b, 0x37, 0xcb, 0x0b, 0x5c, 0x10, 0xb1, 0x9e, 0x97, 0x1c,
-	0x4f, 0x6d, 0x1a, 0xb4, 0x51, 0x7a, 0x8c, 0x6d, 0xc3, 0xdc, 0xdb, 0xc4, 0xe6, 0xeb, 0x57, 0xb7,
-	0x40, 0xe8, 0xd9, 0xc4, 0xa6, 0x96, 0x10, 0x80, 0x6e, 0x42, 0x8e, 0x9d, 0x01, 0x93, 0x43, 0xce,
-	0x00, 0x46, 0x95, 0x40, 0xff, 0xdc, 0x51, 0xa0, 0xff, 0x47, 0x30, 0xe9, 0x7b, 0x3e, 0x2b, 0x91,
-	0x42, 0xf9, 0x46, 0xd6, 0x73, 0x3d, 0xf0, 0xbc, 0xfa, 0x93, 0x7a, 0xd5, 0x23, 0x04, 0x33, 0x9b,
-	0x2b, 0x4f, 0x37, 0xb4, 0x90, 0x0f, 0xad, 0xc1, 0x59, 0x56, 0x32, 0xd8, 0xd2, 0x05, 0x6b, 0x04,
-	0xe4, 0x1c, 0xaa, 0xe7, 0xc5, 0x6e, 0x85, 0x6f, 0x0a, 0x4c, 0x0f, 0xa1, 0x2d, 0xe2, 0xa2, 0x66,
-	0xc4, 0x71, 0x82, 0x71, 0xcc, 0x45, 0x1c, 0xd4, 0x14, 0xd4, 0xf1, 0xe5, 0xec, 0xe4, 0xc0, 0x0b,
-	0xf8, 0x54, 0xdf, 0x05, 0xbc, 0xfc, 0xdb, 0xd3, 0x70, 0x9c, 0x9d, 0xf9, 0xe8, 0xe7, 0x12, 0xe4,
-	0xf9, 0xd4, 0x01, 0x5d, 0xcb, 0xf0, 0xb2, 0x7f, 0xf8, 0x52, 0xbc, 0x3e, 0x0a, 0x29, 0x2f, 0x2e,
-	0xe5, 0xfd, 0x9f, 0x7d, 0xfd, 0x8f, 0x5f, 0x4f, 0x2c, 0xa3, 0x45, 0x75, 0xd0, 0xd0, 0x08, 0xfd,
-	0x41, 0x82, 0x53, 0x3d, 0xe3, 0x13, 0x54, 0x1e, 0xae, 0xa6, 0x77, 0x48, 0x53, 0xbc, 0x33, 0x16,
-	0x8f, 0xb0, 0x51, 0x65, 0x36, 0x5e, 0x43, 0x57, 0x07, 0xda, 0xa8, 0xbe, 0x14, 0xf0, 0xbb, 0x8f,
-	0xfe, 0x28, 0xc1, 0xe9, 0xbe, 0x67, 0x02, 0x5a, 0x1b, 0xa4, 0x3b, 0x6b, 0x7c, 0x53, 0xfc, 0x60,
-	0x4c, 0x2e, 0x61, 0xf3, 0x2a, 0xb3, 0xf9, 0x06, 0xba, 0x96, 0x61, 0x73, 0xff, 0x03, 0x05, 0xbd,
-	0x96, 0x60, 0xae, 0x57, 0x20, 0xba, 0x33, 0x8e, 0xfa, 0xc8, 0xe6, 0xb5, 0xf1, 0x98, 0x84, 0xc9,
-	0xdb, 0xcc, 0xe4, 0x2d, 0xf4, 0xf9, 0xc8, 0x26, 0xab, 0x2f, 0xbb, 0xde, 0x0e, 0xfb, 0xfd, 0x24,
-	0xe8, 0x77, 0x12, 0xcc, 0x76, 0xcf, 0x1d, 0xd0, 0xea, 0x20, 0xeb, 0x52, 0xc7, 0x29, 0xc5, 0xf2,
-	0x38, 0x2c, 0xc2, 0x9d, 0x12, 0x73, 0x67, 0x05, 0x5d, 0x51, 0x33, 0x47, 0x9d, 0xc9, 0x47, 0x05,
-	0xfa, 0xa7, 0x04, 0xcb, 0x43, 0x5e, 0x98, 0xa8, 0x32, 0xc8, 0x8e, 0xd1, 0x9e, 0xcb, 0xc5, 0x8d,
-	0x43, 0xc9, 0x10, 0xce, 0x7d, 0x97, 0x39, 0xb7, 0x86, 0xca, 0x63, 0xe4, 0x8a, 0x03, 0xd0, 0x3e,
-	0xfa, 0xaf, 0x04, 0x8b, 0x03, 0x67, 0x1c, 0xe8, 0xfe, 0x38, 0xf5, 0x93, 0x36, 0x86, 0x29, 0xae,
-	0x1f, 0x42, 0x82, 0x70, 0xb1, 0xca, 0x5c, 0xfc, 0x0c, 0x3d, 0x3c, 0x78, 0x39, 0x32, 0x84, 0x8d,
-	0x1d, 0xff, 0xb7, 0x04, 0x17, 0x06, 0x0d, 0x4f, 0xd0, 0xbd, 0x71, 0xac, 0x4e, 0x99, 0xe2, 0x14,
-	0xef, 0x1f, 0x5c, 0x80, 0xf0, 0xfa, 0x01, 0xf3, 0x7a, 0x1d, 0xdd, 0x3b, 0xa4, 0xd7, 0x0c, 0xb1,
-	0x7b, 0x06, 0x07, 0x83, 0x11, 0x3b, 0x7d, 0x08, 0x31, 0x18, 0xb1, 0x33, 0x26, 0x13, 0x43, 0x11,
-	0xdb, 0x88, 0xf8, 0xc4, 0x29, 0x8a, 0xfe, 0x23, 0xc1, 0xc2, 0x80, 0xb1, 0x00, 0xfa, 0x78, 0x9c,
-	0xc0, 0xa6, 0x00, 0xc8, 0xbd, 0x03, 0xf3, 0x0b, 0x8f, 0xb6, 0x98, 0x47, 0x0f, 0xd0, 0x27, 0x07,
-	0xcf, 0x4b, 0x12, 0x6c, 0xfe, 0x24, 0xc1, 0x4c, 0x17, 0x6e, 0xa1, 0xdb, 0x23, 0x43, 0x5c, 0xe4,
-	0xd3, 0xea, 0x18, 0x1c, 0xc2, 0x8b, 0x4d, 0xe6, 0xc5, 0xc7, 0xe8, 0x7b, 0xa3, 0x61, 0xa2, 0xfa,
-	0x32, 0x65, 0x52, 0xb1, 0x5f, 0x79, 0xfc, 0xe5, 0xdb, 0x25, 0xe9, 0xab, 0xb7, 0x4b, 0xd2, 0xdf,
-	0xdf, 0x2e, 0x49, 0xbf, 0x7a, 0xb7, 0x74, 0xec, 0xab, 0x77, 0x4b, 0xc7, 0xfe, 0xf6, 0x6e, 0xe9,
-	0xd8, 0x8f, 0x86, 0x5e, 0xe9, 0xda, 0x49, 0x85, 0xec, 0x7e, 0x57, 0xcb, 0xb3, 0xff, 0x91, 0xee,
-	0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x92, 0xc6, 0x90, 0x35, 0x91, 0x1b, 0x00, 0x00,
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+// QueryConsumerFinalityProvidersAtHeightResponse is the response type for the
+// Query/ConsumerFinalityProvidersAtHeight RPC method.
+type QueryConsumerFinalityProvidersAtHeightResponse struct {
+	// finality_providers contains the consumer finality providers with voting power at the given height
+	FinalityProviders []*FinalityProviderWithMeta `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) Reset() {
+	*m = QueryConsumerFinalityProvidersAtHeightResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*QueryConsumerFinalityProvidersAtHeightResponse) ProtoMessage() {}
+func (*QueryConsumerFinalityProvidersAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
 }
-
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightResponse.Merge(m, src)
 }
-
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	out := new(QueryActiveFinalityProvidersAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	out := new(QueryFinalityProviderPowerAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryConsumerFinalityProvidersAtHeightResponse proto.InternalMessageInfo
 
-func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	out := new(QueryFinalityProviderCurrentPowerResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) GetFinalityProviders() []*FinalityProviderWithMeta {
+	if m != nil {
+		return m.FinalityProviders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
-	out := new(QueryActivatedHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,6,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,7,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,8,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,9,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,10,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,11,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,12,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,13,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,14,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,15,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,16,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
-}
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
-}
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
-}
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
 }
-func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+func (m *BTCDelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+
+func (m *BTCDelegationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *BTCDelegationResponse) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashSigHex
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSigs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
+	return nil
+}
+
+func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
+	return ""
+}
+
+func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	return 0
+}
+
+func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+	if m != nil {
+		return m.UndelegationResponse
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	return nil
+}
+
+func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// BTCUndelegationResponse provides all necessary info about the undeleagation
+type BTCUndelegationResponse struct {
+	// unbonding_tx is the transaction which will transfer the funds from staking
+	// output to unbonding output. Unbonding output will usually have lower timelock
+	// than staking output. The unbonding tx as string hex.
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// delegator_unbonding_sig is the signature on the unbonding tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It effectively proves that the delegator wants to unbond and thus
+	// Babylon will consider this BTC delegation unbonded. Delegator's BTC
+	// on Bitcoin will be unbonded after timelock. The unbonding delegator sig as string hex.
+	DelegatorUnbondingSigHex string `protobuf:"bytes,2,opt,name=delegator_unbonding_sig_hex,json=delegatorUnbondingSigHex,proto3" json:"delegator_unbonding_sig_hex,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
+	// by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,3,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// slashingTxHex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,4,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It will be a part of the witness for the unbonding tx output.
+	// The delegator slashing sig as string hex.
+	DelegatorSlashingSigHex string `protobuf:"bytes,5,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the
+	// unbonding slashing tx by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,6,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
+func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegationResponse) ProtoMessage()    {}
+func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{31}
+}
+func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+}
+func (m *BTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
 }
 
-func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveFinalityProvidersAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
+var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+
+func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderPowerAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
+func (m *BTCUndelegationResponse) GetDelegatorUnbondingSigHex() string {
+	if m != nil {
+		return m.DelegatorUnbondingSigHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderCurrentPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
+func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivatedHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActivatedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "ActiveFinalityProvidersAtHeight",
-			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderPowerAtHeight",
-			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderCurrentPower",
-			Handler:    _Query_FinalityProviderCurrentPower_Handler,
-		},
-		{
-			MethodName: "ActivatedHeight",
-			Handler:    _Query_ActivatedHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
+type BTCDelegatorDelegationsResponse struct {
+	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
+func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+	if m != nil {
+		return m.Dels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalityProviderResponse defines a finality provider with voting power information.
+type FinalityProviderResponse struct {
+	// description defines the description terms for the finality provider.
+	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider.
+	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// addr is the address to receive commission from delegations.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of the BTC_PK by the fp addr.
+	// Essentially is the signature where the BTC SK sigs the fp addr.
+	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// slashed_babylon_height indicates the Babylon height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height indicates the BTC height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// voting_power is the voting power of this finality provider at the given height
+	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// consumer_id is the ID of the consumer chain the finality provider
+	// provides finality for. If it's empty then the finality provider
+	// provides finality for Babylon
+	ConsumerId string `protobuf:"bytes,10,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,11,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// max_commission_rate is the maximum commission rate that the finality
+	// provider can ever charge
+	MaxCommissionRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate,omitempty"`
+	// max_commission_change_rate is the maximum change of the commission rate
+	// in a single commission change
+	MaxCommissionChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate,omitempty"`
+	// commission_update_height is the Babylon height of the last commission
+	// change request, or of the registration if there is none
+	CommissionUpdateHeight uint64 `protobuf:"varint,14,opt,name=commission_update_height,json=commissionUpdateHeight,proto3" json:"commission_update_height,omitempty"`
+	// pending_commission is the commission rate that is scheduled to take
+	// effect at pending_commission_height, if any
+	PendingCommission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=pending_commission,json=pendingCommission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pending_commission,omitempty"`
+	// pending_commission_height is the Babylon height at which
+	// pending_commission takes effect
+	PendingCommissionHeight uint64 `protobuf:"varint,16,opt,name=pending_commission_height,json=pendingCommissionHeight,proto3" json:"pending_commission_height,omitempty"`
+	// retired_babylon_height indicates the Babylon height when the finality
+	// provider is retired.
+	// if it's 0 then the finality provider is not retired
+	RetiredBabylonHeight uint64 `protobuf:"varint,17,opt,name=retired_babylon_height,json=retiredBabylonHeight,proto3" json:"retired_babylon_height,omitempty"`
+	// previous_addrs are the Babylon addresses that were bound to the
+	// finality provider before address rotations
+	PreviousAddrs []string `protobuf:"bytes,18,rep,name=previous_addrs,json=previousAddrs,proto3" json:"previous_addrs,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
+func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderResponse) ProtoMessage()    {}
+func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
+}
+func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+}
+func (m *FinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+
+func (m *FinalityProviderResponse) GetDescription() *types.Description {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.Pop
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.SlashedBtcHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *FinalityProviderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *FinalityProviderResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetCommissionUpdateHeight() uint64 {
+	if m != nil {
+		return m.CommissionUpdateHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetPendingCommissionHeight() uint64 {
+	if m != nil {
+		return m.PendingCommissionHeight
+	}
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *FinalityProviderResponse) GetRetiredBabylonHeight() uint64 {
+	if m != nil {
+		return m.RetiredBabylonHeight
 	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *FinalityProviderResponse) GetPreviousAddrs() []string {
+	if m != nil {
+		return m.PreviousAddrs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
+	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*QueryBTCDelegationsByStakerAddrRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerAddrRequest")
+	proto.RegisterType((*QueryBTCDelegationsByStakerAddrResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerAddrResponse")
+	proto.RegisterType((*QueryBTCDelegationsByDelegatorBtcPkRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByDelegatorBtcPkRequest")
+	proto.RegisterType((*QueryBTCDelegationsByDelegatorBtcPkResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByDelegatorBtcPkResponse")
+	proto.RegisterType((*QueryConsumersRequest)(nil), "babylon.btcstaking.v1.QueryConsumersRequest")
+	proto.RegisterType((*QueryConsumersResponse)(nil), "babylon.btcstaking.v1.QueryConsumersResponse")
+	proto.RegisterType((*QueryConsumerFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryConsumerFinalityProvidersAtHeightRequest")
+	proto.RegisterType((*QueryConsumerFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryConsumerFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 2352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0x8e, 0x63, 0xbf, 0xb1, 0x27, 0x4e, 0xc5, 0xb1, 0x27, 0xe3, 0xf8, 0x23, 0xb3,
+	0xd9, 0xc4, 0xf9, 0xf0, 0x4c, 0xec, 0x78, 0xc3, 0x92, 0x25, 0x1f, 0x1e, 0x3b, 0x9f, 0x1b, 0x2b,
+	0xa6, 0x9d, 0x80, 0xc4, 0x2e, 0xb4, 0x6a, 0xba, 0xcb, 0x3d, 0xbd, 0x99, 0xe9, 0xee, 0x74, 0xd5,
+	0x98, 0xb1, 0x2c, 0x5f, 0x10, 0xe2, 0x82, 0x90, 0x10, 0xfc, 0x11, 0xac, 0xc4, 0x05, 0x89, 0x9c,
+	0x90, 0xb8, 0x2f, 0x07, 0xa4, 0x55, 0xf6, 0x00, 0x44, 0x10, 0x41, 0x82, 0x40, 0x42, 0x42, 0x1c,
+	0x90, 0x38, 0xa3, 0xa9, 0xae, 0x9e, 0xee, 0x9e, 0xe9, 0x9e, 0x2f, 0x7b, 0xa5, 0xcd, 0xcd, 0x53,
+	0xf5, 0xbe, 0x7e, 0xef, 0xbd, 0x7a, 0xef, 0x75, 0x95, 0xe1, 0x74, 0x01, 0x17, 0x76, 0x4a, 0x96,
+	0x99, 0x2b, 0x30, 0x95, 0x32, 0xfc, 0xd4, 0x30, 0xf5, 0xdc, 0xf6, 0x62, 0xee, 0x59, 0x85, 0x38,
+	0x3b, 0x59, 0xdb, 0xb1, 0x98, 0x85, 0x4e, 0x08, 0x92, 0xac, 0x4f, 0x92, 0xdd, 0x5e, 0x4c, 0x8f,
+	0xeb, 0x96, 0x6e, 0x71, 0x8a, 0x5c, 0xed, 0x2f, 0x97, 0x38, 0x7d, 0x4a, 0xb7, 0x2c, 0xbd, 0x44,
+	0x72, 0xd8, 0x36, 0x72, 0xd8, 0x34, 0x2d, 0x86, 0x99, 0x61, 0x99, 0x54, 0xec, 0x9e, 0x54, 0x2d,
+	0x5a, 0xb6, 0xa8, 0xe2, 0xb2, 0xb9, 0x3f, 0xc4, 0xd6, 0x19, 0xf7, 0x57, 0xce, 0x37, 0xa2, 0x40,
+	0x18, 0x5e, 0xf4, 0x7e, 0x0b, 0xaa, 0x0b, 0x82, 0xaa, 0x80, 0x29, 0x71, 0x8d, 0xac, 0x13, 0xda,
+	0x58, 0x37, 0x4c, 0xae, 0x4d, 0xd0, 0x66, 0xa2, 0xa1, 0xd9, 0xd8, 0xc1, 0x65, 0x4f, 0xeb, 0xd9,
+	0x68, 0x1a, 0xff, 0x97, 0xa0, 0x9b, 0x8d, 0x91, 0x65, 0xd9, 0x2e, 0x41, 0x66, 0x1c, 0xd0, 0x37,
+	0x6b, 0xe6, 0x6c, 0x70, 0xe9, 0x32, 0x79, 0x56, 0x21, 0x94, 0x65, 0x64, 0x38, 0x1e, 0x5a, 0xa5,
+	0xb6, 0x65, 0x52, 0x82, 0x3e, 0x80, 0x41, 0xd7, 0x8a, 0x94, 0x34, 0x27, 0xcd, 0x27, 0x96, 0xa6,
+	0xb3, 0x91, 0x2e, 0xce, 0xba, 0x6c, 0xf9, 0x81, 0xcf, 0x5e, 0xcd, 0x1e, 0x92, 0x05, 0x4b, 0xe6,
+	0x6b, 0x30, 0x15, 0x90, 0x99, 0xdf, 0xf9, 0x16, 0x71, 0xa8, 0x61, 0x99, 0x42, 0x25, 0x4a, 0xc1,
+	0x91, 0x6d, 0x77, 0x85, 0x0b, 0x1f, 0x95, 0xbd, 0x9f, 0x99, 0x8f, 0xe0, 0x54, 0x34, 0xe3, 0x41,
+	0x58, 0xa5, 0xc3, 0x34, 0x17, 0x7e, 0xc7, 0x30, 0x71, 0xc9, 0x60, 0x3b, 0x1b, 0x8e, 0xb5, 0x6d,
+	0x68, 0xc4, 0xf1, 0x5c, 0x81, 0xee, 0x00, 0xf8, 0x11, 0x12, 0x1a, 0xce, 0x66, 0x45, 0x0a, 0xd4,
+	0xc2, 0x99, 0x75, 0x73, 0x4e, 0x84, 0x33, 0xbb, 0x81, 0x75, 0x22, 0x78, 0xe5, 0x00, 0x67, 0xe6,
+	0x77, 0x12, 0xcc, 0xc4, 0x69, 0x12, 0x40, 0xbe, 0x07, 0x68, 0x4b, 0x6c, 0x2a, 0xb6, 0xb7, 0x9b,
+	0x92, 0xe6, 0xfa, 0xe7, 0x13, 0x4b, 0xb9, 0x18, 0x50, 0x8d, 0xd2, 0x3c, 0x61, 0xf2, 0xb1, 0xad,
+	0x46, 0x3d, 0xe8, 0x6e, 0x08, 0x4a, 0x1f, 0x87, 0x72, 0xae, 0x2d, 0x14, 0x21, 0x2f, 0x88, 0x65,
+	0x45, 0x44, 0xa4, 0x59, 0xb9, 0xeb, 0xb3, 0xd3, 0x30, 0xba, 0x65, 0x2b, 0x05, 0xa6, 0x2a, 0xf6,
+	0x53, 0xa5, 0x48, 0xaa, 0xdc, 0x6d, 0xc3, 0x32, 0x6c, 0xd9, 0x79, 0xa6, 0x6e, 0x3c, 0xbd, 0x47,
+	0xaa, 0x99, 0xbd, 0x18, 0xbf, 0xd7, 0x9d, 0xf1, 0x31, 0x1c, 0x6b, 0x72, 0x86, 0x70, 0x7f, 0xd7,
+	0xbe, 0x18, 0x6b, 0xf4, 0x45, 0xe6, 0x53, 0x09, 0xd2, 0x5c, 0x7f, 0xfe, 0xf1, 0xea, 0x1a, 0x29,
+	0x11, 0xdd, 0x3d, 0xee, 0x1e, 0x80, 0x3c, 0x0c, 0x52, 0x86, 0x59, 0xc5, 0x4d, 0xa9, 0xe4, 0xd2,
+	0x85, 0x18, 0x8d, 0x21, 0xee, 0x4d, 0xce, 0x21, 0x0b, 0x4e, 0x74, 0x27, 0xc2, 0xdb, 0xbd, 0x24,
+	0xce, 0x6f, 0x25, 0x71, 0x70, 0x1a, 0x4d, 0x15, 0x8e, 0x7a, 0x02, 0x47, 0x6b, 0x9e, 0xd6, 0xfc,
+	0x2d, 0x91, 0x32, 0x97, 0x3a, 0x31, 0xba, 0xee, 0xa3, 0x64, 0x81, 0xa9, 0x01, 0xf1, 0x07, 0x97,
+	0x2c, 0x5b, 0x70, 0x3e, 0x32, 0xd2, 0x1b, 0xd6, 0xf7, 0x89, 0xb3, 0xc2, 0xee, 0x11, 0x43, 0x2f,
+	0xb2, 0xce, 0x33, 0x07, 0x4d, 0xc0, 0x60, 0x91, 0xf3, 0x70, 0xa3, 0x06, 0x64, 0xf1, 0x2b, 0xf3,
+	0x08, 0x2e, 0x74, 0xa2, 0x47, 0x78, 0xed, 0x34, 0x8c, 0x6c, 0x5b, 0xcc, 0x30, 0x75, 0xc5, 0xae,
+	0xed, 0x73, 0x3d, 0x03, 0x72, 0xc2, 0x5d, 0xe3, 0x2c, 0x99, 0x75, 0x98, 0x8f, 0x14, 0xb8, 0x5a,
+	0x71, 0x1c, 0x62, 0x32, 0x4e, 0xd4, 0x45, 0xc6, 0xc7, 0xf9, 0x21, 0x2c, 0x4e, 0x98, 0xe7, 0x83,
+	0x94, 0x82, 0x20, 0x9b, 0xcc, 0xee, 0x6b, 0x36, 0xfb, 0x27, 0x12, 0x5c, 0xe4, 0x8a, 0x56, 0x54,
+	0x66, 0x6c, 0x93, 0x46, 0x75, 0xb4, 0xd1, 0xe5, 0x71, 0xaa, 0x0e, 0x2a, 0x7f, 0xff, 0x20, 0xc1,
+	0xa5, 0xce, 0xec, 0x39, 0xc0, 0x32, 0xf8, 0x6d, 0x83, 0x15, 0xd7, 0x09, 0xc3, 0x5f, 0x6a, 0x19,
+	0x9c, 0x86, 0x29, 0x1f, 0x18, 0x66, 0x44, 0x0b, 0x39, 0x36, 0x73, 0x15, 0x4e, 0x45, 0x6f, 0xb7,
+	0x8e, 0x71, 0xe6, 0xcf, 0x12, 0x9c, 0x8b, 0xcc, 0x94, 0x88, 0x42, 0xd5, 0xc1, 0x79, 0x39, 0xa0,
+	0x38, 0xa2, 0x3b, 0x30, 0xe4, 0x56, 0x36, 0x42, 0x53, 0xfd, 0x73, 0xfd, 0x5d, 0x56, 0xc5, 0x3a,
+	0x6f, 0xe6, 0x9f, 0x52, 0xcc, 0xb9, 0x8a, 0x2a, 0x6e, 0x0e, 0x9c, 0x0c, 0x14, 0x37, 0xcb, 0x89,
+	0x28, 0x73, 0x57, 0xdb, 0x5a, 0x61, 0x45, 0x89, 0x96, 0x27, 0xfd, 0x82, 0x17, 0x22, 0x38, 0xb8,
+	0xfc, 0x78, 0x00, 0x27, 0x9b, 0x0b, 0xb7, 0x17, 0xb9, 0x05, 0x38, 0x2e, 0x8c, 0x55, 0x58, 0x55,
+	0x29, 0x62, 0x5a, 0x0c, 0xc4, 0x6f, 0x4c, 0x6c, 0x3d, 0xae, 0xde, 0xc3, 0xb4, 0x58, 0xab, 0x1e,
+	0xcf, 0xa2, 0xfa, 0x55, 0xdd, 0x4d, 0x9b, 0x90, 0x0c, 0xf7, 0x00, 0xd1, 0x29, 0xbb, 0x6b, 0x01,
+	0xa3, 0xa1, 0x16, 0x90, 0xf9, 0xaf, 0x04, 0x67, 0x9b, 0x75, 0xd2, 0xfc, 0xce, 0x26, 0xc3, 0x4f,
+	0x89, 0xb3, 0xa2, 0x69, 0xf5, 0xf2, 0xf7, 0x75, 0x48, 0x50, 0xbe, 0xa8, 0x60, 0x4d, 0x73, 0x8b,
+	0xe9, 0x70, 0x3e, 0xf5, 0xe2, 0xf9, 0xc2, 0xb8, 0x70, 0x5b, 0x8d, 0x98, 0x50, 0xba, 0xc9, 0x1c,
+	0xc3, 0xd4, 0x65, 0xa0, 0x75, 0x09, 0xa1, 0xb4, 0xea, 0xeb, 0x3d, 0xad, 0x1a, 0xd2, 0xbc, 0x7f,
+	0x3f, 0x73, 0xda, 0xb9, 0xb6, 0xa8, 0xdf, 0x92, 0xd6, 0xfb, 0x37, 0x49, 0xf4, 0xc4, 0x46, 0x2c,
+	0xf5, 0xbc, 0xe7, 0x55, 0xc2, 0x8b, 0xe2, 0x3b, 0x90, 0xd4, 0x48, 0xa9, 0xb9, 0x9a, 0x24, 0x34,
+	0x52, 0x0a, 0x94, 0x93, 0xaf, 0x56, 0xbc, 0x7e, 0xef, 0xb5, 0xbb, 0x76, 0x18, 0xdf, 0x92, 0x98,
+	0x29, 0x70, 0x82, 0xc3, 0x59, 0xb5, 0x4c, 0x5a, 0x29, 0x7f, 0x09, 0x1f, 0x22, 0x9f, 0x4a, 0x30,
+	0xd1, 0xa8, 0x41, 0xf8, 0xe6, 0x36, 0x0c, 0xab, 0xde, 0xa2, 0xf0, 0xca, 0xb9, 0x18, 0xaf, 0x78,
+	0xcc, 0x32, 0xd1, 0x0d, 0xca, 0x88, 0x23, 0xfb, 0x9c, 0x07, 0xe7, 0x8b, 0x5f, 0x49, 0xb0, 0x10,
+	0x32, 0xb5, 0xed, 0x30, 0x33, 0x0b, 0x09, 0xcf, 0x0e, 0xc5, 0xd0, 0xbc, 0x6e, 0xe8, 0x2d, 0xdd,
+	0xd7, 0xe2, 0xa6, 0xc7, 0x03, 0x4b, 0xc7, 0x3f, 0x49, 0x90, 0xed, 0xd4, 0xe4, 0xb7, 0x6d, 0xde,
+	0xf9, 0x62, 0x10, 0x4e, 0x44, 0xf7, 0x9f, 0x7d, 0xd4, 0xff, 0x75, 0x18, 0x74, 0x0b, 0x0e, 0xb7,
+	0x6c, 0x24, 0x7f, 0xf5, 0xe5, 0xab, 0xd9, 0x25, 0xdd, 0x60, 0xc5, 0x4a, 0x21, 0xab, 0x5a, 0xe5,
+	0x9c, 0xc0, 0xaf, 0x16, 0xb1, 0x61, 0x7a, 0x3f, 0x72, 0x6c, 0xc7, 0x26, 0x34, 0x9b, 0xbf, 0xbf,
+	0x71, 0x65, 0xf9, 0xf2, 0x46, 0xa5, 0xf0, 0x21, 0xd9, 0x91, 0x0f, 0x17, 0x6a, 0xc7, 0x1c, 0x7d,
+	0x04, 0x49, 0x7f, 0x20, 0x2a, 0x19, 0x94, 0xf1, 0x59, 0xa5, 0x77, 0xb1, 0x09, 0x31, 0x49, 0x3d,
+	0x34, 0xf8, 0xb4, 0x35, 0x42, 0x19, 0x76, 0x98, 0x22, 0x52, 0x68, 0xc0, 0x9d, 0xbe, 0xf9, 0x9a,
+	0x1b, 0x54, 0x34, 0x0d, 0x40, 0x4c, 0xcd, 0x23, 0x38, 0xcc, 0x09, 0x86, 0x89, 0x29, 0x66, 0x3f,
+	0x34, 0x05, 0xc3, 0xcc, 0x62, 0xb8, 0xa4, 0x50, 0xcc, 0x52, 0x83, 0x7c, 0x77, 0x88, 0x2f, 0x6c,
+	0x62, 0x86, 0xce, 0x40, 0x32, 0x38, 0x12, 0x90, 0x6a, 0xea, 0x08, 0xcf, 0xdf, 0x11, 0x7f, 0x1a,
+	0x20, 0x55, 0x74, 0x16, 0x8e, 0xd2, 0x12, 0xa6, 0xc5, 0x00, 0xd9, 0x10, 0x27, 0x1b, 0xf5, 0x96,
+	0x5d, 0xba, 0xf7, 0x60, 0xd2, 0x1f, 0x9b, 0xf8, 0x96, 0x42, 0x0d, 0x9d, 0xd3, 0x0f, 0x73, 0xfa,
+	0xf1, 0xfa, 0xf6, 0x66, 0x6d, 0x77, 0xd3, 0xd0, 0x6b, 0x6c, 0x4f, 0x60, 0x54, 0xb5, 0xb6, 0x89,
+	0x89, 0x4d, 0x56, 0xa3, 0xa7, 0x29, 0xe0, 0x89, 0x78, 0x39, 0xb6, 0x0e, 0xb8, 0xb4, 0x2b, 0x1a,
+	0xb6, 0x6b, 0x92, 0x0c, 0xdd, 0xc4, 0xac, 0xe2, 0x10, 0x2a, 0x8f, 0x78, 0x62, 0x36, 0x0d, 0x9d,
+	0xa2, 0x4b, 0x80, 0x3c, 0x6c, 0x56, 0x85, 0xd9, 0x15, 0xa6, 0x18, 0x5a, 0x35, 0x95, 0xe0, 0x37,
+	0x3d, 0xde, 0xb4, 0xf3, 0x88, 0x6f, 0xdc, 0xd7, 0xf8, 0x37, 0x1e, 0xe6, 0x5f, 0x0b, 0xa9, 0x91,
+	0x39, 0x69, 0x7e, 0x48, 0x16, 0xbf, 0x6a, 0xc7, 0xdb, 0x6d, 0x20, 0x8a, 0x46, 0xa8, 0x9a, 0x1a,
+	0x75, 0x8f, 0xb7, 0xbb, 0xb4, 0x46, 0xa8, 0x8a, 0xde, 0x85, 0x64, 0xc5, 0x2c, 0x58, 0xa6, 0xc6,
+	0xbd, 0x63, 0x94, 0x49, 0x2a, 0xc9, 0x55, 0x8c, 0xd6, 0x57, 0x1f, 0x1b, 0x65, 0x82, 0x54, 0x38,
+	0x51, 0x31, 0xfd, 0x16, 0xa0, 0x38, 0x22, 0x91, 0x53, 0x47, 0xf9, 0xe9, 0xc8, 0xc6, 0xb7, 0x82,
+	0x27, 0xa6, 0xd6, 0x94, 0xfe, 0xf2, 0x78, 0x25, 0x62, 0xb5, 0x66, 0x8b, 0x7b, 0xc9, 0xa4, 0x78,
+	0x17, 0x5b, 0x63, 0xae, 0x2d, 0xee, 0xaa, 0xb8, 0xc6, 0xca, 0x3c, 0xef, 0x87, 0xc9, 0x18, 0xc1,
+	0x68, 0x1e, 0xc6, 0x02, 0x70, 0xaa, 0x81, 0x9e, 0xec, 0xc3, 0x74, 0xa3, 0x7d, 0x1d, 0xa6, 0xfc,
+	0x68, 0xfb, 0x3c, 0x5e, 0xc4, 0xfb, 0x38, 0x53, 0xaa, 0x4e, 0xf2, 0xc4, 0xa3, 0x10, 0x51, 0x57,
+	0x61, 0xaa, 0x1e, 0xf5, 0x30, 0x77, 0xfd, 0x0c, 0x25, 0x96, 0xce, 0xc4, 0xb8, 0xa5, 0x1e, 0xf4,
+	0xfb, 0xe6, 0x96, 0x25, 0xa7, 0x3c, 0x41, 0x41, 0x1d, 0xfc, 0xf8, 0x44, 0x64, 0xee, 0x40, 0x54,
+	0xe6, 0x7e, 0x00, 0xe9, 0x86, 0xcc, 0x0d, 0x42, 0x39, 0xcc, 0x59, 0x26, 0xc3, 0xc9, 0xeb, 0x23,
+	0xd9, 0x82, 0x09, 0x3f, 0x7f, 0x03, 0xbc, 0x34, 0x35, 0xd8, 0x63, 0x22, 0x8f, 0xd7, 0x13, 0xd9,
+	0xd7, 0x44, 0x33, 0x2a, 0xcc, 0xb6, 0xf9, 0xc2, 0x40, 0xb7, 0x60, 0x40, 0x23, 0xa5, 0xde, 0xe6,
+	0x0b, 0xce, 0x99, 0x79, 0x39, 0x04, 0xa9, 0xd8, 0x1b, 0xb2, 0xdb, 0x90, 0xa8, 0x9d, 0x02, 0xc7,
+	0xb0, 0x03, 0x13, 0xc1, 0x3b, 0x5e, 0x61, 0xf7, 0x35, 0xb8, 0x55, 0x7d, 0xcd, 0x27, 0x95, 0x83,
+	0x7c, 0x68, 0x1d, 0x40, 0xb5, 0xca, 0x65, 0x83, 0x52, 0xaf, 0x3d, 0x0c, 0xe7, 0x17, 0x5e, 0xbe,
+	0x9a, 0x9d, 0x72, 0x05, 0x51, 0xed, 0x69, 0xd6, 0xb0, 0x72, 0x65, 0xcc, 0x8a, 0xd9, 0x87, 0x44,
+	0xc7, 0xea, 0xce, 0x1a, 0x51, 0x5f, 0x3c, 0x5f, 0x00, 0xa1, 0x67, 0x8d, 0xa8, 0x72, 0x40, 0x00,
+	0xba, 0x04, 0x03, 0xbc, 0x07, 0xf4, 0xb7, 0xe9, 0x01, 0x03, 0x38, 0x5c, 0xfd, 0x07, 0x0e, 0xa2,
+	0xfa, 0x5f, 0x87, 0x7e, 0xdb, 0xb2, 0x79, 0x8a, 0x24, 0x96, 0x2e, 0xc6, 0xdd, 0x03, 0x3b, 0x96,
+	0xb5, 0xf5, 0x68, 0x6b, 0xc3, 0xa2, 0x94, 0x70, 0x9b, 0xf3, 0x8f, 0x57, 0xe5, 0x1a, 0x1f, 0x5a,
+	0x86, 0x09, 0x9e, 0x32, 0x44, 0x53, 0x04, 0xab, 0x57, 0xc8, 0xdd, 0x52, 0x3d, 0x2e, 0x76, 0xf3,
+	0xee, 0xa6, 0xa8, 0xe9, 0xb5, 0xd2, 0xe6, 0x71, 0x31, 0xd5, 0xe3, 0x38, 0xc2, 0x39, 0xc6, 0x3c,
+	0x0e, 0xa6, 0x0a, 0x6a, 0x7f, 0x00, 0x19, 0x6a, 0x79, 0xb3, 0x33, 0xdc, 0x74, 0xb3, 0xd3, 0x38,
+	0xdc, 0x40, 0xd4, 0x70, 0xf3, 0x09, 0x36, 0x4a, 0x44, 0xe3, 0x85, 0x75, 0x48, 0x16, 0xbf, 0xd0,
+	0x77, 0xe1, 0x78, 0x19, 0x57, 0x15, 0x3f, 0x4a, 0x8a, 0x83, 0x99, 0x5b, 0x5b, 0xbb, 0x8e, 0xf5,
+	0xb1, 0x32, 0xae, 0xae, 0xd6, 0x05, 0xc9, 0x98, 0x11, 0xf4, 0x09, 0xa4, 0x1b, 0xc4, 0xab, 0x45,
+	0x6c, 0xea, 0xc4, 0xd5, 0x32, 0xda, 0x8b, 0x96, 0xc9, 0x90, 0x96, 0x55, 0x2e, 0x8e, 0xeb, 0x7a,
+	0x1f, 0x52, 0x01, 0x3d, 0x15, 0x5b, 0xc3, 0x8c, 0x78, 0x2e, 0x4f, 0x72, 0x97, 0x4d, 0xf8, 0xfb,
+	0x4f, 0xf8, 0xb6, 0x70, 0xfc, 0xc7, 0x80, 0x6c, 0xe2, 0xd6, 0xb5, 0x40, 0xbe, 0x1f, 0xed, 0xc9,
+	0x07, 0x42, 0x90, 0x6f, 0x21, 0xba, 0x06, 0x27, 0x9b, 0xa5, 0x7b, 0x86, 0x8d, 0x71, 0xc3, 0x26,
+	0x9b, 0xb8, 0x84, 0x65, 0xcb, 0x30, 0xe1, 0x10, 0x66, 0x38, 0xcd, 0x69, 0x77, 0xcc, 0x4d, 0x3b,
+	0xb1, 0x1b, 0x4e, 0xbb, 0x9b, 0x90, 0xb4, 0x1d, 0xb2, 0x6d, 0x58, 0x15, 0xca, 0xa7, 0x2e, 0x9a,
+	0x42, 0x73, 0xfd, 0x2d, 0x8f, 0xdc, 0xa8, 0x47, 0x5f, 0x5b, 0xa6, 0x4b, 0x3f, 0x4e, 0xc1, 0x61,
+	0x3e, 0xaa, 0xa2, 0x1f, 0x49, 0x30, 0xe8, 0xbe, 0x8e, 0xa0, 0xf3, 0x31, 0x87, 0xa6, 0xf9, 0x91,
+	0x28, 0x7d, 0xa1, 0x13, 0x52, 0xb7, 0x56, 0x65, 0xde, 0xfd, 0xc1, 0x17, 0x7f, 0xff, 0x79, 0xdf,
+	0x2c, 0x9a, 0xce, 0xb5, 0x7a, 0xdc, 0x42, 0xbf, 0x94, 0xe0, 0x68, 0xc3, 0x33, 0x0f, 0x5a, 0x6a,
+	0xaf, 0xa6, 0xf1, 0x31, 0x29, 0x7d, 0xa5, 0x2b, 0x1e, 0x61, 0x63, 0x8e, 0xdb, 0x78, 0x1e, 0x9d,
+	0x6b, 0x69, 0x63, 0x6e, 0x57, 0x74, 0xf3, 0x3d, 0xf4, 0x6b, 0x09, 0x8e, 0x35, 0x8d, 0xf7, 0x68,
+	0xb9, 0x95, 0xee, 0xb8, 0x67, 0xa6, 0xf4, 0x7b, 0x5d, 0x72, 0x09, 0x9b, 0x17, 0xb9, 0xcd, 0x17,
+	0xd1, 0xf9, 0x18, 0x9b, 0x9b, 0x3f, 0x2c, 0xd0, 0x0b, 0x09, 0xc6, 0x1a, 0x05, 0xa2, 0x2b, 0xdd,
+	0xa8, 0xf7, 0x6c, 0x5e, 0xee, 0x8e, 0x49, 0x98, 0xbc, 0xc9, 0x4d, 0x5e, 0x47, 0x1f, 0x76, 0x6c,
+	0x72, 0x6e, 0x37, 0x74, 0xc7, 0xb9, 0xd7, 0x4c, 0x82, 0x7e, 0x21, 0x41, 0x32, 0x7c, 0x01, 0x80,
+	0x16, 0x5b, 0x59, 0x17, 0xf9, 0xec, 0x93, 0x5e, 0xea, 0x86, 0x45, 0xc0, 0xc9, 0x72, 0x38, 0xf3,
+	0xe8, 0x6c, 0x2e, 0xf6, 0x49, 0x36, 0x78, 0xd9, 0x80, 0xfe, 0x21, 0xc1, 0x6c, 0x9b, 0x9b, 0x70,
+	0x94, 0x6f, 0x65, 0x47, 0x67, 0xd7, 0xfa, 0xe9, 0xd5, 0x7d, 0xc9, 0x10, 0xe0, 0xae, 0x71, 0x70,
+	0xcb, 0x68, 0xa9, 0x8b, 0x58, 0xb9, 0xa5, 0x6b, 0x0f, 0xfd, 0x4f, 0x82, 0xe9, 0x96, 0x6f, 0x31,
+	0xe8, 0x56, 0x37, 0xf9, 0x13, 0xf5, 0x5c, 0x94, 0x5e, 0xd9, 0x87, 0x04, 0x01, 0x71, 0x83, 0x43,
+	0x7c, 0x80, 0xee, 0xf5, 0x9e, 0x8e, 0xbc, 0x61, 0xfb, 0xc0, 0xff, 0x25, 0xc1, 0xa9, 0x56, 0x8f,
+	0x3c, 0xe8, 0x66, 0x37, 0x56, 0x47, 0xbc, 0x36, 0xa5, 0x6f, 0xf5, 0x2e, 0x40, 0xa0, 0xbe, 0xcb,
+	0x51, 0xaf, 0xa0, 0x9b, 0xfb, 0x44, 0xcd, 0x2b, 0x76, 0xc3, 0x03, 0x47, 0xeb, 0x8a, 0x1d, 0xfd,
+	0x58, 0x92, 0xbe, 0xd2, 0x15, 0x4f, 0x87, 0x15, 0x1b, 0x7b, 0x7c, 0xa2, 0x9f, 0xa2, 0x7f, 0x4b,
+	0x30, 0xd5, 0xe2, 0xd9, 0x01, 0xdd, 0xe8, 0xc6, 0xb1, 0x11, 0x05, 0xe4, 0x66, 0xcf, 0xfc, 0x02,
+	0xd1, 0x3a, 0x47, 0x74, 0x17, 0xdd, 0xee, 0x3d, 0x2e, 0xc1, 0x62, 0xf3, 0x1b, 0x09, 0x46, 0x43,
+	0x75, 0x0b, 0x5d, 0xee, 0xb8, 0xc4, 0x79, 0x98, 0x16, 0xbb, 0xe0, 0x10, 0x28, 0xd6, 0x38, 0x8a,
+	0x1b, 0xe8, 0x1b, 0x9d, 0xd5, 0xc4, 0xdc, 0x6e, 0xc4, 0x4b, 0xc8, 0x1e, 0xfa, 0x8b, 0x04, 0xe9,
+	0xf8, 0x4b, 0x78, 0x74, 0xbd, 0xf3, 0x62, 0x1d, 0xf1, 0x64, 0x91, 0xbe, 0xd1, 0x2b, 0xbb, 0xc0,
+	0x78, 0x8b, 0x63, 0xbc, 0x86, 0xde, 0x8f, 0xc1, 0x48, 0x39, 0x8b, 0xc0, 0x26, 0x2e, 0xc6, 0xc2,
+	0xc1, 0xf9, 0x8f, 0x04, 0x33, 0xad, 0x2f, 0xad, 0xd1, 0x4a, 0x37, 0x46, 0x46, 0x5e, 0xea, 0xa7,
+	0xf3, 0xfb, 0x11, 0x21, 0xb0, 0xde, 0xe1, 0x58, 0x6f, 0xa1, 0x1b, 0x31, 0x58, 0xeb, 0xdf, 0xe2,
+	0x34, 0xb7, 0x1b, 0x7e, 0x41, 0x08, 0x23, 0xfe, 0x99, 0x04, 0xc3, 0xf5, 0x5b, 0x67, 0x74, 0xa9,
+	0x95, 0x65, 0x8d, 0xd7, 0xdf, 0xe9, 0x85, 0x0e, 0xa9, 0x85, 0xc9, 0xf3, 0xdc, 0xe4, 0x0c, 0x9a,
+	0x8b, 0x31, 0xd9, 0xbf, 0xad, 0xfe, 0x61, 0x1f, 0x9c, 0x6e, 0x7b, 0x59, 0x8b, 0xd6, 0x3a, 0x51,
+	0xdf, 0xb6, 0x29, 0xdf, 0xde, 0xa7, 0x14, 0x01, 0x4e, 0xe6, 0xe0, 0x1e, 0xa2, 0x07, 0xed, 0xc0,
+	0xe5, 0x76, 0x03, 0x1f, 0x8c, 0x7b, 0xad, 0xda, 0x75, 0xfe, 0xe1, 0x67, 0xaf, 0x67, 0xa4, 0xcf,
+	0x5f, 0xcf, 0x48, 0x7f, 0x7d, 0x3d, 0x23, 0xfd, 0xf4, 0xcd, 0xcc, 0xa1, 0xcf, 0xdf, 0xcc, 0x1c,
+	0xfa, 0xe3, 0x9b, 0x99, 0x43, 0xdf, 0x69, 0xfb, 0x3d, 0x5e, 0x0d, 0xaa, 0xe7, 0x1f, 0xe7, 0x85,
+	0x41, 0xfe, 0xdf, 0x65, 0x57, 0xfe, 0x3f, 0x00, 0x58, 0x2c, 0x57, 0x1c, 0xa7, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// BTCDelegationsByStakerAddr queries all BTC delegations of the given staker's Babylon address
+	BTCDelegationsByStakerAddr(ctx context.Context, in *QueryBTCDelegationsByStakerAddrRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerAddrResponse, error)
+	// BTCDelegationsByDelegatorBtcPk queries all BTC delegations of the given delegator's BTC PK
+	BTCDelegationsByDelegatorBtcPk(ctx context.Context, in *QueryBTCDelegationsByDelegatorBtcPkRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByDelegatorBtcPkResponse, error)
+	// Consumers queries all registered consumer chains
+	Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error)
+	// ConsumerFinalityProvidersAtHeight queries the finality providers of the given
+	// consumer chain with non zero voting power at the given height
+	ConsumerFinalityProvidersAtHeight(ctx context.Context, in *QueryConsumerFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryConsumerFinalityProvidersAtHeightResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	out := new(QueryActiveFinalityProvidersAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	out := new(QueryFinalityProviderPowerAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	out := new(QueryFinalityProviderCurrentPowerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
+	out := new(QueryActivatedHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BTCDelegationsByStakerAddr(ctx context.Context, in *QueryBTCDelegationsByStakerAddrRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerAddrResponse, error) {
+	out := new(QueryBTCDelegationsByStakerAddrResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BTCDelegationsByDelegatorBtcPk(ctx context.Context, in *QueryBTCDelegationsByDelegatorBtcPkRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByDelegatorBtcPkResponse, error) {
+	out := new(QueryBTCDelegationsByDelegatorBtcPkResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByDelegatorBtcPk", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Consumers(ctx context.Context, in *QueryConsumersRequest, opts ...grpc.CallOption) (*QueryConsumersResponse, error) {
+	out := new(QueryConsumersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Consumers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ConsumerFinalityProvidersAtHeight(ctx context.Context, in *QueryConsumerFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryConsumerFinalityProvidersAtHeightResponse, error) {
+	out := new(QueryConsumerFinalityProvidersAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ConsumerFinalityProvidersAtHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// BTCDelegationsByStakerAddr queries all BTC delegations of the given staker's Babylon address
+	BTCDelegationsByStakerAddr(context.Context, *QueryBTCDelegationsByStakerAddrRequest) (*QueryBTCDelegationsByStakerAddrResponse, error)
+	// BTCDelegationsByDelegatorBtcPk queries all BTC delegations of the given delegator's BTC PK
+	BTCDelegationsByDelegatorBtcPk(context.Context, *QueryBTCDelegationsByDelegatorBtcPkRequest) (*QueryBTCDelegationsByDelegatorBtcPkResponse, error)
+	// Consumers queries all registered consumer chains
+	Consumers(context.Context, *QueryConsumersRequest) (*QueryConsumersResponse, error)
+	// ConsumerFinalityProvidersAtHeight queries the finality providers of the given
+	// consumer chain with non zero voting power at the given height
+	ConsumerFinalityProvidersAtHeight(context.Context, *QueryConsumerFinalityProvidersAtHeightRequest) (*QueryConsumerFinalityProvidersAtHeightResponse, error)
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
 }
-
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
 }
-
-func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+}
+func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByStakerAddr(ctx context.Context, req *QueryBTCDelegationsByStakerAddrRequest) (*QueryBTCDelegationsByStakerAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByStakerAddr not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByDelegatorBtcPk(ctx context.Context, req *QueryBTCDelegationsByDelegatorBtcPkRequest) (*QueryBTCDelegationsByDelegatorBtcPkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByDelegatorBtcPk not implemented")
+}
+func (*UnimplementedQueryServer) Consumers(ctx context.Context, req *QueryConsumersRequest) (*QueryConsumersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Consumers not implemented")
+}
+func (*UnimplementedQueryServer) ConsumerFinalityProvidersAtHeight(ctx context.Context, req *QueryConsumerFinalityProvidersAtHeightRequest) (*QueryConsumerFinalityProvidersAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerFinalityProvidersAtHeight not implemented")
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x10
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFinalityProvidersAtHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPowerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderCurrentPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivatedHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ActivatedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BTCDelegationsByStakerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByStakerAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByStakerAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByStakerAddr(ctx, req.(*QueryBTCDelegationsByStakerAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BTCDelegationsByDelegatorBtcPk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByDelegatorBtcPkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByDelegatorBtcPk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByDelegatorBtcPk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByDelegatorBtcPk(ctx, req.(*QueryBTCDelegationsByDelegatorBtcPkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Consumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Consumers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Consumers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Consumers(ctx, req.(*QueryConsumersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func _Query_ConsumerFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerFinalityProvidersAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsumerFinalityProvidersAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ConsumerFinalityProvidersAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsumerFinalityProvidersAtHeight(ctx, req.(*QueryConsumerFinalityProvidersAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "ActiveFinalityProvidersAtHeight",
+			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderPowerAtHeight",
+			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderCurrentPower",
+			Handler:    _Query_FinalityProviderCurrentPower_Handler,
+		},
+		{
+			MethodName: "ActivatedHeight",
+			Handler:    _Query_ActivatedHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByStakerAddr",
+			Handler:    _Query_BTCDelegationsByStakerAddr_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByDelegatorBtcPk",
+			Handler:    _Query_BTCDelegationsByDelegatorBtcPk_Handler,
+		},
+		{
+			MethodName: "Consumers",
+			Handler:    _Query_Consumers_Handler,
+		},
+		{
+			MethodName: "ConsumerFinalityProvidersAtHeight",
+			Handler:    _Query_ConsumerFinalityProvidersAtHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2877,46 +2975,53 @@ func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2926,27 +3031,25 @@ func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2956,19 +3059,52 @@ func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BtcDelegation != nil {
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2981,7 +3117,7 @@ func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2991,26 +3127,19 @@ func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.UndelegationResponse != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3018,39 +3147,12 @@ func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x70
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x12
 	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3058,82 +3160,78 @@ func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BtcPk != nil {
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
 		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3143,76 +3241,37 @@ func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.DelegatorUnbondingSigHex) > 0 {
-		i -= len(m.DelegatorUnbondingSigHex)
-		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3222,20 +3281,32 @@ func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3249,7 +3320,7 @@ func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3259,82 +3330,185 @@ func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x48
-	}
 	if m.Height != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x10
 	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0xa
 	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x8
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x10
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
 	}
-	if m.Commission != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Description != nil {
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3342,502 +3516,2670 @@ func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Statuses) > 0 {
+		dAtA11 := make([]byte, len(m.Statuses)*10)
+		var j10 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	return n
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivatedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationsByStakerAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryActivatedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
+func (m *QueryBTCDelegationsByStakerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBTCDelegationsByStakerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Statuses) > 0 {
+		dAtA17 := make([]byte, len(m.Statuses)*10)
+		var j16 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintQuery(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationsByStakerAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
-	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
-	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
-	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
-	}
-	if m.Active {
-		n += 2
-	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
-	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Statuses) > 0 {
+		dAtA21 := make([]byte, len(m.Statuses)*10)
+		var j20 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ParamsVersion != 0 {
-		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	if len(m.DelBtcPkHex) > 0 {
+		i -= len(m.DelBtcPkHex)
+		copy(dAtA[i:], m.DelBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorUnbondingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatorUnbondingSigHex) > 0 {
+		i -= len(m.DelegatorUnbondingSigHex)
+		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousAddrs) > 0 {
+		for iNdEx := len(m.PreviousAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousAddrs[iNdEx])
+			copy(dAtA[i:], m.PreviousAddrs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PreviousAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.RetiredBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RetiredBabylonHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PendingCommissionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingCommissionHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.PendingCommission != nil {
+		{
+			size := m.PendingCommission.Size()
+			i -= size
+			if _, err := m.PendingCommission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.CommissionUpdateHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommissionUpdateHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxCommissionChangeRate != nil {
+		{
+			size := m.MaxCommissionChangeRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxCommissionRate != nil {
+		{
+			size := m.MaxCommissionRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActivatedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivatedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxCommissionChangeRate != nil {
+		l = m.MaxCommissionChangeRate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CommissionUpdateHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CommissionUpdateHeight))
+	}
+	if m.PendingCommission != nil {
+		l = m.PendingCommission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingCommissionHeight != 0 {
+		n += 2 + sovQuery(uint64(m.PendingCommissionHeight))
+	}
+	if m.RetiredBabylonHeight != 0 {
+		n += 2 + sovQuery(uint64(m.RetiredBabylonHeight))
+	}
+	if len(m.PreviousAddrs) > 0 {
+		for _, s := range m.PreviousAddrs {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
-	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3860,12 +6202,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3887,7 +6280,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3910,17 +6303,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var msglen int
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3930,25 +6323,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3970,7 +6349,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3993,17 +6372,17 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4013,11 +6392,24 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4039,7 +6431,7 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4062,17 +6454,17 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4082,25 +6474,30 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4122,7 +6519,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4141,17 +6538,36 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4208,7 +6624,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4231,10 +6647,10 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4266,7 +6682,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
 			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4328,7 +6744,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4351,44 +6767,12 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4410,7 +6794,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,17 +6817,17 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4453,28 +6837,11 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
-			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4496,7 +6863,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4519,17 +6886,17 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4539,11 +6906,24 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4580,6 +6960,75 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v BTCDelegationStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BTCDelegationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]BTCDelegationStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BTCDelegationStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BTCDelegationStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4601,7 +7050,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4624,15 +7073,15 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4659,8 +7108,8 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4721,7 +7170,7 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4744,15 +7193,15 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4780,27 +7229,8 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4822,7 +7252,7 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4845,17 +7275,17 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
-			m.VotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4865,11 +7295,28 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4891,7 +7338,7 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByStakerAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4914,15 +7361,15 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4950,7 +7397,112 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v BTCDelegationStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BTCDelegationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]BTCDelegationStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BTCDelegationStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BTCDelegationStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4973,7 +7525,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByStakerAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4996,17 +7548,17 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5016,16 +7568,31 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.VotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5035,11 +7602,28 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5061,7 +7645,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByDelegatorBtcPkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5084,17 +7668,17 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByDelegatorBtcPkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByDelegatorBtcPkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelBtcPkHex", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5104,12 +7688,94 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v BTCDelegationStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= BTCDelegationStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]BTCDelegationStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v BTCDelegationStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= BTCDelegationStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5166,7 +7832,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationsByDelegatorBtcPkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5189,15 +7855,15 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationsByDelegatorBtcPkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationsByDelegatorBtcPkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5224,8 +7890,8 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5286,57 +7952,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,17 +7975,17 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5379,11 +7995,28 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5405,7 +8038,7 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5428,17 +8061,17 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5448,23 +8081,25 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.Consumers = append(m.Consumers, &ConsumerRegister{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5496,7 +8131,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5523,7 +8158,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5546,17 +8181,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5566,27 +8201,44 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5616,7 +8268,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5643,7 +8295,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5666,17 +8318,17 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5686,77 +8338,29 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5783,10 +8387,10 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6905,6 +9509,255 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionChangeRate = &v
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdateHeight", wireType)
+			}
+			m.CommissionUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommissionUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PendingCommission = &v
+			if err := m.PendingCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionHeight", wireType)
+			}
+			m.PendingCommissionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingCommissionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBabylonHeight", wireType)
+			}
+			m.RetiredBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddrs = append(m.PreviousAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -48,6 +48,17 @@ type MsgCreateFinalityProvider struct {
 	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
 	// pop is the proof of possession of btc_pk over the FP signer address.
 	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// consumer_id is the ID of the consumer chain the finality provider
+	// provides finality for. If it's empty then the finality provider
+	// provides finality for Babylon
+	ConsumerId string `protobuf:"bytes,6,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// max_commission_rate is the maximum commission rate that the finality
+	// provider can ever charge. It defaults to 1 if not specified
+	MaxCommissionRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_rate,omitempty"`
+	// max_commission_change_rate is the maximum change of the commission rate
+	// in a single commission change. It defaults to max_commission_rate if
+	// not specified
+	MaxCommissionChangeRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_commission_change_rate,json=maxCommissionChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_commission_change_rate,omitempty"`
 }
 
 func (m *MsgCreateFinalityProvider) Reset()         { *m = MsgCreateFinalityProvider{} }
@@ -104,6 +115,13 @@ func (m *MsgCreateFinalityProvider) GetPop() *ProofOfPossessionBTC {
 	return nil
 }
 
+func (m *MsgCreateFinalityProvider) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
 // MsgCreateFinalityProviderResponse is the response for MsgCreateFinalityProvider
 type MsgCreateFinalityProviderResponse struct {
 }
@@ -149,7 +167,9 @@ type MsgEditFinalityProvider struct {
 	BtcPk []byte `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
 	// description defines the updated description terms for the finality provider
 	Description *types.Description `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the updated commission rate of the finality provider
+	// commission defines the updated commission rate of the finality provider.
+	// A change of the commission rate is scheduled to take effect after
+	// `commission_change_delay` Babylon blocks
 	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
 }
 
@@ -259,7 +279,10 @@ type MsgCreateBTCDelegation struct {
 	StakingTime uint32 `protobuf:"varint,5,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
 	// staking_value  is the amount of satoshis locked in staking output
 	StakingValue int64 `protobuf:"varint,6,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
-	// staking_tx is the staking tx along with the merkle proof of inclusion in btc block
+	// staking_tx is the staking tx along with the merkle proof of inclusion in btc block.
+	// If the key and the proof are empty, then the BTC delegation is registered as a
+	// pre-staking BTC delegation, whose inclusion proof is submitted later via
+	// MsgAddBTCDelegationInclusionProof
 	StakingTx *types1.TransactionInfo `protobuf:"bytes,7,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
 	// slashing_tx is the slashing tx
 	// Note that the tx itself does not contain signatures, which are off-chain.
@@ -834,863 +857,1251 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
-	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
-	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
-	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
-	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
-	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
-	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
-	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
-	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
-	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
-	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
-	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+// MsgRegisterConsumer is the governance message for registering a consumer chain
+type MsgRegisterConsumer struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// consumer is the registration of the consumer chain
+	Consumer *ConsumerRegister `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
-
-var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0xcf, 0xda, 0x89, 0x69, 0x9e, 0xe3, 0x24, 0x5d, 0x42, 0xd8, 0x6c, 0xc1, 0x76, 0x0c, 0x85,
-	0x40, 0xc9, 0x9a, 0x84, 0x82, 0x4a, 0x50, 0x0f, 0x38, 0x09, 0x02, 0x15, 0xab, 0xd6, 0xda, 0xe9,
-	0xa1, 0x3d, 0x58, 0xeb, 0xdd, 0xc9, 0x7a, 0x64, 0x7b, 0x67, 0xb5, 0x33, 0xb1, 0x1c, 0x55, 0xaa,
-	0x2a, 0xd4, 0x6b, 0xa5, 0x9e, 0x7a, 0xe8, 0xa7, 0xe0, 0xc0, 0x47, 0xa8, 0x2a, 0x8e, 0x88, 0x53,
-	0x95, 0x43, 0x54, 0x41, 0x25, 0x3e, 0x41, 0xa5, 0xde, 0x5a, 0xed, 0xec, 0x5f, 0xbb, 0xde, 0x92,
-	0x60, 0x6e, 0xde, 0x99, 0xdf, 0xfb, 0xbd, 0xf7, 0x7e, 0xef, 0xbd, 0x99, 0x31, 0xe4, 0x5b, 0x5a,
-	0xeb, 0xb0, 0x4b, 0xac, 0x72, 0x8b, 0xe9, 0x94, 0x69, 0x1d, 0x6c, 0x99, 0xe5, 0xfe, 0x46, 0x99,
-	0x0d, 0x14, 0xdb, 0x21, 0x8c, 0x88, 0xe7, 0xfc, 0x7d, 0x25, 0xda, 0x57, 0xfa, 0x1b, 0xf2, 0x92,
-	0x49, 0x4c, 0xc2, 0x11, 0x65, 0xf7, 0x97, 0x07, 0x96, 0x57, 0x74, 0x42, 0x7b, 0x84, 0x36, 0xbd,
-	0x0d, 0xef, 0xc3, 0xdf, 0x3a, 0xef, 0x7d, 0x95, 0x7b, 0x94, 0xf3, 0xf7, 0xa8, 0xe9, 0x6f, 0x94,
-	0xc6, 0x07, 0x60, 0x6b, 0x8e, 0xd6, 0x0b, 0x8c, 0x6f, 0xc4, 0x30, 0x7a, 0x1b, 0xe9, 0x1d, 0x9b,
-	0x60, 0x8b, 0xb9, 0xb0, 0xa1, 0x05, 0x1f, 0x7d, 0xd9, 0x77, 0x15, 0xb1, 0xb5, 0x10, 0xd3, 0x36,
-	0x82, 0x6f, 0x1f, 0x55, 0x48, 0xf0, 0x4b, 0x6c, 0x0f, 0x50, 0xfa, 0x3b, 0x05, 0x2b, 0x55, 0x6a,
-	0x6e, 0x3b, 0x48, 0x63, 0xe8, 0x01, 0xb6, 0xb4, 0x2e, 0x66, 0x87, 0x35, 0x87, 0xf4, 0xb1, 0x81,
-	0x1c, 0xf1, 0x06, 0x4c, 0x6b, 0x86, 0xe1, 0x48, 0x42, 0x51, 0x58, 0x9b, 0xad, 0x48, 0x2f, 0x9f,
-	0xad, 0x2f, 0xf9, 0xf9, 0xde, 0x37, 0x0c, 0x07, 0x51, 0x5a, 0x67, 0x0e, 0xb6, 0x4c, 0x95, 0xa3,
-	0xc4, 0x5d, 0xc8, 0x1a, 0x88, 0xea, 0x0e, 0xb6, 0x19, 0x26, 0x96, 0x94, 0x2a, 0x0a, 0x6b, 0xd9,
-	0xcd, 0x4b, 0x8a, 0x6f, 0x11, 0xe9, 0xca, 0x03, 0x55, 0x76, 0x22, 0xa8, 0x1a, 0xb7, 0x13, 0xab,
-	0x00, 0x3a, 0xe9, 0xf5, 0x30, 0xa5, 0x2e, 0x4b, 0x9a, 0xbb, 0x5e, 0x3f, 0x3a, 0x2e, 0x7c, 0xe4,
-	0x11, 0x51, 0xa3, 0xa3, 0x60, 0x52, 0xee, 0x69, 0xac, 0xad, 0x3c, 0x46, 0xa6, 0xa6, 0x1f, 0xee,
-	0x20, 0xfd, 0xe5, 0xb3, 0x75, 0xf0, 0xfd, 0xec, 0x20, 0x5d, 0x8d, 0x11, 0x88, 0x55, 0xc8, 0xb4,
-	0x98, 0xde, 0xb4, 0x3b, 0xd2, 0x74, 0x51, 0x58, 0x9b, 0xab, 0xdc, 0x39, 0x3a, 0x2e, 0x6c, 0x9a,
-	0x98, 0xb5, 0x0f, 0x5a, 0x8a, 0x4e, 0x7a, 0x65, 0x5f, 0x21, 0xbd, 0xad, 0x61, 0x2b, 0xf8, 0x28,
-	0xb3, 0x43, 0x1b, 0x51, 0xa5, 0xf2, 0xa8, 0x76, 0xeb, 0xd3, 0x9b, 0xb5, 0x83, 0xd6, 0x17, 0xe8,
-	0x50, 0x9d, 0x69, 0x31, 0xbd, 0xd6, 0x11, 0x3f, 0x87, 0xb4, 0x4d, 0x6c, 0x69, 0x86, 0x27, 0xf7,
-	0x89, 0x32, 0xb6, 0x71, 0x94, 0x9a, 0x43, 0xc8, 0xfe, 0x97, 0xfb, 0x35, 0x42, 0x29, 0xe2, 0x51,
-	0x54, 0x1a, 0xdb, 0xaa, 0x6b, 0xb7, 0x35, 0xfb, 0xe4, 0xcd, 0xd3, 0xeb, 0x5c, 0xae, 0xd2, 0x25,
-	0x58, 0x4d, 0x54, 0x5e, 0x45, 0xd4, 0x26, 0x16, 0x45, 0xa5, 0x7f, 0x04, 0x38, 0x5f, 0xa5, 0xe6,
-	0xae, 0x81, 0xd9, 0x84, 0xd5, 0x39, 0x17, 0xea, 0xe0, 0x16, 0x66, 0x2e, 0xc8, 0x67, 0xa4, 0x68,
-	0xe9, 0xf7, 0x52, 0xb4, 0xe9, 0x09, 0x8b, 0x16, 0x97, 0x69, 0x15, 0x0a, 0x09, 0x02, 0x84, 0x22,
-	0xfd, 0x76, 0x06, 0x96, 0x43, 0x29, 0x2b, 0x8d, 0xed, 0x1d, 0xd4, 0x45, 0xa6, 0xc6, 0xe3, 0xba,
-	0x0b, 0x59, 0x37, 0x07, 0xe4, 0x34, 0x4f, 0x24, 0x15, 0x78, 0x60, 0x77, 0x31, 0xa8, 0x74, 0xea,
-	0xdd, 0x2a, 0x1d, 0xeb, 0xbb, 0xf4, 0xfb, 0xe8, 0xbb, 0x6f, 0x60, 0x7e, 0xdf, 0x6e, 0x7a, 0x8c,
-	0xcd, 0x2e, 0xa6, 0x4c, 0x9a, 0x2e, 0xa6, 0x27, 0xa0, 0xcd, 0xee, 0xdb, 0x15, 0x97, 0xf8, 0x31,
-	0xa6, 0x4c, 0x5c, 0x85, 0x39, 0x3f, 0xa7, 0x26, 0xc3, 0x3d, 0xc4, 0xbb, 0x3b, 0xa7, 0x66, 0xfd,
-	0xb5, 0x06, 0xee, 0x21, 0xf1, 0x12, 0xe4, 0x02, 0x48, 0x5f, 0xeb, 0x1e, 0x20, 0x29, 0x53, 0x14,
-	0xd6, 0xd2, 0x6a, 0x60, 0xf7, 0x95, 0xbb, 0x26, 0x3e, 0x04, 0x08, 0x79, 0x06, 0xd2, 0x19, 0xae,
-	0xdc, 0xb5, 0xb8, 0x72, 0xb1, 0x63, 0xac, 0xbf, 0xa1, 0x34, 0x1c, 0xcd, 0xa2, 0x9a, 0xee, 0x16,
-	0xea, 0x91, 0xb5, 0x4f, 0xd4, 0xd9, 0xc0, 0xe1, 0x40, 0xdc, 0x84, 0x2c, 0xed, 0x6a, 0xb4, 0xed,
-	0x53, 0x7d, 0xc0, 0x25, 0xfc, 0xf0, 0xe8, 0xb8, 0x90, 0xab, 0x34, 0xb6, 0xeb, 0xfe, 0x4e, 0x63,
-	0xa0, 0x02, 0x0d, 0x7f, 0x8b, 0x04, 0x96, 0x0d, 0xaf, 0xf2, 0xc4, 0x69, 0x86, 0xd6, 0x14, 0x9b,
-	0xd2, 0x2c, 0x37, 0xbf, 0x7b, 0x74, 0x5c, 0xb8, 0x7d, 0x1a, 0xa9, 0xea, 0xd8, 0xb4, 0x34, 0x76,
-	0xe0, 0x20, 0x75, 0x29, 0x24, 0x0e, 0x7c, 0xd7, 0xb1, 0x29, 0x7e, 0x0c, 0xf3, 0x07, 0x56, 0x8b,
-	0x58, 0x46, 0x28, 0x1c, 0x70, 0xe1, 0x72, 0xe1, 0x2a, 0x97, 0x6e, 0x15, 0xe6, 0x62, 0xb0, 0x81,
-	0x94, 0xe5, 0xf3, 0x97, 0x8d, 0x40, 0x03, 0xf1, 0x2a, 0x2c, 0x44, 0x10, 0x4f, 0xdf, 0x39, 0xae,
-	0x6f, 0xe4, 0xc0, 0x53, 0x78, 0x17, 0xce, 0x45, 0xc0, 0xb8, 0x42, 0xb9, 0x24, 0x85, 0xce, 0x86,
-	0xf8, 0x68, 0x51, 0x7c, 0x22, 0x40, 0x31, 0xd2, 0x6a, 0x0c, 0xa3, 0xab, 0xda, 0xfc, 0xa4, 0xaa,
-	0x5d, 0x0c, 0x5d, 0xec, 0x8d, 0xc6, 0x50, 0xc7, 0xe6, 0xd6, 0xa2, 0x3b, 0xe4, 0xf1, 0xf1, 0x2c,
-	0x15, 0x21, 0x3f, 0x7e, 0x8e, 0xc3, 0x51, 0xff, 0x2b, 0x05, 0x62, 0x95, 0x9a, 0xf7, 0x0d, 0x63,
-	0x9b, 0xf4, 0x91, 0xa5, 0x59, 0xac, 0x8e, 0x4d, 0x2a, 0x2e, 0x43, 0x86, 0x62, 0xd3, 0x42, 0xfe,
-	0x84, 0xab, 0xfe, 0x97, 0xf8, 0x00, 0x52, 0xc1, 0x81, 0xf7, 0xce, 0x93, 0x92, 0xb2, 0x3b, 0xe2,
-	0x15, 0x58, 0x88, 0x1a, 0xbb, 0xd9, 0xd6, 0x68, 0xdb, 0xbb, 0x98, 0xd4, 0x5c, 0xd8, 0xb2, 0x0f,
-	0x35, 0xda, 0x16, 0xd7, 0x60, 0x31, 0x56, 0x14, 0x57, 0x45, 0xea, 0xcd, 0xa9, 0x3a, 0x1f, 0x35,
-	0x2a, 0x8f, 0x58, 0x87, 0xc5, 0x78, 0x53, 0x70, 0xc1, 0x67, 0x26, 0x15, 0x7c, 0x3e, 0xd6, 0x53,
-	0x6e, 0x83, 0xde, 0x03, 0x39, 0x0c, 0x67, 0xd4, 0x1b, 0x95, 0x32, 0x3c, 0xb0, 0xf3, 0x01, 0x62,
-	0x6f, 0xc8, 0x96, 0x6e, 0x65, 0xdd, 0xf2, 0xf8, 0x42, 0x96, 0x2e, 0x80, 0xfc, 0x5f, 0xd9, 0xc3,
-	0xaa, 0xfc, 0x2a, 0xc0, 0x62, 0x95, 0x9a, 0x95, 0xc6, 0xf6, 0x9e, 0xe5, 0xd7, 0x1c, 0x25, 0xd6,
-	0x64, 0x8c, 0x96, 0xa9, 0x71, 0x5a, 0x8e, 0x53, 0x28, 0xfd, 0x9e, 0x15, 0x1a, 0x4e, 0x52, 0x06,
-	0x69, 0x34, 0x8b, 0x30, 0xc5, 0x5f, 0x04, 0xb8, 0x50, 0xa5, 0x66, 0x1d, 0x75, 0x91, 0xce, 0x70,
-	0x1f, 0x05, 0x8d, 0xbc, 0xeb, 0x5e, 0x45, 0x96, 0x3e, 0x79, 0xba, 0xeb, 0x70, 0xd6, 0x41, 0x3a,
-	0xe9, 0x23, 0x07, 0x19, 0x4d, 0xff, 0xa8, 0xa7, 0xfe, 0xe5, 0xa1, 0x2e, 0x86, 0x5b, 0x0f, 0xdc,
-	0x63, 0xbb, 0xde, 0x19, 0x0e, 0xfc, 0x0a, 0x5c, 0xfe, 0xbf, 0xd8, 0xc2, 0x24, 0x7e, 0x16, 0x60,
-	0xa1, 0x4a, 0xcd, 0x3d, 0xdb, 0xd0, 0x18, 0xaa, 0xf1, 0xc7, 0xa7, 0x78, 0x07, 0x66, 0xb5, 0x03,
-	0xd6, 0x26, 0x0e, 0x66, 0x87, 0x6f, 0xbd, 0x1f, 0x23, 0xa8, 0x78, 0x0f, 0x32, 0xde, 0xf3, 0xd5,
-	0xbf, 0x21, 0x2f, 0x26, 0xdd, 0x90, 0x1c, 0x54, 0x99, 0x7e, 0x7e, 0x5c, 0x98, 0x52, 0x7d, 0x93,
-	0xad, 0x79, 0x37, 0xfa, 0x88, 0xac, 0xb4, 0xc2, 0x5f, 0x39, 0xf1, 0xb8, 0x82, 0x98, 0x37, 0xff,
-	0xcc, 0x40, 0xba, 0x4a, 0x4d, 0xf1, 0x07, 0x01, 0x96, 0x13, 0x9e, 0xa9, 0x37, 0x13, 0x5c, 0x27,
-	0x3e, 0xaf, 0xe4, 0xcf, 0x4e, 0x6b, 0x11, 0x84, 0x23, 0x7e, 0x07, 0x4b, 0x63, 0x1f, 0x63, 0x4a,
-	0x32, 0xe3, 0x38, 0xbc, 0x7c, 0xe7, 0x74, 0xf8, 0xd0, 0xff, 0xb7, 0x70, 0x76, 0xdc, 0x3b, 0x67,
-	0xfd, 0x6d, 0x09, 0x0d, 0xc1, 0xe5, 0xdb, 0xa7, 0x82, 0x87, 0xce, 0x09, 0x2c, 0x8c, 0x9e, 0xbc,
-	0xd7, 0x92, 0x99, 0x46, 0xa0, 0xf2, 0xc6, 0x89, 0xa1, 0xa1, 0x43, 0x0c, 0xb9, 0xe1, 0x43, 0xe5,
-	0x6a, 0x32, 0xc7, 0x10, 0x50, 0x2e, 0x9f, 0x10, 0x18, 0xba, 0xfa, 0x51, 0x80, 0x95, 0xe4, 0xe9,
-	0xbe, 0x95, 0x4c, 0x97, 0x68, 0x24, 0xdf, 0x7b, 0x07, 0xa3, 0x30, 0x9e, 0x7d, 0x98, 0x1b, 0x9a,
-	0xd3, 0x2b, 0xc9, 0x64, 0x71, 0x9c, 0xac, 0x9c, 0x0c, 0x17, 0xf8, 0x91, 0x67, 0xbe, 0x7f, 0xf3,
-	0xf4, 0xba, 0x50, 0x79, 0xfc, 0xfc, 0x55, 0x5e, 0x78, 0xf1, 0x2a, 0x2f, 0xfc, 0xf1, 0x2a, 0x2f,
-	0xfc, 0xf4, 0x3a, 0x3f, 0xf5, 0xe2, 0x75, 0x7e, 0xea, 0xf7, 0xd7, 0xf9, 0xa9, 0xaf, 0xdf, 0x7a,
-	0x67, 0x0e, 0xe2, 0xff, 0x2e, 0xf9, 0xb1, 0xdb, 0xca, 0xf0, 0x7f, 0x97, 0xb7, 0xfe, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xf6, 0xe9, 0xb3, 0x62, 0x79, 0x0f, 0x00, 0x00,
+func (m *MsgRegisterConsumer) Reset()         { *m = MsgRegisterConsumer{} }
+func (m *MsgRegisterConsumer) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterConsumer) ProtoMessage()    {}
+func (*MsgRegisterConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
+}
+func (m *MsgRegisterConsumer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterConsumer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterConsumer.Merge(m, src)
+}
+func (m *MsgRegisterConsumer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterConsumer.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterConsumer proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgRegisterConsumer) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterConsumer) GetConsumer() *ConsumerRegister {
+	if m != nil {
+		return m.Consumer
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRegisterConsumerResponse is the response to the MsgRegisterConsumer message.
+type MsgRegisterConsumerResponse struct {
 }
 
-func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
-	out := new(MsgCreateFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterConsumerResponse) Reset()         { *m = MsgRegisterConsumerResponse{} }
+func (m *MsgRegisterConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterConsumerResponse) ProtoMessage()    {}
+func (*MsgRegisterConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
 }
-
-func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
-	out := new(MsgEditFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterConsumerResponse.Merge(m, src)
+}
+func (m *MsgRegisterConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterConsumerResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
-	out := new(MsgCreateBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterConsumerResponse proto.InternalMessageInfo
+
+// MsgReportStakingOutputSpend is the message for reporting that a BTC
+// delegation's staking output is spent on Bitcoin. Anyone can submit it
+// together with the inclusion proof of the spending tx
+type MsgReportStakingOutputSpend struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// spend_tx_info is the tx that spends the staking output, together with
+	// its inclusion proof on Bitcoin
+	SpendTxInfo *types1.TransactionInfo `protobuf:"bytes,3,opt,name=spend_tx_info,json=spendTxInfo,proto3" json:"spend_tx_info,omitempty"`
 }
 
-func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
-	out := new(MsgAddCovenantSigsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReportStakingOutputSpend) Reset()         { *m = MsgReportStakingOutputSpend{} }
+func (m *MsgReportStakingOutputSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgReportStakingOutputSpend) ProtoMessage()    {}
+func (*MsgReportStakingOutputSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
+}
+func (m *MsgReportStakingOutputSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportStakingOutputSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportStakingOutputSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgReportStakingOutputSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportStakingOutputSpend.Merge(m, src)
+}
+func (m *MsgReportStakingOutputSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportStakingOutputSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportStakingOutputSpend.DiscardUnknown(m)
 }
 
-func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
-	out := new(MsgBTCUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportStakingOutputSpend proto.InternalMessageInfo
+
+func (m *MsgReportStakingOutputSpend) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	out := new(MsgSelectiveSlashingEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReportStakingOutputSpend) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReportStakingOutputSpend) GetSpendTxInfo() *types1.TransactionInfo {
+	if m != nil {
+		return m.SpendTxInfo
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateFinalityProvider creates a new finality provider
-	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
-	// EditFinalityProvider edits an existing finality provider
-	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
-	// CreateBTCDelegation creates a new BTC delegation
-	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
-	// AddCovenantSigs handles signatures from a covenant member
-	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
-	// BTCUndelegate handles a signature on unbonding tx from its delegator
-	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
-	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
-	// by a finality provider
-	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
-	// UpdateParams updates the btcstaking module parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgReportStakingOutputSpendResponse is the response for MsgReportStakingOutputSpend
+type MsgReportStakingOutputSpendResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgReportStakingOutputSpendResponse) Reset()         { *m = MsgReportStakingOutputSpendResponse{} }
+func (m *MsgReportStakingOutputSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportStakingOutputSpendResponse) ProtoMessage()    {}
+func (*MsgReportStakingOutputSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
+}
+func (m *MsgReportStakingOutputSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportStakingOutputSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportStakingOutputSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportStakingOutputSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportStakingOutputSpendResponse.Merge(m, src)
+}
+func (m *MsgReportStakingOutputSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportStakingOutputSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportStakingOutputSpendResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+var xxx_messageInfo_MsgReportStakingOutputSpendResponse proto.InternalMessageInfo
+
+// MsgAddBTCDelegationInclusionProof is the message for adding the inclusion
+// proof of the staking tx of a pre-staking BTC delegation, such that the BTC
+// delegation can become active
+type MsgAddBTCDelegationInclusionProof struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// staking_tx_info is the staking tx along with the merkle proof of
+	// inclusion in btc block
+	StakingTxInfo *types1.TransactionInfo `protobuf:"bytes,3,opt,name=staking_tx_info,json=stakingTxInfo,proto3" json:"staking_tx_info,omitempty"`
 }
-func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+
+func (m *MsgAddBTCDelegationInclusionProof) Reset()         { *m = MsgAddBTCDelegationInclusionProof{} }
+func (m *MsgAddBTCDelegationInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBTCDelegationInclusionProof) ProtoMessage()    {}
+func (*MsgAddBTCDelegationInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
 }
-func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAddBTCDelegationInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProof proto.InternalMessageInfo
 
-func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
+func (m *MsgAddBTCDelegationInclusionProof) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditFinalityProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditFinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBTCDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxInfo() *types1.TransactionInfo {
+	if m != nil {
+		return m.StakingTxInfo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCovenantSigs)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCovenantSigs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAddBTCDelegationInclusionProofResponse is the response for MsgAddBTCDelegationInclusionProof
+type MsgAddBTCDelegationInclusionProofResponse struct {
 }
 
-func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBTCUndelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BTCUndelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+func (m *MsgAddBTCDelegationInclusionProofResponse) Reset() {
+	*m = MsgAddBTCDelegationInclusionProofResponse{}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAddBTCDelegationInclusionProofResponse) ProtoMessage() {}
+func (*MsgAddBTCDelegationInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Merge(m, src)
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.DiscardUnknown(m)
 }
 
-func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSelectiveSlashingEvidence)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse proto.InternalMessageInfo
+
+// MsgRetireFinalityProvider is the message for retiring an existing finality
+// provider. A retired finality provider does not accept new BTC delegations,
+// and leaves the active set from the next height on
+type MsgRetireFinalityProvider struct {
+	// addr is the address of the finality provider that wishes to retire
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the finality provider to be retired
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+func (m *MsgRetireFinalityProvider) Reset()         { *m = MsgRetireFinalityProvider{} }
+func (m *MsgRetireFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireFinalityProvider) ProtoMessage()    {}
+func (*MsgRetireFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
+}
+func (m *MsgRetireFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgRetireFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireFinalityProvider.Merge(m, src)
+}
+func (m *MsgRetireFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgRetireFinalityProvider) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateFinalityProvider",
-			Handler:    _Msg_CreateFinalityProvider_Handler,
-		},
-		{
-			MethodName: "EditFinalityProvider",
-			Handler:    _Msg_EditFinalityProvider_Handler,
-		},
-		{
-			MethodName: "CreateBTCDelegation",
-			Handler:    _Msg_CreateBTCDelegation_Handler,
-		},
-		{
-			MethodName: "AddCovenantSigs",
-			Handler:    _Msg_AddCovenantSigs_Handler,
-		},
-		{
-			MethodName: "BTCUndelegate",
-			Handler:    _Msg_BTCUndelegate_Handler,
-		},
-		{
-			MethodName: "SelectiveSlashingEvidence",
-			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/tx.proto",
+// MsgRetireFinalityProviderResponse is the response for MsgRetireFinalityProvider
+type MsgRetireFinalityProviderResponse struct {
 }
 
-func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRetireFinalityProviderResponse) Reset()         { *m = MsgRetireFinalityProviderResponse{} }
+func (m *MsgRetireFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgRetireFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireFinalityProviderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRetireFinalityProviderResponse proto.InternalMessageInfo
+
+// MsgRotateFinalityProviderAddr is the message for rotating the Babylon
+// address bound to an existing finality provider. It is signed by the new
+// address and authorized by a proof of possession of the finality provider's
+// BTC PK over the new address
+type MsgRotateFinalityProviderAddr struct {
+	// new_addr is the new Babylon address of the finality provider, which
+	// will receive the commissions of the finality provider from now on
+	NewAddr string `protobuf:"bytes,1,opt,name=new_addr,json=newAddr,proto3" json:"new_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the finality provider
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of btc_pk over new_addr
+	Pop *ProofOfPossessionBTC `protobuf:"bytes,3,opt,name=pop,proto3" json:"pop,omitempty"`
 }
 
-func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgRotateFinalityProviderAddr) Reset()         { *m = MsgRotateFinalityProviderAddr{} }
+func (m *MsgRotateFinalityProviderAddr) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderAddr) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{22}
+}
+func (m *MsgRotateFinalityProviderAddr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateFinalityProviderAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFinalityProviderAddr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+}
+func (m *MsgRotateFinalityProviderAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderAddr.Merge(m, src)
+}
+func (m *MsgRotateFinalityProviderAddr) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateFinalityProviderAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateFinalityProviderAddr proto.InternalMessageInfo
+
+func (m *MsgRotateFinalityProviderAddr) GetNewAddr() string {
+	if m != nil {
+		return m.NewAddr
 	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *MsgRotateFinalityProviderAddr) GetPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.Pop
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	return nil
+}
+
+// MsgRotateFinalityProviderAddrResponse is the response for MsgRotateFinalityProviderAddr
+type MsgRotateFinalityProviderAddrResponse struct {
+}
+
+func (m *MsgRotateFinalityProviderAddrResponse) Reset()         { *m = MsgRotateFinalityProviderAddrResponse{} }
+func (m *MsgRotateFinalityProviderAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderAddrResponse) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{23}
+}
+func (m *MsgRotateFinalityProviderAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateFinalityProviderAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFinalityProviderAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRotateFinalityProviderAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderAddrResponse.Merge(m, src)
+}
+func (m *MsgRotateFinalityProviderAddrResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateFinalityProviderAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderAddrResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRotateFinalityProviderAddrResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
+	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
+	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
+	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
+	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
+	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
+	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
+	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
+	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
+	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterConsumer)(nil), "babylon.btcstaking.v1.MsgRegisterConsumer")
+	proto.RegisterType((*MsgRegisterConsumerResponse)(nil), "babylon.btcstaking.v1.MsgRegisterConsumerResponse")
+	proto.RegisterType((*MsgReportStakingOutputSpend)(nil), "babylon.btcstaking.v1.MsgReportStakingOutputSpend")
+	proto.RegisterType((*MsgReportStakingOutputSpendResponse)(nil), "babylon.btcstaking.v1.MsgReportStakingOutputSpendResponse")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProof")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProofResponse")
+	proto.RegisterType((*MsgRetireFinalityProvider)(nil), "babylon.btcstaking.v1.MsgRetireFinalityProvider")
+	proto.RegisterType((*MsgRetireFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgRetireFinalityProviderResponse")
+	proto.RegisterType((*MsgRotateFinalityProviderAddr)(nil), "babylon.btcstaking.v1.MsgRotateFinalityProviderAddr")
+	proto.RegisterType((*MsgRotateFinalityProviderAddrResponse)(nil), "babylon.btcstaking.v1.MsgRotateFinalityProviderAddrResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
+
+var fileDescriptor_4baddb53e97f38f2 = []byte{
+	// 1644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x4f, 0x1b, 0xdb,
+	0x15, 0x67, 0x30, 0x10, 0x38, 0xc6, 0xc0, 0x1b, 0x08, 0x98, 0x79, 0x0f, 0x1b, 0xcc, 0x0b, 0x90,
+	0xbc, 0x87, 0xcd, 0xc7, 0x7b, 0x51, 0x1e, 0x69, 0xa5, 0x62, 0x43, 0x14, 0xd4, 0x58, 0xa1, 0x63,
+	0xd3, 0x45, 0xab, 0xca, 0x1a, 0xcf, 0x5c, 0xc6, 0x53, 0xec, 0xb9, 0xa3, 0xb9, 0xd7, 0x8e, 0x51,
+	0xa5, 0xaa, 0x8a, 0xba, 0xaa, 0x54, 0x29, 0xab, 0x2e, 0xaa, 0xaa, 0x8b, 0xf6, 0x1f, 0xc8, 0x22,
+	0x7f, 0x42, 0x55, 0x65, 0x19, 0xa5, 0x9b, 0x0a, 0xa9, 0xa8, 0x4a, 0x2a, 0x65, 0xdd, 0x45, 0xd7,
+	0xad, 0xe6, 0xeb, 0x8e, 0x6d, 0x66, 0x0c, 0xb6, 0xe9, 0xce, 0xf7, 0xde, 0xdf, 0xf9, 0xfa, 0xdd,
+	0x73, 0xe6, 0x9c, 0x6b, 0x48, 0x94, 0xa5, 0xf2, 0x79, 0x15, 0xeb, 0x99, 0x32, 0x95, 0x09, 0x95,
+	0xce, 0x34, 0x5d, 0xcd, 0x34, 0xb6, 0x33, 0xb4, 0x99, 0x36, 0x4c, 0x4c, 0x31, 0x7f, 0xd7, 0x3d,
+	0x4f, 0xfb, 0xe7, 0xe9, 0xc6, 0xb6, 0x30, 0xa7, 0x62, 0x15, 0xdb, 0x88, 0x8c, 0xf5, 0xcb, 0x01,
+	0x0b, 0x8b, 0x32, 0x26, 0x35, 0x4c, 0x4a, 0xce, 0x81, 0xb3, 0x70, 0x8f, 0x16, 0x9c, 0x55, 0xa6,
+	0x46, 0x6c, 0xfd, 0x35, 0xa2, 0xba, 0x07, 0xa9, 0x60, 0x07, 0x0c, 0xc9, 0x94, 0x6a, 0x9e, 0xf0,
+	0xd7, 0x2d, 0x18, 0xb9, 0x82, 0xe4, 0x33, 0x03, 0x6b, 0x3a, 0xb5, 0x60, 0x6d, 0x1b, 0x2e, 0xfa,
+	0x4b, 0xd7, 0x94, 0xaf, 0xad, 0x8c, 0xa8, 0xb4, 0xed, 0xad, 0x5d, 0x54, 0x32, 0xc4, 0x2e, 0x36,
+	0x5c, 0xc0, 0x5a, 0x30, 0xc0, 0x5f, 0x39, 0xb8, 0xd4, 0xbf, 0x46, 0x60, 0x31, 0x4f, 0xd4, 0x9c,
+	0x89, 0x24, 0x8a, 0x9e, 0x68, 0xba, 0x54, 0xd5, 0xe8, 0xf9, 0xb1, 0x89, 0x1b, 0x9a, 0x82, 0x4c,
+	0xfe, 0x6b, 0x18, 0x91, 0x14, 0xc5, 0x8c, 0x73, 0xcb, 0xdc, 0xc6, 0x44, 0x36, 0xfe, 0xfe, 0xcd,
+	0xe6, 0x9c, 0xcb, 0xcb, 0xbe, 0xa2, 0x98, 0x88, 0x90, 0x02, 0x35, 0x35, 0x5d, 0x15, 0x6d, 0x14,
+	0x7f, 0x08, 0x51, 0x05, 0x11, 0xd9, 0xd4, 0x0c, 0xaa, 0x61, 0x3d, 0x3e, 0xbc, 0xcc, 0x6d, 0x44,
+	0x77, 0x56, 0xd3, 0xae, 0x84, 0xcf, 0xbf, 0x1d, 0x50, 0xfa, 0xc0, 0x87, 0x8a, 0xad, 0x72, 0x7c,
+	0x1e, 0x40, 0xc6, 0xb5, 0x9a, 0x46, 0x88, 0xa5, 0x25, 0x62, 0x9b, 0xde, 0xbc, 0xb8, 0x4c, 0x7e,
+	0xee, 0x28, 0x22, 0xca, 0x59, 0x5a, 0xc3, 0x99, 0x9a, 0x44, 0x2b, 0xe9, 0x67, 0x48, 0x95, 0xe4,
+	0xf3, 0x03, 0x24, 0xbf, 0x7f, 0xb3, 0x09, 0xae, 0x9d, 0x03, 0x24, 0x8b, 0x2d, 0x0a, 0xf8, 0x3c,
+	0x8c, 0x95, 0xa9, 0x5c, 0x32, 0xce, 0xe2, 0x23, 0xcb, 0xdc, 0xc6, 0x64, 0xf6, 0xe1, 0xc5, 0x65,
+	0x72, 0x47, 0xd5, 0x68, 0xa5, 0x5e, 0x4e, 0xcb, 0xb8, 0x96, 0x71, 0x89, 0x92, 0x2b, 0x92, 0xa6,
+	0x7b, 0x8b, 0x0c, 0x3d, 0x37, 0x10, 0x49, 0x67, 0x8f, 0x8e, 0x77, 0xbf, 0xd9, 0x3a, 0xae, 0x97,
+	0x7f, 0x88, 0xce, 0xc5, 0xd1, 0x32, 0x95, 0x8f, 0xcf, 0xf8, 0xef, 0x43, 0xc4, 0xc0, 0x46, 0x7c,
+	0xd4, 0x0e, 0xee, 0xab, 0x74, 0x60, 0x82, 0xa5, 0x8f, 0x4d, 0x8c, 0x4f, 0x9f, 0x9f, 0x1e, 0x63,
+	0x42, 0x90, 0xed, 0x45, 0xb6, 0x98, 0x13, 0x2d, 0x39, 0x3e, 0x09, 0x51, 0x19, 0xeb, 0xa4, 0x5e,
+	0x43, 0x66, 0x49, 0x53, 0xe2, 0x63, 0x56, 0x74, 0x22, 0x78, 0x5b, 0x47, 0x0a, 0xff, 0x33, 0x98,
+	0xad, 0x49, 0xcd, 0x92, 0x1f, 0x40, 0xc9, 0x94, 0x28, 0x8a, 0xdf, 0xe9, 0x87, 0x86, 0xcf, 0x6a,
+	0x52, 0x33, 0xc7, 0x14, 0x89, 0x12, 0x45, 0xfc, 0xcf, 0x41, 0xe8, 0x50, 0x2f, 0x57, 0x24, 0x5d,
+	0x45, 0x8e, 0x95, 0xf1, 0x7e, 0xac, 0x2c, 0xb4, 0x59, 0xc9, 0xd9, 0xea, 0x2c, 0x5b, 0x7b, 0x13,
+	0x2f, 0x3f, 0xbd, 0x7e, 0x60, 0xa7, 0x46, 0x6a, 0x15, 0x56, 0x42, 0xb3, 0x4c, 0x44, 0xc4, 0xc0,
+	0x3a, 0x41, 0xa9, 0xff, 0x72, 0xb0, 0x90, 0x27, 0xea, 0xa1, 0xa2, 0xd1, 0x01, 0x33, 0xf1, 0x2e,
+	0xbb, 0x73, 0x2b, 0x09, 0x27, 0xbd, 0xbb, 0xeb, 0x48, 0xd0, 0xc8, 0xad, 0x24, 0xe8, 0xc8, 0x80,
+	0x09, 0xda, 0x4a, 0xd3, 0x0a, 0x24, 0x43, 0x08, 0x60, 0x24, 0xfd, 0xf5, 0x0e, 0xcc, 0x33, 0x2a,
+	0xb3, 0xc5, 0xdc, 0x01, 0xaa, 0x22, 0x55, 0xb2, 0xfd, 0xfa, 0x0e, 0xa2, 0x56, 0x0c, 0xc8, 0x2c,
+	0xdd, 0x88, 0x2a, 0x70, 0xc0, 0xd6, 0xa6, 0x97, 0xd5, 0xc3, 0x7d, 0x66, 0xb5, 0x5f, 0x63, 0x91,
+	0xdb, 0xa8, 0xb1, 0x9f, 0xc2, 0xd4, 0xa9, 0x51, 0x72, 0x34, 0x96, 0xaa, 0x1a, 0xa1, 0xf1, 0x91,
+	0xe5, 0xc8, 0x00, 0x6a, 0xa3, 0xa7, 0x46, 0xd6, 0x52, 0xfc, 0x4c, 0x23, 0x94, 0x5f, 0x81, 0x49,
+	0x37, 0xa6, 0x12, 0xd5, 0x6a, 0xc8, 0xae, 0xe4, 0x98, 0x18, 0x75, 0xf7, 0x8a, 0x5a, 0x0d, 0xf1,
+	0xab, 0x10, 0xf3, 0x20, 0x0d, 0xa9, 0x5a, 0x47, 0x76, 0x99, 0x46, 0x44, 0x4f, 0xee, 0xc7, 0xd6,
+	0x1e, 0xff, 0x14, 0x80, 0xe9, 0x69, 0xda, 0xf5, 0x19, 0xdd, 0xb9, 0xdf, 0xca, 0x5c, 0xcb, 0xa7,
+	0xbd, 0xb1, 0x9d, 0x2e, 0x9a, 0x92, 0x4e, 0x24, 0xd9, 0xba, 0xa8, 0x23, 0xfd, 0x14, 0x8b, 0x13,
+	0x9e, 0xc1, 0x26, 0xbf, 0x03, 0x51, 0x52, 0x95, 0x48, 0xc5, 0x55, 0x35, 0x6e, 0x53, 0xf8, 0xd9,
+	0xc5, 0x65, 0x32, 0x96, 0x2d, 0xe6, 0x0a, 0xee, 0x49, 0xb1, 0x29, 0x02, 0x61, 0xbf, 0x79, 0x0c,
+	0xf3, 0x8a, 0x73, 0xf3, 0xd8, 0x2c, 0x31, 0x69, 0xa2, 0xa9, 0xf1, 0x09, 0x5b, 0xfc, 0xbb, 0x8b,
+	0xcb, 0xe4, 0xb7, 0xbd, 0x50, 0x55, 0xd0, 0x54, 0x5d, 0xa2, 0x75, 0x13, 0x89, 0x73, 0x4c, 0xb1,
+	0x67, 0xbb, 0xa0, 0xa9, 0xfc, 0x3d, 0x98, 0xaa, 0xeb, 0x65, 0xac, 0x2b, 0x8c, 0x38, 0xb0, 0x89,
+	0x8b, 0xb1, 0x5d, 0x9b, 0xba, 0x15, 0x98, 0x6c, 0x81, 0x35, 0xe3, 0x51, 0xbb, 0xfe, 0xa2, 0x3e,
+	0xa8, 0xc9, 0xaf, 0xc3, 0xb4, 0x0f, 0x71, 0xf8, 0x9d, 0xb4, 0xf9, 0xf5, 0x0d, 0x38, 0x0c, 0x1f,
+	0xc2, 0x5d, 0x1f, 0xd8, 0xca, 0x50, 0x2c, 0x8c, 0xa1, 0x59, 0x86, 0xf7, 0x37, 0xf9, 0x97, 0x1c,
+	0x2c, 0xfb, 0x5c, 0x05, 0x68, 0xb4, 0x58, 0x9b, 0x1a, 0x94, 0xb5, 0x25, 0x66, 0xe2, 0xa4, 0xd3,
+	0x87, 0x82, 0xa6, 0xee, 0xcd, 0x58, 0x45, 0xde, 0x5a, 0x9e, 0xa9, 0x65, 0x48, 0x04, 0xd7, 0x31,
+	0x2b, 0xf5, 0xff, 0x0c, 0x03, 0x9f, 0x27, 0xea, 0xbe, 0xa2, 0xe4, 0x70, 0x03, 0xe9, 0x92, 0x4e,
+	0x0b, 0x9a, 0x4a, 0xf8, 0x79, 0x18, 0x23, 0x9a, 0xaa, 0x23, 0xb7, 0xc2, 0x45, 0x77, 0xc5, 0x3f,
+	0x81, 0x61, 0xef, 0x83, 0xd7, 0x77, 0xa5, 0x0c, 0x1b, 0x67, 0xfc, 0x1a, 0x4c, 0xfb, 0x89, 0x5d,
+	0xaa, 0x48, 0xa4, 0xe2, 0x34, 0x61, 0x31, 0xc6, 0x52, 0xf6, 0xa9, 0x44, 0x2a, 0xfc, 0x06, 0xcc,
+	0xb4, 0x5c, 0x8a, 0xc5, 0x22, 0x71, 0xea, 0x54, 0x9c, 0xf2, 0x13, 0xd5, 0xf6, 0x58, 0x86, 0x99,
+	0xd6, 0xa4, 0xb0, 0x09, 0x1f, 0x1d, 0x94, 0xf0, 0xa9, 0x96, 0x9c, 0xb2, 0x12, 0xf4, 0x31, 0x08,
+	0xcc, 0x9d, 0x4e, 0x6b, 0x24, 0x3e, 0x66, 0x3b, 0xb6, 0xe0, 0x21, 0x4e, 0xda, 0x64, 0xc9, 0x5e,
+	0xd4, 0xba, 0x1e, 0x97, 0xc8, 0xd4, 0x17, 0x20, 0x5c, 0xa5, 0x9d, 0xdd, 0xca, 0x5f, 0x38, 0x98,
+	0xc9, 0x13, 0x35, 0x5b, 0xcc, 0x9d, 0xe8, 0xee, 0x9d, 0xa3, 0xd0, 0x3b, 0x09, 0xe0, 0x72, 0x38,
+	0x88, 0xcb, 0x20, 0x86, 0x22, 0xb7, 0xcc, 0x50, 0x7b, 0x90, 0x02, 0xc4, 0x3b, 0xa3, 0x60, 0x21,
+	0xfe, 0x9e, 0x83, 0x2f, 0xf2, 0x44, 0x2d, 0xa0, 0x2a, 0x92, 0xa9, 0xd6, 0x40, 0x5e, 0x22, 0x1f,
+	0x5a, 0xad, 0x48, 0x97, 0x07, 0x0f, 0x77, 0x13, 0x66, 0x4d, 0x24, 0xe3, 0x06, 0x32, 0x91, 0x52,
+	0x72, 0x3f, 0xf5, 0xc4, 0x6d, 0x1e, 0xe2, 0x0c, 0x3b, 0x7a, 0x62, 0x7d, 0xb6, 0x0b, 0x67, 0xed,
+	0x8e, 0xaf, 0xc1, 0x97, 0xdd, 0x7c, 0x63, 0x41, 0xfc, 0x8e, 0x83, 0xe9, 0x3c, 0x51, 0x4f, 0x0c,
+	0x45, 0xa2, 0xe8, 0xd8, 0x1e, 0xc8, 0xf9, 0x87, 0x30, 0x21, 0xd5, 0x69, 0x05, 0x9b, 0x1a, 0x3d,
+	0xbf, 0xb6, 0x3f, 0xfa, 0x50, 0xfe, 0x31, 0x8c, 0x39, 0x23, 0xbd, 0xdb, 0x21, 0x97, 0xc2, 0x3a,
+	0xa4, 0x0d, 0xca, 0x8e, 0xbc, 0xbd, 0x4c, 0x0e, 0x89, 0xae, 0xc8, 0xde, 0x94, 0xe5, 0xbd, 0xaf,
+	0x2c, 0xb5, 0x08, 0x0b, 0x1d, 0x7e, 0x31, 0x9f, 0xff, 0xc4, 0xc1, 0x6c, 0x9e, 0xa8, 0x22, 0x52,
+	0x35, 0x42, 0x91, 0x99, 0x73, 0xc7, 0xc2, 0xbe, 0xfd, 0xce, 0xc1, 0xb8, 0x37, 0x5a, 0xba, 0x9e,
+	0xaf, 0x87, 0x78, 0xee, 0x99, 0xf2, 0x4c, 0x8b, 0x4c, 0xf0, 0x8a, 0xff, 0x4b, 0xf0, 0x79, 0x80,
+	0x8f, 0x2c, 0x86, 0xbf, 0x71, 0xee, 0xb9, 0x81, 0x4d, 0x5a, 0x70, 0x2c, 0x3c, 0xaf, 0x53, 0xa3,
+	0x4e, 0x0b, 0x06, 0xd2, 0x15, 0x7e, 0xab, 0x3d, 0x77, 0xba, 0x04, 0xd2, 0x6b, 0x56, 0xe5, 0x21,
+	0x46, 0x2c, 0x13, 0x16, 0x4a, 0xd3, 0x4f, 0x71, 0x3c, 0xd2, 0x6b, 0x53, 0x8e, 0xda, 0xf2, 0xc5,
+	0xa6, 0xb5, 0x68, 0xcf, 0xba, 0x7b, 0xb0, 0xda, 0x25, 0x28, 0x16, 0xfc, 0x3f, 0x38, 0x7b, 0xd0,
+	0xdd, 0x57, 0x94, 0xb6, 0x4f, 0xfa, 0x91, 0x2e, 0x57, 0xeb, 0x44, 0xc3, 0xba, 0x3d, 0x42, 0xfd,
+	0x1f, 0x29, 0xf8, 0x51, 0x1b, 0xae, 0x3f, 0x12, 0x7c, 0x95, 0x57, 0x69, 0xf8, 0x0a, 0xee, 0x5f,
+	0x1b, 0x1e, 0x23, 0xe3, 0xcf, 0x9c, 0xfd, 0xb6, 0x14, 0x11, 0xd5, 0xcc, 0x41, 0xdf, 0x96, 0xf9,
+	0xf6, 0x89, 0x7e, 0xc0, 0x09, 0xf3, 0xea, 0xd3, 0x24, 0xd8, 0x49, 0x16, 0xca, 0xbf, 0x39, 0x58,
+	0xb2, 0x50, 0x98, 0x06, 0x3c, 0x60, 0xec, 0x09, 0x7a, 0x17, 0xc6, 0x75, 0xf4, 0xe2, 0x66, 0x93,
+	0xf7, 0x1d, 0x1d, 0xbd, 0xd8, 0xbf, 0xfd, 0xa8, 0xbc, 0x29, 0x3e, 0xd2, 0xdf, 0x14, 0xbf, 0x17,
+	0xb3, 0x48, 0x61, 0x51, 0xa4, 0xd6, 0xe1, 0x5e, 0xd7, 0x90, 0x3d, 0x72, 0x76, 0xfe, 0x38, 0x09,
+	0x91, 0x3c, 0x51, 0xf9, 0x5f, 0x73, 0x30, 0x1f, 0xf2, 0x47, 0xc2, 0x56, 0x88, 0x33, 0xa1, 0x8f,
+	0x42, 0xe1, 0x51, 0xaf, 0x12, 0x9e, 0x3b, 0xfc, 0x2f, 0x61, 0x2e, 0xf0, 0x09, 0x99, 0x0e, 0xd7,
+	0x18, 0x84, 0x17, 0x1e, 0xf6, 0x86, 0x67, 0xf6, 0x7f, 0x01, 0xb3, 0x41, 0xaf, 0xb3, 0xcd, 0xeb,
+	0x02, 0x6a, 0x83, 0x0b, 0xdf, 0xf6, 0x04, 0x67, 0xc6, 0x31, 0x4c, 0x77, 0xce, 0x8b, 0xf7, 0xc3,
+	0x35, 0x75, 0x40, 0x85, 0xed, 0x1b, 0x43, 0x99, 0x41, 0x0d, 0x62, 0xed, 0xa3, 0xd0, 0x7a, 0xb8,
+	0x8e, 0x36, 0xa0, 0x90, 0xb9, 0x21, 0x90, 0x99, 0xfa, 0x2d, 0x07, 0x8b, 0xe1, 0x33, 0xc9, 0x6e,
+	0xb8, 0xba, 0x50, 0x21, 0xe1, 0x71, 0x1f, 0x42, 0xcc, 0x9f, 0x53, 0x98, 0x6c, 0x9b, 0x2e, 0xd6,
+	0xc2, 0x95, 0xb5, 0xe2, 0x84, 0xf4, 0xcd, 0x70, 0xcc, 0x8e, 0x09, 0x33, 0x57, 0x26, 0x82, 0x07,
+	0xe1, 0x3a, 0x3a, 0xb1, 0xc2, 0xce, 0xcd, 0xb1, 0xcc, 0xe6, 0x6f, 0x38, 0x88, 0x87, 0xb6, 0xf0,
+	0xae, 0x0a, 0x83, 0x65, 0x84, 0xbd, 0xde, 0x65, 0x98, 0x33, 0x7f, 0xe0, 0x20, 0x71, 0x4d, 0x4b,
+	0x7d, 0xd4, 0x35, 0x73, 0xbb, 0x48, 0x0a, 0x3f, 0xe8, 0x57, 0x92, 0xb9, 0x67, 0x7d, 0xf7, 0x42,
+	0x9a, 0xdc, 0x56, 0xb7, 0xa8, 0x83, 0x24, 0x84, 0x47, 0xbd, 0x4a, 0x30, 0x37, 0x5e, 0x71, 0x20,
+	0x74, 0x69, 0x50, 0xdf, 0x74, 0x51, 0x1c, 0x2a, 0x25, 0x7c, 0xaf, 0x1f, 0x29, 0xcf, 0x25, 0x61,
+	0xf4, 0x57, 0x9f, 0x5e, 0x3f, 0xe0, 0xb2, 0xcf, 0xde, 0x7e, 0x48, 0x70, 0xef, 0x3e, 0x24, 0xb8,
+	0x7f, 0x7e, 0x48, 0x70, 0xaf, 0x3e, 0x26, 0x86, 0xde, 0x7d, 0x4c, 0x0c, 0xfd, 0xfd, 0x63, 0x62,
+	0xe8, 0x27, 0xd7, 0x36, 0xbb, 0x66, 0xeb, 0x1f, 0xd8, 0x76, 0xe7, 0x2b, 0x8f, 0xd9, 0xff, 0x5c,
+	0xef, 0xfe, 0x6f, 0x00, 0x2e, 0xcc, 0x70, 0x65, 0xfd, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterConsumer registers a consumer chain that BTC delegations can
+	// restake to via its finality providers.
+	RegisterConsumer(ctx context.Context, in *MsgRegisterConsumer, opts ...grpc.CallOption) (*MsgRegisterConsumerResponse, error)
+	// ReportStakingOutputSpend handles the report that a BTC delegation's
+	// staking output is spent on Bitcoin
+	ReportStakingOutputSpend(ctx context.Context, in *MsgReportStakingOutputSpend, opts ...grpc.CallOption) (*MsgReportStakingOutputSpendResponse, error)
+	// AddBTCDelegationInclusionProof handles the inclusion proof of the
+	// staking tx of a pre-staking BTC delegation
+	AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error)
+	// RetireFinalityProvider retires an existing finality provider
+	RetireFinalityProvider(ctx context.Context, in *MsgRetireFinalityProvider, opts ...grpc.CallOption) (*MsgRetireFinalityProviderResponse, error)
+	// RotateFinalityProviderAddr rotates the Babylon address bound to an
+	// existing finality provider
+	RotateFinalityProviderAddr(ctx context.Context, in *MsgRotateFinalityProviderAddr, opts ...grpc.CallOption) (*MsgRotateFinalityProviderAddrResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error) {
+	out := new(MsgCreateFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateFinalityProvider", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error) {
+	out := new(MsgEditFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/EditFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
+	out := new(MsgCreateBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error) {
+	out := new(MsgAddCovenantSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantSigs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
+	out := new(MsgBTCUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *msgClient) SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	out := new(MsgSelectiveSlashingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BtcPk) > 0 {
-		i -= len(m.BtcPk)
-		copy(dAtA[i:], m.BtcPk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) RegisterConsumer(ctx context.Context, in *MsgRegisterConsumer, opts ...grpc.CallOption) (*MsgRegisterConsumerResponse, error) {
+	out := new(MsgRegisterConsumerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RegisterConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReportStakingOutputSpend(ctx context.Context, in *MsgReportStakingOutputSpend, opts ...grpc.CallOption) (*MsgReportStakingOutputSpendResponse, error) {
+	out := new(MsgReportStakingOutputSpendResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/ReportStakingOutputSpend", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error) {
+	out := new(MsgAddBTCDelegationInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddBTCDelegationInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RetireFinalityProvider(ctx context.Context, in *MsgRetireFinalityProvider, opts ...grpc.CallOption) (*MsgRetireFinalityProviderResponse, error) {
+	out := new(MsgRetireFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RetireFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RotateFinalityProviderAddr(ctx context.Context, in *MsgRotateFinalityProviderAddr, opts ...grpc.CallOption) (*MsgRotateFinalityProviderAddrResponse, error) {
+	out := new(MsgRotateFinalityProviderAddrResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RotateFinalityProviderAddr", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateFinalityProvider creates a new finality provider
+	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
+	// EditFinalityProvider edits an existing finality provider
+	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
+	// CreateBTCDelegation creates a new BTC delegation
+	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
+	// AddCovenantSigs handles signatures from a covenant member
+	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
+	// BTCUndelegate handles a signature on unbonding tx from its delegator
+	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
+	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
+	// by a finality provider
+	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
+	// UpdateParams updates the btcstaking module parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterConsumer registers a consumer chain that BTC delegations can
+	// restake to via its finality providers.
+	RegisterConsumer(context.Context, *MsgRegisterConsumer) (*MsgRegisterConsumerResponse, error)
+	// ReportStakingOutputSpend handles the report that a BTC delegation's
+	// staking output is spent on Bitcoin
+	ReportStakingOutputSpend(context.Context, *MsgReportStakingOutputSpend) (*MsgReportStakingOutputSpendResponse, error)
+	// AddBTCDelegationInclusionProof handles the inclusion proof of the
+	// staking tx of a pre-staking BTC delegation
+	AddBTCDelegationInclusionProof(context.Context, *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error)
+	// RetireFinalityProvider retires an existing finality provider
+	RetireFinalityProvider(context.Context, *MsgRetireFinalityProvider) (*MsgRetireFinalityProviderResponse, error)
+	// RotateFinalityProviderAddr rotates the Babylon address bound to an
+	// existing finality provider
+	RotateFinalityProviderAddr(context.Context, *MsgRotateFinalityProviderAddr) (*MsgRotateFinalityProviderAddrResponse, error)
 }
 
-func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingSlashingSig != nil {
-		{
-			size := m.DelegatorUnbondingSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.UnbondingSlashingTx != nil {
-		{
-			size := m.UnbondingSlashingTx.Size()
-			i -= size
-			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.UnbondingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
-		i--
-		dAtA[i] = 0x60
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
+}
+func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
+}
+func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, req *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectiveSlashingEvidence not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterConsumer(ctx context.Context, req *MsgRegisterConsumer) (*MsgRegisterConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterConsumer not implemented")
+}
+func (*UnimplementedMsgServer) ReportStakingOutputSpend(ctx context.Context, req *MsgReportStakingOutputSpend) (*MsgReportStakingOutputSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportStakingOutputSpend not implemented")
+}
+func (*UnimplementedMsgServer) AddBTCDelegationInclusionProof(ctx context.Context, req *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBTCDelegationInclusionProof not implemented")
+}
+func (*UnimplementedMsgServer) RetireFinalityProvider(ctx context.Context, req *MsgRetireFinalityProvider) (*MsgRetireFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireFinalityProvider not implemented")
+}
+func (*UnimplementedMsgServer) RotateFinalityProviderAddr(ctx context.Context, req *MsgRotateFinalityProviderAddr) (*MsgRotateFinalityProviderAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFinalityProviderAddr not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UnbondingTx) > 0 {
-		i -= len(m.UnbondingTx)
-		copy(dAtA[i:], m.UnbondingTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, in)
 	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateFinalityProvider",
 	}
-	if m.DelegatorSlashingSig != nil {
-		{
-			size := m.DelegatorSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFinalityProvider(ctx, req.(*MsgCreateFinalityProvider))
 	}
-	if m.SlashingTx != nil {
-		{
-			size := m.SlashingTx.Size()
-			i -= size
-			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StakingTx != nil {
-		{
-			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).EditFinalityProvider(ctx, in)
 	}
-	if m.StakingValue != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/EditFinalityProvider",
 	}
-	if m.StakingTime != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditFinalityProvider(ctx, req.(*MsgEditFinalityProvider))
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBTCDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, in)
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegation",
 	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBTCDelegation(ctx, req.(*MsgCreateBTCDelegation))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantSigs)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantSigs(ctx, req.(*MsgAddCovenantSigs))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBTCUndelegate)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).BTCUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/BTCUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BTCUndelegate(ctx, req.(*MsgBTCUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SelectiveSlashingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSelectiveSlashingEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/SelectiveSlashingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SelectiveSlashingEvidence(ctx, req.(*MsgSelectiveSlashingEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterConsumer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RegisterConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterConsumer(ctx, req.(*MsgRegisterConsumer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportStakingOutputSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportStakingOutputSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportStakingOutputSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/ReportStakingOutputSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportStakingOutputSpend(ctx, req.(*MsgReportStakingOutputSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddBTCDelegationInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddBTCDelegationInclusionProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddBTCDelegationInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddBTCDelegationInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddBTCDelegationInclusionProof(ctx, req.(*MsgAddBTCDelegationInclusionProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RetireFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireFinalityProvider(ctx, req.(*MsgRetireFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateFinalityProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateFinalityProviderAddr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateFinalityProviderAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RotateFinalityProviderAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateFinalityProviderAddr(ctx, req.(*MsgRotateFinalityProviderAddr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFinalityProvider",
+			Handler:    _Msg_CreateFinalityProvider_Handler,
+		},
+		{
+			MethodName: "EditFinalityProvider",
+			Handler:    _Msg_EditFinalityProvider_Handler,
+		},
+		{
+			MethodName: "CreateBTCDelegation",
+			Handler:    _Msg_CreateBTCDelegation_Handler,
+		},
+		{
+			MethodName: "AddCovenantSigs",
+			Handler:    _Msg_AddCovenantSigs_Handler,
+		},
+		{
+			MethodName: "BTCUndelegate",
+			Handler:    _Msg_BTCUndelegate_Handler,
+		},
+		{
+			MethodName: "SelectiveSlashingEvidence",
+			Handler:    _Msg_SelectiveSlashingEvidence_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterConsumer",
+			Handler:    _Msg_RegisterConsumer_Handler,
+		},
+		{
+			MethodName: "ReportStakingOutputSpend",
+			Handler:    _Msg_ReportStakingOutputSpend_Handler,
+		},
+		{
+			MethodName: "AddBTCDelegationInclusionProof",
+			Handler:    _Msg_AddBTCDelegationInclusionProof_Handler,
+		},
+		{
+			MethodName: "RetireFinalityProvider",
+			Handler:    _Msg_RetireFinalityProvider_Handler,
+		},
+		{
+			MethodName: "RotateFinalityProviderAddr",
+			Handler:    _Msg_RotateFinalityProviderAddr_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/tx.proto",
+}
+
+func (m *MsgCreateFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
+	if m.MaxCommissionChangeRate != nil {
+		{
+			size := m.MaxCommissionChangeRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.UnbondingTxSig != nil {
+	if m.MaxCommissionRate != nil {
 		{
-			size := m.UnbondingTxSig.Size()
+			size := m.MaxCommissionRate.Size()
 			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.SlashingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Pk != nil {
+	if m.Description != nil {
 		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1700,12 +2111,12 @@ func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1713,7 +2124,7 @@ func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1723,46 +2134,58 @@ func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingTxSig != nil {
+	if m.Commission != nil {
 		{
-			size := m.UnbondingTxSig.Size()
+			size := m.Commission.Size()
 			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1772,12 +2195,12 @@ func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1785,7 +2208,7 @@ func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1795,41 +2218,152 @@ func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StakingTx != nil {
+		{
+			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1839,12 +2373,12 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1852,7 +2386,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1862,37 +2396,76 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1902,12 +2475,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1915,298 +2488,2146 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BtcPk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.StakingTime != 0 {
-		n += 1 + sovTx(uint64(m.StakingTime))
-	}
-	if m.StakingValue != 0 {
-		n += 1 + sovTx(uint64(m.StakingValue))
-	}
-	if m.StakingTx != nil {
-		l = m.StakingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SlashingTx != nil {
-		l = m.SlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelegatorSlashingSig != nil {
-		l = m.DelegatorSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingTime))
-	}
-	l = len(m.UnbondingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingValue != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingValue))
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingSlashingTx != nil {
-		l = m.UnbondingSlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelegatorUnbondingSlashingSig != nil {
-		l = m.DelegatorUnbondingSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddCovenantSigs) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Pk != nil {
-		l = m.Pk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SlashingTxSigs) > 0 {
-		for _, b := range m.SlashingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumer != nil {
+		{
+			size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for _, b := range m.SlashingUnbondingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportStakingOutputSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportStakingOutputSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportStakingOutputSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendTxInfo != nil {
+		{
+			size, err := m.SpendTxInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportStakingOutputSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportStakingOutputSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportStakingOutputSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakingTxInfo != nil {
+		{
+			size, err := m.StakingTxInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateFinalityProviderAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateFinalityProviderAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewAddr) > 0 {
+		i -= len(m.NewAddr)
+		copy(dAtA[i:], m.NewAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateFinalityProviderAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateFinalityProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxCommissionChangeRate != nil {
+		l = m.MaxCommissionChangeRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	if m.StakingTx != nil {
+		l = m.StakingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantSigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for _, b := range m.SlashingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for _, b := range m.SlashingUnbondingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCovenantSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Consumer != nil {
+		l = m.Consumer.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportStakingOutputSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpendTxInfo != nil {
+		l = m.SpendTxInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportStakingOutputSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTxInfo != nil {
+		l = m.StakingTxInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetireFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetireFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateFinalityProviderAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateFinalityProviderAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.MaxCommissionChangeRate = &v
+			if err := m.MaxCommissionChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTx == nil {
+				m.StakingTx = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgAddCovenantSigsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgBTCUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgBTCUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecoveredFpBtcSk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2229,15 +4650,15 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2265,13 +4686,13 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2281,31 +4702,30 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2333,15 +4753,11 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2368,17 +4784,14 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2388,27 +4801,58 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2431,7 +4875,7 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2454,10 +4898,10 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2481,7 +4925,7 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,15 +4948,15 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,13 +4984,13 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2556,67 +5000,29 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
-			if m.BtcPk == nil {
-				m.BtcPk = []byte{}
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2626,25 +5032,24 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2669,7 +5074,7 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +5097,10 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +5124,7 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +5147,15 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,13 +5183,13 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2794,31 +5199,27 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2845,126 +5246,116 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveredFpBtcSk == nil {
+				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
-			}
-			m.StakingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
-			}
-			m.StakingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakingTx == nil {
-				m.StakingTx = &types1.TransactionInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2974,32 +5365,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3009,104 +5397,130 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSlashingSig = &v
-			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnbondingTx == nil {
-				m.UnbondingTx = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3116,32 +5530,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.UnbondingSlashingTx = &v
-			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3151,24 +5562,25 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Consumer == nil {
+				m.Consumer = &ConsumerRegister{}
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3193,7 +5605,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterConsumerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,10 +5628,10 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterConsumerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3243,7 +5655,7 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+func (m *MsgReportStakingOutputSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3266,10 +5678,10 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportStakingOutputSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportStakingOutputSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3305,41 +5717,6 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
@@ -3371,11 +5748,11 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendTxInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3385,91 +5762,28 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.SpendTxInfo == nil {
+				m.SpendTxInfo = &types1.TransactionInfo{}
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpendTxInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3491,7 +5805,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportStakingOutputSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3514,10 +5828,10 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportStakingOutputSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportStakingOutputSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3541,7 +5855,7 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3564,10 +5878,10 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3636,9 +5950,9 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3648,24 +5962,25 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StakingTxInfo == nil {
+				m.StakingTxInfo = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTxInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3690,7 +6005,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3713,10 +6028,10 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3740,7 +6055,7 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgRetireFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3763,15 +6078,15 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetireFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetireFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3799,43 +6114,11 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3862,9 +6145,10 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
-			if m.RecoveredFpBtcSk == nil {
-				m.RecoveredFpBtcSk = []byte{}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3888,7 +6172,7 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetireFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3911,10 +6195,10 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetireFinalityProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetireFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3938,7 +6222,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFinalityProviderAddr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,15 +6245,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFinalityProviderAddr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFinalityProviderAddr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3997,11 +6281,46 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NewAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4028,7 +6347,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4053,7 +6375,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFinalityProviderAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4076,10 +6398,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFinalityProviderAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFinalityProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9,15 +9,22 @@ import (
 
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
-// TODO: importing/exporting genesis
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
-	k.SetGenBlsKeys(ctx, genState.GenesisKeys)
-	// set epoch 0 to be finalised at genesis
-	k.SetLastFinalizedEpoch(ctx, 0)
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	if err := k.InitGenesis(ctx, genState); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
+	genesis, err := k.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
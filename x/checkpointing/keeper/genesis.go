@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	k.SetGenBlsKeys(ctx, gs.GenesisKeys)
+
+	for _, reg := range gs.Registrations {
+		valAddr, err := sdk.ValAddressFromBech32(reg.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.RegistrationState(ctx).CreateRegistration(*reg.BlsPubKey, valAddr); err != nil {
+			return err
+		}
+	}
+
+	for _, ckpt := range gs.Checkpoints {
+		if err := k.AddRawCheckpoint(ctx, ckpt); err != nil {
+			return err
+		}
+	}
+
+	for _, valSet := range gs.ValidatorSets {
+		valBlsSetBytes := types.ValidatorBlsKeySetToBytes(k.cdc, valSet.ValSet)
+		k.valBlsSetStore(ctx).Set(types.ValidatorBlsKeySetKey(valSet.EpochNumber), valBlsSetBytes)
+	}
+
+	// epoch 0 is finalised at genesis if no other epoch is
+	k.SetLastFinalizedEpoch(ctx, gs.LastFinalizedEpoch)
+
+	return nil
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+// The BLS keys of the genesis validators are exported as registrations,
+// as their proof-of-possession is not kept in the store.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	ckpts, err := k.rawCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	valSets, err := k.epochValidatorBlsKeySets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	regs, err := k.blsKeyRegistrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Checkpoints:        ckpts,
+		ValidatorSets:      valSets,
+		Registrations:      regs,
+		LastFinalizedEpoch: k.GetLastFinalizedEpoch(ctx),
+	}, nil
+}
+
+// rawCheckpoints loads all raw checkpoints stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) rawCheckpoints(ctx context.Context) ([]*types.RawCheckpointWithMeta, error) {
+	ckpts := make([]*types.RawCheckpointWithMeta, 0)
+
+	iter := k.CheckpointsState(ctx).checkpoints.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		ckpt, err := types.BytesToCkptWithMeta(k.cdc, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		ckpts = append(ckpts, ckpt)
+	}
+
+	return ckpts, nil
+}
+
+// epochValidatorBlsKeySets loads the validator BLS key sets of all epochs stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) epochValidatorBlsKeySets(ctx context.Context) ([]*types.EpochValidatorBlsKeySet, error) {
+	valSets := make([]*types.EpochValidatorBlsKeySet, 0)
+
+	iter := k.valBlsSetStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valSet, err := types.BytesToValidatorBlsKeySet(k.cdc, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		valSets = append(valSets, &types.EpochValidatorBlsKeySet{
+			EpochNumber: sdk.BigEndianToUint64(iter.Key()),
+			ValSet:      valSet,
+		})
+	}
+
+	return valSets, nil
+}
+
+// blsKeyRegistrations loads all BLS public keys registered by validators.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) blsKeyRegistrations(ctx context.Context) ([]*types.BlsKeyRegistration, error) {
+	regs := make([]*types.BlsKeyRegistration, 0)
+
+	iter := k.RegistrationState(ctx).addrToBlsKeys.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		pk := new(bls12381.PublicKey)
+		if err := pk.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		regs = append(regs, &types.BlsKeyRegistration{
+			ValidatorAddress: sdk.ValAddress(iter.Key()).String(),
+			BlsPubKey:        pk,
+		})
+	}
+
+	return regs, nil
+}
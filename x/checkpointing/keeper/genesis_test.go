@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func FuzzExportImportGenesis(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(4)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ek := helper.App.EpochingKeeper
+		ck := helper.App.CheckpointingKeeper
+
+		// move to epoch 3 so that the checkpoints of epoch 1 and 2 are sealed
+		interval := ek.GetParams(helper.Ctx).EpochInterval
+		for i := uint64(0); i < 2*interval; i++ {
+			_, err := helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		ctx := helper.Ctx
+		require.Equal(t, uint64(3), ek.GetEpoch(ctx).EpochNumber)
+
+		// finalise the checkpoint of epoch 1
+		ck.SetCheckpointSubmitted(ctx, 1)
+		ck.SetCheckpointConfirmed(ctx, 1)
+		ck.SetCheckpointFinalized(ctx, 1)
+
+		gs, err := ck.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.Checkpoints, 2)
+		require.Len(t, gs.Registrations, 4)
+		require.NotEmpty(t, gs.ValidatorSets)
+		require.Equal(t, uint64(1), gs.LastFinalizedEpoch)
+
+		// import the exported state into a fresh keeper
+		newKeeper, newCtx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+		require.NoError(t, newKeeper.InitGenesis(newCtx, *gs))
+
+		for epoch := uint64(1); epoch <= 2; epoch++ {
+			ckpt, err := ck.GetRawCheckpoint(ctx, epoch)
+			require.NoError(t, err)
+			importedCkpt, err := newKeeper.GetRawCheckpoint(newCtx, epoch)
+			require.NoError(t, err)
+			require.True(t, ckpt.Equal(importedCkpt))
+		}
+		ckpt, err := newKeeper.GetRawCheckpoint(newCtx, 1)
+		require.NoError(t, err)
+		require.Equal(t, types.Finalized, ckpt.Status)
+		require.Equal(t, uint64(1), newKeeper.GetLastFinalizedEpoch(newCtx))
+		for _, val := range ek.GetValidatorSet(ctx, 2) {
+			blsKey, err := ck.GetBlsPubKey(ctx, val.Addr)
+			require.NoError(t, err)
+			importedBlsKey, err := newKeeper.GetBlsPubKey(newCtx, val.Addr)
+			require.NoError(t, err)
+			require.True(t, blsKey.Equal(importedBlsKey))
+		}
+		require.Equal(t, ck.GetValidatorBlsKeySet(ctx, 2), newKeeper.GetValidatorBlsKeySet(newCtx, 2))
+
+		exported, err := newKeeper.ExportGenesis(newCtx)
+		require.NoError(t, err)
+		require.Equal(t, gs, exported)
+	})
+}
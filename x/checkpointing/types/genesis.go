@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -35,6 +36,148 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	blsKeys := make(map[string]struct{})
+	for _, gk := range gs.GenesisKeys {
+		blsKeys[string(*gk.BlsKey.Pubkey)] = struct{}{}
+	}
+	for _, reg := range gs.Registrations {
+		if _, err := sdk.ValAddressFromBech32(reg.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator address in BLS key registration: %w", err)
+		}
+		if _, exists := addresses[reg.ValidatorAddress]; exists {
+			return fmt.Errorf("duplicate BLS key registration of validator %s", reg.ValidatorAddress)
+		}
+		addresses[reg.ValidatorAddress] = struct{}{}
+		if reg.BlsPubKey == nil {
+			return fmt.Errorf("empty BLS public key of validator %s", reg.ValidatorAddress)
+		}
+		if len(*reg.BlsPubKey) != bls12381.PubKeySize {
+			return fmt.Errorf("invalid BLS public key length of validator %s", reg.ValidatorAddress)
+		}
+		if _, exists := blsKeys[string(*reg.BlsPubKey)]; exists {
+			return fmt.Errorf("BLS public key of validator %s is registered by another validator", reg.ValidatorAddress)
+		}
+		blsKeys[string(*reg.BlsPubKey)] = struct{}{}
+	}
+
+	valSets := make(map[uint64]*ValidatorWithBlsKeySet)
+	for _, valSet := range gs.ValidatorSets {
+		if valSet.ValSet == nil {
+			return fmt.Errorf("empty validator BLS key set of epoch %d", valSet.EpochNumber)
+		}
+		if _, exists := valSets[valSet.EpochNumber]; exists {
+			return fmt.Errorf("duplicate validator BLS key set of epoch %d", valSet.EpochNumber)
+		}
+		for _, val := range valSet.ValSet.ValSet {
+			if _, err := sdk.ValAddressFromBech32(val.ValidatorAddress); err != nil {
+				return fmt.Errorf("invalid validator address in the validator BLS key set of epoch %d: %w", valSet.EpochNumber, err)
+			}
+			if len(val.BlsPubKey) != bls12381.PubKeySize {
+				return fmt.Errorf("invalid BLS public key length of validator %s in epoch %d", val.ValidatorAddress, valSet.EpochNumber)
+			}
+		}
+		valSets[valSet.EpochNumber] = valSet.ValSet
+	}
+
+	ckpts := make(map[uint64]*RawCheckpointWithMeta)
+	for _, ckpt := range gs.Checkpoints {
+		if ckpt == nil || ckpt.Ckpt == nil {
+			return errors.New("empty raw checkpoint")
+		}
+		epoch := ckpt.Ckpt.EpochNum
+		if _, exists := ckpts[epoch]; exists {
+			return fmt.Errorf("duplicate raw checkpoint of epoch %d", epoch)
+		}
+		ckpts[epoch] = ckpt
+		if err := ckpt.validateLifecycle(); err != nil {
+			return fmt.Errorf("invalid lifecycle of the raw checkpoint of epoch %d: %w", epoch, err)
+		}
+		if valSet, ok := valSets[epoch]; ok {
+			if err := ckpt.validateBlsAggrPk(valSet); err != nil {
+				return fmt.Errorf("invalid raw checkpoint of epoch %d: %w", epoch, err)
+			}
+		}
+	}
+
+	if gs.LastFinalizedEpoch > 0 {
+		ckpt, ok := ckpts[gs.LastFinalizedEpoch]
+		if !ok || ckpt.Status != Finalized {
+			return fmt.Errorf("last finalized epoch %d does not have a finalized checkpoint", gs.LastFinalizedEpoch)
+		}
+	}
+
+	return nil
+}
+
+// validateLifecycle checks that the lifecycle of the checkpoint only contains
+// valid status transitions and ends with the status of the checkpoint.
+// A checkpoint is sealed upon being stored and the Sealed state update is not
+// persisted, so its lifecycle can start from Accumulating, Sealed or Submitted.
+func (cm *RawCheckpointWithMeta) validateLifecycle() error {
+	if cm.Status != Accumulating && cm.Ckpt.BlsMultiSig == nil {
+		return fmt.Errorf("checkpoint with status %s does not have a BLS multisig", cm.Status)
+	}
+
+	if len(cm.Lifecycle) == 0 {
+		if cm.Status > Sealed {
+			return fmt.Errorf("checkpoint with status %s has an empty lifecycle", cm.Status)
+		}
+		return nil
+	}
+
+	for i, update := range cm.Lifecycle {
+		if update == nil {
+			return errors.New("empty state update")
+		}
+		if i == 0 {
+			if update.State > Submitted {
+				return fmt.Errorf("lifecycle cannot start with status %s", update.State)
+			}
+			continue
+		}
+		prev := cm.Lifecycle[i-1]
+		// a submitted checkpoint rolls back to Sealed when it is forgotten
+		forgotten := prev.State == Submitted && update.State == Sealed
+		if update.State != prev.State+1 && !forgotten {
+			return fmt.Errorf("invalid status transition from %s to %s", prev.State, update.State)
+		}
+		if update.BlockHeight < prev.BlockHeight {
+			return fmt.Errorf("status %s is updated at height %d, lower than the previous update at height %d", update.State, update.BlockHeight, prev.BlockHeight)
+		}
+	}
+
+	if last := cm.Lifecycle[len(cm.Lifecycle)-1].State; last != cm.Status {
+		return fmt.Errorf("status %s does not match the last state update %s", cm.Status, last)
+	}
+
+	return nil
+}
+
+// validateBlsAggrPk checks that the aggregated BLS public key and the power sum
+// of the checkpoint are consistent with the signers given by the bitmap
+func (cm *RawCheckpointWithMeta) validateBlsAggrPk(valSet *ValidatorWithBlsKeySet) error {
+	signers, powerSum, err := valSet.FindSubsetWithPowerSum(cm.Ckpt.Bitmap)
+	if err != nil {
+		return err
+	}
+	if powerSum != cm.PowerSum {
+		return fmt.Errorf("power sum %d does not match the power sum %d of the signers", cm.PowerSum, powerSum)
+	}
+
+	if len(signers.ValSet) == 0 {
+		if cm.BlsAggrPk != nil {
+			return errors.New("checkpoint without signers has an aggregated BLS public key")
+		}
+		return nil
+	}
+	aggrPk, err := bls12381.AggrPKList(signers.GetBLSKeySet())
+	if err != nil {
+		return err
+	}
+	if cm.BlsAggrPk == nil || !cm.BlsAggrPk.Equal(aggrPk) {
+		return errors.New("aggregated BLS public key does not match the signers")
+	}
+
 	return nil
 }
 
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
 	ed25519 "github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// genesis_keys defines the public keys for the genesis validators
 	GenesisKeys []*GenesisKey `protobuf:"bytes,1,rep,name=genesis_keys,json=genesisKeys,proto3" json:"genesis_keys,omitempty"`
+	// checkpoints are all raw checkpoints with their metadata and lifecycle
+	Checkpoints []*RawCheckpointWithMeta `protobuf:"bytes,2,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	// validator_sets are the validator sets with BLS keys of each epoch
+	ValidatorSets []*EpochValidatorBlsKeySet `protobuf:"bytes,3,rep,name=validator_sets,json=validatorSets,proto3" json:"validator_sets,omitempty"`
+	// registrations are the BLS public keys registered by validators
+	Registrations []*BlsKeyRegistration `protobuf:"bytes,4,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	// last_finalized_epoch is the number of the last finalised epoch
+	LastFinalizedEpoch uint64 `protobuf:"varint,5,opt,name=last_finalized_epoch,json=lastFinalizedEpoch,proto3" json:"last_finalized_epoch,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +79,34 @@ func (m *GenesisState) GetGenesisKeys() []*GenesisKey {
 	return nil
 }
 
+func (m *GenesisState) GetCheckpoints() []*RawCheckpointWithMeta {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSets() []*EpochValidatorBlsKeySet {
+	if m != nil {
+		return m.ValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistrations() []*BlsKeyRegistration {
+	if m != nil {
+		return m.Registrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.LastFinalizedEpoch
+	}
+	return 0
+}
+
 // GenesisKey defines public key information about the genesis validators
 type GenesisKey struct {
 	// validator_address is the address corresponding to a validator
@@ -133,9 +171,114 @@ func (m *GenesisKey) GetValPubkey() *ed25519.PubKey {
 	return nil
 }
 
+// EpochValidatorBlsKeySet is the validator set with BLS keys of a given epoch
+type EpochValidatorBlsKeySet struct {
+	// epoch_number is the number of the epoch
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// val_set is the validator set with BLS keys of the epoch
+	ValSet *ValidatorWithBlsKeySet `protobuf:"bytes,2,opt,name=val_set,json=valSet,proto3" json:"val_set,omitempty"`
+}
+
+func (m *EpochValidatorBlsKeySet) Reset()         { *m = EpochValidatorBlsKeySet{} }
+func (m *EpochValidatorBlsKeySet) String() string { return proto.CompactTextString(m) }
+func (*EpochValidatorBlsKeySet) ProtoMessage()    {}
+func (*EpochValidatorBlsKeySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf2c524ebc9800de, []int{2}
+}
+func (m *EpochValidatorBlsKeySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochValidatorBlsKeySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochValidatorBlsKeySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochValidatorBlsKeySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochValidatorBlsKeySet.Merge(m, src)
+}
+func (m *EpochValidatorBlsKeySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochValidatorBlsKeySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochValidatorBlsKeySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochValidatorBlsKeySet proto.InternalMessageInfo
+
+func (m *EpochValidatorBlsKeySet) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochValidatorBlsKeySet) GetValSet() *ValidatorWithBlsKeySet {
+	if m != nil {
+		return m.ValSet
+	}
+	return nil
+}
+
+// BlsKeyRegistration is the BLS public key registered by a validator
+type BlsKeyRegistration struct {
+	// validator_address is the address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// bls_pub_key is the BLS public key of the validator
+	BlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_pub_key,omitempty"`
+}
+
+func (m *BlsKeyRegistration) Reset()         { *m = BlsKeyRegistration{} }
+func (m *BlsKeyRegistration) String() string { return proto.CompactTextString(m) }
+func (*BlsKeyRegistration) ProtoMessage()    {}
+func (*BlsKeyRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf2c524ebc9800de, []int{3}
+}
+func (m *BlsKeyRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlsKeyRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlsKeyRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlsKeyRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsKeyRegistration.Merge(m, src)
+}
+func (m *BlsKeyRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlsKeyRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsKeyRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlsKeyRegistration proto.InternalMessageInfo
+
+func (m *BlsKeyRegistration) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.checkpointing.v1.GenesisState")
 	proto.RegisterType((*GenesisKey)(nil), "babylon.checkpointing.v1.GenesisKey")
+	proto.RegisterType((*EpochValidatorBlsKeySet)(nil), "babylon.checkpointing.v1.EpochValidatorBlsKeySet")
+	proto.RegisterType((*BlsKeyRegistration)(nil), "babylon.checkpointing.v1.BlsKeyRegistration")
 }
 
 func init() {
@@ -143,28 +286,43 @@ func init() {
 }
 
 var fileDescriptor_bf2c524ebc9800de = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x6a, 0x2a, 0x31,
-	0x18, 0xc5, 0xcd, 0x15, 0xbc, 0x18, 0x5d, 0xb4, 0xb3, 0x12, 0xa1, 0x61, 0x90, 0x52, 0x84, 0x42,
-	0x82, 0x16, 0x17, 0x42, 0x37, 0x75, 0xe3, 0xa2, 0x8b, 0x8a, 0x5d, 0x14, 0xba, 0x91, 0x24, 0x13,
-	0xc6, 0x60, 0x9c, 0x0c, 0x93, 0x38, 0x34, 0x6f, 0xd1, 0x67, 0xe9, 0x53, 0x74, 0xe9, 0xb2, 0xcb,
-	0xa2, 0x2f, 0x52, 0x66, 0x26, 0xb5, 0xb4, 0x30, 0xab, 0xfc, 0xf9, 0xce, 0xf9, 0xce, 0xe1, 0x07,
-	0xaf, 0x18, 0x65, 0x4e, 0xe9, 0x84, 0xf0, 0xb5, 0xe0, 0x9b, 0x54, 0xcb, 0xc4, 0xca, 0x24, 0x26,
-	0xf9, 0x88, 0xc4, 0x22, 0x11, 0x46, 0x1a, 0x9c, 0x66, 0xda, 0xea, 0xa0, 0xe7, 0x75, 0xf8, 0x97,
-	0x0e, 0xe7, 0xa3, 0x7e, 0xc8, 0xb5, 0xd9, 0x6a, 0x43, 0x78, 0xe6, 0x52, 0xab, 0x89, 0x88, 0xc6,
-	0x93, 0xc9, 0x68, 0x4a, 0x36, 0xc2, 0x79, 0x6f, 0xbf, 0x3e, 0x83, 0x29, 0xb3, 0xda, 0x08, 0x57,
-	0xe9, 0x06, 0x4f, 0xb0, 0x3b, 0xaf, 0x42, 0x1f, 0x2d, 0xb5, 0x22, 0x98, 0xc3, 0xae, 0x2f, 0x51,
-	0x88, 0x4c, 0x0f, 0x84, 0xcd, 0x61, 0x67, 0x7c, 0x89, 0xeb, 0xaa, 0x60, 0xef, 0xbe, 0x17, 0x6e,
-	0xd9, 0x89, 0x4f, 0x77, 0x33, 0x78, 0x03, 0x10, 0xfe, 0xcc, 0x82, 0x6b, 0x78, 0x9e, 0x53, 0x25,
-	0x23, 0x6a, 0x75, 0xb6, 0xa2, 0x51, 0x94, 0x09, 0x53, 0x2c, 0x07, 0xc3, 0xf6, 0xf2, 0xec, 0x34,
-	0xb8, 0xab, 0xfe, 0x83, 0x29, 0xfc, 0xef, 0x5b, 0xf6, 0xfe, 0x85, 0x60, 0xd8, 0x19, 0x87, 0xf5,
-	0xf9, 0x33, 0x55, 0x66, 0xb7, 0x58, 0x79, 0x06, 0xb7, 0x10, 0xe6, 0x54, 0xad, 0xd2, 0x1d, 0x2b,
-	0xdc, 0xcd, 0xd2, 0x7d, 0x81, 0x2b, 0x5c, 0xb8, 0xc2, 0x85, 0x3d, 0x2e, 0xbc, 0xd8, 0xb1, 0xc2,
-	0xda, 0xce, 0xa9, 0x5a, 0x94, 0xfa, 0xd9, 0xc3, 0xfb, 0x01, 0x81, 0xfd, 0x01, 0x81, 0xcf, 0x03,
-	0x02, 0xaf, 0x47, 0xd4, 0xd8, 0x1f, 0x51, 0xe3, 0xe3, 0x88, 0x1a, 0xcf, 0x93, 0x58, 0xda, 0xf5,
-	0x8e, 0x61, 0xae, 0xb7, 0xc4, 0x77, 0xe1, 0x6b, 0x2a, 0x93, 0xef, 0x07, 0x79, 0xf9, 0x43, 0xda,
-	0xba, 0x54, 0x18, 0xd6, 0x2a, 0x29, 0xdf, 0x7c, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xab, 0xd1,
-	0x3a, 0xf3, 0x01, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x8a, 0xd3, 0x4c,
+	0x18, 0xdd, 0x6c, 0xf7, 0xef, 0xb2, 0x93, 0xee, 0x8f, 0x0e, 0x0b, 0x86, 0x82, 0xb1, 0x16, 0x91,
+	0x8a, 0x92, 0x6c, 0xba, 0x14, 0x2c, 0xe8, 0x85, 0x15, 0x5d, 0x44, 0xd4, 0x35, 0x05, 0x15, 0x41,
+	0xc2, 0x4c, 0x3a, 0xa6, 0x43, 0xa7, 0x99, 0x90, 0x99, 0x46, 0xe3, 0x0b, 0x08, 0x5e, 0xf9, 0x08,
+	0x3e, 0x83, 0x4f, 0xe1, 0xe5, 0x5e, 0x8a, 0x17, 0x22, 0xed, 0x8b, 0xc8, 0x4c, 0xb2, 0xe9, 0xae,
+	0x12, 0x16, 0xaf, 0x12, 0xbe, 0x39, 0xe7, 0xcc, 0xf9, 0xce, 0x7c, 0x1f, 0xb8, 0x8e, 0x11, 0xce,
+	0x19, 0x8f, 0xdd, 0x70, 0x4a, 0xc2, 0x59, 0xc2, 0x69, 0x2c, 0x69, 0x1c, 0xb9, 0x99, 0xe7, 0x46,
+	0x24, 0x26, 0x82, 0x0a, 0x27, 0x49, 0xb9, 0xe4, 0xd0, 0x2a, 0x71, 0xce, 0x19, 0x9c, 0x93, 0x79,
+	0xed, 0xbd, 0x88, 0x47, 0x5c, 0x83, 0x5c, 0xf5, 0x57, 0xe0, 0xdb, 0x9d, 0x90, 0x8b, 0x39, 0x17,
+	0x6e, 0x98, 0xe6, 0x89, 0xe4, 0x2e, 0x99, 0xf4, 0x07, 0x03, 0x6f, 0xe8, 0xce, 0x48, 0x5e, 0x2a,
+	0xb6, 0xeb, 0x6f, 0xc6, 0x4c, 0x04, 0x33, 0x92, 0x97, 0xb8, 0x1b, 0xb5, 0xb8, 0x75, 0xa1, 0x80,
+	0x76, 0x3f, 0x35, 0x40, 0xeb, 0xb0, 0xb0, 0x3d, 0x96, 0x48, 0x12, 0x78, 0x08, 0x5a, 0x65, 0x1b,
+	0x4a, 0x50, 0x58, 0x46, 0xa7, 0xd1, 0x33, 0xfb, 0xd7, 0x9c, 0xba, 0x66, 0x9c, 0x92, 0xfd, 0x98,
+	0xe4, 0xbe, 0x19, 0x55, 0xff, 0x02, 0x3e, 0x07, 0xe6, 0x1a, 0x2b, 0xac, 0x4d, 0xad, 0xe3, 0xd6,
+	0xeb, 0xf8, 0xe8, 0xdd, 0xfd, 0xaa, 0xf6, 0x92, 0xca, 0xe9, 0x13, 0x22, 0x91, 0x7f, 0x5a, 0x03,
+	0xbe, 0x02, 0xff, 0x67, 0x88, 0xd1, 0x09, 0x92, 0x3c, 0x0d, 0x04, 0x91, 0xc2, 0x6a, 0x68, 0x55,
+	0xaf, 0x5e, 0xf5, 0x41, 0xc2, 0xc3, 0xe9, 0x8b, 0x13, 0xd2, 0x88, 0x29, 0x6f, 0x63, 0x22, 0xfd,
+	0xdd, 0x4a, 0x68, 0x4c, 0xa4, 0x80, 0x3e, 0xd8, 0x4d, 0x49, 0x44, 0x85, 0x4c, 0x91, 0xa4, 0x3c,
+	0x16, 0xd6, 0x96, 0x16, 0xbe, 0x55, 0x2f, 0x5c, 0x48, 0xf9, 0xa7, 0x48, 0xfe, 0x59, 0x09, 0xb8,
+	0x0f, 0xf6, 0x18, 0x12, 0x32, 0x78, 0x4b, 0x63, 0xc4, 0xe8, 0x07, 0x32, 0x09, 0x88, 0x32, 0x63,
+	0xfd, 0xd7, 0x31, 0x7a, 0x5b, 0x3e, 0x54, 0x67, 0x0f, 0x4f, 0x8e, 0xb4, 0xcd, 0xee, 0x57, 0x03,
+	0x80, 0x75, 0x9c, 0xf0, 0x26, 0xb8, 0xb8, 0x6e, 0x17, 0x4d, 0x26, 0x29, 0x11, 0xea, 0x3d, 0x8c,
+	0xde, 0x8e, 0x7f, 0xa1, 0x3a, 0xb8, 0x57, 0xd4, 0xe1, 0x10, 0x6c, 0x97, 0x43, 0x60, 0x6d, 0x76,
+	0x8c, 0x9e, 0xd9, 0xef, 0x9c, 0xeb, 0xbd, 0x89, 0xf5, 0x17, 0xde, 0x01, 0x20, 0x43, 0x2c, 0x48,
+	0x16, 0x58, 0xb1, 0x1b, 0x9a, 0x7d, 0xd9, 0x29, 0xa6, 0xd1, 0x29, 0xa6, 0xd1, 0x29, 0xa7, 0xd1,
+	0x39, 0x5a, 0x60, 0x45, 0xdd, 0xc9, 0x10, 0x3b, 0xd2, 0xf8, 0xee, 0x47, 0x03, 0x5c, 0xaa, 0x49,
+	0x19, 0x5e, 0x05, 0x2d, 0xdd, 0x73, 0x10, 0x2f, 0xe6, 0x98, 0xa4, 0xda, 0xfc, 0x96, 0x6f, 0xea,
+	0xda, 0x53, 0x5d, 0x82, 0x8f, 0xc0, 0xb6, 0xba, 0x5c, 0x10, 0x59, 0xfa, 0xde, 0xaf, 0xf7, 0x5d,
+	0xdd, 0xa0, 0xc6, 0x63, 0xfd, 0x96, 0xcd, 0x0c, 0xb1, 0x31, 0x91, 0xdd, 0x2f, 0x06, 0x80, 0x7f,
+	0x3f, 0xcb, 0xbf, 0xc5, 0xf8, 0x06, 0x98, 0x2a, 0xc6, 0x64, 0x81, 0xab, 0x28, 0x5b, 0xa3, 0xbb,
+	0x3f, 0x7e, 0x5e, 0x19, 0x46, 0x54, 0x4e, 0x17, 0xd8, 0x09, 0xf9, 0xdc, 0x2d, 0x0d, 0x86, 0x53,
+	0x44, 0x63, 0xb7, 0xda, 0xb5, 0x62, 0x6d, 0x31, 0x13, 0x5e, 0xff, 0xe0, 0xb6, 0xa7, 0x92, 0x62,
+	0x34, 0xd4, 0x61, 0x61, 0x26, 0x8a, 0xdc, 0x46, 0xcf, 0xbe, 0x2d, 0x6d, 0xe3, 0x78, 0x69, 0x1b,
+	0xbf, 0x96, 0xb6, 0xf1, 0x79, 0x65, 0x6f, 0x1c, 0xaf, 0xec, 0x8d, 0xef, 0x2b, 0x7b, 0xe3, 0xf5,
+	0xe0, 0x3c, 0xfd, 0xf7, 0x7f, 0x6c, 0xb3, 0xcc, 0x13, 0x22, 0x70, 0x53, 0xaf, 0xf1, 0xc1, 0xef,
+	0x01, 0x00, 0x08, 0x06, 0xdd, 0x69, 0x95, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -187,6 +345,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastFinalizedEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastFinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for iNdEx := len(m.ValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.GenesisKeys) > 0 {
 		for iNdEx := len(m.GenesisKeys) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -258,6 +463,88 @@ func (m *GenesisKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochValidatorBlsKeySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochValidatorBlsKeySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochValidatorBlsKeySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValSet != nil {
+		{
+			size, err := m.ValSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlsKeyRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlsKeyRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlsKeyRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsPubKey != nil {
+		{
+			size := m.BlsPubKey.Size()
+			i -= size
+			if _, err := m.BlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -281,6 +568,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for _, e := range m.ValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastFinalizedEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.LastFinalizedEpoch))
+	}
 	return n
 }
 
@@ -305,6 +613,39 @@ func (m *GenesisKey) Size() (n int) {
 	return n
 }
 
+func (m *EpochValidatorBlsKeySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.ValSet != nil {
+		l = m.ValSet.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlsKeyRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BlsPubKey != nil {
+		l = m.BlsPubKey.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -374,29 +715,150 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+			m.Checkpoints = append(m.Checkpoints, &RawCheckpointWithMeta{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSets = append(m.ValidatorSets, &EpochValidatorBlsKeySet{})
+			if err := m.ValidatorSets[len(m.ValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, &BlsKeyRegistration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFinalizedEpoch", wireType)
+			}
+			m.LastFinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -549,6 +1011,228 @@ func (m *GenesisKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EpochValidatorBlsKeySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochValidatorBlsKeySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochValidatorBlsKeySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValSet == nil {
+				m.ValSet = &ValidatorWithBlsKeySet{}
+			}
+			if err := m.ValSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlsKeyRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlsKeyRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlsKeyRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.BlsPubKey = &v
+			if err := m.BlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
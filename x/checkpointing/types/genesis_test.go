@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/boljen/go-bitmap"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+
+	// genState returns a genesis state with 4 registered validators and a
+	// finalized checkpoint of epoch 1 signed by the first 3 validators
+	genState := func() *types.GenesisState {
+		gs := types.DefaultGenesis()
+		valSet := &types.ValidatorWithBlsKeySet{}
+		for i := 0; i < 4; i++ {
+			valAddr := datagen.GenRandomValidatorAddress().String()
+			blsPubKey := bls12381.GenPrivKey().PubKey()
+			gs.Registrations = append(gs.Registrations, &types.BlsKeyRegistration{
+				ValidatorAddress: valAddr,
+				BlsPubKey:        &blsPubKey,
+			})
+			valSet.ValSet = append(valSet.ValSet, &types.ValidatorWithBlsKey{
+				ValidatorAddress: valAddr,
+				BlsPubKey:        blsPubKey,
+				VotingPower:      10,
+			})
+		}
+		gs.ValidatorSets = []*types.EpochValidatorBlsKeySet{{EpochNumber: 1, ValSet: valSet}}
+
+		ckpt := types.NewCheckpointWithMeta(types.NewCheckpoint(1, datagen.GenRandomBlockHash(r)), types.Finalized)
+		signerKeys := make([]bls12381.PublicKey, 0)
+		for i := 0; i < 3; i++ {
+			bitmap.Set(ckpt.Ckpt.Bitmap, i, true)
+			signerKeys = append(signerKeys, valSet.ValSet[i].BlsPubKey)
+			ckpt.PowerSum += valSet.ValSet[i].VotingPower
+		}
+		aggrPk, err := bls12381.AggrPKList(signerKeys)
+		require.NoError(t, err)
+		ckpt.BlsAggrPk = &aggrPk
+		multiSig := datagen.GenRandomBlsMultiSig(r)
+		ckpt.Ckpt.BlsMultiSig = &multiSig
+		for i, status := range []types.CheckpointStatus{types.Submitted, types.Confirmed, types.Finalized} {
+			ckpt.Lifecycle = append(ckpt.Lifecycle, &types.CheckpointStateUpdate{
+				State:       status,
+				BlockHeight: uint64(10 + i),
+			})
+		}
+		gs.Checkpoints = []*types.RawCheckpointWithMeta{ckpt}
+		gs.LastFinalizedEpoch = 1
+		return gs
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState func() *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis,
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state with checkpoints",
+			genState: genState,
+			valid:    true,
+		},
+		{
+			desc: "duplicate registration",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Registrations[1].ValidatorAddress = gs.Registrations[0].ValidatorAddress
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "BLS key registered by several validators",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Registrations[1].BlsPubKey = gs.Registrations[0].BlsPubKey
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate checkpoint",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints = append(gs.Checkpoints, gs.Checkpoints[0])
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid status transition",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints[0].Lifecycle[1].State = types.Finalized
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "status does not match lifecycle",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints[0].Status = types.Confirmed
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "decreasing state update height",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints[0].Lifecycle[2].BlockHeight = 0
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "sealed checkpoint without multisig",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints[0].Ckpt.BlsMultiSig = nil
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "aggregated BLS key does not match the bitmap",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				bitmap.Set(gs.Checkpoints[0].Ckpt.Bitmap, 2, false)
+				bitmap.Set(gs.Checkpoints[0].Ckpt.Bitmap, 3, true)
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "power sum does not match the bitmap",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Checkpoints[0].PowerSum++
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "last finalized epoch is not finalized",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.LastFinalizedEpoch = 2
+				return gs
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState().Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -14,9 +15,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+const (
+	FlagSpendLimit        = "spend-limit"
+	FlagAllowedValidators = "allowed-validators"
+	FlagDenyValidators    = "deny-validators"
+	FlagExpiration        = "expiration"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +41,7 @@ func GetTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingCmd(),
+		NewGrantStakeAuthorizationCmd(),
 	)
 
 	return cmd
@@ -226,3 +236,111 @@ $ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100%s --from m
 
 	return cmd
 }
+
+func NewGrantStakeAuthorizationCmd() *cobra.Command {
+	bech32PrefixAccAddr := params.Bech32PrefixAccAddr
+	bech32PrefixValAddr := params.Bech32PrefixAccAddr
+
+	cmd := &cobra.Command{
+		Use:   "grant-stake-authorization [grantee] [delegate|unbond|redelegate|cancel-unbond]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Grant authorization to an address to submit wrapped staking messages on behalf of you",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to submit wrapped staking messages, e.g., MsgWrappedDelegate, on behalf of you.
+The authorization applies the same restrictions as the staking module's stake authorization.
+
+Example:
+$ %s tx epoching grant-stake-authorization %s1skjw.. delegate --spend-limit=1000ubbn --allowed-validators=%s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authzType stakingtypes.AuthorizationType
+			switch args[1] {
+			case "delegate":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE
+			case "unbond":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE
+			case "redelegate":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE
+			case "cancel-unbond":
+				authzType = stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION
+			default:
+				return fmt.Errorf("invalid authorization type %s", args[1])
+			}
+
+			fs := cmd.Flags()
+
+			var spendLimit *sdk.Coin
+			if limitStr, _ := fs.GetString(FlagSpendLimit); limitStr != "" {
+				limit, err := sdk.ParseCoinNormalized(limitStr)
+				if err != nil {
+					return err
+				}
+				spendLimit = &limit
+			}
+
+			allowedValStrs, _ := fs.GetStringSlice(FlagAllowedValidators)
+			allowed, err := parseValAddrs(allowedValStrs)
+			if err != nil {
+				return err
+			}
+			denyValStrs, _ := fs.GetStringSlice(FlagDenyValidators)
+			denied, err := parseValAddrs(denyValStrs)
+			if err != nil {
+				return err
+			}
+
+			authorization, err := types.NewStakeAuthorization(allowed, denied, authzType, spendLimit)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp, _ := fs.GetInt64(FlagExpiration); exp > 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.String(FlagSpendLimit, "", "The (optional) maximum amount of tokens that the grantee can spend")
+	fs.StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
+	fs.StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	fs.Int64(FlagExpiration, 0, "The (optional) Unix timestamp of the expiration of the authorization")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseValAddrs(valAddrStrs []string) ([]sdk.ValAddress, error) {
+	valAddrs := make([]sdk.ValAddress, 0, len(valAddrStrs))
+	for _, valAddrStr := range valAddrStrs {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return nil, err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+	return valAddrs, nil
+}
@@ -6,6 +6,22 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// maxNestedMsgDepth is the maximum depth of nested messages, e.g., messages
+// inside authz.MsgExec, that DropValidatorMsgDecorator accepts
+const maxNestedMsgDepth = 5
+
+// nestedMsgsGetter is implemented by messages that contain other messages,
+// e.g., authz.MsgExec
+type nestedMsgsGetter interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// nestedMsgsProposal is implemented by proposal messages that contain other
+// messages, e.g., gov's and group's MsgSubmitProposal
+type nestedMsgsProposal interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
 // DropValidatorMsgDecorator defines an AnteHandler decorator that rejects all messages that might change the validator set.
 type DropValidatorMsgDecorator struct {
 	ek Keeper
@@ -25,6 +41,7 @@ func NewDropValidatorMsgDecorator(ek Keeper) *DropValidatorMsgDecorator {
 // - MsgUndelegate
 // - MsgBeginRedelegate
 // - MsgCancelUnbondingDelegation
+// including those nested in other messages, e.g., authz.MsgExec
 func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// skip if at genesis block, as genesis state contains txs that bootstrap the initial validator set
 	if ctx.BlockHeight() == 0 {
@@ -32,14 +49,40 @@ func (qmd DropValidatorMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 	// after genesis, if validator-related message, reject msg
 	for _, msg := range tx.GetMsgs() {
-		if qmd.IsValidatorRelatedMsg(msg) {
-			return ctx, epochingtypes.ErrUnwrappedMsgType
+		if err := qmd.checkMsg(msg, 0); err != nil {
+			return ctx, err
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// checkMsg checks that the given message at the given nesting depth, and all
+// messages nested in it, are not non-wrapped validator-related messages
+func (qmd DropValidatorMsgDecorator) checkMsg(msg sdk.Msg, depth int) error {
+	if qmd.IsValidatorRelatedMsg(msg) {
+		return epochingtypes.ErrUnwrappedMsgType
+	}
+
+	nestedMsgs, err := getNestedMsgs(msg)
+	if err != nil {
+		return err
+	}
+	if len(nestedMsgs) == 0 {
+		return nil
+	}
+	if depth >= maxNestedMsgDepth {
+		return epochingtypes.ErrTooDeepNestedMsg.Wrapf("maximum depth: %d", maxNestedMsgDepth)
+	}
+	for _, nestedMsg := range nestedMsgs {
+		if err := qmd.checkMsg(nestedMsg, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // IsValidatorRelatedMsg checks if the given message is of non-wrapped type, which should be rejected
 func (qmd DropValidatorMsgDecorator) IsValidatorRelatedMsg(msg sdk.Msg) bool {
 	switch msg.(type) {
@@ -49,3 +92,15 @@ func (qmd DropValidatorMsgDecorator) IsValidatorRelatedMsg(msg sdk.Msg) bool {
 		return false
 	}
 }
+
+// getNestedMsgs returns the messages nested in the given message, if any
+func getNestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case nestedMsgsGetter:
+		return msg.GetMessages()
+	case nestedMsgsProposal:
+		return msg.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
@@ -3,12 +3,26 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
 
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+// testTx is a minimal sdk.Tx carrying the given messages
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
 func TestDropValidatorMsgDecorator(t *testing.T) {
 	testCases := []struct {
 		msg        sdk.Msg
@@ -35,3 +49,81 @@ func TestDropValidatorMsgDecorator(t *testing.T) {
 		}
 	}
 }
+
+func TestDropValidatorMsgDecoratorNestedMsgs(t *testing.T) {
+	grantee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	// wrapMsgExec wraps the given messages in the given levels of authz.MsgExec
+	wrapMsgExec := func(levels int, msgs ...sdk.Msg) sdk.Msg {
+		msgExec := authz.NewMsgExec(grantee, msgs)
+		for i := 1; i < levels; i++ {
+			innerMsgExec := msgExec
+			msgExec = authz.NewMsgExec(grantee, []sdk.Msg{&innerMsgExec})
+		}
+		return &msgExec
+	}
+	msgSubmitProposal := func(msgs ...sdk.Msg) sdk.Msg {
+		msg, err := govv1.NewMsgSubmitProposal(msgs, sdk.NewCoins(), grantee.String(), "", "title", "summary", false)
+		require.NoError(t, err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		expectErr error
+	}{
+		{
+			"MsgExec with a non-wrapped validator-related message",
+			[]sdk.Msg{wrapMsgExec(1, &stakingtypes.MsgDelegate{})},
+			epochingtypes.ErrUnwrappedMsgType,
+		},
+		{
+			"MsgExec with an allowed and a non-wrapped validator-related message",
+			[]sdk.Msg{wrapMsgExec(1, &stakingtypes.MsgEditValidator{}, &stakingtypes.MsgUndelegate{})},
+			epochingtypes.ErrUnwrappedMsgType,
+		},
+		{
+			"multi-level MsgExec with a non-wrapped validator-related message",
+			[]sdk.Msg{wrapMsgExec(3, &stakingtypes.MsgBeginRedelegate{})},
+			epochingtypes.ErrUnwrappedMsgType,
+		},
+		{
+			"proposal with a MsgExec with a non-wrapped validator-related message",
+			[]sdk.Msg{msgSubmitProposal(wrapMsgExec(1, &stakingtypes.MsgCancelUnbondingDelegation{}))},
+			epochingtypes.ErrUnwrappedMsgType,
+		},
+		{
+			"MsgExec nested too deeply",
+			[]sdk.Msg{wrapMsgExec(maxNestedMsgDepth+1, &stakingtypes.MsgEditValidator{})},
+			epochingtypes.ErrTooDeepNestedMsg,
+		},
+		{
+			"MsgExec with wrapped messages",
+			[]sdk.Msg{wrapMsgExec(1,
+				&epochingtypes.MsgWrappedDelegate{Msg: &stakingtypes.MsgDelegate{}},
+				&epochingtypes.MsgWrappedUndelegate{Msg: &stakingtypes.MsgUndelegate{}},
+			)},
+			nil,
+		},
+		{
+			"multi-level MsgExec with allowed messages",
+			[]sdk.Msg{wrapMsgExec(maxNestedMsgDepth, &stakingtypes.MsgEditValidator{})},
+			nil,
+		},
+	}
+
+	decorator := NewDropValidatorMsgDecorator(Keeper{})
+	ctx := sdk.Context{}.WithBlockHeight(1)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, testTx{msgs: tc.msgs}, false, next)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
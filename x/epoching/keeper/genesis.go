@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/epoching/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+// If the genesis state does not contain any epoch, the module starts from epoch 0
+// with the validator set of the staking module. Otherwise, the module resumes
+// from the last epoch in the genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if len(gs.Epochs) == 0 {
+		// init epoch number
+		k.InitEpoch(ctx)
+		// init msg queue
+		k.InitMsgQueue(ctx)
+		// init validator set
+		k.InitValidatorSet(ctx)
+		// init slashed voting power
+		k.InitSlashedVotingPower(ctx)
+		return nil
+	}
+
+	for _, epoch := range gs.Epochs {
+		k.setEpochInfo(ctx, epoch.EpochNumber, epoch)
+	}
+
+	for _, queue := range gs.Queues {
+		store := k.msgQueueStore(ctx, queue.EpochNumber)
+		for i, msg := range queue.Msgs {
+			msgBytes, err := k.cdc.MarshalInterface(msg)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrMarshal, err.Error())
+			}
+			store.Set(sdk.Uint64ToBigEndian(uint64(i)), msgBytes)
+		}
+		queueLenBytes := sdk.Uint64ToBigEndian(uint64(len(queue.Msgs)))
+		k.msgQueueLengthStore(ctx).Set(sdk.Uint64ToBigEndian(queue.EpochNumber), queueLenBytes)
+	}
+
+	for _, valSet := range gs.ValidatorSets {
+		if err := k.setEpochValidatorSet(ctx, k.valSetStore(ctx, valSet.EpochNumber), valSet); err != nil {
+			return err
+		}
+		totalPowerBytes, err := sdkmath.NewInt(valSet.TotalVotingPower).Marshal()
+		if err != nil {
+			return errorsmod.Wrap(types.ErrMarshal, err.Error())
+		}
+		k.votingPowerStore(ctx).Set(sdk.Uint64ToBigEndian(valSet.EpochNumber), totalPowerBytes)
+	}
+
+	for _, valSet := range gs.SlashedValidatorSets {
+		if err := k.setEpochValidatorSet(ctx, k.slashedValSetStore(ctx, valSet.EpochNumber), valSet); err != nil {
+			return err
+		}
+		k.setSlashedVotingPower(ctx, valSet.EpochNumber, valSet.TotalVotingPower)
+	}
+
+	for _, lc := range gs.ValidatorsLifecycle {
+		valAddr, err := sdk.ValAddressFromBech32(lc.ValAddr)
+		if err != nil {
+			return err
+		}
+		k.SetValLifecycle(ctx, valAddr, lc)
+	}
+
+	for _, lc := range gs.DelegationsLifecycle {
+		delAddr, err := sdk.AccAddressFromBech32(lc.DelAddr)
+		if err != nil {
+			return err
+		}
+		k.SetDelegationLifecycle(ctx, delAddr, lc)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochs, err := k.epochs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queues := make([]*types.EpochQueue, 0)
+	valSets := make([]*types.EpochValidatorSet, 0)
+	slashedValSets := make([]*types.EpochValidatorSet, 0)
+	for _, epoch := range epochs {
+		epochNumber := epoch.EpochNumber
+		epochNumberBytes := sdk.Uint64ToBigEndian(epochNumber)
+
+		if k.msgQueueLengthStore(ctx).Has(epochNumberBytes) {
+			queues = append(queues, &types.EpochQueue{
+				EpochNumber: epochNumber,
+				Msgs:        k.GetEpochMsgs(ctx, epochNumber),
+			})
+		}
+
+		if k.votingPowerStore(ctx).Has(epochNumberBytes) {
+			valSets = append(valSets, newEpochValidatorSet(
+				epochNumber,
+				k.GetValidatorSet(ctx, epochNumber),
+				k.GetTotalVotingPower(ctx, epochNumber),
+			))
+		}
+
+		if k.slashedVotingPowerStore(ctx).Has(epochNumberBytes) {
+			slashedValSets = append(slashedValSets, newEpochValidatorSet(
+				epochNumber,
+				k.GetSlashedValidators(ctx, epochNumber),
+				k.GetSlashedVotingPower(ctx, epochNumber),
+			))
+		}
+	}
+
+	valLifecycles, err := k.validatorLifecycles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delLifecycles, err := k.delegationLifecycles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:               k.GetParams(ctx),
+		Epochs:               epochs,
+		Queues:               queues,
+		ValidatorSets:        valSets,
+		SlashedValidatorSets: slashedValSets,
+		ValidatorsLifecycle:  valLifecycles,
+		DelegationsLifecycle: delLifecycles,
+	}, nil
+}
+
+// setEpochValidatorSet writes the validators of the given set to the given store
+func (k Keeper) setEpochValidatorSet(ctx context.Context, store prefix.Store, valSet *types.EpochValidatorSet) error {
+	for _, val := range valSet.Validators {
+		powerBytes, err := sdkmath.NewInt(val.Power).Marshal()
+		if err != nil {
+			return errorsmod.Wrap(types.ErrMarshal, err.Error())
+		}
+		store.Set(val.Addr, powerBytes)
+	}
+	return nil
+}
+
+// epochs loads the metadata of all epochs stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) epochs(ctx context.Context) ([]*types.Epoch, error) {
+	epochs := make([]*types.Epoch, 0)
+
+	iter := k.epochInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var epoch types.Epoch
+		if err := k.cdc.Unmarshal(iter.Value(), &epoch); err != nil {
+			return nil, err
+		}
+		epochs = append(epochs, &epoch)
+	}
+
+	return epochs, nil
+}
+
+// validatorLifecycles loads the lifecycles of all validators stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) validatorLifecycles(ctx context.Context) ([]*types.ValidatorLifecycle, error) {
+	lcs := make([]*types.ValidatorLifecycle, 0)
+
+	iter := k.valLifecycleStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var lc types.ValidatorLifecycle
+		if err := k.cdc.Unmarshal(iter.Value(), &lc); err != nil {
+			return nil, err
+		}
+		lcs = append(lcs, &lc)
+	}
+
+	return lcs, nil
+}
+
+// delegationLifecycles loads the lifecycles of all delegations stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) delegationLifecycles(ctx context.Context) ([]*types.DelegationLifecycle, error) {
+	lcs := make([]*types.DelegationLifecycle, 0)
+
+	iter := k.delegationLifecycleStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var lc types.DelegationLifecycle
+		if err := k.cdc.Unmarshal(iter.Value(), &lc); err != nil {
+			return nil, err
+		}
+		lcs = append(lcs, &lc)
+	}
+
+	return lcs, nil
+}
+
+func newEpochValidatorSet(epochNumber uint64, valSet types.ValidatorSet, totalPower int64) *types.EpochValidatorSet {
+	vals := make([]*types.Validator, 0, len(valSet))
+	for i := range valSet {
+		vals = append(vals, &valSet[i])
+	}
+	return &types.EpochValidatorSet{
+		EpochNumber:      epochNumber,
+		Validators:       vals,
+		TotalVotingPower: totalPower,
+	}
+}
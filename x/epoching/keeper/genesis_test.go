@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+)
+
+func FuzzExportImportGenesis(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		genesisValSet, privSigner, err := datagen.GenesisValidatorSetWithPrivSigner(4)
+		require.NoError(t, err)
+		helper := testhelper.NewHelperWithValSet(t, genesisValSet, privSigner)
+		ctx, keeper, genAccs := helper.Ctx, helper.App.EpochingKeeper, helper.GenAccs
+		params := keeper.GetParams(ctx)
+		genAddr := genAccs[0].GetAddress()
+
+		// delegate in epoch 1 and move to epoch 2 so that the msgs are
+		// executed and delegation lifecycles are recorded
+		val := keeper.GetCurrentValidatorSet(ctx)[0].Addr
+		numDels := r.Intn(5) + 1
+		for i := 0; i < numDels; i++ {
+			helper.WrappedDelegate(genAddr, val, coinWithOnePower.Amount)
+		}
+		for i := uint64(0); i < params.EpochInterval; i++ {
+			ctx, err = helper.ApplyEmptyBlockWithVoteExtension(r)
+			require.NoError(t, err)
+		}
+		require.Equal(t, uint64(2), keeper.GetEpoch(ctx).EpochNumber)
+		require.NotNil(t, keeper.GetDelegationLifecycle(ctx, genAddr))
+
+		// queue some msgs in epoch 2 that remain pending
+		numPendingDels := r.Intn(5) + 1
+		for i := 0; i < numPendingDels; i++ {
+			helper.WrappedDelegate(genAddr, val, coinWithOnePower.Amount)
+		}
+		pendingMsgs := keeper.GetCurrentEpochMsgs(ctx)
+		require.Len(t, pendingMsgs, numPendingDels)
+
+		gs, err := keeper.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.Epochs, 3)
+		require.Len(t, gs.Queues, 3)
+		require.Len(t, gs.ValidatorSets, 3)
+		require.Len(t, gs.SlashedValidatorSets, 3)
+		require.NotEmpty(t, gs.ValidatorsLifecycle)
+		require.NotEmpty(t, gs.DelegationsLifecycle)
+
+		// import the exported state into a fresh keeper
+		newKeeper, newCtx := keepertest.EpochingKeeper(t)
+		require.NoError(t, newKeeper.InitGenesis(newCtx, *gs))
+
+		// the new keeper resumes from the same epoch with the same pending msgs
+		require.Equal(t, keeper.GetEpoch(ctx), newKeeper.GetEpoch(newCtx))
+		require.Equal(t, pendingMsgs, newKeeper.GetCurrentEpochMsgs(newCtx))
+		require.Equal(t, keeper.GetCurrentQueueLength(ctx), newKeeper.GetCurrentQueueLength(newCtx))
+		for epochNum := uint64(0); epochNum <= 2; epochNum++ {
+			require.Equal(t, keeper.GetValidatorSet(ctx, epochNum), newKeeper.GetValidatorSet(newCtx, epochNum))
+			require.Equal(t, keeper.GetTotalVotingPower(ctx, epochNum), newKeeper.GetTotalVotingPower(newCtx, epochNum))
+			require.Equal(t, keeper.GetSlashedVotingPower(ctx, epochNum), newKeeper.GetSlashedVotingPower(newCtx, epochNum))
+		}
+		require.Equal(t, keeper.GetDelegationLifecycle(ctx, genAddr), newKeeper.GetDelegationLifecycle(newCtx, genAddr))
+
+		exported, err := newKeeper.ExportGenesis(newCtx)
+		require.NoError(t, err)
+		require.Equal(t, gs, exported)
+	})
+}
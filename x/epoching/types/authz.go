@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ authz.Authorization = &StakeAuthorization{}
+
+// NewStakeAuthorization creates a new StakeAuthorization for the wrapped
+// staking messages of the given authorization type, with the same
+// restrictions as the staking module's StakeAuthorization
+func NewStakeAuthorization(
+	allowed []sdk.ValAddress,
+	denied []sdk.ValAddress,
+	authzType stakingtypes.AuthorizationType,
+	amount *sdk.Coin,
+) (*StakeAuthorization, error) {
+	stakeAuthz, err := stakingtypes.NewStakeAuthorization(allowed, denied, authzType, amount)
+	if err != nil {
+		return nil, err
+	}
+	return &StakeAuthorization{StakeAuthorization: stakeAuthz}, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. It returns the type URL of
+// the wrapped staking message corresponding to the authorization type
+func (a StakeAuthorization) MsgTypeURL() string {
+	if a.StakeAuthorization == nil {
+		panic("empty stake authorization")
+	}
+	msgTypeURL, err := wrappedMsgTypeURL(a.StakeAuthorization.AuthorizationType)
+	if err != nil {
+		panic(err)
+	}
+	return msgTypeURL
+}
+
+// ValidateBasic performs a stateless validation of the fields
+func (a StakeAuthorization) ValidateBasic() error {
+	if a.StakeAuthorization == nil {
+		return fmt.Errorf("empty stake authorization")
+	}
+	if _, err := wrappedMsgTypeURL(a.StakeAuthorization.AuthorizationType); err != nil {
+		return err
+	}
+	return a.StakeAuthorization.ValidateBasic()
+}
+
+// Accept implements Authorization.Accept. It unwraps the given wrapped
+// staking message and applies the staking module's StakeAuthorization to
+// the staking message inside
+func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var stakingMsg sdk.Msg
+	switch msg := msg.(type) {
+	case *MsgWrappedDelegate:
+		stakingMsg = msg.Msg
+	case *MsgWrappedUndelegate:
+		stakingMsg = msg.Msg
+	case *MsgWrappedBeginRedelegate:
+		stakingMsg = msg.Msg
+	case *MsgWrappedCancelUnbondingDelegation:
+		stakingMsg = msg.Msg
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+	if stakingMsg == nil {
+		return authz.AcceptResponse{}, ErrNoWrappedMsg
+	}
+
+	resp, err := a.StakeAuthorization.Accept(ctx, stakingMsg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	// wrap the updated staking module's StakeAuthorization, if any
+	if updated, ok := resp.Updated.(*stakingtypes.StakeAuthorization); ok {
+		resp.Updated = &StakeAuthorization{StakeAuthorization: updated}
+	}
+	return resp, nil
+}
+
+// wrappedMsgTypeURL returns the type URL of the wrapped staking message
+// corresponding to the given authorization type
+func wrappedMsgTypeURL(authzType stakingtypes.AuthorizationType) (string, error) {
+	switch authzType {
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return sdk.MsgTypeURL(&MsgWrappedDelegate{}), nil
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return sdk.MsgTypeURL(&MsgWrappedUndelegate{}), nil
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return sdk.MsgTypeURL(&MsgWrappedBeginRedelegate{}), nil
+	case stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_CANCEL_UNBONDING_DELEGATION:
+		return sdk.MsgTypeURL(&MsgWrappedCancelUnbondingDelegation{}), nil
+	default:
+		return "", authz.ErrUnknownAuthorizationType.Wrapf("cannot handle %s authorization type", authzType)
+	}
+}
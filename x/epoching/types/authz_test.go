@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/x/epoching/types"
+)
+
+func TestStakeAuthorization(t *testing.T) {
+	ctx := sdk.Context{}.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	val1 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	val2 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coin := func(amount int64) sdk.Coin { return sdk.NewCoin("ubbn", sdkmath.NewInt(amount)) }
+	maxTokens := coin(100)
+
+	// the authorization is keyed by the wrapped message type
+	delegateAuthz, err := types.NewStakeAuthorization([]sdk.ValAddress{val1}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &maxTokens)
+	require.NoError(t, err)
+	require.NoError(t, delegateAuthz.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgWrappedDelegate{}), delegateAuthz.MsgTypeURL())
+	undelegateAuthz, err := types.NewStakeAuthorization(nil, []sdk.ValAddress{val2}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgWrappedUndelegate{}), undelegateAuthz.MsgTypeURL())
+
+	// a wrapped delegation to an allowed validator within the limit is
+	// accepted, and the limit is reduced
+	resp, err := delegateAuthz.Accept(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr.String(), val1.String(), coin(40))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*types.StakeAuthorization)
+	require.True(t, ok)
+	require.Equal(t, coin(60), *updated.StakeAuthorization.MaxTokens)
+
+	// a wrapped delegation using up the limit deletes the authorization
+	resp, err = updated.Accept(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr.String(), val1.String(), coin(60))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// a wrapped delegation beyond the limit or to a non-allowed validator is rejected
+	_, err = delegateAuthz.Accept(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr.String(), val1.String(), coin(101))))
+	require.Error(t, err)
+	_, err = delegateAuthz.Accept(ctx, types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr.String(), val2.String(), coin(1))))
+	require.Error(t, err)
+
+	// a wrapped undelegation from a denied validator is rejected
+	_, err = undelegateAuthz.Accept(ctx, types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr.String(), val2.String(), coin(1))))
+	require.Error(t, err)
+	resp, err = undelegateAuthz.Accept(ctx, types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr.String(), val1.String(), coin(1))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	// a non-wrapped staking message is rejected
+	_, err = delegateAuthz.Accept(ctx, stakingtypes.NewMsgDelegate(delAddr.String(), val1.String(), coin(1)))
+	require.Error(t, err)
+
+	// an empty or unspecified authorization is invalid
+	require.Error(t, types.StakeAuthorization{}.ValidateBasic())
+	require.Error(t, types.StakeAuthorization{StakeAuthorization: &stakingtypes.StakeAuthorization{}}.ValidateBasic())
+
+	// the authorization can be packed as an authz grant
+	_, err = authz.NewGrant(ctx.BlockTime(), delegateAuthz, nil)
+	require.NoError(t, err)
+}
@@ -5,14 +5,17 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWrappedDelegate{}, "epoching/WrappedDelegate", nil)
 	cdc.RegisterConcrete(&MsgWrappedUndelegate{}, "epoching/WrappedUndelegate", nil)
 	cdc.RegisterConcrete(&MsgWrappedBeginRedelegate{}, "epoching/WrappedBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgWrappedCancelUnbondingDelegation{}, "epoching/WrappedCancelUnbondingDelegation", nil)
 	cdc.RegisterConcrete(&QueuedMessage{}, "epoching/QueuedMessage", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "epoching/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&StakeAuthorization{}, "epoching/StakeAuthorization", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,10 +25,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgWrappedDelegate{},
 		&MsgWrappedUndelegate{},
 		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedCancelUnbondingDelegation{},
 		&QueuedMessage{},
 		&MsgUpdateParams{},
 	)
 
+	// Register authorizations for the wrapped staking messages
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&StakeAuthorization{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
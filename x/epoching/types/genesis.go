@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -20,6 +27,164 @@ func NewGenesis(params Params) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.Epochs) == 0 {
+		if len(gs.Queues) > 0 || len(gs.ValidatorSets) > 0 || len(gs.SlashedValidatorSets) > 0 {
+			return fmt.Errorf("genesis without epochs cannot have msg queues or validator sets")
+		}
+	}
+
+	// ensure epochs are consecutive
+	epochs := make(map[uint64]struct{})
+	for i, epoch := range gs.Epochs {
+		if epoch == nil {
+			return fmt.Errorf("epoch at index %d is nil", i)
+		}
+		if err := epoch.ValidateBasic(); err != nil {
+			return err
+		}
+		if i > 0 && epoch.EpochNumber != gs.Epochs[i-1].EpochNumber+1 {
+			return fmt.Errorf("epochs are not consecutive: epoch %d follows epoch %d", epoch.EpochNumber, gs.Epochs[i-1].EpochNumber)
+		}
+		epochs[epoch.EpochNumber] = struct{}{}
+	}
+
+	queues := make(map[uint64]struct{})
+	for _, queue := range gs.Queues {
+		if _, ok := epochs[queue.EpochNumber]; !ok {
+			return fmt.Errorf("msg queue of unknown epoch %d", queue.EpochNumber)
+		}
+		if _, ok := queues[queue.EpochNumber]; ok {
+			return fmt.Errorf("duplicated msg queue for epoch %d", queue.EpochNumber)
+		}
+		queues[queue.EpochNumber] = struct{}{}
+		for _, msg := range queue.Msgs {
+			if msg == nil || msg.Msg == nil {
+				return fmt.Errorf("msg queue of epoch %d contains an empty msg", queue.EpochNumber)
+			}
+		}
+	}
+
+	valSets := make(map[uint64]ValidatorSet)
+	for _, valSet := range gs.ValidatorSets {
+		if _, ok := epochs[valSet.EpochNumber]; !ok {
+			return fmt.Errorf("validator set of unknown epoch %d", valSet.EpochNumber)
+		}
+		if _, ok := valSets[valSet.EpochNumber]; ok {
+			return fmt.Errorf("duplicated validator set for epoch %d", valSet.EpochNumber)
+		}
+		vs, totalPower, err := valSet.validate()
+		if err != nil {
+			return err
+		}
+		if totalPower != valSet.TotalVotingPower {
+			return fmt.Errorf("total voting power of epoch %d is %d, but validators have %d", valSet.EpochNumber, valSet.TotalVotingPower, totalPower)
+		}
+		valSets[valSet.EpochNumber] = vs
+	}
+
+	slashedValSets := make(map[uint64]struct{})
+	for _, valSet := range gs.SlashedValidatorSets {
+		if _, ok := epochs[valSet.EpochNumber]; !ok {
+			return fmt.Errorf("slashed validator set of unknown epoch %d", valSet.EpochNumber)
+		}
+		if _, ok := slashedValSets[valSet.EpochNumber]; ok {
+			return fmt.Errorf("duplicated slashed validator set for epoch %d", valSet.EpochNumber)
+		}
+		slashedValSets[valSet.EpochNumber] = struct{}{}
+		slashedVals, _, err := valSet.validate()
+		if err != nil {
+			return err
+		}
+		if valSet.TotalVotingPower < 0 {
+			return fmt.Errorf("negative slashed voting power in epoch %d", valSet.EpochNumber)
+		}
+		// slashed validators must be in the validator set of the epoch
+		if vs, ok := valSets[valSet.EpochNumber]; ok {
+			for _, val := range slashedVals {
+				if _, _, err := vs.FindValidatorWithIndex(val.GetValAddress()); err != nil {
+					return fmt.Errorf("slashed validator %s is not in the validator set of epoch %d", val.GetValAddressStr(), valSet.EpochNumber)
+				}
+			}
+		}
+	}
 
-	return gs.Params.Validate()
+	// the current epoch needs a msg queue and validator sets to proceed
+	if len(gs.Epochs) > 0 {
+		curEpochNumber := gs.Epochs[len(gs.Epochs)-1].EpochNumber
+		if _, ok := queues[curEpochNumber]; !ok {
+			return fmt.Errorf("missing msg queue of the current epoch %d", curEpochNumber)
+		}
+		if _, ok := valSets[curEpochNumber]; !ok {
+			return fmt.Errorf("missing validator set of the current epoch %d", curEpochNumber)
+		}
+		if _, ok := slashedValSets[curEpochNumber]; !ok {
+			return fmt.Errorf("missing slashed validator set of the current epoch %d", curEpochNumber)
+		}
+	}
+
+	valLifecycles := make(map[string]struct{})
+	for _, lc := range gs.ValidatorsLifecycle {
+		if _, err := sdk.ValAddressFromBech32(lc.ValAddr); err != nil {
+			return fmt.Errorf("invalid validator address in lifecycle: %w", err)
+		}
+		if _, ok := valLifecycles[lc.ValAddr]; ok {
+			return fmt.Errorf("duplicated lifecycle of validator %s", lc.ValAddr)
+		}
+		valLifecycles[lc.ValAddr] = struct{}{}
+	}
+
+	delLifecycles := make(map[string]struct{})
+	for _, lc := range gs.DelegationsLifecycle {
+		if _, err := sdk.AccAddressFromBech32(lc.DelAddr); err != nil {
+			return fmt.Errorf("invalid delegator address in lifecycle: %w", err)
+		}
+		if _, ok := delLifecycles[lc.DelAddr]; ok {
+			return fmt.Errorf("duplicated lifecycle of delegator %s", lc.DelAddr)
+		}
+		delLifecycles[lc.DelAddr] = struct{}{}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, queue := range gs.Queues {
+		for _, msg := range queue.Msgs {
+			if err := msg.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validate checks the validators in the set are unique and have non-negative
+// voting power, and returns the sorted validator set and its total voting power
+func (evs *EpochValidatorSet) validate() (ValidatorSet, int64, error) {
+	vals := make([]Validator, 0, len(evs.Validators))
+	addrs := make(map[string]struct{})
+	totalPower := int64(0)
+	for _, val := range evs.Validators {
+		if val == nil {
+			return nil, 0, fmt.Errorf("empty validator in the validator set of epoch %d", evs.EpochNumber)
+		}
+		if err := sdk.VerifyAddressFormat(val.Addr); err != nil {
+			return nil, 0, fmt.Errorf("invalid validator address in the validator set of epoch %d: %w", evs.EpochNumber, err)
+		}
+		if _, ok := addrs[string(val.Addr)]; ok {
+			return nil, 0, fmt.Errorf("duplicated validator %s in the validator set of epoch %d", val.GetValAddressStr(), evs.EpochNumber)
+		}
+		addrs[string(val.Addr)] = struct{}{}
+		if val.Power < 0 {
+			return nil, 0, fmt.Errorf("validator %s has negative voting power in epoch %d", val.GetValAddressStr(), evs.EpochNumber)
+		}
+		totalPower += val.Power
+		vals = append(vals, *val)
+	}
+	return NewSortedValidatorSet(vals), totalPower, nil
 }
@@ -25,7 +25,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epoching module's genesis state.
 type GenesisState struct {
+	// params the current params of the state.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// epochs contains the metadata of all epochs, where the last one is the
+	// current epoch. If empty, the module starts from epoch 0.
+	Epochs []*Epoch `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	// queues contains the queued messages of every epoch.
+	Queues []*EpochQueue `protobuf:"bytes,3,rep,name=queues,proto3" json:"queues,omitempty"`
+	// validator_sets contains the validator set of every epoch.
+	ValidatorSets []*EpochValidatorSet `protobuf:"bytes,4,rep,name=validator_sets,json=validatorSets,proto3" json:"validator_sets,omitempty"`
+	// slashed_validator_sets contains the slashed validator set of every epoch.
+	SlashedValidatorSets []*EpochValidatorSet `protobuf:"bytes,5,rep,name=slashed_validator_sets,json=slashedValidatorSets,proto3" json:"slashed_validator_sets,omitempty"`
+	// validators_lifecycle contains the lifecycle of every validator.
+	ValidatorsLifecycle []*ValidatorLifecycle `protobuf:"bytes,6,rep,name=validators_lifecycle,json=validatorsLifecycle,proto3" json:"validators_lifecycle,omitempty"`
+	// delegations_lifecycle contains the lifecycle of every delegation.
+	DelegationsLifecycle []*DelegationLifecycle `protobuf:"bytes,7,rep,name=delegations_lifecycle,json=delegationsLifecycle,proto3" json:"delegations_lifecycle,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,27 +82,208 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEpochs() []*Epoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueues() []*EpochQueue {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorSets() []*EpochValidatorSet {
+	if m != nil {
+		return m.ValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashedValidatorSets() []*EpochValidatorSet {
+	if m != nil {
+		return m.SlashedValidatorSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorsLifecycle() []*ValidatorLifecycle {
+	if m != nil {
+		return m.ValidatorsLifecycle
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationsLifecycle() []*DelegationLifecycle {
+	if m != nil {
+		return m.DelegationsLifecycle
+	}
+	return nil
+}
+
+// EpochQueue is the queue of messages of a given epoch.
+type EpochQueue struct {
+	// epoch_number is the number of the epoch.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// msgs is the list of messages queued in the epoch, in order of
+	// submission.
+	Msgs []*QueuedMessage `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *EpochQueue) Reset()         { *m = EpochQueue{} }
+func (m *EpochQueue) String() string { return proto.CompactTextString(m) }
+func (*EpochQueue) ProtoMessage()    {}
+func (*EpochQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef836361c424501, []int{1}
+}
+func (m *EpochQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochQueue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochQueue.Merge(m, src)
+}
+func (m *EpochQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochQueue proto.InternalMessageInfo
+
+func (m *EpochQueue) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochQueue) GetMsgs() []*QueuedMessage {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// EpochValidatorSet is a validator set of a given epoch.
+type EpochValidatorSet struct {
+	// epoch_number is the number of the epoch.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// validators is the list of validators in the set.
+	Validators []*Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	// total_voting_power is the total voting power recorded for the set.
+	TotalVotingPower int64 `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *EpochValidatorSet) Reset()         { *m = EpochValidatorSet{} }
+func (m *EpochValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*EpochValidatorSet) ProtoMessage()    {}
+func (*EpochValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef836361c424501, []int{2}
+}
+func (m *EpochValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochValidatorSet.Merge(m, src)
+}
+func (m *EpochValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochValidatorSet proto.InternalMessageInfo
+
+func (m *EpochValidatorSet) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochValidatorSet) GetValidators() []*Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *EpochValidatorSet) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.epoching.v1.GenesisState")
+	proto.RegisterType((*EpochQueue)(nil), "babylon.epoching.v1.EpochQueue")
+	proto.RegisterType((*EpochValidatorSet)(nil), "babylon.epoching.v1.EpochValidatorSet")
 }
 
 func init() { proto.RegisterFile("babylon/epoching/v1/genesis.proto", fileDescriptor_2ef836361c424501) }
 
 var fileDescriptor_2ef836361c424501 = []byte{
-	// 200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x2a, 0xd1, 0x83, 0x29, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x1a, 0xa6,
-	0xe4, 0xc9, 0xc5, 0xe3, 0x0e, 0x31, 0x3d, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x92, 0x8b, 0x0d,
-	0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xad, 0x87, 0xc5, 0x36, 0xbd, 0x00, 0xb0,
-	0x12, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x1a, 0x9c, 0xbc, 0x4e, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0x1f, 0x6a, 0x5c, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x70, 0x60,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x75, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x51, 0x6f, 0x4e, 0x0f, 0x01, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0x87, 0x1b, 0x5b, 0x23, 0x4c, 0x57, 0xd1, 0xd9, 0x2a, 0xa1, 0x42, 0xb6, 0x9b, 0x83, 0xf6,
+	0x20, 0x89, 0x5b, 0x41, 0xf1, 0xe2, 0x61, 0x51, 0x04, 0x71, 0x65, 0x9d, 0x85, 0x1e, 0x16, 0x25,
+	0x4c, 0xd2, 0xd7, 0x69, 0x20, 0xcd, 0xc4, 0xcc, 0x34, 0xda, 0x6f, 0xe1, 0x27, 0xf0, 0xe4, 0x87,
+	0xd9, 0xe3, 0x1e, 0x3d, 0x89, 0xb4, 0x5f, 0x44, 0xfa, 0x36, 0x7f, 0xaa, 0x66, 0xc5, 0xbd, 0xcd,
+	0xbc, 0xf3, 0xfc, 0x9e, 0x77, 0x78, 0x99, 0x21, 0xfb, 0x01, 0x0f, 0x16, 0xb1, 0x4c, 0x3c, 0x48,
+	0x65, 0x38, 0x8d, 0x12, 0xe1, 0xe5, 0x07, 0x9e, 0x80, 0x04, 0x54, 0xa4, 0xdc, 0x34, 0x93, 0x5a,
+	0xd2, 0xdd, 0x02, 0x71, 0x4b, 0xc4, 0xcd, 0x0f, 0xfa, 0x3d, 0x21, 0x85, 0xc4, 0x73, 0x6f, 0xbd,
+	0xda, 0xa0, 0xfd, 0x41, 0x93, 0x2d, 0xe5, 0x19, 0x9f, 0x15, 0xb2, 0xbe, 0xd3, 0x44, 0x54, 0x62,
+	0x64, 0x9c, 0xaf, 0x1d, 0xb2, 0xf3, 0x72, 0x73, 0x85, 0x13, 0xcd, 0x35, 0xd0, 0xa7, 0xc4, 0xdc,
+	0x48, 0x2c, 0x63, 0x60, 0x0c, 0xbb, 0xa3, 0xbb, 0x6e, 0xc3, 0x95, 0xdc, 0x63, 0x44, 0x0e, 0x3b,
+	0x67, 0x3f, 0xf6, 0x5a, 0xac, 0x08, 0xd0, 0x11, 0x31, 0x91, 0x51, 0xd6, 0x95, 0x41, 0x7b, 0xd8,
+	0x1d, 0xf5, 0x1b, 0xa3, 0x2f, 0xd6, 0x6b, 0x56, 0x90, 0xf4, 0x09, 0x31, 0x3f, 0xce, 0x61, 0x0e,
+	0xca, 0x6a, 0x63, 0x66, 0xef, 0xe2, 0xcc, 0xdb, 0x35, 0xc7, 0x0a, 0x9c, 0x1e, 0x91, 0x1b, 0x39,
+	0x8f, 0xa3, 0x09, 0xd7, 0x32, 0xf3, 0x15, 0x68, 0x65, 0x75, 0x50, 0x70, 0xef, 0x62, 0xc1, 0xb8,
+	0xe4, 0x4f, 0x40, 0xb3, 0xeb, 0xf9, 0xd6, 0x4e, 0xd1, 0x77, 0xe4, 0x8e, 0x8a, 0xb9, 0x9a, 0xc2,
+	0xc4, 0xff, 0x43, 0x7b, 0xf5, 0x52, 0xda, 0x5e, 0x61, 0x19, 0xff, 0x66, 0x3f, 0x25, 0xbd, 0xca,
+	0xaa, 0xfc, 0x38, 0xfa, 0x00, 0xe1, 0x22, 0x8c, 0xc1, 0x32, 0xd1, 0x7d, 0xbf, 0xd1, 0x5d, 0x19,
+	0x5e, 0x97, 0x38, 0xdb, 0xad, 0x25, 0x55, 0x91, 0xbe, 0x27, 0xb7, 0x27, 0x10, 0x83, 0xe0, 0x3a,
+	0x92, 0xc9, 0xb6, 0xfc, 0x1a, 0xca, 0x87, 0x8d, 0xf2, 0xe7, 0x55, 0xa2, 0xb6, 0xf7, 0xb6, 0x34,
+	0x55, 0xd5, 0x11, 0x84, 0xd4, 0xd3, 0xa7, 0xfb, 0x64, 0x07, 0x35, 0x7e, 0x32, 0x9f, 0x05, 0x90,
+	0xe1, 0x1b, 0xe9, 0xb0, 0x2e, 0xd6, 0xde, 0x60, 0x89, 0x3e, 0x26, 0x9d, 0x99, 0x12, 0xe5, 0x1b,
+	0x70, 0x1a, 0xdb, 0xa3, 0x6c, 0x72, 0x04, 0x4a, 0x71, 0x01, 0x0c, 0x79, 0xe7, 0x9b, 0x41, 0x6e,
+	0xfd, 0x35, 0xcf, 0xff, 0x69, 0xf8, 0x8c, 0x90, 0x7a, 0x2e, 0x45, 0x5b, 0xfb, 0xdf, 0x23, 0x65,
+	0x5b, 0x09, 0xfa, 0x80, 0x50, 0x2d, 0x35, 0x8f, 0xfd, 0x5c, 0xea, 0x28, 0x11, 0x7e, 0x2a, 0x3f,
+	0x41, 0x66, 0xb5, 0x07, 0xc6, 0xb0, 0xcd, 0x6e, 0xe2, 0xc9, 0x18, 0x0f, 0x8e, 0xd7, 0xf5, 0xc3,
+	0x57, 0x67, 0x4b, 0xdb, 0x38, 0x5f, 0xda, 0xc6, 0xcf, 0xa5, 0x6d, 0x7c, 0x59, 0xd9, 0xad, 0xf3,
+	0x95, 0xdd, 0xfa, 0xbe, 0xb2, 0x5b, 0xa7, 0x0f, 0x45, 0xa4, 0xa7, 0xf3, 0xc0, 0x0d, 0xe5, 0xcc,
+	0x2b, 0xba, 0x87, 0x53, 0x1e, 0x25, 0xe5, 0xc6, 0xfb, 0x5c, 0x7f, 0x44, 0xbd, 0x48, 0x41, 0x05,
+	0x26, 0xfe, 0xc1, 0x47, 0xbf, 0x06, 0x00, 0x24, 0x1d, 0x9f, 0x08, 0x19, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +306,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationsLifecycle) > 0 {
+		for iNdEx := len(m.DelegationsLifecycle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationsLifecycle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidatorsLifecycle) > 0 {
+		for iNdEx := len(m.ValidatorsLifecycle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorsLifecycle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SlashedValidatorSets) > 0 {
+		for iNdEx := len(m.SlashedValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for iNdEx := len(m.ValidatorSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +403,95 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,6 +511,81 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSets) > 0 {
+		for _, e := range m.ValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedValidatorSets) > 0 {
+		for _, e := range m.SlashedValidatorSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorsLifecycle) > 0 {
+		for _, e := range m.ValidatorsLifecycle {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationsLifecycle) > 0 {
+		for _, e := range m.DelegationsLifecycle {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalVotingPower))
+	}
 	return n
 }
 
@@ -214,6 +657,435 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &Epoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &EpochQueue{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSets = append(m.ValidatorSets, &EpochValidatorSet{})
+			if err := m.ValidatorSets[len(m.ValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedValidatorSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedValidatorSets = append(m.SlashedValidatorSets, &EpochValidatorSet{})
+			if err := m.SlashedValidatorSets[len(m.SlashedValidatorSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsLifecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsLifecycle = append(m.ValidatorsLifecycle, &ValidatorLifecycle{})
+			if err := m.ValidatorsLifecycle[len(m.ValidatorsLifecycle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationsLifecycle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationsLifecycle = append(m.DelegationsLifecycle, &DelegationLifecycle{})
+			if err := m.DelegationsLifecycle[len(m.DelegationsLifecycle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &QueuedMessage{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/testutil/nullify"
 	"github.com/babylonchain/babylon/x/epoching"
 	"github.com/babylonchain/babylon/x/epoching/types"
@@ -30,6 +34,25 @@ func TestGenesis(t *testing.T) {
 }
 
 func TestGenesisState_Validate(t *testing.T) {
+	valAddr := sdk.ValAddress(datagen.GenRandomByteArray(rand.New(rand.NewSource(10)), 20))
+	// genState returns a genesis state that is at epoch 1 with a single validator
+	genState := func() *types.GenesisState {
+		params := types.DefaultParams()
+		gs := types.NewGenesis(params)
+		for epochNum := uint64(0); epochNum <= 1; epochNum++ {
+			epoch := types.NewEpoch(epochNum, params.EpochInterval, epochNum*params.EpochInterval, nil)
+			gs.Epochs = append(gs.Epochs, &epoch)
+			gs.Queues = append(gs.Queues, &types.EpochQueue{EpochNumber: epochNum})
+			gs.ValidatorSets = append(gs.ValidatorSets, &types.EpochValidatorSet{
+				EpochNumber:      epochNum,
+				Validators:       []*types.Validator{{Addr: valAddr, Power: 10}},
+				TotalVotingPower: 10,
+			})
+			gs.SlashedValidatorSets = append(gs.SlashedValidatorSets, &types.EpochValidatorSet{EpochNumber: epochNum})
+		}
+		return gs
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -49,6 +72,66 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc:     "valid genesis state with epochs",
+			genState: genState(),
+			valid:    true,
+		},
+		{
+			desc: "non-consecutive epochs",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Epochs[1].EpochNumber = 2
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "msg queue of unknown epoch",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Queues = append(gs.Queues, &types.EpochQueue{EpochNumber: 5})
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "missing msg queue of the current epoch",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Queues = gs.Queues[:1]
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "wrong total voting power",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.ValidatorSets[1].TotalVotingPower = 11
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "slashed validator not in the validator set",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.SlashedValidatorSets[1].Validators = []*types.Validator{{Addr: sdk.ValAddress(datagen.GenRandomByteArray(rand.New(rand.NewSource(11)), 20)), Power: 1}}
+				gs.SlashedValidatorSets[1].TotalVotingPower = 1
+				return gs
+			}(),
+			valid: false,
+		},
+		{
+			desc: "validator sets without epochs",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Epochs = nil
+				return gs
+			}(),
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -25,6 +25,10 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		k.IndexBlock(ctx)
 		// tally all non-finalised blocks
 		k.TallyBlocks(ctx)
+		// track the liveness of finality providers and jail the inactive ones
+		k.HandleLiveness(ctx)
+		// prune the data of finalised blocks outside the retention window
+		k.PruneFinalityData(ctx)
 	}
 
 	return []abci.ValidatorUpdate{}, nil
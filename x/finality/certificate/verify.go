@@ -0,0 +1,139 @@
+// Package certificate implements the verification of finality certificates.
+// A finality certificate proves that a Babylon block is finalised by BTC
+// stakers, and can be verified without a Babylon node given a trusted AppHash
+// of Babylon, e.g., from a light client.
+package certificate
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// Verify verifies the given finality certificate against the given AppHash,
+// which is the AppHash of the trusted Babylon header at the certificate's
+// proof height. The verification includes
+//   - verifying that the block is finalised and committed to the AppHash,
+//   - verifying that the voting power table is committed to the AppHash and is
+//     complete w.r.t. the total voting power recorded in the block,
+//   - verifying that each vote's public randomness commitment is committed to
+//     the AppHash, and the vote's finality signature is valid w.r.t. it, and
+//   - verifying that the votes reach a quorum of >2/3 voting power.
+func Verify(cert *types.FinalityCertificate, appHash []byte) error {
+	if cert == nil || cert.Block == nil {
+		return types.ErrInvalidFinalityCertificate.Wrap("empty certificate or block")
+	}
+	block := cert.Block
+
+	// verify the block is finalised and committed to the AppHash
+	if !block.Finalized {
+		return types.ErrInvalidFinalityCertificate.Wrapf("the block at height %d is not finalized", block.Height)
+	}
+	blockBytes, err := block.Marshal()
+	if err != nil {
+		return types.ErrInvalidFinalityCertificate.Wrapf("failed to marshal the block: %v", err)
+	}
+	if err := verifyStore(appHash, types.StoreKey, types.GetBlockKey(block.Height), blockBytes, cert.BlockProof); err != nil {
+		return types.ErrInvalidFinalityCertificate.Wrapf("invalid proof of the block: %v", err)
+	}
+
+	// verify the voting power table is committed to the AppHash and complete
+	powers := make(map[string]uint64, len(cert.VotingPowerTable))
+	totalPower := uint64(0)
+	for i, entry := range cert.VotingPowerTable {
+		if entry == nil || entry.FpBtcPk == nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("empty entry %d in the voting power table", i)
+		}
+		// entries are in strictly ascending order, so that none of them are
+		// counted twice
+		if i > 0 && bytes.Compare(*cert.VotingPowerTable[i-1].FpBtcPk, *entry.FpBtcPk) >= 0 {
+			return types.ErrInvalidFinalityCertificate.Wrap("the voting power table is not in strictly ascending order of finality providers' BTC PKs")
+		}
+		key := bstypes.GetVotingPowerKey(block.Height, *entry.FpBtcPk)
+		if err := verifyStore(appHash, bstypes.StoreKey, key, sdk.Uint64ToBigEndian(entry.VotingPower), entry.Proof); err != nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("invalid proof of the voting power of finality provider %s: %v", entry.FpBtcPk.MarshalHex(), err)
+		}
+		powers[entry.FpBtcPk.MarshalHex()] = entry.VotingPower
+		totalPower += entry.VotingPower
+	}
+	if totalPower != block.TotalVotingPower {
+		return types.ErrInvalidFinalityCertificate.Wrapf("the voting power table is incomplete: total voting power %d, expected %d", totalPower, block.TotalVotingPower)
+	}
+	if totalPower != cert.TotalVotingPower {
+		return types.ErrInvalidFinalityCertificate.Wrapf("the total voting power %d does not match the voting power table (%d)", cert.TotalVotingPower, totalPower)
+	}
+
+	// verify each vote w.r.t. its public randomness commitment that is
+	// committed to the AppHash
+	voters := make(map[string]struct{}, len(cert.Votes))
+	votedPower := uint64(0)
+	for i, vote := range cert.Votes {
+		if vote == nil || vote.FpBtcPk == nil || vote.PubRandCommit == nil || vote.PubRand == nil || vote.PubRandProof == nil || vote.FinalitySig == nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("incomplete vote %d", i)
+		}
+		fpBTCPKHex := vote.FpBtcPk.MarshalHex()
+		power, ok := powers[fpBTCPKHex]
+		if !ok {
+			return types.ErrInvalidFinalityCertificate.Wrapf("the voter %s is not in the voting power table", fpBTCPKHex)
+		}
+		if _, ok := voters[fpBTCPKHex]; ok {
+			return types.ErrInvalidFinalityCertificate.Wrapf("duplicated votes from %s", fpBTCPKHex)
+		}
+		voters[fpBTCPKHex] = struct{}{}
+
+		prCommit := vote.PubRandCommit
+		if !prCommit.IsInRange(block.Height) {
+			return types.ErrInvalidFinalityCertificate.Wrapf("the public randomness commitment of %s does not cover height %d", fpBTCPKHex, block.Height)
+		}
+		prCommitBytes, err := prCommit.Marshal()
+		if err != nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("failed to marshal the public randomness commitment: %v", err)
+		}
+		key := types.GetPubRandCommitKey(*vote.FpBtcPk, prCommit.StartHeight)
+		if err := verifyStore(appHash, types.StoreKey, key, prCommitBytes, vote.PubRandCommitProof); err != nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("invalid proof of the public randomness commitment of %s: %v", fpBTCPKHex, err)
+		}
+
+		msg := &types.MsgAddFinalitySig{
+			FpBtcPk:      vote.FpBtcPk,
+			BlockHeight:  block.Height,
+			PubRand:      vote.PubRand,
+			Proof:        vote.PubRandProof,
+			BlockAppHash: block.AppHash,
+			FinalitySig:  vote.FinalitySig,
+		}
+		if err := types.VerifyFinalitySig(msg, prCommit); err != nil {
+			return types.ErrInvalidFinalityCertificate.Wrapf("invalid vote from %s: %v", fpBTCPKHex, err)
+		}
+		votedPower += power
+	}
+	if votedPower != cert.VotedVotingPower {
+		return types.ErrInvalidFinalityCertificate.Wrapf("the voted voting power %d does not match the votes (%d)", cert.VotedVotingPower, votedPower)
+	}
+
+	// verify the votes reach a quorum
+	if votedPower*3 <= totalPower*2 {
+		return types.ErrInvalidFinalityCertificate.Wrapf("the voted voting power %d does not reach a quorum of the total voting power %d", votedPower, totalPower)
+	}
+
+	return nil
+}
+
+// verifyStore verifies that the given KV pair is committed to the given
+// AppHash in the store of the given module
+func verifyStore(appHash []byte, moduleStoreKey string, key []byte, value []byte, proof *cmtcrypto.ProofOps) error {
+	if proof == nil {
+		return fmt.Errorf("empty Merkle proof")
+	}
+	keypath := merkle.KeyPath{}
+	keypath = keypath.AppendKey([]byte(moduleStoreKey), merkle.KeyEncodingURL)
+	keypath = keypath.AppendKey(key, merkle.KeyEncodingURL)
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, keypath.String(), value)
+}
@@ -31,10 +31,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListPublicRandomness())
 	cmd.AddCommand(CmdListPubRandCommit())
+	cmd.AddCommand(CmdPubRandCommitGaps())
 	cmd.AddCommand(CmdBlock())
 	cmd.AddCommand(CmdListBlocks())
+	cmd.AddCommand(CmdFinalityCertificate())
 	cmd.AddCommand(CmdVotesAtHeight())
 	cmd.AddCommand(CmdListEvidences())
+	cmd.AddCommand(CmdPruningHorizon())
+	cmd.AddCommand(CmdSigningInfo())
+	cmd.AddCommand(CmdSigningInfos())
 
 	return cmd
 }
@@ -134,6 +139,68 @@ func CmdListPubRandCommit() *cobra.Command {
 	return cmd
 }
 
+func CmdPubRandCommitGaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pub-rand-commit-gaps [fp_btc_pk_hex] [start_height] [end_height]",
+		Short: "list ranges of heights not covered by public randomness commitments of a given finality provider",
+		Long:  "list ranges of heights within [start_height, end_height] not covered by public randomness commitments of a given finality provider. If end_height is 0, the end height of the last public randomness commitment of the finality provider is used.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PubRandCommitGaps(cmd.Context(), &types.QueryPubRandCommitGapsRequest{
+				FpBtcPkHex:  args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPruningHorizon() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-horizon",
+		Short: "show the lowest height whose finality votes, revealed public randomness and indexed block are retained",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PruningHorizon(cmd.Context(), &types.QueryPruningHorizonRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListBlocks() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-blocks",
@@ -207,6 +274,39 @@ func CmdBlock() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityCertificate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-certificate [height]",
+		Short: "show the finality certificate of the finalized block at a given height",
+		Long: "Show the finality certificate of the finalized block at a given height. " +
+			"Its Merkle proofs are against the AppHash of the Babylon header at the certificate's proof height.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queriedBlockHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityCertificate(cmd.Context(), &types.QueryFinalityCertificateRequest{
+				Height: queriedBlockHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListEvidences() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-evidences",
@@ -244,3 +344,61 @@ func CmdListEvidences() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info [fp_btc_pk_hex]",
+		Short: "retrieve the liveness information of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(cmd.Context(), &types.QuerySigningInfoRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "list the liveness information of all finality providers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SigningInfos(cmd.Context(), &types.QuerySigningInfosRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signing-infos")
+
+	return cmd
+}
@@ -28,6 +28,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCommitPubRandListCmd(),
 		NewAddFinalitySigCmd(),
+		NewUnjailFinalityProviderCmd(),
+		NewEquivocationEvidenceCmd(),
 	)
 
 	return cmd
@@ -165,3 +167,120 @@ func NewAddFinalitySigCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewUnjailFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-finality-provider [fp_btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unjail a jailed finality provider",
+		Long: strings.TrimSpace(
+			`Unjail a jailed finality provider after its jailing period has passed. The signer has to be the finality provider's Babylon address.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnjailFinalityProvider{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewEquivocationEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "equivocation-evidence [fp_btc_pk] [block_height] [pub_rand] [proof] [canonical_app_hash] [fork_app_hash] [canonical_finality_sig] [fork_finality_sig]",
+		Args:  cobra.ExactArgs(8),
+		Short: "Submit the evidence that a finality provider signs two conflicting blocks",
+		Long: strings.TrimSpace(
+			`Submit the evidence that a finality provider signs two conflicting blocks at the same height using the same public randomness. Babylon will slash the finality provider upon a valid evidence.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get block height
+			blockHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get public randomness
+			pubRand, err := bbn.NewSchnorrPubRandFromHex(args[2])
+			if err != nil {
+				return err
+			}
+
+			// get proof
+			proofBytes, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+			var proof cmtcrypto.Proof
+			if err := clientCtx.Codec.Unmarshal(proofBytes, &proof); err != nil {
+				return err
+			}
+
+			// get canonical and fork block app hashes
+			canonicalAppHash, err := hex.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+			forkAppHash, err := hex.DecodeString(args[5])
+			if err != nil {
+				return err
+			}
+
+			// get canonical and fork finality signatures
+			canonicalFinalitySig, err := bbn.NewSchnorrEOTSSigFromHex(args[6])
+			if err != nil {
+				return err
+			}
+			forkFinalitySig, err := bbn.NewSchnorrEOTSSigFromHex(args[7])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgEquivocationEvidence{
+				Signer:               clientCtx.FromAddress.String(),
+				FpBtcPk:              fpBTCPK,
+				BlockHeight:          blockHeight,
+				PubRand:              pubRand,
+				Proof:                &proof,
+				CanonicalAppHash:     canonicalAppHash,
+				ForkAppHash:          forkAppHash,
+				CanonicalFinalitySig: canonicalFinalitySig,
+				ForkFinalitySig:      forkFinalitySig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
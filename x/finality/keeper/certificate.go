@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFinalityCertificate returns a self-contained certificate that the block
+// at the given height is finalised by BTC stakers. The certificate includes
+//   - the indexed block,
+//   - the voting power table at this height,
+//   - the finality votes on this block, each of which with the public
+//     randomness commitment that the vote is verified against, and
+//   - the quorum computation.
+//
+// The indexed block, the voting power table and the public randomness
+// commitments come with Merkle proofs against the AppHash of the header at
+// the current height, which commits to the state at the previous height.
+// All of them, as well as the votes, are read from the state at the previous
+// height, so that the certificate is consistent with its proofs.
+func (k Keeper) GetFinalityCertificate(ctx context.Context, height uint64) (*types.FinalityCertificate, error) {
+	if pruningHorizon := k.GetPruningHorizon(ctx); height < pruningHorizon {
+		return nil, types.ErrHeightPruned.Wrapf("the block at height %d is below the pruning horizon %d", height, pruningHorizon)
+	}
+	proofHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// get the indexed block with its Merkle proof
+	_, blockBytes, blockProof, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, types.GetBlockKey(height), proofHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(blockBytes) == 0 {
+		return nil, types.ErrBlockNotFound.Wrapf("height: %d", height)
+	}
+	var block types.IndexedBlock
+	k.cdc.MustUnmarshal(blockBytes, &block)
+	if !block.Finalized {
+		return nil, types.ErrBlockNotFinalized.Wrapf("the block at height %d is not finalized as of height %d", height, proofHeight-1)
+	}
+	// a finalized block always has a positive total voting power, unless it
+	// was finalized before the total voting power was recorded upon
+	// finalization, in which case the completeness of the voting power table
+	// cannot be proven
+	if block.TotalVotingPower == 0 {
+		return nil, types.ErrBlockNotCertifiable.Wrapf("height: %d", height)
+	}
+
+	cert := &types.FinalityCertificate{
+		Block:       &block,
+		BlockProof:  blockProof,
+		ProofHeight: uint64(proofHeight),
+	}
+
+	// get the voting power table with Merkle proofs, in ascending order of
+	// the finality providers' BTC PKs
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	fpBTCPKs := make([]bbn.BIP340PubKey, 0, len(fpSet))
+	for fpBTCPKHex := range fpSet {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			return nil, err
+		}
+		fpBTCPKs = append(fpBTCPKs, *fpBTCPK)
+	}
+	sort.Slice(fpBTCPKs, func(i, j int) bool {
+		return bytes.Compare(fpBTCPKs[i], fpBTCPKs[j]) < 0
+	})
+	for i := range fpBTCPKs {
+		fpBTCPK := fpBTCPKs[i]
+		_, powerBytes, proof, err := bbn.QueryStore(k.storeQuerier, bstypes.StoreKey, bstypes.GetVotingPowerKey(height, fpBTCPK), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(powerBytes) == 0 {
+			return nil, types.ErrInvalidFinalityCertificate.Wrapf("the voting power of finality provider %s at height %d is not found", fpBTCPK.MarshalHex(), height)
+		}
+		cert.VotingPowerTable = append(cert.VotingPowerTable, &types.FinalityProviderVotingPower{
+			FpBtcPk:     &fpBTCPK,
+			VotingPower: sdk.BigEndianToUint64(powerBytes),
+			Proof:       proof,
+		})
+		cert.TotalVotingPower += sdk.BigEndianToUint64(powerBytes)
+	}
+
+	// get the finality votes, each with the public randomness commitment and
+	// the inclusion proof of the public randomness
+	for _, entry := range cert.VotingPowerTable {
+		fpBTCPK := entry.FpBtcPk
+		_, sigBytes, _, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, types.GetVoteKey(height, *fpBTCPK), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(sigBytes) == 0 {
+			// this finality provider has not voted for the block
+			continue
+		}
+		sig, err := bbn.NewSchnorrEOTSSig(sigBytes)
+		if err != nil {
+			return nil, err
+		}
+		_, pubRandBytes, _, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, types.GetPubRandKey(*fpBTCPK, height), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(pubRandBytes) == 0 {
+			return nil, types.ErrPubRandNotFound.Wrapf("the public randomness of finality provider %s at height %d is not found", fpBTCPK.MarshalHex(), height)
+		}
+		pubRand, err := bbn.NewSchnorrPubRand(pubRandBytes)
+		if err != nil {
+			return nil, err
+		}
+		_, pubRandProofBytes, _, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, types.GetPubRandProofKey(*fpBTCPK, height), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(pubRandProofBytes) == 0 {
+			return nil, types.ErrPubRandNotFound.Wrapf("the inclusion proof of public randomness of finality provider %s at height %d is not found", fpBTCPK.MarshalHex(), height)
+		}
+		var pubRandProof cmtcrypto.Proof
+		k.cdc.MustUnmarshal(pubRandProofBytes, &pubRandProof)
+		// public randomness commitments never overlap and are never removed,
+		// so the commitment covering this height in the current state is the
+		// one that the vote was verified against
+		prCommitInState, err := k.GetPubRandCommitForHeight(ctx, fpBTCPK, height)
+		if err != nil {
+			return nil, err
+		}
+		_, prCommitBytes, prCommitProof, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, types.GetPubRandCommitKey(*fpBTCPK, prCommitInState.StartHeight), proofHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(prCommitBytes) == 0 {
+			return nil, types.ErrPubRandNotFound.Wrapf("the public randomness commitment of finality provider %s at height %d is not found", fpBTCPK.MarshalHex(), height)
+		}
+		var prCommit types.PubRandCommit
+		k.cdc.MustUnmarshal(prCommitBytes, &prCommit)
+		cert.Votes = append(cert.Votes, &types.FinalityCertificateVote{
+			FpBtcPk:            fpBTCPK,
+			PubRandCommit:      &prCommit,
+			PubRandCommitProof: prCommitProof,
+			PubRand:            pubRand,
+			PubRandProof:       &pubRandProof,
+			FinalitySig:        sig,
+		})
+		cert.VotedVotingPower += entry.VotingPower
+	}
+
+	return cert, nil
+}
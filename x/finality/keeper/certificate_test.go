@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/finality/certificate"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzFinalityCertificate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mount the BTC staking store together with the finality store, so
+		// that the voting power table can be proven
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		bsStoreKey := storetypes.NewKVStoreKey(bstypes.StoreKey)
+		stateStore.MountStoreWithDB(bsStoreKey, storetypes.StoreTypeIAVL, nil)
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeperWithStore(t, stateStore, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Any()).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(1)).AnyTimes()
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		// index a block
+		blockHeight := datagen.RandomInt(r, 10) + 1
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
+		fKeeper.IndexBlock(ctx)
+
+		// generate a random voting power table, where each finality provider
+		// commits public randomness and votes for the block, except for the
+		// last one that does so only after the block is finalized. The last
+		// one has the minimum voting power so that the others reach a quorum
+		numFps := int(datagen.RandomInt(r, 5) + 2)
+		var lateVote func()
+		fpSet := map[string]uint64{}
+		totalPower := uint64(0)
+		signer := datagen.GenRandomAccount().Address
+		for i := 0; i < numFps; i++ {
+			btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+			require.NoError(t, err)
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+			power := datagen.RandomInt(r, 100) + 3
+			if i == numFps-1 {
+				power = 1
+			}
+			fpSet[fpBTCPK.MarshalHex()] = power
+			totalPower += power
+			ctx.KVStore(bsStoreKey).Set(bstypes.GetVotingPowerKey(blockHeight, *fpBTCPK), sdk.Uint64ToBigEndian(power))
+			bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(fp, nil).AnyTimes()
+			bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal()), gomock.Eq(blockHeight)).Return(power).AnyTimes()
+
+			randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, 0, 100)
+			require.NoError(t, err)
+			msg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, 0, blockHeight, randListInfo, blockAppHash)
+			require.NoError(t, err)
+			vote := func() {
+				_, err := ms.CommitPubRandList(ctx, msgCommitPubRandList)
+				require.NoError(t, err)
+				_, err = ms.AddFinalitySig(ctx, msg)
+				require.NoError(t, err)
+			}
+			if i == numFps-1 {
+				lateVote = vote
+				continue
+			}
+			vote()
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(blockHeight)).Return(fpSet).AnyTimes()
+
+		// fail if the block is not finalized yet
+		commitID := stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version + 1)
+		_, err := fKeeper.GetFinalityCertificate(ctx, blockHeight)
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+
+		// finalize the block
+		ib, err := fKeeper.GetBlock(ctx, blockHeight)
+		require.NoError(t, err)
+		ib.Finalized = true
+		ib.TotalVotingPower = totalPower
+		fKeeper.SetBlock(ctx, ib)
+		commitID = stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version + 1)
+
+		// the last finality provider commits public randomness and votes at
+		// the current height, which is not committed yet
+		lateVote()
+
+		// the certificate is valid w.r.t. the committed AppHash, and only
+		// includes the votes that are committed to it
+		cert, err := fKeeper.GetFinalityCertificate(ctx, blockHeight)
+		require.NoError(t, err)
+		require.Equal(t, totalPower, cert.TotalVotingPower)
+		require.Equal(t, totalPower-1, cert.VotedVotingPower)
+		require.Len(t, cert.Votes, numFps-1)
+		require.NoError(t, certificate.Verify(cert, commitID.Hash))
+
+		// once committed, the late vote is included in the certificate
+		commitID = stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version + 1)
+		cert, err = fKeeper.GetFinalityCertificate(ctx, blockHeight)
+		require.NoError(t, err)
+		require.Equal(t, totalPower, cert.VotedVotingPower)
+		require.Len(t, cert.Votes, numFps)
+		require.NoError(t, certificate.Verify(cert, commitID.Hash))
+
+		// the certificate is invalid w.r.t. another AppHash
+		require.Error(t, certificate.Verify(cert, datagen.GenRandomByteArray(r, 32)))
+
+		// the certificate is invalid if the voting power table is incomplete
+		incompleteCert := *cert
+		incompleteCert.VotingPowerTable = cert.VotingPowerTable[1:]
+		incompleteCert.TotalVotingPower -= cert.VotingPowerTable[0].VotingPower
+		require.ErrorIs(t, certificate.Verify(&incompleteCert, commitID.Hash), types.ErrInvalidFinalityCertificate)
+
+		// the certificate is invalid if the votes do not reach a quorum
+		noQuorumCert := *cert
+		noQuorumCert.Votes = cert.Votes[:1]
+		noQuorumCert.VotedVotingPower = fpSet[cert.Votes[0].FpBtcPk.MarshalHex()]
+		if noQuorumCert.VotedVotingPower*3 <= totalPower*2 {
+			require.ErrorIs(t, certificate.Verify(&noQuorumCert, commitID.Hash), types.ErrInvalidFinalityCertificate)
+		}
+
+		// the certificate is invalid if a vote is forged
+		forgedCert := *cert
+		forgedVote := *cert.Votes[0]
+		forgedVote.FinalitySig = cert.Votes[1].FinalitySig
+		forgedCert.Votes = append([]*types.FinalityCertificateVote{&forgedVote}, cert.Votes[1:]...)
+		require.ErrorIs(t, certificate.Verify(&forgedCert, commitID.Hash), types.ErrInvalidFinalityCertificate)
+
+		// a block finalized before the total voting power is recorded cannot
+		// be certified
+		ib.TotalVotingPower = 0
+		fKeeper.SetBlock(ctx, ib)
+		commitID = stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version + 1)
+		_, err = fKeeper.GetFinalityCertificate(ctx, blockHeight)
+		require.ErrorIs(t, err, types.ErrBlockNotCertifiable)
+	})
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// SetParamsWithoutValidation stores the given params without validating them,
+// e.g., to mimic the params of a chain before some params were introduced
+func (k Keeper) SetParamsWithoutValidation(ctx context.Context, p types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.ParamsKey, k.cdc.MustMarshal(&p)); err != nil {
+		panic(err)
+	}
+}
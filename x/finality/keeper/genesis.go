@@ -26,12 +26,28 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 
 	for _, pubRand := range gs.PublicRandomness {
 		k.SetPubRand(ctx, pubRand.FpBtcPk, pubRand.BlockHeight, *pubRand.PubRand)
+		if pubRand.Proof != nil {
+			k.SetPubRandProof(ctx, pubRand.FpBtcPk, pubRand.BlockHeight, pubRand.Proof)
+		}
 	}
 
 	for _, prc := range gs.PubRandCommit {
 		k.SetPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
 	}
 
+	for _, signInfo := range gs.SigningInfos {
+		k.SetFinalityProviderSigningInfo(ctx, signInfo)
+	}
+
+	for _, missedBlocks := range gs.MissedBlocks {
+		for _, index := range missedBlocks.Indexes {
+			k.setMissedBlockBitmapValue(ctx, missedBlocks.FpBtcPk, index, true)
+		}
+	}
+
+	k.setNextHeightToFinalize(ctx, gs.NextHeightToFinalize)
+	k.setPruningHorizon(ctx, gs.PruningHorizon)
+
 	return k.SetParams(ctx, gs.Params)
 }
 
@@ -62,13 +78,22 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	signInfos, missedBlocks, err := k.signingInfosAndMissedBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:           k.GetParams(ctx),
-		IndexedBlocks:    blocks,
-		Evidences:        evidences,
-		VoteSigs:         voteSigs,
-		PublicRandomness: pubRandomness,
-		PubRandCommit:    prCommit,
+		Params:               k.GetParams(ctx),
+		IndexedBlocks:        blocks,
+		Evidences:            evidences,
+		VoteSigs:             voteSigs,
+		PublicRandomness:     pubRandomness,
+		PubRandCommit:        prCommit,
+		SigningInfos:         signInfos,
+		MissedBlocks:         missedBlocks,
+		NextHeightToFinalize: k.getNextHeightToFinalize(ctx),
+		PruningHorizon:       k.GetPruningHorizon(ctx),
 	}, nil
 }
 
@@ -160,10 +185,15 @@ func (k Keeper) publicRandomness(ctx context.Context) ([]*types.PublicRandomness
 			return nil, err
 		}
 
+		// the inclusion proof only exists if the public randomness is
+		// revealed by a finality vote on the canonical block
+		proof, _ := k.GetPubRandProof(ctx, fpBTCPK, blkHeight)
+
 		commtRandoms = append(commtRandoms, &types.PublicRandomness{
 			BlockHeight: blkHeight,
 			FpBtcPk:     fpBTCPK,
 			PubRand:     pubRand,
+			Proof:       proof,
 		})
 	}
 
@@ -198,6 +228,36 @@ func (k Keeper) exportPubRandCommit(ctx context.Context) ([]*types.PubRandCommit
 	return commtRandoms, nil
 }
 
+// signingInfosAndMissedBlocks iterates over all finality providers' liveness
+// information on the store, and gathers the missed block indexes of each
+// finality provider.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) signingInfosAndMissedBlocks(ctx context.Context) ([]*types.FinalityProviderSigningInfo, []*types.FinalityProviderMissedBlocks, error) {
+	iter := k.signingInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	signInfos := make([]*types.FinalityProviderSigningInfo, 0)
+	missedBlocks := make([]*types.FinalityProviderMissedBlocks, 0)
+	for ; iter.Valid(); iter.Next() {
+		var signInfo types.FinalityProviderSigningInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &signInfo); err != nil {
+			return nil, nil, err
+		}
+		signInfos = append(signInfos, &signInfo)
+
+		indexes := k.getMissedBlockIndexes(ctx, signInfo.FpBtcPk)
+		if len(indexes) == 0 {
+			continue
+		}
+		missedBlocks = append(missedBlocks, &types.FinalityProviderMissedBlocks{
+			FpBtcPk: signInfo.FpBtcPk,
+			Indexes: indexes,
+		})
+	}
+
+	return signInfos, missedBlocks, nil
+}
+
 // parsePubKeyAndBlkHeightFromStoreKey expects to receive a key with
 // BIP340PubKey(fpBTCPK) || BigEndianUint64(blkHeight)
 func parsePubKeyAndBlkHeightFromStoreKey(key []byte) (fpBTCPK *bbn.BIP340PubKey, blkHeight uint64, err error) {
@@ -86,6 +86,20 @@ func TestExportGenesis(t *testing.T) {
 	}
 	k.SetPubRandCommit(ctx, fpBTCPK, prc)
 
+	// liveness information
+	signInfo := types.NewFinalityProviderSigningInfo(fpBTCPK, startHeight)
+	signInfo.MissedBlocksCounter = 1
+	missedBlocks := &types.FinalityProviderMissedBlocks{FpBtcPk: fpBTCPK, Indexes: []uint64{blkHeight}}
+	err = k.InitGenesis(ctx, types.GenesisState{
+		Params:       k.GetParams(ctx),
+		SigningInfos: []*types.FinalityProviderSigningInfo{signInfo},
+		MissedBlocks: []*types.FinalityProviderMissedBlocks{missedBlocks},
+		// pruning and finalization progress
+		NextHeightToFinalize: blkHeight,
+		PruningHorizon:       startHeight,
+	})
+	require.NoError(t, err)
+
 	require.Equal(t, len(allVotes), int(numPubRand))
 	require.Equal(t, len(allBlocks), int(numPubRand))
 	require.Equal(t, len(allEvidences), int(numPubRand))
@@ -100,4 +114,8 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, allEvidences, gs.Evidences)
 	require.Equal(t, allPublicRandomness, gs.PublicRandomness)
 	require.Equal(t, prc, gs.PubRandCommit[0].PubRandCommit)
+	require.Equal(t, []*types.FinalityProviderSigningInfo{signInfo}, gs.SigningInfos)
+	require.Equal(t, []*types.FinalityProviderMissedBlocks{missedBlocks}, gs.MissedBlocks)
+	require.Equal(t, blkHeight, gs.NextHeightToFinalize)
+	require.Equal(t, startHeight, gs.PruningHorizon)
 }
@@ -67,13 +67,16 @@ func (k Keeper) ListPubRandCommit(ctx context.Context, req *types.QueryListPubRa
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	lastFinalizedEpoch := k.GetLastFinalizedEpoch(sdkCtx)
 	store := k.pubRandCommitFpStore(sdkCtx, fpBTCPK)
 	pubRandCommitMap := map[uint64]*types.PubRandCommitResponse{}
 	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
 		height := sdk.BigEndianToUint64(key)
 		var prCommit types.PubRandCommit
 		k.cdc.MustUnmarshal(value, &prCommit)
-		pubRandCommitMap[height] = prCommit.ToResponse()
+		prCommitResp := prCommit.ToResponse()
+		prCommitResp.Timestamped = prCommit.EpochNum <= lastFinalizedEpoch
+		pubRandCommitMap[height] = prCommitResp
 		return nil
 	})
 	if err != nil {
@@ -87,6 +90,49 @@ func (k Keeper) ListPubRandCommit(ctx context.Context, req *types.QueryListPubRa
 	return resp, nil
 }
 
+// PubRandCommitGaps returns the ranges of heights within the given range that
+// are not covered by any public randomness commitment of a given finality provider
+func (k Keeper) PubRandCommitGaps(ctx context.Context, req *types.QueryPubRandCommitGapsRequest) (*types.QueryPubRandCommitGapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		lastPrCommit := k.GetLastPubRandCommit(sdkCtx, fpBTCPK)
+		if lastPrCommit == nil {
+			return nil, status.Error(codes.NotFound, types.ErrNoPubRandYet.Error())
+		}
+		endHeight = lastPrCommit.EndHeight()
+	}
+	if req.StartHeight > endHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is larger than end height %d", req.StartHeight, endHeight)
+	}
+
+	gaps := k.GetPubRandCommitGaps(sdkCtx, fpBTCPK, req.StartHeight, endHeight)
+	return &types.QueryPubRandCommitGapsResponse{Gaps: gaps}, nil
+}
+
+// PruningHorizon returns the lowest height whose finality votes, revealed public
+// randomness and indexed block are retained
+func (k Keeper) PruningHorizon(ctx context.Context, req *types.QueryPruningHorizonRequest) (*types.QueryPruningHorizonResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryPruningHorizonResponse{
+		PruningHorizon:  k.GetPruningHorizon(sdkCtx),
+		RetentionBlocks: k.GetParams(sdkCtx).PruningRetentionBlocks,
+	}, nil
+}
+
 func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types.QueryBlockResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -100,6 +146,21 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 	return &types.QueryBlockResponse{Block: b}, nil
 }
 
+// FinalityCertificate returns a self-contained certificate that the block at
+// the given height is finalised by BTC stakers
+func (k Keeper) FinalityCertificate(ctx context.Context, req *types.QueryFinalityCertificateRequest) (*types.QueryFinalityCertificateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cert, err := k.GetFinalityCertificate(sdkCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityCertificateResponse{Certificate: cert}, nil
+}
+
 // ListBlocks returns a list of blocks at the given finalisation status
 func (k Keeper) ListBlocks(ctx context.Context, req *types.QueryListBlocksRequest) (*types.QueryListBlocksResponse, error) {
 	if req == nil {
@@ -225,3 +286,48 @@ func (k Keeper) ListEvidences(ctx context.Context, req *types.QueryListEvidences
 	}
 	return resp, nil
 }
+
+// SigningInfo returns the liveness information of the given finality provider
+func (k Keeper) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signInfo, err := k.GetFinalityProviderSigningInfo(sdkCtx, fpBTCPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySigningInfoResponse{SigningInfo: *signInfo}, nil
+}
+
+// SigningInfos returns the liveness information of all finality providers
+func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.signingInfoStore(sdkCtx)
+
+	var signInfos []types.FinalityProviderSigningInfo
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var signInfo types.FinalityProviderSigningInfo
+		if err := k.cdc.Unmarshal(value, &signInfo); err != nil {
+			return err
+		}
+		signInfos = append(signInfos, signInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySigningInfosResponse{SigningInfos: signInfos, Pagination: pageRes}, nil
+}
@@ -204,6 +204,8 @@ func FuzzListPubRandCommit(f *testing.F) {
 		require.NoError(t, err)
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(bip340PK.MustMarshal())).Return(fp, nil).AnyTimes()
 		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(bip340PK.MustMarshal())).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
 
 		numPrCommitList := datagen.RandomInt(r, 10) + 1
 		prCommitList := []*types.PubRandCommit{}
@@ -249,10 +251,105 @@ func FuzzListPubRandCommit(f *testing.F) {
 			require.True(t, ok)
 			require.Equal(t, prCommitResp.NumPubRand, prCommit.NumPubRand)
 			require.Equal(t, prCommitResp.Commitment, prCommit.Commitment)
+			require.True(t, prCommitResp.Timestamped)
 		}
 	})
 }
 
+func FuzzPubRandCommitGaps(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		ctx = sdk.UnwrapSDKContext(ctx)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// set random BTC SK PK
+		sk, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK := bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey())
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(bip340PK.MustMarshal())).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+
+		// generate a list of non-overlapping public randomness commitments
+		// with random gaps between them
+		numPrCommitList := datagen.RandomInt(r, 10) + 1
+		msgs := []*types.MsgCommitPubRandList{}
+		expectedGaps := []*types.PubRandCommitGap{}
+		startHeight := datagen.RandomInt(r, 10) + 1
+		if startHeight > 1 {
+			expectedGaps = append(expectedGaps, &types.PubRandCommitGap{StartHeight: 1, EndHeight: startHeight - 1})
+		}
+		for i := uint64(0); i < numPrCommitList; i++ {
+			numPubRand := datagen.RandomInt(r, 10) + 100
+			_, msg, err := datagen.GenRandomMsgCommitPubRandList(r, sk, startHeight, numPubRand)
+			require.NoError(t, err)
+			msgs = append(msgs, msg)
+			startHeight += numPubRand
+			// leave a gap after this commitment, except the last one
+			if gap := datagen.RandomInt(r, 3) * datagen.RandomInt(r, 50); gap > 0 && i < numPrCommitList-1 {
+				expectedGaps = append(expectedGaps, &types.PubRandCommitGap{StartHeight: startHeight, EndHeight: startHeight + gap - 1})
+				startHeight += gap
+			}
+		}
+		lastEndHeight := startHeight - 1
+
+		// commit them in a random order
+		r.Shuffle(len(msgs), func(i, j int) { msgs[i], msgs[j] = msgs[j], msgs[i] })
+		for _, msg := range msgs {
+			_, err = ms.CommitPubRandList(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		// the public randomness commitment of each height can be found
+		for _, msg := range msgs {
+			height := msg.StartHeight + datagen.RandomInt(r, int(msg.NumPubRand))
+			prCommit, err := fKeeper.GetPubRandCommitForHeight(ctx, bip340PK, height)
+			require.NoError(t, err)
+			require.Equal(t, msg.StartHeight, prCommit.StartHeight)
+		}
+		for _, gap := range expectedGaps {
+			_, err := fKeeper.GetPubRandCommitForHeight(ctx, bip340PK, gap.EndHeight)
+			require.ErrorIs(t, err, types.ErrPubRandNotFound)
+		}
+
+		// query the gaps until the end of the last commitment
+		resp, err := fKeeper.PubRandCommitGaps(ctx, &types.QueryPubRandCommitGapsRequest{
+			FpBtcPkHex:  bip340PK.MarshalHex(),
+			StartHeight: 1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedGaps, resp.Gaps)
+
+		// query the gaps beyond the end of the last commitment
+		resp, err = fKeeper.PubRandCommitGaps(ctx, &types.QueryPubRandCommitGapsRequest{
+			FpBtcPkHex:  bip340PK.MarshalHex(),
+			StartHeight: lastEndHeight,
+			EndHeight:   lastEndHeight + 10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*types.PubRandCommitGap{{StartHeight: lastEndHeight + 1, EndHeight: lastEndHeight + 10}}, resp.Gaps)
+
+		// a commitment overlapping with any existing one is rejected
+		overlappedMsg := msgs[datagen.RandomInt(r, len(msgs))]
+		overlappedStartHeight := overlappedMsg.StartHeight + datagen.RandomInt(r, int(overlappedMsg.NumPubRand))
+		if overlappedMsg.StartHeight > 100 && datagen.OneInN(r, 2) {
+			// overlap with the beginning of the existing commitment instead
+			overlappedStartHeight = overlappedMsg.StartHeight - datagen.RandomInt(r, 99) - 1
+		}
+		_, msg, err := datagen.GenRandomMsgCommitPubRandList(r, sk, overlappedStartHeight, 100)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidPubRand)
+	})
+}
+
 func FuzzQueryEvidence(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	corestoretypes "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +18,8 @@ type (
 	Keeper struct {
 		cdc          codec.BinaryCodec
 		storeService corestoretypes.KVStoreService
+		// storeQuerier allows querying the KVStore with Merkle proofs
+		storeQuerier storetypes.Queryable
 
 		BTCStakingKeeper types.BTCStakingKeeper
 		IncentiveKeeper  types.IncentiveKeeper
@@ -29,6 +32,7 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	storeQuerier storetypes.Queryable,
 	btctakingKeeper types.BTCStakingKeeper,
 	incentiveKeeper types.IncentiveKeeper,
 	authority string,
@@ -36,6 +40,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		storeQuerier: storeQuerier,
 
 		BTCStakingKeeper: btctakingKeeper,
 		IncentiveKeeper:  incentiveKeeper,
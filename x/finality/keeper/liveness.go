@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// HandleLiveness updates the liveness information of all finality providers
+// that have voting power at the height whose finality signature timeout
+// expires at the current height, and jails the finality providers that miss
+// too many finality votes.
+// This function is invoked upon each `EndBlock` *after* the BTC staking protocol is activated
+func (k Keeper) HandleLiveness(ctx context.Context) {
+	params := k.GetParams(ctx)
+	// the stored params might miss the liveness params, e.g., if they are not
+	// set upon a software upgrade, in which case liveness is not tracked
+	if err := params.ValidateLiveness(); err != nil {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("skip tracking liveness due to invalid params", "error", err)
+		return
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	// the finality providers have a timeout of `FinalitySigTimeout` blocks
+	// to vote on a block, after which they are judged to miss this block
+	if curHeight <= params.FinalitySigTimeout {
+		return
+	}
+	height := curHeight - params.FinalitySigTimeout
+	activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	if err != nil || height < activatedHeight {
+		return
+	}
+
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(ctx, height)
+	// sort finality providers to ensure determinism
+	fpBTCPKHexList := make([]string, 0, len(fpSet))
+	for fpBTCPKHex := range fpSet {
+		fpBTCPKHexList = append(fpBTCPKHexList, fpBTCPKHex)
+	}
+	sort.Strings(fpBTCPKHexList)
+
+	for _, fpBTCPKHex := range fpBTCPKHexList {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(fpBTCPKHex)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		missed := !k.HasSig(ctx, height, fpBTCPK)
+		if err := k.HandleFinalityProviderLiveness(ctx, fpBTCPK, missed, height); err != nil {
+			panic(fmt.Errorf("failed to handle liveness of finality provider %s: %w", fpBTCPKHex, err))
+		}
+	}
+}
+
+// HandleFinalityProviderLiveness records whether the finality provider has
+// missed the block at the given height in the sliding window, and jails the
+// finality provider if it has missed too many blocks in the sliding window
+func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, missed bool, height uint64) error {
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return err
+	}
+	// no need to track the liveness of slashed or jailed finality providers
+	if fp.IsSlashed() || fp.IsJailed() {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if params.ValidateLiveness() != nil {
+		// liveness is not tracked without valid liveness params
+		return nil
+	}
+	signInfo, err := k.GetFinalityProviderSigningInfo(ctx, fpBTCPK)
+	if err != nil {
+		// start tracking the liveness of this finality provider
+		signInfo = types.NewFinalityProviderSigningInfo(fpBTCPK, height)
+	}
+
+	// update the missed block bitmap and counter
+	window := params.SignedBlocksWindow
+	index := height % window
+	previouslyMissed := k.getMissedBlockBitmapValue(ctx, fpBTCPK, index)
+	switch {
+	case !previouslyMissed && missed:
+		k.setMissedBlockBitmapValue(ctx, fpBTCPK, index, true)
+		signInfo.MissedBlocksCounter++
+	case previouslyMissed && !missed:
+		k.setMissedBlockBitmapValue(ctx, fpBTCPK, index, false)
+		signInfo.MissedBlocksCounter--
+	}
+
+	if missed {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Debug(
+			"finality provider missed a finality vote",
+			"height", height,
+			"finality_provider", fpBTCPK.MarshalHex(),
+			"missed", signInfo.MissedBlocksCounter,
+			"threshold", window-params.MinSignedPerWindowInt(),
+		)
+	}
+
+	// jail the finality provider if it has been tracked for at least a
+	// window and has missed too many blocks in the window
+	minHeight := signInfo.StartHeight + window
+	maxMissed := window - params.MinSignedPerWindowInt()
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+		if err := k.jailFinalityProvider(ctx, fpBTCPK, signInfo, params); err != nil {
+			return err
+		}
+	}
+
+	k.SetFinalityProviderSigningInfo(ctx, signInfo)
+	return nil
+}
+
+// jailFinalityProvider jails the given finality provider, resets its liveness
+// information and emits an event
+func (k Keeper) jailFinalityProvider(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, signInfo *types.FinalityProviderSigningInfo, params types.Params) error {
+	if err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpBTCPK.MustMarshal()); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signInfo.JailedUntil = sdkCtx.HeaderInfo().Time.Add(params.JailDuration)
+	// reset the missed block counter and bitmap, such that the finality
+	// provider starts with a clean sliding window once unjailed
+	signInfo.MissedBlocksCounter = 0
+	k.deleteMissedBlockBitmap(ctx, fpBTCPK)
+
+	k.Logger(sdkCtx).Info(
+		"finality provider is jailed due to missing too many finality votes",
+		"finality_provider", fpBTCPK.MarshalHex(),
+		"jailed_until", signInfo.JailedUntil,
+	)
+
+	return sdkCtx.EventManager().EmitTypedEvent(types.NewEventJailedFinalityProvider(fpBTCPK, signInfo.JailedUntil))
+}
+
+/* finality provider signing info */
+
+func (k Keeper) SetFinalityProviderSigningInfo(ctx context.Context, signInfo *types.FinalityProviderSigningInfo) {
+	store := k.signingInfoStore(ctx)
+	store.Set(signInfo.FpBtcPk.MustMarshal(), k.cdc.MustMarshal(signInfo))
+}
+
+// GetFinalityProviderSigningInfo gets the liveness information of the given
+// finality provider
+func (k Keeper) GetFinalityProviderSigningInfo(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) (*types.FinalityProviderSigningInfo, error) {
+	store := k.signingInfoStore(ctx)
+	signInfoBytes := store.Get(fpBTCPK.MustMarshal())
+	if len(signInfoBytes) == 0 {
+		return nil, types.ErrSigningInfoNotFound.Wrapf("finality provider: %s", fpBTCPK.MarshalHex())
+	}
+	var signInfo types.FinalityProviderSigningInfo
+	k.cdc.MustUnmarshal(signInfoBytes, &signInfo)
+	return &signInfo, nil
+}
+
+// signingInfoStore returns the KVStore of the finality providers' liveness information
+// prefix: FinalityProviderSigningInfoKey
+// key: finality provider's BTC PK
+// value: FinalityProviderSigningInfo
+func (k Keeper) signingInfoStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FinalityProviderSigningInfoKey)
+}
+
+/* finality provider missed block bitmap */
+
+func (k Keeper) getMissedBlockBitmapValue(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, index uint64) bool {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	return store.Has(sdk.Uint64ToBigEndian(index))
+}
+
+// setMissedBlockBitmapValue sets the bit of the given index in the given
+// finality provider's missed block bitmap. Only missed blocks are stored
+func (k Keeper) setMissedBlockBitmapValue(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, index uint64, missed bool) {
+	store := k.missedBlockBitmapFpStore(ctx, fpBTCPK)
+	if missed {
+		store.Set(sdk.Uint64ToBigEndian(index), []byte{1})
+	} else {
+		store.Delete(sdk.Uint64ToBigEndian(index))
+	}
+}
+
+// getMissedBlockIndexes gets the indexes of all missed blocks of the given
+// finality provider in the sliding window
+func (k Keeper) getMissedBlockIndexes(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) []uint64 {
+	iter := k.missedBlockBitmapFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	indexes := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		indexes = append(indexes, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return indexes
+}
+
+func (k Keeper) deleteMissedBlockBitmap(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) {
+	for _, index := range k.getMissedBlockIndexes(ctx, fpBTCPK) {
+		k.setMissedBlockBitmapValue(ctx, fpBTCPK, index, false)
+	}
+}
+
+// missedBlockBitmapFpStore returns the KVStore of the missed block bitmap
+// of the given finality provider
+// prefix: FinalityProviderMissedBlockBitmapKey || finality provider's BTC PK
+// key: index in the sliding window
+// value: 1 if the block is missed. Non-missed blocks are not stored
+func (k Keeper) missedBlockBitmapFpStore(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.FinalityProviderMissedBlockBitmapKey)
+	return prefix.NewStore(store, fpBTCPK.MustMarshal())
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzHandleLiveness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// use a small sliding window
+		params := fKeeper.GetParams(ctx)
+		params.SignedBlocksWindow = 10 + datagen.RandomInt(r, 10)
+		params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(5, 1)
+		params.JailDuration = time.Hour
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		window := params.SignedBlocksWindow
+
+		// create a random finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fpBTCPKBytes := fp.BtcPk.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// the finality provider signs the first window in full, and is not jailed
+		startHeight := datagen.RandomInt(r, 100) + 1
+		height := startHeight
+		for ; height < startHeight+window; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fp.BtcPk, false, height)
+			require.NoError(t, err)
+		}
+		signInfo, err := fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, startHeight, signInfo.StartHeight)
+		require.Zero(t, signInfo.MissedBlocksCounter)
+
+		// the finality provider misses blocks until it exceeds the threshold
+		// of missed blocks, upon which it is jailed
+		curTime := time.Now().UTC()
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height), Time: curTime})
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = true
+				return nil
+			}).Times(1)
+		maxMissed := window - params.MinSignedPerWindowInt()
+		for i := uint64(0); i <= maxMissed; i++ {
+			require.False(t, fp.IsJailed())
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fp.BtcPk, true, height)
+			require.NoError(t, err)
+			height++
+		}
+		require.True(t, fp.IsJailed())
+		signInfo, err = fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Zero(t, signInfo.MissedBlocksCounter)
+		require.Equal(t, curTime.Add(params.JailDuration), signInfo.JailedUntil)
+
+		// a jailed finality provider is not tracked anymore
+		err = fKeeper.HandleFinalityProviderLiveness(ctx, fp.BtcPk, true, height)
+		require.NoError(t, err)
+		signInfo, err = fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Zero(t, signInfo.MissedBlocksCounter)
+
+		// unjailing fails if the signer is not the finality provider
+		msg := &types.MsgUnjailFinalityProvider{
+			Signer:  datagen.GenRandomAccount().Address,
+			FpBtcPk: fp.BtcPk,
+		}
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.Error(t, err)
+
+		// unjailing fails if the jailing period has not passed
+		msg.Signer = fp.Addr
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrJailingPeriodNotPassed)
+
+		// unjailing succeeds once the jailing period has passed
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height), Time: signInfo.JailedUntil})
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = false
+				return nil
+			}).Times(1)
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.NoError(t, err)
+		require.False(t, fp.IsJailed())
+		signInfo, err = fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, height, signInfo.StartHeight)
+
+		// unjailing a non-jailed finality provider fails
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrFpNotJailed)
+	})
+}
+
+func FuzzHandleLivenessRetiredFp(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+
+		// use a small sliding window
+		params := fKeeper.GetParams(ctx)
+		params.SignedBlocksWindow = 10 + datagen.RandomInt(r, 10)
+		params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(5, 1)
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// create a retired finality provider that still has voting power,
+		// e.g., at the heights before it leaves the active set
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fp.RetiredBabylonHeight = datagen.RandomInt(r, 100) + 1
+		fpBTCPKBytes := fp.BtcPk.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, _ uint64) map[string]uint64 {
+				if fp.IsJailed() {
+					return map[string]uint64{}
+				}
+				return map[string]uint64{fp.BtcPk.MarshalHex(): datagen.RandomInt(r, 100) + 1}
+			}).AnyTimes()
+		// the retired finality provider is jailed as it has voting power
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).DoAndReturn(
+			func(_ interface{}, _ []byte) error {
+				fp.Jailed = true
+				return nil
+			}).Times(1)
+
+		// the retired finality provider stops voting, and is jailed after
+		// missing too many votes in the sliding window
+		startHeight := params.FinalitySigTimeout + 1
+		for height := startHeight; height <= startHeight+3*params.SignedBlocksWindow; height++ {
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height)})
+			fKeeper.HandleLiveness(ctx)
+		}
+		require.True(t, fp.IsJailed())
+		signInfo, err := fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), signInfo.StartHeight)
+	})
+}
+
+func FuzzHandleLivenessUnsetParams(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+
+		// the liveness params are unset, as in a chain whose params were
+		// stored before the liveness params were introduced
+		params := fKeeper.GetParams(ctx)
+		fKeeper.SetParamsWithoutValidation(ctx, types.Params{MinPubRand: params.MinPubRand})
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		fpBTCPKBytes := fp.BtcPk.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Any()).Return(map[string]uint64{
+			fp.BtcPk.MarshalHex(): datagen.RandomInt(r, 100) + 1,
+		}).AnyTimes()
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Any()).Times(0)
+
+		// the finality provider never votes, while liveness is neither
+		// tracked nor panics
+		numHeights := datagen.RandomInt(r, 100) + 1
+		for height := uint64(1); height <= numHeights; height++ {
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height)})
+			require.NotPanics(t, func() { fKeeper.HandleLiveness(ctx) })
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fp.BtcPk, true, height)
+			require.NoError(t, err)
+		}
+		_, err = fKeeper.GetFinalityProviderSigningInfo(ctx, fp.BtcPk)
+		require.ErrorIs(t, err, types.ErrSigningInfoNotFound)
+	})
+}
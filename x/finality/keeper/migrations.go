@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2, i.e., it sets the params
+// introduced for the liveness of finality providers to their default values
+// if they are unset.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaultParams := types.DefaultParams()
+	if params.SignedBlocksWindow == 0 {
+		params.SignedBlocksWindow = defaultParams.SignedBlocksWindow
+	}
+	if params.FinalitySigTimeout == 0 {
+		params.FinalitySigTimeout = defaultParams.FinalitySigTimeout
+	}
+	// the param does not exist in version 1, so a zero value is unset as well
+	if params.MinSignedPerWindow.IsNil() || params.MinSignedPerWindow.IsZero() {
+		params.MinSignedPerWindow = defaultParams.MinSignedPerWindow
+	}
+	if params.JailDuration == 0 {
+		params.JailDuration = defaultParams.JailDuration
+	}
+	// an unset PruningRetentionBlocks is valid and disables pruning, which is
+	// also its default value
+	return m.keeper.SetParams(ctx, params)
+}
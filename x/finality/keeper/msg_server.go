@@ -12,6 +12,7 @@ import (
 	"github.com/babylonchain/babylon/x/finality/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -76,45 +77,153 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		return nil, bstypes.ErrFpAlreadySlashed
 	}
 
+	_, indexedBlock, err := ms.verifyFinalitySig(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if indexedBlock != nil {
+		ms.addVerifiedFinalitySig(ctx, req, indexedBlock)
+	}
+
+	return &types.MsgAddFinalitySigResponse{}, nil
+}
+
+// AddFinalitySigs adds new votes to a range of blocks. The finality provider
+// and its public randomness commitments are loaded once for all votes, while
+// each vote is handled in the same way as in AddFinalitySig. All votes are
+// verified before any of them is added, so that an invalid vote fails the msg
+// without rolling back the evidence stored due to the other votes
+func (ms msgServer) AddFinalitySigs(goCtx context.Context, req *types.MsgAddFinalitySigs) (*types.MsgAddFinalitySigsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySigs)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, types.ErrInvalidFinalitySig.Wrap(err.Error())
+	}
+
+	// ensure the finality provider exists and is not slashed at this time
+	// point, as in AddFinalitySig
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+
+	// verify all votes, where the public randomness commitment is reused
+	// for all votes in its range
+	msgs := make([]*types.MsgAddFinalitySig, len(req.Votes))
+	indexedBlocks := make([]*types.IndexedBlock, len(req.Votes))
+	var prCommit *types.PubRandCommit
+	for i := range req.Votes {
+		msgs[i] = req.ToMsgAddFinalitySig(i)
+		prCommit, indexedBlocks[i], err = ms.verifyFinalitySig(ctx, msgs[i], prCommit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// add all verified votes
+	for i := range msgs {
+		if indexedBlocks[i] == nil {
+			// duplicated vote
+			continue
+		}
+		if slashed := ms.addVerifiedFinalitySig(ctx, msgs[i], indexedBlocks[i]); slashed {
+			// the finality provider is slashed due to this vote, thus its
+			// remaining votes are ignored
+			// NOTE: we should NOT return error here, otherwise the evidence
+			// will be rolled back
+			break
+		}
+	}
+
+	return &types.MsgAddFinalitySigsResponse{}, nil
+}
+
+// verifyFinalitySig verifies the given vote of a non-slashed finality
+// provider without changing any state. The given public randomness commitment
+// is used if it covers the voted height, otherwise the one covering the voted
+// height is loaded. It returns the public randomness commitment used for the
+// vote, and the indexed block at the voted height. If exactly the same vote
+// already exists, the returned indexed block is nil, and the vote does not
+// need to be added again
+func (ms msgServer) verifyFinalitySig(
+	ctx sdk.Context,
+	req *types.MsgAddFinalitySig,
+	prCommit *types.PubRandCommit,
+) (*types.PubRandCommit, *types.IndexedBlock, error) {
+	// ensure the block has not been pruned
+	if pruningHorizon := ms.GetPruningHorizon(ctx); req.BlockHeight < pruningHorizon {
+		return nil, nil, types.ErrHeightPruned.Wrapf("the block at height %d is below the pruning horizon %d", req.BlockHeight, pruningHorizon)
+	}
+
 	// ensure the finality provider has voting power at this height
 	if req.FpBtcPk == nil {
-		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
+		return nil, nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
 	}
 	fpPK := req.FpBtcPk
 	if ms.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
-		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
+		return nil, nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
 	}
 
 	// ensure the finality provider has not cast the same vote yet
 	if req.FinalitySig == nil {
-		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality signature")
+		return nil, nil, types.ErrInvalidFinalitySig.Wrap("empty finality signature")
 	}
 	existingSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
 	if err == nil && existingSig.Equals(req.FinalitySig) {
 		ms.Logger(ctx).Debug("Received duplicated finiality vote", "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
 		// exactly same vote alreay exists, return success to the provider
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return prCommit, nil, nil
 	}
 
 	// find the public randomness commitment for this height from this finality provider
-	prCommit, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
-	if err != nil {
-		return nil, err
+	if prCommit == nil || !prCommit.IsInRange(req.BlockHeight) {
+		prCommit, err = ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
+		if err != nil {
+			return nil, nil, err
+		}
+		// ensure the public randomness commitment is BTC-timestamped, so
+		// that the finality provider cannot replace it by rewriting Babylon
+		// history without being detected
+		if lastFinalizedEpoch := ms.GetLastFinalizedEpoch(ctx); prCommit.EpochNum > lastFinalizedEpoch {
+			return nil, nil, types.ErrPubRandCommitNotBTCTimestamped.Wrapf("the public randomness commitment is made in epoch %d, while the last finalized epoch is %d", prCommit.EpochNum, lastFinalizedEpoch)
+		}
 	}
 
 	// verify the finality signature message w.r.t. the public randomness commitment
 	// including the public randomness inclusion proof and the finality signature
 	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	// the public randomness is good, set the public randomness
-	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
 
-	// verify whether the voted block is a fork or not
+	// ensure the voted block is indexed
 	indexedBlock, err := ms.GetBlock(ctx, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+
+	return prCommit, indexedBlock, nil
+}
+
+// addVerifiedFinalitySig adds the given vote verified by verifyFinalitySig,
+// and slashes the finality provider if the vote leads to an equivocation. It
+// returns whether the finality provider is slashed
+func (ms msgServer) addVerifiedFinalitySig(
+	ctx sdk.Context,
+	req *types.MsgAddFinalitySig,
+	indexedBlock *types.IndexedBlock,
+) bool {
+	fpPK := req.FpBtcPk
+
+	// the public randomness is good, set the public randomness
+	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
+	ms.SetPubRandProof(ctx, req.FpBtcPk, req.BlockHeight, req.Proof)
+
+	// verify whether the voted block is a fork or not
 	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
 		// the finality provider votes for a fork!
 
@@ -131,7 +240,8 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// if this finality provider has also signed canonical block, slash it
 		canonicalSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
-		if err == nil {
+		slashed := err == nil
+		if slashed {
 			//set canonial sig
 			evidence.CanonicalFinalitySig = canonicalSig
 			// slash this finality provider, including setting its voting power to
@@ -144,7 +254,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return slashed
 	}
 
 	// this signature is good, add vote to DB
@@ -169,9 +279,10 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		// slash this finality provider, including setting its voting power to
 		// zero, extracting its BTC SK, and emit an event
 		ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
+		return true
 	}
 
-	return &types.MsgAddFinalitySigResponse{}, nil
+	return false
 }
 
 // CommitPubRandList commits a list of EOTS public randomness
@@ -202,27 +313,25 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 		return nil, types.ErrInvalidPubRand.Wrapf("invalid signature over the public randomness list: %v", err)
 	}
 
+	// ensure the range of heights does not overflow
+	if req.StartHeight+req.NumPubRand-1 < req.StartHeight {
+		return nil, types.ErrInvalidPubRand.Wrapf("the range of heights starting at %d with %d public randomness overflows", req.StartHeight, req.NumPubRand)
+	}
+
 	prCommit := &types.PubRandCommit{
 		StartHeight: req.StartHeight,
 		NumPubRand:  req.NumPubRand,
 		Commitment:  req.Commitment,
+		EpochNum:    ms.BTCStakingKeeper.GetCurrentEpoch(ctx),
 	}
 
-	// get last public randomness commitment
-	// TODO: allow committing public randomness earlier than existing ones?
-	lastPrCommit := ms.GetLastPubRandCommit(ctx, req.FpBtcPk)
-
-	// this finality provider has not commit any public randomness,
-	// commit the given public randomness list and return
-	if lastPrCommit == nil {
-		ms.SetPubRandCommit(ctx, req.FpBtcPk, prCommit)
-		return &types.MsgCommitPubRandListResponse{}, nil
-	}
-
-	// ensure height and req.StartHeight do not overlap, i.e., height < req.StartHeight
-	lastPrHeightCommitted := lastPrCommit.EndHeight()
-	if req.StartHeight <= lastPrCommit.EndHeight() {
-		return nil, types.ErrInvalidPubRand.Wrapf("the start height (%d) has overlap with the height of the highest public randomness committed (%d)", req.StartHeight, lastPrHeightCommitted)
+	// ensure the given public randomness commitment does not overlap with
+	// any existing one of this finality provider. The commitments can be
+	// made in any order, e.g., to fill a gap in the past or to commit
+	// for a window far in the future
+	if overlapped := ms.GetOverlappingPubRandCommit(ctx, req.FpBtcPk, prCommit); overlapped != nil {
+		start, end := overlapped.Range()
+		return nil, types.ErrInvalidPubRand.Wrapf("the range of heights [%d, %d] has overlap with an existing public randomness commitment [%d, %d]", req.StartHeight, prCommit.EndHeight(), start, end)
 	}
 
 	// all good, commit the given public randomness list
@@ -230,6 +339,104 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 	return &types.MsgCommitPubRandListResponse{}, nil
 }
 
+// EquivocationEvidence handles the evidence that a finality provider has
+// signed two conflicting blocks at the same height. Anyone can submit the
+// evidence, e.g., a watchtower observing a fork outside of Babylon
+func (ms msgServer) EquivocationEvidence(goCtx context.Context, req *types.MsgEquivocationEvidence) (*types.MsgEquivocationEvidenceResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyEquivocationEvidence)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, types.ErrInvalidEquivocationEvidence.Wrap(err.Error())
+	}
+
+	// ensure the finality provider exists and is not slashed yet
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+	if fp.IsSlashed() {
+		return nil, bstypes.ErrFpAlreadySlashed
+	}
+
+	// find the public randomness commitment for this height from this finality provider
+	prCommit, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the public randomness inclusion proof and both finality signatures
+	if err := types.VerifyEquivocationEvidence(req, prCommit); err != nil {
+		return nil, err
+	}
+
+	// ensure the finality provider's BTC SK can be extracted from the evidence
+	evidence := req.ToEvidence()
+	btcSK, err := evidence.ExtractBTCSK()
+	if err != nil {
+		return nil, types.ErrInvalidEquivocationEvidence.Wrapf("failed to extract BTC SK: %v", err)
+	}
+	if !bbn.NewBIP340PubKeyFromBTCPK(btcSK.PubKey()).Equals(req.FpBtcPk) {
+		return nil, types.ErrInvalidEquivocationEvidence.Wrap("the extracted BTC SK does not match the finality provider's BTC PK")
+	}
+
+	// all good, save the evidence and slash this finality provider, including
+	// setting its voting power to zero, extracting its BTC SK, and emit an event
+	ms.SetEvidence(ctx, evidence)
+	ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
+
+	return &types.MsgEquivocationEvidenceResponse{}, nil
+}
+
+// UnjailFinalityProvider unjails a jailed finality provider, such that it
+// can receive voting power again
+func (ms msgServer) UnjailFinalityProvider(goCtx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.FpBtcPk == nil {
+		return nil, bstypes.ErrFpNotFound.Wrap("empty finality provider public key")
+	}
+	// ensure the finality provider exists
+	fpBTCPKBytes := req.FpBtcPk.MustMarshal()
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPKBytes)
+	if err != nil {
+		return nil, err
+	}
+	// ensure the signer is the finality provider
+	if fp.Addr != req.Signer {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("the signer %s is not the finality provider %s", req.Signer, fp.Addr)
+	}
+	// ensure the finality provider is jailed
+	if !fp.IsJailed() {
+		return nil, types.ErrFpNotJailed
+	}
+	// ensure the jailing period has passed
+	signInfo, err := ms.GetFinalityProviderSigningInfo(ctx, req.FpBtcPk)
+	if err != nil {
+		return nil, err
+	}
+	curTime := ctx.HeaderInfo().Time
+	if curTime.Before(signInfo.JailedUntil) {
+		return nil, types.ErrJailingPeriodNotPassed.Wrapf("jailed until %s, current time %s", signInfo.JailedUntil, curTime)
+	}
+
+	// unjail the finality provider
+	if err := ms.BTCStakingKeeper.UnjailFinalityProvider(ctx, fpBTCPKBytes); err != nil {
+		return nil, err
+	}
+	// restart tracking the liveness of the finality provider from the
+	// current height
+	signInfo.StartHeight = uint64(ctx.HeaderInfo().Height)
+	ms.SetFinalityProviderSigningInfo(ctx, signInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventUnjailedFinalityProvider(req.FpBtcPk)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnjailFinalityProviderResponse{}, nil
+}
+
 // slashFinalityProvider slashes a finality provider with the given evidence
 // including setting its voting power to zero, extracting its BTC SK,
 // and emit an event
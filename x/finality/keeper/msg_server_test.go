@@ -56,6 +56,9 @@ func FuzzCommitPubRandList(f *testing.F) {
 		require.Error(t, err)
 		// register the finality provider
 		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		// mock the current epoch
+		epochNum := datagen.RandomInt(r, 10)
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(epochNum).AnyTimes()
 
 		// Case 2: commit a list of <minPubRand pubrand and it should fail
 		startHeight = datagen.RandomInt(r, 10)
@@ -75,6 +78,7 @@ func FuzzCommitPubRandList(f *testing.F) {
 		// query last public randomness and assert
 		lastPrCommit := fKeeper.GetLastPubRandCommit(ctx, fpBTCPK)
 		require.NotNil(t, lastPrCommit)
+		require.Equal(t, epochNum, lastPrCommit.EpochNum)
 
 		// Case 4: commit a pubrand list with overlap of the existing pubrand in KVStore and it should fail
 		overlappedStartHeight := startHeight + numPubRand - 1 - datagen.RandomInt(r, 5)
@@ -89,6 +93,27 @@ func FuzzCommitPubRandList(f *testing.F) {
 		require.NoError(t, err)
 		_, err = ms.CommitPubRandList(ctx, msg)
 		require.NoError(t, err)
+
+		// Case 6: commit a pubrand list for a window far in the future and it should succeed
+		futureStartHeight := nonOverlappedStartHeight + 10*numPubRand + datagen.RandomInt(r, 1000)
+		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, futureStartHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.NoError(t, err)
+
+		// Case 7: commit a pubrand list filling the gap before the future window and it should succeed
+		gapStartHeight := nonOverlappedStartHeight + numPubRand + datagen.RandomInt(r, 5)
+		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, gapStartHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.NoError(t, err)
+
+		// Case 8: commit a pubrand list overlapping with the beginning of the future window and it should fail
+		overlappedStartHeight = futureStartHeight - 1 - datagen.RandomInt(r, 5)
+		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, overlappedStartHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.Error(t, err)
 	})
 }
 
@@ -113,7 +138,9 @@ func FuzzAddFinalitySig(f *testing.F) {
 		fpBTCPKBytes := fpBTCPK.MustMarshal()
 		require.NoError(t, err)
 		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
-		// commit some public randomness
+		// commit some public randomness in a random epoch
+		epochNum := datagen.RandomInt(r, 10) + 1
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(epochNum).AnyTimes()
 		startHeight := uint64(0)
 		numPubRand := uint64(200)
 		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
@@ -151,6 +178,13 @@ func FuzzAddFinalitySig(f *testing.F) {
 		// index this block first
 		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
 		fKeeper.IndexBlock(ctx)
+		// fail if the public randomness commitment is not BTC-timestamped yet
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(epochNum - 1).Times(1)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySig(ctx, msg)
+		require.ErrorIs(t, err, types.ErrPubRandCommitNotBTCTimestamped)
+		// BTC-timestamp the epoch of the public randomness commitment
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(epochNum).AnyTimes()
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 		// add vote and it should work
 		_, err = ms.AddFinalitySig(ctx, msg)
@@ -203,6 +237,226 @@ func FuzzAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzAddFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+		bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// index a number of blocks and generate a vote for each of them
+		signer := datagen.GenRandomAccount().Address
+		numVotes := datagen.RandomInt(r, 10) + 2
+		msg := &types.MsgAddFinalitySigs{
+			Signer:  signer,
+			FpBtcPk: fpBTCPK,
+		}
+		for i := uint64(0); i < numVotes; i++ {
+			blockHeight := startHeight + i + 1
+			blockAppHash := datagen.GenRandomByteArray(r, 32)
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
+			fKeeper.IndexBlock(ctx)
+			voteMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, blockAppHash)
+			require.NoError(t, err)
+			msg.Votes = append(msg.Votes, &types.FinalityVote{
+				BlockHeight:  voteMsg.BlockHeight,
+				PubRand:      voteMsg.PubRand,
+				Proof:        voteMsg.Proof,
+				BlockAppHash: voteMsg.BlockAppHash,
+				FinalitySig:  voteMsg.FinalitySig,
+			})
+		}
+
+		// Case 1: fail if the votes are not in strictly increasing order of heights
+		invalidMsg := &types.MsgAddFinalitySigs{
+			Signer:  signer,
+			FpBtcPk: fpBTCPK,
+			Votes:   []*types.FinalityVote{msg.Votes[1], msg.Votes[0]},
+		}
+		_, err = ms.AddFinalitySigs(ctx, invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// Case 2: successful if the finality provider has voting power and
+		// has not casted these votes yet
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+		for _, vote := range msg.Votes {
+			sig, err := fKeeper.GetSig(ctx, vote.BlockHeight, fpBTCPK)
+			require.NoError(t, err)
+			require.Equal(t, vote.FinalitySig.MustMarshal(), sig.MustMarshal())
+		}
+
+		// Case 3: in case of duplicate votes return success
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+
+		// Case 4: the finality provider is slashed if one of its votes is for
+		// a fork, and the remaining votes are ignored, unless any vote is
+		// invalid
+		forkIdx := datagen.RandomInt(r, int(numVotes))
+		forkHeight := msg.Votes[forkIdx].BlockHeight
+		forkAppHash := datagen.GenRandomByteArray(r, 32)
+		forkVoteMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, forkHeight, randListInfo, forkAppHash)
+		require.NoError(t, err)
+		forkMsg := &types.MsgAddFinalitySigs{
+			Signer:  signer,
+			FpBtcPk: fpBTCPK,
+			Votes: []*types.FinalityVote{{
+				BlockHeight:  forkVoteMsg.BlockHeight,
+				PubRand:      forkVoteMsg.PubRand,
+				Proof:        forkVoteMsg.Proof,
+				BlockAppHash: forkVoteMsg.BlockAppHash,
+				FinalitySig:  forkVoteMsg.FinalitySig,
+			}},
+		}
+		// an invalid vote after the fork vote fails the msg before any state
+		// change, thus the fork vote is not processed either
+		invalidVote := *msg.Votes[len(msg.Votes)-1]
+		invalidVote.BlockHeight = startHeight + numPubRand + 1
+		invalidForkMsg := *forkMsg
+		invalidForkMsg.Votes = append([]*types.FinalityVote{}, forkMsg.Votes[0], &invalidVote)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, &invalidForkMsg)
+		require.Error(t, err)
+		require.False(t, fKeeper.HasEvidence(ctx, fpBTCPK, forkHeight))
+		// a valid vote after the fork vote is ignored as the finality
+		// provider is slashed
+		if forkIdx+1 < numVotes {
+			forkMsg.Votes = append(forkMsg.Votes, msg.Votes[forkIdx+1])
+		}
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		// NOTE: even though this finality provider is slashed, the msg should be successful
+		// Otherwise the saved evidence will be rolled back
+		_, err = ms.AddFinalitySigs(ctx, forkMsg)
+		require.NoError(t, err)
+		// ensure the evidence has been stored
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, forkHeight)
+		require.NoError(t, err)
+		require.Equal(t, forkAppHash, evidence.ForkAppHash)
+		require.Equal(t, forkVoteMsg.FinalitySig.MustMarshal(), evidence.ForkFinalitySig.MustMarshal())
+
+		// Case 5: slashed finality provider cannot vote
+		fp.SlashedBabylonHeight = forkHeight
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.Equal(t, bstypes.ErrFpAlreadySlashed, err)
+	})
+}
+
+func FuzzEquivocationEvidence(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// the finality provider signs two conflicting blocks at a random
+		// height, which are not known to Babylon
+		blockHeight := startHeight + datagen.RandomInt(r, int(numPubRand))
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight)})
+		signer := datagen.GenRandomAccount().Address
+		canonicalMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		forkMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		msg := &types.MsgEquivocationEvidence{
+			Signer:               datagen.GenRandomAccount().Address,
+			FpBtcPk:              fpBTCPK,
+			BlockHeight:          blockHeight,
+			PubRand:              canonicalMsg.PubRand,
+			Proof:                canonicalMsg.Proof,
+			CanonicalAppHash:     canonicalMsg.BlockAppHash,
+			ForkAppHash:          forkMsg.BlockAppHash,
+			CanonicalFinalitySig: canonicalMsg.FinalitySig,
+			ForkFinalitySig:      forkMsg.FinalitySig,
+		}
+
+		// Case 1: fail if the two signatures are over the same block
+		invalidMsg := *msg
+		invalidMsg.ForkAppHash = invalidMsg.CanonicalAppHash
+		_, err = ms.EquivocationEvidence(ctx, &invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidEquivocationEvidence)
+
+		// Case 2: fail if any of the signatures is invalid
+		invalidMsg = *msg
+		invalidMsg.ForkFinalitySig = canonicalMsg.FinalitySig
+		_, err = ms.EquivocationEvidence(ctx, &invalidMsg)
+		require.ErrorIs(t, err, types.ErrInvalidEquivocationEvidence)
+
+		// Case 3: fail if the public randomness is not committed at the height
+		invalidMsg = *msg
+		invalidMsg.BlockHeight = blockHeight + 1
+		_, err = ms.EquivocationEvidence(ctx, &invalidMsg)
+		require.Error(t, err)
+
+		// Case 4: the finality provider is slashed upon a valid evidence
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil).Times(1)
+		_, err = ms.EquivocationEvidence(ctx, msg)
+		require.NoError(t, err)
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+		require.NoError(t, err)
+		require.True(t, evidence.IsSlashable())
+		btcSK2, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+
+		// Case 5: fail if the finality provider is already slashed
+		fp.SlashedBabylonHeight = blockHeight + 1
+		_, err = ms.EquivocationEvidence(ctx, msg)
+		require.ErrorIs(t, err, bstypes.ErrFpAlreadySlashed)
+	})
+}
+
 func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
@@ -220,6 +474,8 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	require.NoError(t, err)
 	bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(),
 		gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+	bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+	bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
 	// commit some public randomness
 	startHeight := uint64(0)
 	numPubRand := uint64(200)
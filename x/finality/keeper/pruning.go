@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxPrunedBlocksPerEndBlock is the maximum number of blocks whose data is
+// pruned upon each EndBlock. This bounds the cost of catching up with the
+// retention window, e.g., right after pruning is enabled
+const maxPrunedBlocksPerEndBlock = 100
+
+// PruneFinalityData prunes the finality votes, the public randomness revealed
+// by these votes, and the indexed blocks at heights that are both
+// - finalised or non-finalisable, i.e., below the next height to finalise, and
+// - outside the retention window of `PruningRetentionBlocks` blocks.
+// Evidences and public randomness commitments are kept, so that equivocations
+// at any height can still be verified and slashed.
+// This function is invoked upon each `EndBlock` *after* the BTC staking protocol is activated
+func (k Keeper) PruneFinalityData(ctx context.Context) {
+	retention := k.GetParams(ctx).PruningRetentionBlocks
+	if retention == 0 {
+		// pruning is disabled
+		return
+	}
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if curHeight <= retention {
+		return
+	}
+
+	// prune all heights below min(curHeight - retention, nextHeightToFinalize)
+	targetHeight := curHeight - retention
+	if nextHeightToFinalize := k.getNextHeightToFinalize(ctx); nextHeightToFinalize < targetHeight {
+		targetHeight = nextHeightToFinalize
+	}
+	horizon := k.GetPruningHorizon(ctx)
+	if horizon >= targetHeight {
+		return
+	}
+
+	// find the indexed blocks to prune
+	// NOTE: votes can only be casted on indexed blocks
+	heights := make([]uint64, 0, maxPrunedBlocksPerEndBlock)
+	iter := k.blockStore(ctx).Iterator(sdk.Uint64ToBigEndian(horizon), sdk.Uint64ToBigEndian(targetHeight))
+	for ; iter.Valid() && len(heights) < maxPrunedBlocksPerEndBlock; iter.Next() {
+		heights = append(heights, sdk.BigEndianToUint64(iter.Key()))
+	}
+	iter.Close()
+
+	for _, height := range heights {
+		k.pruneHeight(ctx, height)
+	}
+
+	// if there might be remaining blocks to prune, continue from the
+	// last pruned block upon the next EndBlock
+	if len(heights) == maxPrunedBlocksPerEndBlock {
+		targetHeight = heights[len(heights)-1] + 1
+	}
+	k.setPruningHorizon(ctx, targetHeight)
+}
+
+// pruneHeight removes the finality votes, the public randomness revealed at
+// the given height and the indexed block at the given height. The public
+// randomness is found via its index by height rather than the votes, since
+// votes for forks reveal public randomness without being stored as votes
+func (k Keeper) pruneHeight(ctx context.Context, height uint64) {
+	voteStore := k.voteHeightStore(ctx, height)
+	indexStore := k.pubRandHeightIndexStore(ctx, height)
+
+	// finality providers that vote or reveal public randomness at this height
+	fpBTCPKBytesList := [][]byte{}
+	for _, store := range []prefix.Store{voteStore, indexStore} {
+		iter := store.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			fpBTCPKBytesList = append(fpBTCPKBytesList, iter.Key())
+		}
+		iter.Close()
+	}
+
+	for _, fpBTCPKBytes := range fpBTCPKBytesList {
+		voteStore.Delete(fpBTCPKBytes)
+		indexStore.Delete(fpBTCPKBytes)
+		fpBTCPK := bbn.BIP340PubKey(fpBTCPKBytes)
+		k.pubRandFpStore(ctx, &fpBTCPK).Delete(sdk.Uint64ToBigEndian(height))
+		k.pubRandProofFpStore(ctx, &fpBTCPK).Delete(sdk.Uint64ToBigEndian(height))
+	}
+
+	k.blockStore(ctx).Delete(sdk.Uint64ToBigEndian(height))
+}
+
+// GetPruningHorizon gets the lowest height whose finality votes, revealed
+// public randomness and indexed block are retained
+func (k Keeper) GetPruningHorizon(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PruningHorizonKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPruningHorizon sets the lowest height whose finality votes, revealed
+// public randomness and indexed block are retained
+func (k Keeper) setPruningHorizon(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PruningHorizonKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func FuzzPruneFinalityData(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil)
+
+		// generate some finality providers
+		numFps := datagen.RandomInt(r, 5) + 1
+		fpBTCPKs := []*bbn.BIP340PubKey{}
+		for i := uint64(0); i < numFps; i++ {
+			_, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fpBTCPKs = append(fpBTCPKs, bbn.NewBIP340PubKeyFromBTCPK(btcPK))
+		}
+
+		// index blocks with votes and revealed public randomness
+		numBlocks := datagen.RandomInt(r, 300) + 1
+		for height := uint64(1); height <= numBlocks; height++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  height,
+				AppHash: datagen.GenRandomByteArray(r, 32),
+			})
+			for _, fpBTCPK := range fpBTCPKs {
+				sig := bbn.SchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				fKeeper.SetSig(ctx, height, fpBTCPK, &sig)
+				fKeeper.SetPubRand(ctx, fpBTCPK, height, datagen.GenRandomByteArray(r, 32))
+			}
+		}
+		// an evidence at a random height
+		evidence := &types.Evidence{
+			FpBtcPk:     fpBTCPKs[0],
+			BlockHeight: datagen.RandomInt(r, int(numBlocks)) + 1,
+		}
+		fKeeper.SetEvidence(ctx, evidence)
+		// a finality provider that only votes for forks, thus reveals public
+		// randomness without any vote
+		_, forkFpBTCPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		forkFpPK := bbn.NewBIP340PubKeyFromBTCPK(forkFpBTCPK)
+		for height := uint64(1); height <= numBlocks; height++ {
+			fKeeper.SetPubRand(ctx, forkFpPK, height, datagen.GenRandomByteArray(r, 32))
+		}
+
+		// enable pruning and finalize a random prefix of blocks
+		params := fKeeper.GetParams(ctx)
+		params.PruningRetentionBlocks = params.FinalitySigTimeout + 1 + datagen.RandomInt(r, 100)
+		nextHeightToFinalize := datagen.RandomInt(r, int(numBlocks)) + 1
+		err = fKeeper.InitGenesis(ctx, types.GenesisState{
+			Params:               params,
+			NextHeightToFinalize: nextHeightToFinalize,
+		})
+		require.NoError(t, err)
+
+		// prune until reaching the expected horizon, i.e., the next height to
+		// finalize or the start of the retention window, whichever is lower
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(numBlocks)})
+		expectedHorizon := uint64(0)
+		if numBlocks > params.PruningRetentionBlocks {
+			expectedHorizon = min(numBlocks-params.PruningRetentionBlocks, nextHeightToFinalize)
+		}
+		for i := 0; i <= int(numBlocks)/100; i++ {
+			fKeeper.PruneFinalityData(ctx)
+		}
+		resp, err := fKeeper.PruningHorizon(ctx, &types.QueryPruningHorizonRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expectedHorizon, resp.PruningHorizon)
+		require.Equal(t, params.PruningRetentionBlocks, resp.RetentionBlocks)
+
+		// the data below the horizon is pruned, while the data since the
+		// horizon is retained
+		for height := uint64(1); height <= numBlocks; height++ {
+			pruned := height < expectedHorizon
+			require.Equal(t, !pruned, fKeeper.HasBlock(ctx, height))
+			for _, fpBTCPK := range fpBTCPKs {
+				require.Equal(t, !pruned, fKeeper.HasSig(ctx, height, fpBTCPK))
+				require.Equal(t, !pruned, fKeeper.HasPubRand(ctx, fpBTCPK, height))
+			}
+			require.Equal(t, !pruned, fKeeper.HasPubRand(ctx, forkFpPK, height))
+		}
+		// the evidence is never pruned
+		_, err = fKeeper.GetEvidence(ctx, evidence.FpBtcPk, evidence.BlockHeight)
+		require.NoError(t, err)
+	})
+}
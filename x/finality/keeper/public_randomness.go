@@ -7,8 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/finality/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,19 +20,102 @@ import (
 
 // GetPubRandCommitForHeight finds the public randomness commitment that includes the given
 // height for the given finality provider
+// NOTE: public randomness commitments of a finality provider do not overlap,
+// and are indexed by their start heights. Thus the only candidate is the
+// commitment with the highest start height that is no larger than the given height
 func (k Keeper) GetPubRandCommitForHeight(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) (*types.PubRandCommit, error) {
+	prCommit := k.getPubRandCommitStartingAtOrBefore(ctx, fpBtcPK, height)
+	if prCommit == nil || !prCommit.IsInRange(height) {
+		return nil, types.ErrPubRandNotFound
+	}
+	return prCommit, nil
+}
+
+// GetOverlappingPubRandCommit returns an existing public randomness commitment
+// of the given finality provider that overlaps with the given one, or nil if
+// there is no such commitment
+func (k Keeper) GetOverlappingPubRandCommit(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, prCommit *types.PubRandCommit) *types.PubRandCommit {
+	// the commitment starting at or before the given one overlaps with it
+	// if it ends at or after the start of the given one
+	prevPrCommit := k.getPubRandCommitStartingAtOrBefore(ctx, fpBtcPK, prCommit.StartHeight)
+	if prevPrCommit != nil && prevPrCommit.EndHeight() >= prCommit.StartHeight {
+		return prevPrCommit
+	}
+
+	// the commitment starting after the given one overlaps with it if it
+	// starts at or before the end of the given one
 	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
-	iter := store.ReverseIterator(nil, nil)
+	iter := store.Iterator(sdk.Uint64ToBigEndian(prCommit.StartHeight), nil)
 	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	var nextPrCommit types.PubRandCommit
+	k.cdc.MustUnmarshal(iter.Value(), &nextPrCommit)
+	if nextPrCommit.StartHeight <= prCommit.EndHeight() {
+		return &nextPrCommit
+	}
+	return nil
+}
 
-	var prCommit types.PubRandCommit
+// GetPubRandCommitGaps returns the ranges of heights within [startHeight, endHeight]
+// that are not covered by any public randomness commitment of the given
+// finality provider, in ascending order of heights
+func (k Keeper) GetPubRandCommitGaps(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, startHeight uint64, endHeight uint64) []*types.PubRandCommitGap {
+	gaps := []*types.PubRandCommitGap{}
+	if startHeight > endHeight {
+		return gaps
+	}
+
+	// the first height in the range that is not known to be covered yet
+	nextHeight := startHeight
+	// the commitment starting before the range might cover its beginning
+	if prCommit := k.getPubRandCommitStartingAtOrBefore(ctx, fpBtcPK, startHeight); prCommit != nil && prCommit.EndHeight() >= startHeight {
+		if prCommit.EndHeight() >= endHeight {
+			return gaps
+		}
+		nextHeight = prCommit.EndHeight() + 1
+	}
+
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	iter := store.Iterator(sdk.Uint64ToBigEndian(nextHeight), storetypes.PrefixEndBytes(sdk.Uint64ToBigEndian(endHeight)))
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
+		var prCommit types.PubRandCommit
 		k.cdc.MustUnmarshal(iter.Value(), &prCommit)
-		if prCommit.IsInRange(height) {
-			return &prCommit, nil
+		if prCommit.StartHeight > nextHeight {
+			gaps = append(gaps, &types.PubRandCommitGap{
+				StartHeight: nextHeight,
+				EndHeight:   prCommit.StartHeight - 1,
+			})
+		}
+		if prCommit.EndHeight() >= endHeight {
+			return gaps
 		}
+		nextHeight = prCommit.EndHeight() + 1
 	}
-	return nil, types.ErrPubRandNotFound
+
+	return append(gaps, &types.PubRandCommitGap{
+		StartHeight: nextHeight,
+		EndHeight:   endHeight,
+	})
+}
+
+// getPubRandCommitStartingAtOrBefore returns the public randomness commitment
+// of the given finality provider with the highest start height that is no
+// larger than the given height, or nil if there is no such commitment
+func (k Keeper) getPubRandCommitStartingAtOrBefore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) *types.PubRandCommit {
+	store := k.pubRandCommitFpStore(ctx, fpBtcPK)
+	iter := store.ReverseIterator(nil, storetypes.PrefixEndBytes(sdk.Uint64ToBigEndian(height)))
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	var prCommit types.PubRandCommit
+	k.cdc.MustUnmarshal(iter.Value(), &prCommit)
+	return &prCommit
 }
 
 // SetPubRandCommit adds the given public randomness commitment for the given public key
@@ -79,10 +164,12 @@ func (k Keeper) pubRandCommitStore(ctx context.Context) prefix.Store {
 	TODO: remove public randomness storage?
 */
 
-// SetPubRand sets a public randomness at a given height for a given finality provider
+// SetPubRand sets a public randomness at a given height for a given finality provider,
+// and indexes it by the height so that it can be pruned together with the height
 func (k Keeper) SetPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64, pubRand bbn.SchnorrPubRand) {
 	store := k.pubRandFpStore(ctx, fpBtcPK)
 	store.Set(sdk.Uint64ToBigEndian(height), pubRand)
+	k.pubRandHeightIndexStore(ctx, height).Set(fpBtcPK.MustMarshal(), []byte{})
 }
 
 func (k Keeper) HasPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) bool {
@@ -136,3 +223,45 @@ func (k Keeper) pubRandStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.PubRandKey)
 }
+
+// pubRandHeightIndexStore returns the KVStore of the index of the public
+// randomness revealed at the given height
+// prefix: PubRandHeightIndexKey || block height
+// key: finality provider PK
+// value: empty
+func (k Keeper) pubRandHeightIndexStore(ctx context.Context, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixedStore := prefix.NewStore(storeAdapter, types.PubRandHeightIndexKey)
+	return prefix.NewStore(prefixedStore, sdk.Uint64ToBigEndian(height))
+}
+
+// SetPubRandProof sets the inclusion proof of the public randomness revealed
+// by a finality provider at a given height
+func (k Keeper) SetPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64, proof *cmtcrypto.Proof) {
+	store := k.pubRandProofFpStore(ctx, fpBtcPK)
+	store.Set(sdk.Uint64ToBigEndian(height), k.cdc.MustMarshal(proof))
+}
+
+// GetPubRandProof gets the inclusion proof of the public randomness revealed
+// by a finality provider at a given height
+func (k Keeper) GetPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) (*cmtcrypto.Proof, error) {
+	store := k.pubRandProofFpStore(ctx, fpBtcPK)
+	proofBytes := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(proofBytes) == 0 {
+		return nil, types.ErrPubRandNotFound.Wrapf("the inclusion proof of public randomness at height %d is not found", height)
+	}
+	var proof cmtcrypto.Proof
+	k.cdc.MustUnmarshal(proofBytes, &proof)
+	return &proof, nil
+}
+
+// pubRandProofFpStore returns the KVStore of the inclusion proofs of the
+// public randomness revealed by finality votes
+// prefix: PubRandProofKey
+// key: (finality provider PK || block height)
+// value: tendermint.crypto.Proof
+func (k Keeper) pubRandProofFpStore(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixedStore := prefix.NewStore(storeAdapter, types.PubRandProofKey)
+	return prefix.NewStore(prefixedStore, fpBtcPK.MustMarshal())
+}
@@ -52,7 +52,7 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 			voterBTCPKs := k.GetVoters(ctx, ib.Height)
 			if tally(fpSet, voterBTCPKs) {
 				// if this block gets >2/3 votes, finalise it
-				k.finalizeBlock(ctx, ib, voterBTCPKs)
+				k.finalizeBlock(ctx, ib, fpSet, voterBTCPKs)
 			} else {
 				// if not, then this block and all subsequent blocks should not be finalised
 				// thus, we need to break here
@@ -76,9 +76,14 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 
 // finalizeBlock sets a block to be finalised in KVStore and distributes rewards to
 // finality providers and delegations
-func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, voterBTCPKs map[string]struct{}) {
-	// set block to be finalised in KVStore
+func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, fpSet map[string]uint64, voterBTCPKs map[string]struct{}) {
+	// set block to be finalised in KVStore, together with the total voting
+	// power of the finality provider set, such that the voting power table
+	// in a finality certificate can be checked to be complete
 	block.Finalized = true
+	for _, power := range fpSet {
+		block.TotalVotingPower += power
+	}
 	k.SetBlock(ctx, block)
 	// set next height to finalise as height+1
 	k.setNextHeightToFinalize(ctx, block.Height+1)
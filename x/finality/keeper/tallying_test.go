@@ -138,6 +138,8 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 			require.NoError(t, err)
 			if i < activatedHeight+numWithQCs {
 				require.True(t, ib.Finalized)
+				// 4 finality providers with 1 voting power each
+				require.Equal(t, uint64(4), ib.TotalVotingPower)
 			} else {
 				require.False(t, ib.Finalized)
 			}
@@ -37,6 +37,9 @@ func benchmarkAddFinalitySig(b *testing.B) {
 	// register the finality provider
 	bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
 	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+	// mock epochs
+	bsKeeper.EXPECT().GetCurrentEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
+	bsKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(0)).AnyTimes()
 	// mock voting power
 	bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
 
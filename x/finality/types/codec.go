@@ -11,6 +11,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgUnjailFinalityProvider{}, "finality/MsgUnjailFinalityProvider", nil)
+	cdc.RegisterConcrete(&MsgEquivocationEvidence{}, "finality/MsgEquivocationEvidence", nil)
+	cdc.RegisterConcrete(&MsgAddFinalitySigs{}, "finality/MsgAddFinalitySigs", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -20,6 +23,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCommitPubRandList{},
 		&MsgAddFinalitySig{},
 		&MsgUpdateParams{},
+		&MsgUnjailFinalityProvider{},
+		&MsgEquivocationEvidence{},
+		&MsgAddFinalitySigs{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
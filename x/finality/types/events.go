@@ -1,7 +1,26 @@
 package types
 
+import (
+	"time"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
 func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
 		Evidence: evidence,
 	}
 }
+
+func NewEventJailedFinalityProvider(fpBTCPK *bbn.BIP340PubKey, jailedUntil time.Time) *EventJailedFinalityProvider {
+	return &EventJailedFinalityProvider{
+		FpBtcPk:     fpBTCPK,
+		JailedUntil: jailedUntil.Unix(),
+	}
+}
+
+func NewEventUnjailedFinalityProvider(fpBTCPK *bbn.BIP340PubKey) *EventUnjailedFinalityProvider {
+	return &EventUnjailedFinalityProvider{
+		FpBtcPk: fpBTCPK,
+	}
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -69,27 +71,125 @@ func (m *EventSlashedFinalityProvider) GetEvidence() *Evidence {
 	return nil
 }
 
+// EventJailedFinalityProvider is the event emitted when a finality provider
+// is jailed due to missing too many finality votes
+type EventJailedFinalityProvider struct {
+	// fp_btc_pk is the BTC PK of the jailed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil int64 `protobuf:"varint,2,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventJailedFinalityProvider) Reset()         { *m = EventJailedFinalityProvider{} }
+func (m *EventJailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventJailedFinalityProvider) ProtoMessage()    {}
+func (*EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{1}
+}
+func (m *EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJailedFinalityProvider.Merge(m, src)
+}
+func (m *EventJailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJailedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventJailedFinalityProvider) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+// EventUnjailedFinalityProvider is the event emitted when a jailed finality
+// provider is unjailed
+type EventUnjailedFinalityProvider struct {
+	// fp_btc_pk is the BTC PK of the unjailed finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *EventUnjailedFinalityProvider) Reset()         { *m = EventUnjailedFinalityProvider{} }
+func (m *EventUnjailedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventUnjailedFinalityProvider) ProtoMessage()    {}
+func (*EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{2}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnjailedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnjailedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnjailedFinalityProvider.Merge(m, src)
+}
+func (m *EventUnjailedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnjailedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnjailedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnjailedFinalityProvider proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventJailedFinalityProvider)(nil), "babylon.finality.v1.EventJailedFinalityProvider")
+	proto.RegisterType((*EventUnjailedFinalityProvider)(nil), "babylon.finality.v1.EventUnjailedFinalityProvider")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x93, 0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x0d, 0x4b, 0xce,
-	0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0x10, 0x4e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0xbb, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0xb5, 0x88, 0x0a, 0x01, 0x00,
-	0x00,
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0xc7, 0x6b, 0x90, 0xf8, 0x70, 0x3b, 0x15, 0x86, 0xaa, 0x50, 0x53, 0x32, 0x75, 0xb2, 0xfb,
+	0x81, 0x90, 0x58, 0x23, 0x15, 0x89, 0xb2, 0x44, 0x41, 0x1d, 0x60, 0xa9, 0xe2, 0xd4, 0x6d, 0xdd,
+	0x06, 0x3b, 0x4a, 0x9c, 0x88, 0xbc, 0x05, 0x03, 0x0f, 0xc5, 0xd8, 0x11, 0x31, 0x20, 0x94, 0xbc,
+	0x08, 0x8a, 0x9b, 0x96, 0x25, 0x12, 0x1b, 0xdb, 0xf9, 0xee, 0xe7, 0xdf, 0xfd, 0xa5, 0x83, 0x6d,
+	0xea, 0xd0, 0xc4, 0x93, 0x82, 0xcc, 0xb8, 0x70, 0x3c, 0xae, 0x12, 0x12, 0xf7, 0x08, 0x8b, 0x99,
+	0x50, 0x21, 0xf6, 0x03, 0xa9, 0x64, 0xfd, 0xa4, 0x20, 0xf0, 0x96, 0xc0, 0x71, 0xaf, 0x79, 0x3a,
+	0x97, 0x73, 0xa9, 0xe7, 0x24, 0xaf, 0x36, 0x68, 0xd3, 0x28, 0x93, 0xed, 0xbe, 0x69, 0xc6, 0x78,
+	0x84, 0xe7, 0xc3, 0x5c, 0xff, 0xe0, 0x39, 0xe1, 0x82, 0x4d, 0x6f, 0x8b, 0xa9, 0x15, 0xc8, 0x98,
+	0x4f, 0x59, 0x50, 0xbf, 0x81, 0x47, 0x2c, 0xaf, 0x84, 0xcb, 0x1a, 0xa0, 0x0d, 0x3a, 0xd5, 0x7e,
+	0x0b, 0x97, 0x24, 0xc0, 0xc3, 0x02, 0xb2, 0x77, 0xb8, 0xf1, 0x06, 0xe0, 0x99, 0x76, 0x8f, 0x1c,
+	0xee, 0x95, 0xa8, 0x6d, 0x78, 0x3c, 0xf3, 0x27, 0x54, 0xb9, 0x13, 0x7f, 0xa5, 0xdd, 0x35, 0xf3,
+	0xfa, 0xf3, 0xeb, 0xa2, 0x3f, 0xe7, 0x6a, 0x11, 0x51, 0xec, 0xca, 0x67, 0x52, 0x6c, 0x72, 0x17,
+	0x0e, 0x17, 0xdb, 0x07, 0x51, 0x89, 0xcf, 0x42, 0x6c, 0xde, 0x59, 0x83, 0xab, 0xae, 0x15, 0xd1,
+	0x7b, 0x96, 0xd8, 0x87, 0x33, 0xdf, 0x54, 0xae, 0xb5, 0xaa, 0x5f, 0xc2, 0xda, 0x52, 0x6f, 0x9b,
+	0x44, 0x42, 0x71, 0xaf, 0xb1, 0xd7, 0x06, 0x9d, 0x7d, 0xbb, 0xba, 0xe9, 0x8d, 0xf3, 0x96, 0x11,
+	0xc2, 0x96, 0x4e, 0x35, 0x16, 0xcb, 0x7f, 0xcb, 0x65, 0x8e, 0xde, 0x53, 0x04, 0xd6, 0x29, 0x02,
+	0xdf, 0x29, 0x02, 0xaf, 0x19, 0xaa, 0xac, 0x33, 0x54, 0xf9, 0xc8, 0x50, 0xe5, 0xa9, 0xfb, 0x97,
+	0xf6, 0xe5, 0xf7, 0x7c, 0x7a, 0x03, 0x3d, 0xd0, 0x97, 0x1b, 0xfc, 0x0c, 0x00, 0xc0, 0xfe, 0xad,
+	0x12, 0x2c, 0x02, 0x00, 0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -127,6 +227,81 @@ func (m *EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnjailedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnjailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -151,6 +326,35 @@ func (m *EventSlashedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventJailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func (m *EventUnjailedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -243,6 +447,195 @@ func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,11 +11,14 @@ type BTCStakingKeeper interface {
 	GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*bstypes.FinalityProvider, error)
 	HasFinalityProvider(ctx context.Context, fpBTCPK []byte) bool
 	SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
+	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	GetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64) uint64
 	GetVotingPowerTable(ctx context.Context, height uint64) map[string]uint64
 	GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error)
 	GetVotingPowerDistCache(ctx context.Context, height uint64) (*bstypes.VotingPowerDistCache, error)
 	RemoveVotingPowerDistCache(ctx context.Context, height uint64)
+	GetCurrentEpoch(ctx context.Context) uint64
 	GetLastFinalizedEpoch(ctx context.Context) uint64
 }
 
@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +38,10 @@ type IndexedBlock struct {
 	// finalized indicates whether the IndexedBlock is finalised by 2/3
 	// finality providers or not
 	Finalized bool `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// total_voting_power is the total voting power of the finality provider
+	// set at this height. It is set upon finalisation, and allows to check
+	// the completeness of the voting power table in a finality certificate
+	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 }
 
 func (m *IndexedBlock) Reset()         { *m = IndexedBlock{} }
@@ -89,6 +98,13 @@ func (m *IndexedBlock) GetFinalized() bool {
 	return false
 }
 
+func (m *IndexedBlock) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
 // PubRandCommit is a commitment to a series of public randomness
 // currently, the commitment is a root of a Merkle tree that includes
 // a series of public randomness
@@ -100,6 +116,10 @@ type PubRandCommit struct {
 	// commitment is the value of the commitment
 	// currently, it is the root of the merkle tree constructed by the public randomness
 	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// epoch_num is the epoch in which the commitment is made. The commitment
+	// can only be used for verifying finality signatures after this epoch is
+	// finalized by BTC timestamping
+	EpochNum uint64 `protobuf:"varint,4,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
 }
 
 func (m *PubRandCommit) Reset()         { *m = PubRandCommit{} }
@@ -156,6 +176,13 @@ func (m *PubRandCommit) GetCommitment() []byte {
 	return nil
 }
 
+func (m *PubRandCommit) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
 // Evidence is the evidence that a finality provider has signed finality
 // signatures with correct public randomness on two conflicting Babylon headers
 type Evidence struct {
@@ -233,10 +260,322 @@ func (m *Evidence) GetForkAppHash() []byte {
 	return nil
 }
 
+// FinalityProviderSigningInfo is the liveness information of a finality
+// provider, which is used for jailing finality providers that miss too
+// many finality votes
+type FinalityProviderSigningInfo struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// start_height is the height since which the liveness of the finality
+	// provider is tracked
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// missed_blocks_counter is the number of blocks that the finality
+	// provider misses in the sliding window
+	MissedBlocksCounter uint64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
+func (m *FinalityProviderSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderSigningInfo) ProtoMessage()    {}
+func (*FinalityProviderSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
+}
+func (m *FinalityProviderSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderSigningInfo.Merge(m, src)
+}
+func (m *FinalityProviderSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderSigningInfo proto.InternalMessageInfo
+
+func (m *FinalityProviderSigningInfo) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// FinalityCertificate is a self-contained certificate that a block is
+// finalised by BTC stakers. All its Merkle proofs are against the AppHash
+// of the Babylon header at `proof_height`, so that it can be verified
+// without a Babylon node given a trusted AppHash
+type FinalityCertificate struct {
+	// block is the finalised block
+	Block *IndexedBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// block_proof is the Merkle proof of the block in the finality store
+	BlockProof *crypto.ProofOps `protobuf:"bytes,2,opt,name=block_proof,json=blockProof,proto3" json:"block_proof,omitempty"`
+	// proof_height is the height of the Babylon header whose AppHash the
+	// Merkle proofs are against
+	ProofHeight uint64 `protobuf:"varint,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+	// voting_power_table is the voting power table at the block's height, in
+	// ascending order of the finality providers' BTC PKs
+	VotingPowerTable []*FinalityProviderVotingPower `protobuf:"bytes,4,rep,name=voting_power_table,json=votingPowerTable,proto3" json:"voting_power_table,omitempty"`
+	// votes are the finality votes on the block
+	Votes []*FinalityCertificateVote `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes,omitempty"`
+	// total_voting_power is the total voting power in the voting power table
+	TotalVotingPower uint64 `protobuf:"varint,6,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// voted_voting_power is the voting power of the finality providers that
+	// have voted for the block
+	VotedVotingPower uint64 `protobuf:"varint,7,opt,name=voted_voting_power,json=votedVotingPower,proto3" json:"voted_voting_power,omitempty"`
+}
+
+func (m *FinalityCertificate) Reset()         { *m = FinalityCertificate{} }
+func (m *FinalityCertificate) String() string { return proto.CompactTextString(m) }
+func (*FinalityCertificate) ProtoMessage()    {}
+func (*FinalityCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{4}
+}
+func (m *FinalityCertificate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityCertificate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCertificate.Merge(m, src)
+}
+func (m *FinalityCertificate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCertificate proto.InternalMessageInfo
+
+func (m *FinalityCertificate) GetBlock() *IndexedBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetBlockProof() *crypto.ProofOps {
+	if m != nil {
+		return m.BlockProof
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
+func (m *FinalityCertificate) GetVotingPowerTable() []*FinalityProviderVotingPower {
+	if m != nil {
+		return m.VotingPowerTable
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetVotes() []*FinalityCertificateVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *FinalityCertificate) GetVotedVotingPower() uint64 {
+	if m != nil {
+		return m.VotedVotingPower
+	}
+	return 0
+}
+
+// FinalityProviderVotingPower is the voting power of a finality provider at
+// a height, with the Merkle proof of it in the BTC staking store
+type FinalityProviderVotingPower struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// proof is the Merkle proof of the voting power in the BTC staking store
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *FinalityProviderVotingPower) Reset()         { *m = FinalityProviderVotingPower{} }
+func (m *FinalityProviderVotingPower) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderVotingPower) ProtoMessage()    {}
+func (*FinalityProviderVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{5}
+}
+func (m *FinalityProviderVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderVotingPower.Merge(m, src)
+}
+func (m *FinalityProviderVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderVotingPower proto.InternalMessageInfo
+
+func (m *FinalityProviderVotingPower) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *FinalityProviderVotingPower) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// FinalityCertificateVote is a finality vote in a finality certificate, with
+// the public randomness commitment that the vote is verified against
+type FinalityCertificateVote struct {
+	// fp_btc_pk is the BTC PK of the finality provider that casts this vote
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// pub_rand_commit is the public randomness commitment that includes the
+	// public randomness of this vote
+	PubRandCommit *PubRandCommit `protobuf:"bytes,2,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// pub_rand_commit_proof is the Merkle proof of the public randomness
+	// commitment in the finality store
+	PubRandCommitProof *crypto.ProofOps `protobuf:"bytes,3,opt,name=pub_rand_commit_proof,json=pubRandCommitProof,proto3" json:"pub_rand_commit_proof,omitempty"`
+	// pub_rand is the public randomness committed at the block's height
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,4,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// pub_rand_proof is the proof that the public randomness is committed
+	// under the public randomness commitment
+	PubRandProof *crypto.Proof `protobuf:"bytes,5,opt,name=pub_rand_proof,json=pubRandProof,proto3" json:"pub_rand_proof,omitempty"`
+	// finality_sig is the finality signature to the block
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,6,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *FinalityCertificateVote) Reset()         { *m = FinalityCertificateVote{} }
+func (m *FinalityCertificateVote) String() string { return proto.CompactTextString(m) }
+func (*FinalityCertificateVote) ProtoMessage()    {}
+func (*FinalityCertificateVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{6}
+}
+func (m *FinalityCertificateVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityCertificateVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityCertificateVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityCertificateVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCertificateVote.Merge(m, src)
+}
+func (m *FinalityCertificateVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityCertificateVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCertificateVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCertificateVote proto.InternalMessageInfo
+
+func (m *FinalityCertificateVote) GetPubRandCommit() *PubRandCommit {
+	if m != nil {
+		return m.PubRandCommit
+	}
+	return nil
+}
+
+func (m *FinalityCertificateVote) GetPubRandCommitProof() *crypto.ProofOps {
+	if m != nil {
+		return m.PubRandCommitProof
+	}
+	return nil
+}
+
+func (m *FinalityCertificateVote) GetPubRandProof() *crypto.Proof {
+	if m != nil {
+		return m.PubRandProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
+	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*FinalityCertificate)(nil), "babylon.finality.v1.FinalityCertificate")
+	proto.RegisterType((*FinalityProviderVotingPower)(nil), "babylon.finality.v1.FinalityProviderVotingPower")
+	proto.RegisterType((*FinalityCertificateVote)(nil), "babylon.finality.v1.FinalityCertificateVote")
 }
 
 func init() {
@@ -244,37 +583,66 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x56, 0xda, 0xee, 0x35, 0x13, 0x10, 0xa6, 0xa9, 0x20, 0x94, 0x95, 0x9e, 0x7a,
-	0x40, 0xcd, 0xc6, 0x26, 0xc4, 0x95, 0xa0, 0xa1, 0x0d, 0x0e, 0x54, 0x0e, 0x27, 0x2e, 0x91, 0xe3,
-	0xb8, 0x89, 0xd5, 0xc6, 0xb6, 0x12, 0xa7, 0x5a, 0xf9, 0x14, 0x7c, 0x2c, 0x8e, 0x3b, 0xa2, 0x1d,
-	0x26, 0xd4, 0x7e, 0x0f, 0x84, 0xe2, 0xba, 0xe9, 0x76, 0x02, 0xb1, 0x9b, 0xfd, 0xf7, 0xd3, 0xff,
-	0xf7, 0xfe, 0xcf, 0x36, 0x0c, 0x22, 0x1c, 0x2d, 0x66, 0x82, 0x7b, 0x13, 0xc6, 0xf1, 0x8c, 0xa9,
-	0x85, 0x37, 0x3f, 0xae, 0xd7, 0x23, 0x99, 0x0b, 0x25, 0x9c, 0xa7, 0xa6, 0x66, 0x54, 0xeb, 0xf3,
-	0xe3, 0xe7, 0xfb, 0x89, 0x48, 0x84, 0x3e, 0xf7, 0xaa, 0xd5, 0xba, 0x74, 0x10, 0x82, 0x7d, 0xc1,
-	0x63, 0x7a, 0x49, 0x63, 0x7f, 0x26, 0xc8, 0xd4, 0x39, 0x80, 0x56, 0x4a, 0x59, 0x92, 0xaa, 0x9e,
-	0xd5, 0xb7, 0x86, 0x4d, 0x64, 0x76, 0xce, 0x33, 0xe8, 0x60, 0x29, 0xc3, 0x14, 0x17, 0x69, 0xef,
-	0x41, 0xdf, 0x1a, 0xda, 0xa8, 0x8d, 0xa5, 0x3c, 0xc7, 0x45, 0xea, 0xbc, 0x80, 0xdd, 0x35, 0xe7,
-	0x1b, 0x8d, 0x7b, 0x3b, 0x7d, 0x6b, 0xd8, 0x41, 0x5b, 0x61, 0xa0, 0x60, 0x6f, 0x5c, 0x46, 0x08,
-	0xf3, 0xf8, 0xbd, 0xc8, 0x32, 0xa6, 0x9c, 0x97, 0x60, 0x17, 0x0a, 0xe7, 0x2a, 0xbc, 0xc3, 0xe9,
-	0x6a, 0xed, 0x7c, 0x0d, 0xeb, 0x83, 0xcd, 0xcb, 0x2c, 0x94, 0x65, 0x14, 0xe6, 0x98, 0xc7, 0x1a,
-	0xd8, 0x44, 0xc0, 0xcb, 0xcc, 0x58, 0x39, 0x2e, 0x00, 0xd1, 0x76, 0x19, 0xe5, 0x4a, 0x43, 0x6d,
-	0x74, 0x4b, 0x19, 0xfc, 0xde, 0x81, 0xce, 0xd9, 0x9c, 0xc5, 0x94, 0x13, 0xea, 0x20, 0xd8, 0x9d,
-	0xc8, 0x30, 0x52, 0x24, 0x94, 0x53, 0x8d, 0xb3, 0xfd, 0x37, 0xd7, 0x37, 0x87, 0xaf, 0x13, 0xa6,
-	0xd2, 0x32, 0x1a, 0x11, 0x91, 0x79, 0x66, 0x60, 0x24, 0xc5, 0x8c, 0x6f, 0x36, 0x9e, 0x5a, 0x48,
-	0x5a, 0x8c, 0xfc, 0x8b, 0xf1, 0xc9, 0xe9, 0xd1, 0xb8, 0x8c, 0x3e, 0xd1, 0x05, 0x6a, 0x4f, 0xa4,
-	0xaf, 0xc8, 0x78, 0x5a, 0xa5, 0x88, 0xaa, 0x81, 0x6d, 0x52, 0xac, 0x5b, 0xec, 0x6a, 0xcd, 0xa4,
-	0x08, 0xa0, 0x53, 0x27, 0xd0, 0x1d, 0xfa, 0x6f, 0xaf, 0x6f, 0x0e, 0x4f, 0xff, 0x8d, 0x1a, 0x90,
-	0x94, 0x8b, 0x3c, 0x37, 0x79, 0x51, 0x5b, 0x9a, 0xe0, 0xaf, 0xc0, 0x21, 0x98, 0x0b, 0xce, 0x08,
-	0x9e, 0x85, 0xf5, 0x8d, 0x34, 0xf5, 0x00, 0x1e, 0xd7, 0x27, 0xef, 0xcc, 0xd5, 0x0c, 0x60, 0x6f,
-	0x22, 0xf2, 0xe9, 0xb6, 0xf0, 0xa1, 0x2e, 0xec, 0x56, 0xe2, 0xa6, 0x86, 0xc3, 0xc1, 0xd6, 0x71,
-	0xf3, 0x60, 0xc2, 0x82, 0x25, 0xbd, 0xd6, 0x7f, 0x36, 0x7d, 0xf6, 0xf9, 0x4b, 0x10, 0xb0, 0x04,
-	0xed, 0xd7, 0xbe, 0x1f, 0x8c, 0x6d, 0xc0, 0x12, 0x27, 0x86, 0x27, 0xba, 0xa7, 0x3b, 0xa8, 0xf6,
-	0x3d, 0x51, 0x8f, 0x2a, 0xcb, 0x5b, 0x14, 0xff, 0xe3, 0x8f, 0xa5, 0x6b, 0x5d, 0x2d, 0x5d, 0xeb,
-	0xd7, 0xd2, 0xb5, 0xbe, 0xaf, 0xdc, 0xc6, 0xd5, 0xca, 0x6d, 0xfc, 0x5c, 0xb9, 0x8d, 0xaf, 0x47,
-	0x7f, 0x03, 0x5c, 0x6e, 0xbf, 0x96, 0x66, 0x45, 0x2d, 0xfd, 0x55, 0x4e, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0xc1, 0xba, 0xbe, 0x7b, 0x03, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x76, 0xec, 0xcc, 0x3a, 0x34, 0x4c, 0xda, 0x62, 0x12, 0xb0, 0xdd, 0x3d, 0xe5,
+	0x10, 0xad, 0x13, 0xb7, 0x02, 0x0e, 0x08, 0x09, 0x47, 0x85, 0xa6, 0x48, 0xad, 0x35, 0x0e, 0x3d,
+	0x80, 0xc4, 0x68, 0x3f, 0x66, 0x77, 0x87, 0xec, 0xce, 0xac, 0x76, 0x67, 0x4d, 0xcd, 0x7f, 0x40,
+	0x6a, 0x7f, 0x09, 0x3f, 0x83, 0x1e, 0x7b, 0x44, 0x3d, 0x04, 0x94, 0xfc, 0x0c, 0x24, 0x84, 0x76,
+	0x66, 0xd7, 0x5e, 0xb7, 0x09, 0xad, 0x20, 0xb9, 0xed, 0xfb, 0x31, 0xcf, 0x3c, 0xef, 0x87, 0x9f,
+	0x31, 0x30, 0x6c, 0xcb, 0x9e, 0x85, 0x9c, 0x0d, 0x3c, 0xca, 0xac, 0x90, 0x8a, 0xd9, 0x60, 0x7a,
+	0x30, 0xff, 0x36, 0xe3, 0x84, 0x0b, 0x0e, 0xb7, 0x8a, 0x1c, 0x73, 0xee, 0x9f, 0x1e, 0x6c, 0xdf,
+	0xf4, 0xb9, 0xcf, 0x65, 0x7c, 0x90, 0x7f, 0xa9, 0xd4, 0xed, 0x9e, 0xcf, 0xb9, 0x1f, 0x92, 0x81,
+	0xb4, 0xec, 0xcc, 0x1b, 0x08, 0x1a, 0x91, 0x54, 0x58, 0x51, 0x5c, 0x24, 0x7c, 0x2c, 0x08, 0x73,
+	0x49, 0x12, 0x51, 0x26, 0x06, 0x4e, 0x32, 0x8b, 0x05, 0xcf, 0x73, 0xb9, 0xa7, 0xc2, 0xc6, 0x2f,
+	0x1a, 0x68, 0x1f, 0x31, 0x97, 0x3c, 0x25, 0xee, 0x28, 0xe4, 0xce, 0x09, 0xbc, 0x0d, 0xd6, 0x02,
+	0x42, 0xfd, 0x40, 0x74, 0xb4, 0xbe, 0xb6, 0x5b, 0x47, 0x85, 0x05, 0x3f, 0x04, 0x2d, 0x2b, 0x8e,
+	0x71, 0x60, 0xa5, 0x41, 0x67, 0xb5, 0xaf, 0xed, 0xb6, 0x51, 0xd3, 0x8a, 0xe3, 0x07, 0x56, 0x1a,
+	0xc0, 0x8f, 0xc0, 0xba, 0x22, 0xfa, 0x33, 0x71, 0x3b, 0xb5, 0xbe, 0xb6, 0xdb, 0x42, 0x0b, 0x07,
+	0xdc, 0x03, 0x50, 0x70, 0x61, 0x85, 0x78, 0xca, 0x05, 0x65, 0x3e, 0x8e, 0xf9, 0x4f, 0x24, 0xe9,
+	0xd4, 0x25, 0xf8, 0xa6, 0x8c, 0x3c, 0x91, 0x81, 0x71, 0xee, 0x37, 0x9e, 0x6b, 0x60, 0x63, 0x9c,
+	0xd9, 0xc8, 0x62, 0xee, 0x21, 0x8f, 0x22, 0x2a, 0xe0, 0x1d, 0xd0, 0x4e, 0x85, 0x95, 0x08, 0xbc,
+	0x44, 0x4b, 0x97, 0xbe, 0x07, 0x8a, 0x5b, 0x1f, 0xb4, 0x59, 0x16, 0xe1, 0x38, 0xb3, 0x71, 0x62,
+	0x31, 0x57, 0xf2, 0xab, 0x23, 0xc0, 0xb2, 0xa8, 0x80, 0x82, 0x5d, 0x00, 0x1c, 0x09, 0x17, 0x11,
+	0x26, 0x24, 0xc7, 0x36, 0xaa, 0x78, 0xe0, 0x0e, 0x58, 0x27, 0x31, 0x77, 0x02, 0xcc, 0xb2, 0xa8,
+	0xe0, 0xd6, 0x92, 0x8e, 0x47, 0x59, 0x64, 0xfc, 0x5d, 0x03, 0xad, 0xfb, 0x53, 0xea, 0x12, 0xe6,
+	0x10, 0x88, 0xc0, 0xba, 0x17, 0x63, 0x5b, 0x38, 0x38, 0x3e, 0x91, 0x5c, 0xda, 0xa3, 0x4f, 0x5e,
+	0x9d, 0xf6, 0x86, 0x3e, 0x15, 0x41, 0x66, 0x9b, 0x0e, 0x8f, 0x06, 0xc5, 0xf4, 0x9c, 0xc0, 0xa2,
+	0xac, 0x34, 0x06, 0x62, 0x16, 0x93, 0xd4, 0x1c, 0x1d, 0x8d, 0xef, 0xde, 0xdb, 0x1f, 0x67, 0xf6,
+	0x37, 0x64, 0x86, 0x9a, 0x5e, 0x3c, 0x12, 0xce, 0xf8, 0x24, 0x2f, 0xd1, 0xce, 0x9b, 0x5f, 0x96,
+	0xa8, 0xf8, 0xeb, 0xd2, 0x57, 0x94, 0x38, 0x01, 0xad, 0x79, 0x79, 0x92, 0xfe, 0xe8, 0xb3, 0x57,
+	0xa7, 0xbd, 0x7b, 0xef, 0x76, 0xeb, 0xc4, 0x09, 0x18, 0x4f, 0x92, 0xa2, 0x19, 0xa8, 0x19, 0x17,
+	0x5d, 0xd9, 0x03, 0xd0, 0xb1, 0x18, 0x67, 0xd4, 0xb1, 0x42, 0x3c, 0x9f, 0x6e, 0x5d, 0x76, 0x67,
+	0x73, 0x1e, 0xf9, 0xb2, 0x18, 0xb3, 0x01, 0x36, 0x3c, 0x9e, 0x9c, 0x2c, 0x12, 0x1b, 0x32, 0x51,
+	0xcf, 0x9d, 0x65, 0x0e, 0x03, 0xb7, 0x17, 0x88, 0xe5, 0xf6, 0xe2, 0x94, 0xfa, 0x9d, 0xb5, 0xff,
+	0x48, 0xfa, 0xfe, 0xe3, 0xe3, 0xc9, 0x84, 0xfa, 0xe8, 0xe6, 0x1c, 0xf7, 0xab, 0x02, 0x76, 0x42,
+	0x7d, 0xe8, 0x82, 0xf7, 0x25, 0xa7, 0xa5, 0xab, 0x9a, 0xff, 0xf3, 0xaa, 0x1b, 0x39, 0x64, 0xe5,
+	0x16, 0xe3, 0xf9, 0x2a, 0xd8, 0x29, 0xed, 0x71, 0xc2, 0xf3, 0x55, 0x48, 0x26, 0xd4, 0x67, 0x94,
+	0xf9, 0x47, 0xcc, 0xe3, 0xd7, 0xb5, 0x13, 0x4b, 0x6b, 0xbf, 0xfa, 0xe6, 0xda, 0x0f, 0xc1, 0xad,
+	0x88, 0xa6, 0x29, 0x71, 0xb1, 0xdc, 0x94, 0x14, 0x3b, 0x3c, 0x63, 0x82, 0x24, 0x72, 0x41, 0xea,
+	0x68, 0x4b, 0x05, 0xe5, 0xcf, 0x3a, 0x3d, 0x54, 0x21, 0xf8, 0x35, 0x68, 0xff, 0x68, 0xd1, 0x90,
+	0xb8, 0x38, 0x63, 0x82, 0x86, 0x72, 0xd8, 0xfa, 0x70, 0xdb, 0x54, 0x32, 0x62, 0x96, 0x32, 0x62,
+	0x1e, 0x97, 0x32, 0x32, 0x6a, 0xbd, 0x38, 0xed, 0xad, 0x3c, 0xfb, 0xa3, 0xa7, 0x21, 0x5d, 0x9d,
+	0xfc, 0x36, 0x3f, 0x68, 0xfc, 0x5a, 0x03, 0x5b, 0x65, 0x4f, 0x0e, 0x49, 0x22, 0xa8, 0x47, 0x1d,
+	0x4b, 0x10, 0xf8, 0x29, 0x68, 0x48, 0x36, 0xb2, 0x0f, 0xfa, 0xf0, 0x8e, 0x79, 0x81, 0x96, 0x99,
+	0x55, 0xc5, 0x41, 0x2a, 0x1f, 0x7e, 0x0e, 0xd4, 0xc2, 0x63, 0x29, 0x4f, 0xb2, 0x5e, 0x7d, 0xb8,
+	0x63, 0x2e, 0xe4, 0xcb, 0x54, 0xf2, 0x65, 0x8e, 0xf3, 0xf8, 0xe3, 0x38, 0x45, 0x40, 0xe6, 0x4b,
+	0x33, 0x6f, 0x97, 0x3c, 0x57, 0xb6, 0x4b, 0xb5, 0x40, 0x97, 0xbe, 0xa2, 0x5d, 0x3f, 0x00, 0x58,
+	0x95, 0x20, 0x2c, 0x2c, 0x3b, 0x24, 0x9d, 0x7a, 0xbf, 0xb6, 0xab, 0x0f, 0xf7, 0x2f, 0xa4, 0xf9,
+	0xfa, 0xcc, 0x2b, 0x42, 0x85, 0x36, 0xa7, 0x0b, 0xe3, 0x38, 0x47, 0x82, 0x23, 0xd0, 0x98, 0x72,
+	0x41, 0xd2, 0x4e, 0x43, 0x42, 0xee, 0xfd, 0x2b, 0x64, 0xa5, 0x65, 0x4f, 0xb8, 0x20, 0x48, 0x1d,
+	0xbd, 0x44, 0x2c, 0xd7, 0x2e, 0x16, 0xcb, 0x3c, 0x3b, 0x3f, 0xe6, 0x2e, 0x67, 0x37, 0x55, 0xb6,
+	0x8c, 0x54, 0xa5, 0xf5, 0x37, 0xed, 0xcd, 0x2d, 0xae, 0xa2, 0x5d, 0xd3, 0x16, 0x2f, 0x71, 0x2b,
+	0xb6, 0xb8, 0xd2, 0x3b, 0x78, 0x00, 0x1a, 0x6a, 0xe2, 0xb5, 0xb7, 0x4f, 0x5c, 0x65, 0x1a, 0x7f,
+	0xd5, 0xc0, 0x07, 0x97, 0x34, 0xf2, 0x5a, 0xaa, 0x78, 0x08, 0x6e, 0x94, 0xe2, 0x8b, 0xd5, 0xa3,
+	0x51, 0xac, 0xa7, 0x71, 0xe1, 0x8c, 0x97, 0xde, 0x2f, 0xb4, 0x11, 0x57, 0x4d, 0xf8, 0x08, 0xdc,
+	0x7a, 0x0d, 0x0b, 0xbf, 0x73, 0xf9, 0x70, 0x09, 0x4a, 0x2d, 0x7e, 0xf5, 0x61, 0xa8, 0x5f, 0xd5,
+	0xc3, 0xf0, 0x05, 0x78, 0x6f, 0x4e, 0x52, 0xb1, 0x6b, 0x48, 0x76, 0x9d, 0xcb, 0xd8, 0xa1, 0x76,
+	0x71, 0x54, 0x91, 0xfa, 0x1e, 0xb4, 0xaf, 0x54, 0xfc, 0x75, 0x6f, 0xa1, 0xc6, 0xa3, 0x87, 0x2f,
+	0xce, 0xba, 0xda, 0xcb, 0xb3, 0xae, 0xf6, 0xe7, 0x59, 0x57, 0x7b, 0x76, 0xde, 0x5d, 0x79, 0x79,
+	0xde, 0x5d, 0xf9, 0xfd, 0xbc, 0xbb, 0xf2, 0xdd, 0xfe, 0xdb, 0xc0, 0x9f, 0x2e, 0xfe, 0x75, 0xc9,
+	0x7b, 0xec, 0x35, 0x29, 0x78, 0x77, 0xff, 0x19, 0x00, 0x67, 0x73, 0xaf, 0x6b, 0x96, 0x09, 0x00,
+	0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -297,6 +665,11 @@ func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Finalized {
 		i--
 		if m.Finalized {
@@ -342,6 +715,11 @@ func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Commitment) > 0 {
 		i -= len(m.Commitment)
 		copy(dAtA[i:], m.Commitment)
@@ -452,51 +830,347 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFinality(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FinalityProviderSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *IndexedBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FinalityProviderSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovFinality(uint64(m.Height))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	i -= n1
+	i = encodeVarintFinality(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Finalized {
-		n += 2
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PubRandCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityCertificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityCertificate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityCertificate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovFinality(uint64(m.StartHeight))
+	if m.VotedVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedVotingPower))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.NumPubRand != 0 {
-		n += 1 + sovFinality(uint64(m.NumPubRand))
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VotingPowerTable) > 0 {
+		for iNdEx := len(m.VotingPowerTable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerTable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockProof != nil {
+		{
+			size, err := m.BlockProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityCertificateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityCertificateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityCertificateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PubRandProof != nil {
+		{
+			size, err := m.PubRandProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PubRandCommitProof != nil {
+		{
+			size, err := m.PubRandCommitProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PubRandCommit != nil {
+		{
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFinality(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *PubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovFinality(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovFinality(uint64(m.EpochNum))
 	}
 	return n
 }
@@ -534,16 +1208,844 @@ func (m *Evidence) Size() (n int) {
 		l = m.ForkFinalitySig.Size()
 		n += 1 + l + sovFinality(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *FinalityProviderSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	return n
+}
+
+func (m *FinalityCertificate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.BlockProof != nil {
+		l = m.BlockProof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovFinality(uint64(m.ProofHeight))
+	}
+	if len(m.VotingPowerTable) > 0 {
+		for _, e := range m.VotingPowerTable {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+	}
+	if m.VotedVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotedVotingPower))
+	}
+	return n
+}
+
+func (m *FinalityProviderVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityCertificateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandCommitProof != nil {
+		l = m.PubRandCommitProof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandProof != nil {
+		l = m.PubRandProof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func sovFinality(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFinality(x uint64) (n int) {
+	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFinality(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFinality(x uint64) (n int) {
-	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+func (m *FinalityCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -566,17 +2068,17 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -586,16 +2088,33 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -605,31 +2124,33 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			if m.BlockProof == nil {
+				m.BlockProof = &crypto.ProofOps{}
+			}
+			if err := m.BlockProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var v int
+			m.ProofHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -639,12 +2160,117 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerTable = append(m.VotingPowerTable, &FinalityProviderVotingPower{})
+			if err := m.VotingPowerTable[len(m.VotingPowerTable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &FinalityCertificateVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedVotingPower", wireType)
+			}
+			m.VotedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -666,7 +2292,7 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderVotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -689,17 +2315,17 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderVotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			m.StartHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -709,16 +2335,32 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			m.NumPubRand = 0
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -728,16 +2370,16 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -747,24 +2389,26 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -788,7 +2432,7 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *FinalityCertificateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,10 +2455,10 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityCertificateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityCertificateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -853,29 +2497,10 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -885,32 +2510,33 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &PubRandCommit{}
+			}
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommitProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -920,29 +2546,31 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
+			if m.PubRandCommitProof == nil {
+				m.PubRandCommitProof = &crypto.ProofOps{}
+			}
+			if err := m.PubRandCommitProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -969,16 +2597,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -988,30 +2617,31 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PubRandProof == nil {
+				m.PubRandProof = &crypto.Proof{}
+			}
+			if err := m.PubRandProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1039,8 +2669,8 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -11,5 +13,29 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// TODO: add validate to IndexedBlocks, Evidences, VoteSigs, PublicRandomness
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, signInfo := range gs.SigningInfos {
+		if signInfo == nil {
+			return fmt.Errorf("null finality provider signing info")
+		}
+		if err := signInfo.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, missedBlocks := range gs.MissedBlocks {
+		if missedBlocks == nil || missedBlocks.FpBtcPk == nil {
+			return fmt.Errorf("null finality provider missed blocks")
+		}
+		for _, index := range missedBlocks.Indexes {
+			if index >= gs.Params.SignedBlocksWindow {
+				return fmt.Errorf("missed block index %d of finality provider %s exceeds the signed blocks window %d",
+					index, missedBlocks.FpBtcPk.MarshalHex(), gs.Params.SignedBlocksWindow)
+			}
+		}
+	}
+	return nil
 }
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -38,6 +39,16 @@ type GenesisState struct {
 	PublicRandomness []*PublicRandomness `protobuf:"bytes,5,rep,name=public_randomness,json=publicRandomness,proto3" json:"public_randomness,omitempty"`
 	// pub_rand_commit contains all the public randomness commitment ever commited from the finality providers.
 	PubRandCommit []*PubRandCommitWithPK `protobuf:"bytes,6,rep,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// signing_infos contains the liveness information of all finality providers.
+	SigningInfos []*FinalityProviderSigningInfo `protobuf:"bytes,7,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+	// missed_blocks contains the missed blocks of all finality providers in the sliding window.
+	MissedBlocks []*FinalityProviderMissedBlocks `protobuf:"bytes,8,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// next_height_to_finalize is the next height to be finalized.
+	NextHeightToFinalize uint64 `protobuf:"varint,9,opt,name=next_height_to_finalize,json=nextHeightToFinalize,proto3" json:"next_height_to_finalize,omitempty"`
+	// pruning_horizon is the lowest height whose finality votes, revealed public
+	// randomness and indexed block are retained. The data of all heights below
+	// it has been pruned.
+	PruningHorizon uint64 `protobuf:"varint,10,opt,name=pruning_horizon,json=pruningHorizon,proto3" json:"pruning_horizon,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +126,34 @@ func (m *GenesisState) GetPubRandCommit() []*PubRandCommitWithPK {
 	return nil
 }
 
+func (m *GenesisState) GetSigningInfos() []*FinalityProviderSigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []*FinalityProviderMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextHeightToFinalize() uint64 {
+	if m != nil {
+		return m.NextHeightToFinalize
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPruningHorizon() uint64 {
+	if m != nil {
+		return m.PruningHorizon
+	}
+	return 0
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote signature.
 type VoteSig struct {
@@ -175,6 +214,9 @@ type PublicRandomness struct {
 	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
 	// pub_rand is the public randomness the finality provider has committed to.
 	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,3,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the public randomness is committed under the
+	// public randomness commitment, if it is revealed by a finality vote.
+	Proof *crypto.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
 func (m *PublicRandomness) Reset()         { *m = PublicRandomness{} }
@@ -217,6 +259,13 @@ func (m *PublicRandomness) GetBlockHeight() uint64 {
 	return 0
 }
 
+func (m *PublicRandomness) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 // PubRandCommitWithPK is the public randomness commitment with the finality provider's BTC public key
 type PubRandCommitWithPK struct {
 	// fp_btc_pk is the BTC PK of the finality provider that commits the public randomness
@@ -265,51 +314,113 @@ func (m *PubRandCommitWithPK) GetPubRandCommit() *PubRandCommit {
 	return nil
 }
 
+// FinalityProviderMissedBlocks contains the missed blocks of a finality
+// provider in the sliding window
+type FinalityProviderMissedBlocks struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// indexes are the indexes of the missed blocks in the sliding window
+	Indexes []uint64 `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *FinalityProviderMissedBlocks) Reset()         { *m = FinalityProviderMissedBlocks{} }
+func (m *FinalityProviderMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderMissedBlocks) ProtoMessage()    {}
+func (*FinalityProviderMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52dc577f74d797d1, []int{4}
+}
+func (m *FinalityProviderMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderMissedBlocks.Merge(m, src)
+}
+func (m *FinalityProviderMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderMissedBlocks proto.InternalMessageInfo
+
+func (m *FinalityProviderMissedBlocks) GetIndexes() []uint64 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.finality.v1.GenesisState")
 	proto.RegisterType((*VoteSig)(nil), "babylon.finality.v1.VoteSig")
 	proto.RegisterType((*PublicRandomness)(nil), "babylon.finality.v1.PublicRandomness")
 	proto.RegisterType((*PubRandCommitWithPK)(nil), "babylon.finality.v1.PubRandCommitWithPK")
+	proto.RegisterType((*FinalityProviderMissedBlocks)(nil), "babylon.finality.v1.FinalityProviderMissedBlocks")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0xb5, 0xb4, 0xab, 0xdb, 0xc1, 0xf0, 0x38, 0x44, 0x05, 0xd2, 0x36, 0x12, 0x52,
-	0x4f, 0xc9, 0xd6, 0x4d, 0x88, 0x89, 0x5b, 0xd0, 0xc4, 0x5e, 0x0e, 0x44, 0x0e, 0x02, 0x09, 0x0e,
-	0x51, 0x92, 0xba, 0x89, 0xd5, 0x36, 0xb6, 0x6a, 0xb7, 0x5a, 0xbf, 0x05, 0x5f, 0x85, 0x03, 0xdf,
-	0x61, 0xc7, 0x1d, 0xd1, 0x24, 0x2a, 0xd4, 0x7e, 0x11, 0x54, 0x37, 0xdd, 0x46, 0x09, 0x1a, 0x42,
-	0x48, 0xdc, 0xec, 0x27, 0xff, 0xe7, 0xa7, 0xff, 0xf3, 0x12, 0xc3, 0x66, 0xe0, 0x07, 0x93, 0x3e,
-	0x4b, 0xac, 0x2e, 0x4d, 0xfc, 0x3e, 0x95, 0x13, 0x6b, 0xbc, 0x67, 0x45, 0x24, 0x21, 0x82, 0x0a,
-	0x93, 0x0f, 0x99, 0x64, 0x68, 0x27, 0x95, 0x98, 0x2b, 0x89, 0x39, 0xde, 0xab, 0x3d, 0x8a, 0x58,
-	0xc4, 0xd4, 0x77, 0x6b, 0x71, 0x5a, 0x4a, 0x6b, 0x8d, 0x2c, 0x1a, 0xf7, 0x87, 0xfe, 0x20, 0x85,
-	0xd5, 0x8c, 0x2c, 0xc5, 0x35, 0x58, 0x69, 0x8c, 0xcf, 0x79, 0x58, 0x7d, 0xbd, 0xb4, 0xe0, 0x4a,
-	0x5f, 0x12, 0x74, 0x08, 0x8b, 0x4b, 0x88, 0x06, 0x1a, 0xa0, 0x55, 0x69, 0x3f, 0x36, 0x33, 0x2c,
-	0x99, 0x8e, 0x92, 0xd8, 0x85, 0x8b, 0x69, 0x3d, 0x87, 0xd3, 0x04, 0x74, 0x0c, 0xef, 0xd3, 0xa4,
-	0x43, 0xce, 0x49, 0xc7, 0x0b, 0xfa, 0x2c, 0xec, 0x09, 0x6d, 0xa3, 0x91, 0x6f, 0x55, 0xda, 0xcd,
-	0x4c, 0xc4, 0xc9, 0x52, 0x6a, 0x2f, 0x94, 0x78, 0x8b, 0xde, 0xba, 0x09, 0xf4, 0x12, 0x96, 0xc9,
-	0x98, 0x76, 0x48, 0x12, 0x12, 0xa1, 0xe5, 0x15, 0xe4, 0x69, 0x26, 0xe4, 0x28, 0x55, 0xe1, 0x1b,
-	0x3d, 0x3a, 0x84, 0xe5, 0x31, 0x93, 0xc4, 0x13, 0x34, 0x12, 0x5a, 0x41, 0x25, 0x3f, 0xc9, 0x4c,
-	0x7e, 0xc7, 0x24, 0x71, 0x69, 0x84, 0x37, 0xc7, 0xcb, 0x83, 0x40, 0x18, 0x3e, 0xe4, 0xa3, 0xa0,
-	0x4f, 0x43, 0x6f, 0xe8, 0x27, 0x1d, 0x36, 0x48, 0x88, 0x10, 0xda, 0x3d, 0x85, 0x78, 0x96, 0xdd,
-	0x07, 0xa5, 0xc6, 0xd7, 0x62, 0xbc, 0xcd, 0xd7, 0x22, 0xc8, 0x81, 0x0f, 0xf8, 0x28, 0x50, 0x40,
-	0x2f, 0x64, 0x83, 0x01, 0x95, 0x5a, 0x51, 0x11, 0x5b, 0xbf, 0x23, 0x2e, 0x92, 0x5f, 0x29, 0xe5,
-	0x7b, 0x2a, 0x63, 0xe7, 0x0c, 0x6f, 0xf1, 0xdb, 0x41, 0xe3, 0x1b, 0x80, 0xa5, 0xd4, 0x3b, 0x6a,
-	0xc2, 0xaa, 0xea, 0xb5, 0x17, 0x13, 0x1a, 0xc5, 0x52, 0x0d, 0xad, 0x80, 0x2b, 0x2a, 0x76, 0xac,
-	0x42, 0x08, 0xc3, 0x72, 0x97, 0x7b, 0x81, 0x0c, 0x3d, 0xde, 0xd3, 0x36, 0x1a, 0xa0, 0x55, 0xb5,
-	0x9f, 0x5f, 0x4d, 0xeb, 0xed, 0x88, 0xca, 0x78, 0x14, 0x98, 0x21, 0x1b, 0x58, 0xa9, 0x91, 0x30,
-	0xf6, 0x69, 0xb2, 0xba, 0x58, 0x72, 0xc2, 0x89, 0x30, 0xed, 0x13, 0x67, 0xff, 0x60, 0xd7, 0x19,
-	0x05, 0x67, 0x64, 0x82, 0x4b, 0x5d, 0x6e, 0xcb, 0xd0, 0xe9, 0xa1, 0x8f, 0xb0, 0xba, 0x32, 0xbd,
-	0xe8, 0xb3, 0x96, 0x57, 0xd8, 0x17, 0x57, 0xd3, 0xfa, 0xc1, 0x9f, 0x61, 0xdd, 0x30, 0x4e, 0xd8,
-	0x70, 0x78, 0xf4, 0xe6, 0xad, 0xbb, 0x18, 0x41, 0x65, 0x45, 0x73, 0x69, 0x64, 0x4c, 0x01, 0xdc,
-	0x5e, 0x6f, 0xec, 0xff, 0x2a, 0xd4, 0x85, 0x9b, 0xab, 0xe9, 0xfd, 0x75, 0x91, 0xe9, 0x48, 0x71,
-	0x29, 0x1d, 0xa3, 0xf1, 0x05, 0xc0, 0x9d, 0x8c, 0x39, 0xff, 0x5c, 0x00, 0xf8, 0x37, 0x05, 0x9c,
-	0xfe, 0xba, 0x7e, 0x1b, 0xea, 0xc7, 0x36, 0xee, 0x5e, 0xbf, 0xb5, 0xc5, 0xb3, 0x4f, 0x2f, 0x66,
-	0x3a, 0xb8, 0x9c, 0xe9, 0xe0, 0xfb, 0x4c, 0x07, 0x9f, 0xe6, 0x7a, 0xee, 0x72, 0xae, 0xe7, 0xbe,
-	0xce, 0xf5, 0xdc, 0x87, 0xdd, 0xbb, 0x2c, 0x9e, 0xdf, 0x3c, 0x42, 0xca, 0x6d, 0x50, 0x54, 0xef,
-	0xcf, 0xfe, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x48, 0xf9, 0xea, 0x15, 0x05, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8f, 0x43, 0x20, 0x64, 0x93, 0x00, 0x6f, 0x41, 0x7a, 0x16, 0x0f, 0x42, 0x88, 0xf4, 0xd4,
+	0x9c, 0x6c, 0xfe, 0xb5, 0x2a, 0xea, 0x2d, 0x15, 0x94, 0x3f, 0xaa, 0x6a, 0x6d, 0x28, 0x95, 0xda,
+	0x83, 0x15, 0x3b, 0x1b, 0x7b, 0x45, 0xbc, 0x6b, 0x79, 0x37, 0x11, 0xe1, 0x33, 0x54, 0x55, 0xbf,
+	0x4c, 0xef, 0x3d, 0x72, 0xe4, 0x58, 0x21, 0x15, 0x55, 0xf0, 0x45, 0x2a, 0xef, 0x3a, 0x84, 0x52,
+	0xb7, 0xa0, 0xaa, 0x55, 0x6f, 0x3b, 0x33, 0xbf, 0xf9, 0xcd, 0xcc, 0xee, 0xcc, 0x2c, 0x58, 0x76,
+	0x5a, 0xce, 0xa0, 0xcb, 0xa8, 0xd9, 0x21, 0xb4, 0xd5, 0x25, 0x62, 0x60, 0xf6, 0x57, 0x4d, 0x0f,
+	0x53, 0xcc, 0x09, 0x37, 0xc2, 0x88, 0x09, 0x06, 0x67, 0x13, 0x88, 0x31, 0x84, 0x18, 0xfd, 0xd5,
+	0xf9, 0x39, 0x8f, 0x79, 0x4c, 0xda, 0xcd, 0xf8, 0xa4, 0xa0, 0xf3, 0xd5, 0x34, 0xb6, 0xb0, 0x15,
+	0xb5, 0x82, 0x84, 0x6c, 0xbe, 0x96, 0x86, 0xb8, 0x26, 0x56, 0x98, 0x45, 0x81, 0x69, 0x1b, 0x47,
+	0x01, 0xa1, 0xc2, 0x74, 0xa3, 0x41, 0x28, 0x98, 0x19, 0x46, 0x8c, 0x75, 0x94, 0xb9, 0xf6, 0x71,
+	0x1c, 0x94, 0x9e, 0xa9, 0x0c, 0x9b, 0xa2, 0x25, 0x30, 0xdc, 0x04, 0x13, 0x2a, 0x86, 0xae, 0x55,
+	0xb5, 0x7a, 0x71, 0xed, 0x3f, 0x23, 0x25, 0x63, 0xc3, 0x92, 0x90, 0x46, 0xee, 0xf4, 0x62, 0x29,
+	0x83, 0x12, 0x07, 0xb8, 0x03, 0xa6, 0x08, 0x6d, 0xe3, 0x63, 0xdc, 0xb6, 0x9d, 0x2e, 0x73, 0x8f,
+	0xb8, 0x9e, 0xad, 0x8e, 0xd5, 0x8b, 0x6b, 0xcb, 0xa9, 0x14, 0xbb, 0x0a, 0xda, 0x88, 0x91, 0xa8,
+	0x4c, 0x6e, 0x48, 0x1c, 0x3e, 0x01, 0x05, 0xdc, 0x27, 0x6d, 0x4c, 0x5d, 0xcc, 0xf5, 0x31, 0x49,
+	0xb2, 0x98, 0x4a, 0xb2, 0x95, 0xa0, 0xd0, 0x08, 0x0f, 0x37, 0x41, 0xa1, 0xcf, 0x04, 0xb6, 0x39,
+	0xf1, 0xb8, 0x9e, 0x93, 0xce, 0x0b, 0xa9, 0xce, 0x87, 0x4c, 0xe0, 0x26, 0xf1, 0xd0, 0x64, 0x5f,
+	0x1d, 0x38, 0x44, 0xe0, 0x9f, 0xb0, 0xe7, 0x74, 0x89, 0x6b, 0x47, 0x2d, 0xda, 0x66, 0x01, 0xc5,
+	0x9c, 0xeb, 0xe3, 0x92, 0xe2, 0xff, 0xf4, 0x7b, 0x90, 0x68, 0x74, 0x0d, 0x46, 0x33, 0xe1, 0x2d,
+	0x0d, 0xb4, 0xc0, 0x74, 0xd8, 0x73, 0x24, 0xa1, 0xed, 0xb2, 0x20, 0x20, 0x42, 0x9f, 0x90, 0x8c,
+	0xf5, 0x1f, 0x31, 0xc6, 0xce, 0x4f, 0x25, 0xf2, 0x15, 0x11, 0xbe, 0xb5, 0x8f, 0xca, 0xe1, 0x4d,
+	0x25, 0x7c, 0x09, 0xca, 0x9c, 0x78, 0x94, 0x50, 0xcf, 0x26, 0xb4, 0xc3, 0xb8, 0x9e, 0x97, 0x7c,
+	0x2b, 0xa9, 0x7c, 0xdb, 0xc9, 0xd9, 0x8a, 0x58, 0x7c, 0x43, 0x51, 0x53, 0x79, 0xee, 0xd2, 0x0e,
+	0x43, 0x25, 0x3e, 0x12, 0x38, 0x3c, 0x04, 0xe5, 0x80, 0x70, 0x3e, 0x7a, 0xbd, 0x49, 0x49, 0xbb,
+	0x7a, 0x2f, 0xda, 0xe7, 0xd2, 0x53, 0x3d, 0x1f, 0x2a, 0x05, 0x37, 0x24, 0xf8, 0x10, 0xfc, 0x4b,
+	0xf1, 0xb1, 0xb0, 0x7d, 0x4c, 0x3c, 0x5f, 0xd8, 0x82, 0xd9, 0x8a, 0xe8, 0x04, 0xeb, 0x85, 0xaa,
+	0x56, 0xcf, 0xa1, 0xb9, 0xd8, 0xbc, 0x23, 0xad, 0x07, 0x6c, 0x3b, 0xb1, 0xc1, 0x07, 0x60, 0x3a,
+	0x8c, 0x7a, 0xb2, 0x4a, 0x9f, 0x45, 0xe4, 0x84, 0x51, 0x1d, 0x48, 0xf8, 0x54, 0xa2, 0xde, 0x51,
+	0xda, 0xda, 0x67, 0x0d, 0xe4, 0x93, 0xa7, 0x84, 0xcb, 0xa0, 0x24, 0x93, 0x4f, 0x82, 0xc9, 0x1e,
+	0xce, 0xa1, 0xa2, 0xd4, 0xa9, 0x08, 0x10, 0x81, 0x42, 0x27, 0xb4, 0x1d, 0xe1, 0xda, 0xe1, 0x91,
+	0x9e, 0xad, 0x6a, 0xf5, 0x52, 0xe3, 0xd1, 0xf9, 0xc5, 0xd2, 0x9a, 0x47, 0x84, 0xdf, 0x73, 0x0c,
+	0x97, 0x05, 0x66, 0x52, 0xb0, 0xeb, 0xb7, 0x08, 0x1d, 0x0a, 0xa6, 0x18, 0x84, 0x98, 0x1b, 0x8d,
+	0x5d, 0x6b, 0x7d, 0x63, 0xc5, 0xea, 0x39, 0xfb, 0x78, 0x80, 0xf2, 0x9d, 0xb0, 0x21, 0x5c, 0xeb,
+	0x08, 0xbe, 0x01, 0xa5, 0xe1, 0xe5, 0xc4, 0x6d, 0xa7, 0x8f, 0x49, 0xda, 0xc7, 0xe7, 0x17, 0x4b,
+	0x1b, 0xf7, 0xa3, 0x6d, 0xba, 0x3e, 0x65, 0x51, 0xb4, 0xf5, 0xe2, 0xa0, 0x19, 0x77, 0x64, 0x71,
+	0xc8, 0xd6, 0x24, 0x5e, 0xed, 0x5d, 0x16, 0xcc, 0xdc, 0xee, 0xb3, 0xbf, 0x55, 0x68, 0x13, 0x4c,
+	0x0e, 0x9b, 0xf9, 0x97, 0x8b, 0x4c, 0x3a, 0x1c, 0xe5, 0x93, 0xae, 0x86, 0x06, 0x18, 0x97, 0x2b,
+	0x49, 0xcf, 0xc9, 0x8d, 0xa3, 0x1b, 0xa3, 0x95, 0x65, 0xa8, 0x95, 0x65, 0x58, 0xb1, 0x1d, 0x29,
+	0x58, 0xed, 0x83, 0x06, 0x66, 0x53, 0xc6, 0xe4, 0xdb, 0x82, 0xb5, 0xdf, 0x53, 0xf0, 0xde, 0xf7,
+	0xd3, 0x9b, 0x95, 0x59, 0xd6, 0xee, 0x9e, 0xde, 0x5b, 0x73, 0x5b, 0x7b, 0xab, 0x81, 0x85, 0x9f,
+	0xcd, 0xcd, 0x1f, 0x29, 0x40, 0x07, 0x79, 0xb5, 0x5b, 0xd5, 0x36, 0xce, 0xa1, 0xa1, 0xd8, 0xd8,
+	0x3b, 0xbd, 0xac, 0x68, 0x67, 0x97, 0x15, 0xed, 0xcb, 0x65, 0x45, 0x7b, 0x7f, 0x55, 0xc9, 0x9c,
+	0x5d, 0x55, 0x32, 0x9f, 0xae, 0x2a, 0x99, 0xd7, 0x2b, 0x77, 0x05, 0x3c, 0x1e, 0xfd, 0x38, 0x32,
+	0xb6, 0x33, 0x21, 0x7f, 0x93, 0xf5, 0xaf, 0x03, 0x00, 0x3d, 0x15, 0xe9, 0xc9, 0x02, 0x07, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -332,6 +443,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PruningHorizon != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PruningHorizon))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NextHeightToFinalize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHeightToFinalize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.PubRandCommit) > 0 {
 		for iNdEx := len(m.PubRandCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -487,6 +636,18 @@ func (m *PublicRandomness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.PubRand != nil {
 		{
 			size := m.PubRand.Size()
@@ -566,6 +727,59 @@ func (m *PubRandCommitWithPK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		dAtA5 := make([]byte, len(m.Indexes)*10)
+		var j4 int
+		for _, num := range m.Indexes {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -615,6 +829,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextHeightToFinalize != 0 {
+		n += 1 + sovGenesis(uint64(m.NextHeightToFinalize))
+	}
+	if m.PruningHorizon != 0 {
+		n += 1 + sovGenesis(uint64(m.PruningHorizon))
+	}
 	return n
 }
 
@@ -655,6 +887,10 @@ func (m *PublicRandomness) Size() (n int) {
 		l = m.PubRand.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -675,6 +911,26 @@ func (m *PubRandCommitWithPK) Size() (n int) {
 	return n
 }
 
+func (m *FinalityProviderMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		l = 0
+		for _, e := range m.Indexes {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -913,6 +1169,112 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, &FinalityProviderSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, &FinalityProviderMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeightToFinalize", wireType)
+			}
+			m.NextHeightToFinalize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeightToFinalize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningHorizon", wireType)
+			}
+			m.PruningHorizon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningHorizon |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1191,6 +1553,42 @@ func (m *PublicRandomness) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1333,6 +1731,167 @@ func (m *PubRandCommitWithPK) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FinalityProviderMissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderMissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indexes = append(m.Indexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indexes) == 0 {
+					m.Indexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indexes = append(m.Indexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
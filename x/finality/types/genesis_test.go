@@ -1,13 +1,22 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
+	"time"
 
-	"github.com/babylonchain/babylon/x/finality/types"
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/finality/types"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -22,11 +31,28 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					MinPubRand: 200,
+					MinPubRand:         200,
+					SignedBlocksWindow: 100,
+					FinalitySigTimeout: 3,
+					MinSignedPerWindow: sdkmath.LegacyNewDecWithPrec(5, 1),
+					JailDuration:       time.Hour,
 				},
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid genesis state with missed block index beyond the window",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MissedBlocks: []*types.FinalityProviderMissedBlocks{
+					{
+						FpBtcPk: fpBTCPK,
+						Indexes: []uint64{types.DefaultParams().SignedBlocksWindow},
+					},
+				},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
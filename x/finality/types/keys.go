@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "finality"
@@ -15,11 +19,59 @@ const (
 )
 
 var (
-	BlockKey                = []byte{0x01} // key prefix for blocks
-	VoteKey                 = []byte{0x02} // key prefix for votes
-	PubRandKey              = []byte{0x03} // key prefix for public randomness
-	PubRandCommitKey        = []byte{0x04} // key prefix for commitment of public randomness
-	ParamsKey               = []byte{0x05} // key prefix for the parameters
-	EvidenceKey             = []byte{0x06} // key prefix for evidences
-	NextHeightToFinalizeKey = []byte{0x07} // key prefix for next height to finalise
+	BlockKey                             = []byte{0x01} // key prefix for blocks
+	VoteKey                              = []byte{0x02} // key prefix for votes
+	PubRandKey                           = []byte{0x03} // key prefix for public randomness
+	PubRandCommitKey                     = []byte{0x04} // key prefix for commitment of public randomness
+	ParamsKey                            = []byte{0x05} // key prefix for the parameters
+	EvidenceKey                          = []byte{0x06} // key prefix for evidences
+	NextHeightToFinalizeKey              = []byte{0x07} // key prefix for next height to finalise
+	FinalityProviderSigningInfoKey       = []byte{0x08} // key prefix for finality provider signing info
+	FinalityProviderMissedBlockBitmapKey = []byte{0x09} // key prefix for finality provider missed block bitmap
+	PruningHorizonKey                    = []byte{0x0a} // key prefix for the lowest height whose data is not pruned
+	PubRandProofKey                      = []byte{0x0b} // key prefix for the inclusion proofs of revealed public randomness
+	PubRandHeightIndexKey                = []byte{0x0c} // key prefix for the index of revealed public randomness by height
 )
+
+// GetBlockKey returns the key of the indexed block at the given height in
+// the finality store, i.e., (BlockKey || height)
+func GetBlockKey(height uint64) []byte {
+	key := append([]byte{}, BlockKey...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetPubRandCommitKey returns the key of the public randomness commitment of
+// the given finality provider starting at the given height in the finality
+// store, i.e., (PubRandCommitKey || finality provider BTC PK || start height)
+func GetPubRandCommitKey(fpBTCPK []byte, startHeight uint64) []byte {
+	key := append([]byte{}, PubRandCommitKey...)
+	key = append(key, fpBTCPK...)
+	return append(key, sdk.Uint64ToBigEndian(startHeight)...)
+}
+
+// GetVoteKey returns the key of the finality signature of the given finality
+// provider on the block at the given height in the finality store, i.e.,
+// (VoteKey || height || finality provider BTC PK)
+func GetVoteKey(height uint64, fpBTCPK []byte) []byte {
+	key := append([]byte{}, VoteKey...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBTCPK...)
+}
+
+// GetPubRandKey returns the key of the public randomness revealed by the
+// given finality provider at the given height in the finality store, i.e.,
+// (PubRandKey || finality provider BTC PK || height)
+func GetPubRandKey(fpBTCPK []byte, height uint64) []byte {
+	key := append([]byte{}, PubRandKey...)
+	key = append(key, fpBTCPK...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetPubRandProofKey returns the key of the inclusion proof of the public
+// randomness revealed by the given finality provider at the given height in
+// the finality store, i.e., (PubRandProofKey || finality provider BTC PK || height)
+func GetPubRandProofKey(fpBTCPK []byte, height uint64) []byte {
+	key := append([]byte{}, PubRandProofKey...)
+	key = append(key, fpBTCPK...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
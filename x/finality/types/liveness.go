@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	bbn "github.com/babylonchain/babylon/types"
+)
+
+// NewFinalityProviderSigningInfo creates the liveness information of a
+// finality provider whose liveness is tracked since the given height
+func NewFinalityProviderSigningInfo(fpBTCPK *bbn.BIP340PubKey, startHeight uint64) *FinalityProviderSigningInfo {
+	return &FinalityProviderSigningInfo{
+		FpBtcPk:             fpBTCPK,
+		StartHeight:         startHeight,
+		MissedBlocksCounter: 0,
+		JailedUntil:         time.Unix(0, 0).UTC(),
+	}
+}
+
+// Validate performs basic validation of the liveness information
+func (si *FinalityProviderSigningInfo) Validate() error {
+	if si.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	if _, err := si.FpBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid finality provider BTC PK: %w", err)
+	}
+	return nil
+}
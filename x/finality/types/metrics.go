@@ -7,8 +7,10 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyCommitPubRandList = "commit_pub_rand_list"
-	MetricsKeyAddFinalitySig    = "add_finality_sig"
+	MetricsKeyCommitPubRandList    = "commit_pub_rand_list"
+	MetricsKeyAddFinalitySig       = "add_finality_sig"
+	MetricsKeyEquivocationEvidence = "equivocation_evidence"
+	MetricsKeyAddFinalitySigs      = "add_finality_sigs"
 )
 
 // Metrics for monitoring block finalization status
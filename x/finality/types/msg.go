@@ -1,11 +1,14 @@
 package types
 
 import (
+	"bytes"
 	fmt "fmt"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,8 +17,15 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
+	_ sdk.Msg = &MsgUnjailFinalityProvider{}
+	_ sdk.Msg = &MsgEquivocationEvidence{}
+	_ sdk.Msg = &MsgAddFinalitySigs{}
 )
 
+// MaxFinalityVotesPerMsg is the maximum number of finality votes in a
+// MsgAddFinalitySigs message
+const MaxFinalityVotesPerMsg = 1000
+
 func (m *MsgAddFinalitySig) MsgToSign() []byte {
 	return msgToSignForVote(m.BlockHeight, m.BlockAppHash)
 }
@@ -25,22 +35,8 @@ func (m *MsgAddFinalitySig) MsgToSign() []byte {
 // - verifying the proof of inclusion of the given public randomness
 // - verifying the finality signature w.r.t. the given block height/hash
 func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
-	// verify the index of the public randomness
-	heightOfProof := prCommit.StartHeight + uint64(m.Proof.Index)
-	if m.BlockHeight != heightOfProof {
-		return ErrInvalidFinalitySig.Wrapf("the inclusion proof (for height %d) does not correspond to the given height (%d) in the message", heightOfProof, m.BlockHeight)
-	}
-	// verify the total number of randomness is same as in the commit
-	if uint64(m.Proof.Total) != prCommit.NumPubRand {
-		return ErrInvalidFinalitySig.Wrapf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", m.Proof.Total, prCommit.NumPubRand)
-	}
-	// verify the proof of inclusion for this public randomness
-	unwrappedProof, err := merkle.ProofFromProto(m.Proof)
-	if err != nil {
-		return ErrInvalidFinalitySig.Wrapf("failed to unwrap proof: %v", err)
-	}
-	if err := unwrappedProof.Verify(prCommit.Commitment, *m.PubRand); err != nil {
-		return ErrInvalidFinalitySig.Wrapf("the inclusion proof of the public randomness is invalid: %v", err)
+	if err := verifyPubRandInclusion(m.BlockHeight, m.PubRand, m.Proof, prCommit); err != nil {
+		return ErrInvalidFinalitySig.Wrap(err.Error())
 	}
 
 	// public randomness is good, verify finality signature
@@ -52,6 +48,29 @@ func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
 	return eots.Verify(pk, m.PubRand.ToFieldVal(), msgToSign, m.FinalitySig.ToModNScalar())
 }
 
+// verifyPubRandInclusion verifies the proof of inclusion of the given public
+// randomness at the given height w.r.t. the public randomness commitment
+func verifyPubRandInclusion(height uint64, pubRand *bbn.SchnorrPubRand, proof *cmtcrypto.Proof, prCommit *PubRandCommit) error {
+	// verify the index of the public randomness
+	heightOfProof := prCommit.StartHeight + uint64(proof.Index)
+	if height != heightOfProof {
+		return fmt.Errorf("the inclusion proof (for height %d) does not correspond to the given height (%d) in the message", heightOfProof, height)
+	}
+	// verify the total number of randomness is same as in the commit
+	if uint64(proof.Total) != prCommit.NumPubRand {
+		return fmt.Errorf("the total number of public randomnesses in the proof (%d) does not match the number of public randomnesses committed (%d)", proof.Total, prCommit.NumPubRand)
+	}
+	// verify the proof of inclusion for this public randomness
+	unwrappedProof, err := merkle.ProofFromProto(proof)
+	if err != nil {
+		return fmt.Errorf("failed to unwrap proof: %v", err)
+	}
+	if err := unwrappedProof.Verify(prCommit.Commitment, *pubRand); err != nil {
+		return fmt.Errorf("the inclusion proof of the public randomness is invalid: %v", err)
+	}
+	return nil
+}
+
 // HashToSign returns a 32-byte hash of (start_height || num_pub_rand || commitment)
 // The signature in MsgCommitPubRandList will be on this hash
 func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
@@ -89,3 +108,106 @@ func (m *MsgCommitPubRandList) VerifySig() error {
 	}
 	return nil
 }
+
+func (m *MsgEquivocationEvidence) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return fmt.Errorf("invalid signer addr %s: %w", m.Signer, err)
+	}
+	if m.Proof == nil {
+		return fmt.Errorf("empty inclusion proof of the public randomness")
+	}
+	evidence := m.ToEvidence()
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+	if bytes.Equal(m.CanonicalAppHash, m.ForkAppHash) {
+		return fmt.Errorf("the canonical and fork AppHashes are the same")
+	}
+	if m.CanonicalFinalitySig == nil {
+		return fmt.Errorf("empty CanonicalFinalitySig")
+	}
+	return nil
+}
+
+// ToEvidence returns the evidence of equivocation in the message
+func (m *MsgEquivocationEvidence) ToEvidence() *Evidence {
+	return &Evidence{
+		FpBtcPk:              m.FpBtcPk,
+		BlockHeight:          m.BlockHeight,
+		PubRand:              m.PubRand,
+		CanonicalAppHash:     m.CanonicalAppHash,
+		ForkAppHash:          m.ForkAppHash,
+		CanonicalFinalitySig: m.CanonicalFinalitySig,
+		ForkFinalitySig:      m.ForkFinalitySig,
+	}
+}
+
+// VerifyEquivocationEvidence verifies the evidence of equivocation w.r.t. the
+// public randomness commitment. The verification includes
+// - verifying the proof of inclusion of the given public randomness
+// - verifying both finality signatures w.r.t. the given block height and
+// the canonical/fork AppHashes
+func VerifyEquivocationEvidence(m *MsgEquivocationEvidence, prCommit *PubRandCommit) error {
+	if err := verifyPubRandInclusion(m.BlockHeight, m.PubRand, m.Proof, prCommit); err != nil {
+		return ErrInvalidEquivocationEvidence.Wrap(err.Error())
+	}
+
+	// public randomness is good, verify both finality signatures
+	pk, err := m.FpBtcPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	evidence := m.ToEvidence()
+	if err := eots.Verify(pk, m.PubRand.ToFieldVal(), evidence.canonicalMsgToSign(), m.CanonicalFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidEquivocationEvidence.Wrapf("invalid canonical finality signature: %v", err)
+	}
+	if err := eots.Verify(pk, m.PubRand.ToFieldVal(), evidence.forkMsgToSign(), m.ForkFinalitySig.ToModNScalar()); err != nil {
+		return ErrInvalidEquivocationEvidence.Wrapf("invalid fork finality signature: %v", err)
+	}
+	return nil
+}
+
+func (m *MsgAddFinalitySigs) ValidateBasic() error {
+	if m.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	if len(m.Votes) == 0 {
+		return fmt.Errorf("empty finality votes")
+	}
+	if len(m.Votes) > MaxFinalityVotesPerMsg {
+		return fmt.Errorf("too many finality votes: %d > %d", len(m.Votes), MaxFinalityVotesPerMsg)
+	}
+	for i, vote := range m.Votes {
+		if vote == nil {
+			return fmt.Errorf("empty finality vote at index %d", i)
+		}
+		if i > 0 && vote.BlockHeight <= m.Votes[i-1].BlockHeight {
+			return fmt.Errorf("the heights of finality votes are not strictly increasing")
+		}
+		if vote.PubRand == nil {
+			return fmt.Errorf("empty public randomness at height %d", vote.BlockHeight)
+		}
+		if vote.Proof == nil {
+			return fmt.Errorf("empty inclusion proof at height %d", vote.BlockHeight)
+		}
+		if vote.FinalitySig == nil {
+			return fmt.Errorf("empty finality signature at height %d", vote.BlockHeight)
+		}
+	}
+	return nil
+}
+
+// ToMsgAddFinalitySig returns the MsgAddFinalitySig corresponding to the
+// finality vote with the given index
+func (m *MsgAddFinalitySigs) ToMsgAddFinalitySig(i int) *MsgAddFinalitySig {
+	vote := m.Votes[i]
+	return &MsgAddFinalitySig{
+		Signer:       m.Signer,
+		FpBtcPk:      m.FpBtcPk,
+		BlockHeight:  vote.BlockHeight,
+		PubRand:      vote.PubRand,
+		Proof:        vote.Proof,
+		BlockAppHash: vote.BlockAppHash,
+		FinalitySig:  vote.FinalitySig,
+	}
+}
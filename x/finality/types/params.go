@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -17,7 +19,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		MinPubRand: 100,
+		MinPubRand:         100,
+		SignedBlocksWindow: 100,
+		FinalitySigTimeout: 3,
+		MinSignedPerWindow: sdkmath.LegacyNewDecWithPrec(5, 1),
+		JailDuration:       24 * time.Hour,
 	}
 }
 
@@ -33,14 +39,89 @@ func validateMinPubRand(minPubRand uint64) error {
 	return nil
 }
 
+func validateSignedBlocksWindow(window uint64) error {
+	if window == 0 {
+		return fmt.Errorf("signed blocks window cannot be 0")
+	}
+	return nil
+}
+
+func validateFinalitySigTimeout(timeout uint64) error {
+	if timeout == 0 {
+		return fmt.Errorf("finality signature timeout cannot be 0")
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(minSigned sdkmath.LegacyDec) error {
+	if minSigned.IsNil() {
+		return fmt.Errorf("min signed per window cannot be nil")
+	}
+	if minSigned.IsNegative() {
+		return fmt.Errorf("min signed per window cannot be negative: %s", minSigned)
+	}
+	if minSigned.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("min signed per window cannot be greater than 1: %s", minSigned)
+	}
+	return nil
+}
+
+func validatePruningRetentionBlocks(retention uint64, finalitySigTimeout uint64) error {
+	// the votes within the finality signature timeout are needed for
+	// tracking the liveness of finality providers
+	if retention != 0 && retention <= finalitySigTimeout {
+		return fmt.Errorf("pruning retention blocks (%d) must be larger than finality signature timeout (%d)", retention, finalitySigTimeout)
+	}
+	return nil
+}
+
+func validateJailDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return fmt.Errorf("jail duration must be positive: %s", duration)
+	}
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validateMinPubRand(p.MinPubRand); err != nil {
 		return err
 	}
+	if err := p.ValidateLiveness(); err != nil {
+		return err
+	}
+	if err := validatePruningRetentionBlocks(p.PruningRetentionBlocks, p.FinalitySigTimeout); err != nil {
+		return err
+	}
 	return nil
 }
 
+// ValidateLiveness validates the params used for tracking the liveness of
+// finality providers
+func (p Params) ValidateLiveness() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateFinalitySigTimeout(p.FinalitySigTimeout); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateJailDuration(p.JailDuration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MinSignedPerWindowInt returns the minimum number of blocks in the sliding
+// window that a finality provider needs to vote for
+func (p Params) MinSignedPerWindowInt() uint64 {
+	// NOTE: RoundInt64 will never panic as the window is bounded by uint64
+	// and min signed per window is at most 1
+	return uint64(p.MinSignedPerWindow.MulInt64(int64(p.SignedBlocksWindow)).RoundInt64())
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -4,18 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +34,26 @@ type Params struct {
 	// min_pub_rand is the minimum number of public randomness each
 	// message should commit
 	MinPubRand uint64 `protobuf:"varint,1,opt,name=min_pub_rand,json=minPubRand,proto3" json:"min_pub_rand,omitempty"`
+	// signed_blocks_window is the size of the sliding window of blocks
+	// over which the liveness of finality providers is tracked
+	SignedBlocksWindow uint64 `protobuf:"varint,2,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// finality_sig_timeout is the number of blocks a finality provider has
+	// for casting a finality vote on a block, before being considered as
+	// missing this block
+	FinalitySigTimeout uint64 `protobuf:"varint,3,opt,name=finality_sig_timeout,json=finalitySigTimeout,proto3" json:"finality_sig_timeout,omitempty"`
+	// min_signed_per_window is the minimum fraction of blocks in the sliding
+	// window that a finality provider needs to vote for, otherwise it will
+	// be jailed
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// jail_duration is the minimum duration that a jailed finality provider
+	// has to wait before unjailing itself
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// pruning_retention_blocks is the number of most recent blocks whose
+	// finality votes, revealed public randomness and indexed blocks are
+	// retained. The data of older blocks is pruned once they are finalized.
+	// Evidences and public randomness commitments are never pruned.
+	// 0 means that pruning is disabled
+	PruningRetentionBlocks uint64 `protobuf:"varint,6,opt,name=pruning_retention_blocks,json=pruningRetentionBlocks,proto3" json:"pruning_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -69,6 +95,34 @@ func (m *Params) GetMinPubRand() uint64 {
 	return 0
 }
 
+func (m *Params) GetSignedBlocksWindow() uint64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFinalitySigTimeout() uint64 {
+	if m != nil {
+		return m.FinalitySigTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetPruningRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PruningRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -76,19 +130,34 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x06, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x42, 0x0a, 0x5c, 0x3c, 0xb9, 0x99,
-	0x79, 0xf1, 0x05, 0xa5, 0x49, 0xf1, 0x45, 0x89, 0x79, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c,
-	0x41, 0x5c, 0xb9, 0x99, 0x79, 0x01, 0xa5, 0x49, 0x41, 0x89, 0x79, 0x29, 0x56, 0x2c, 0x33, 0x16,
-	0xc8, 0x33, 0x38, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x05, 0xc9, 0x19, 0x89,
-	0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0xc2, 0xc9, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x47, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x3d, 0x78, 0xb7, 0xd3, 0x00, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0x93, 0x72, 0x9c, 0x90, 0x39, 0x96, 0x50, 0x50, 0x5a, 0xa4, 0x5c, 0xc4, 0x74, 0x0b,
+	0x76, 0x0f, 0x16, 0xc4, 0x78, 0xba, 0x01, 0x21, 0x86, 0x53, 0x8a, 0x84, 0xc4, 0x62, 0x39, 0x89,
+	0xeb, 0x7b, 0x69, 0x62, 0x47, 0x89, 0xd3, 0x92, 0x6f, 0xc1, 0xd8, 0x91, 0x0f, 0xc1, 0x87, 0xe8,
+	0x58, 0x31, 0x21, 0x86, 0x03, 0xdd, 0x7d, 0x0f, 0x84, 0xe2, 0x3f, 0x62, 0xcb, 0xeb, 0xdf, 0xf3,
+	0xe6, 0x79, 0xfc, 0xc8, 0x28, 0xcd, 0x59, 0x3e, 0x54, 0x4a, 0x92, 0x0b, 0x90, 0xac, 0x02, 0x3d,
+	0x90, 0xab, 0x25, 0x69, 0x58, 0xcb, 0xea, 0x0e, 0x37, 0xad, 0xd2, 0x2a, 0x7a, 0xec, 0x14, 0xd8,
+	0x2b, 0xf0, 0xd5, 0xf2, 0xf4, 0x58, 0x28, 0xa1, 0x0c, 0x27, 0xe3, 0x97, 0x95, 0x9e, 0x9e, 0x14,
+	0xaa, 0xab, 0x55, 0x47, 0x2d, 0xb0, 0x83, 0x43, 0x89, 0x50, 0x4a, 0x54, 0x9c, 0x98, 0x29, 0xef,
+	0x2f, 0x48, 0xd9, 0xb7, 0x4c, 0x83, 0x92, 0x96, 0x3f, 0xff, 0x7b, 0x84, 0xa6, 0x1b, 0x63, 0x1b,
+	0xa5, 0x68, 0x56, 0x83, 0xa4, 0x4d, 0x9f, 0xd3, 0x96, 0xc9, 0x32, 0x0e, 0xd3, 0x70, 0x31, 0xc9,
+	0x50, 0x0d, 0x72, 0xd3, 0xe7, 0x19, 0x93, 0x65, 0x74, 0x86, 0x8e, 0x3b, 0x10, 0x92, 0x97, 0x34,
+	0xaf, 0x54, 0x71, 0xd9, 0xd1, 0x6b, 0x90, 0xa5, 0xba, 0x8e, 0x8f, 0x8c, 0x32, 0xb2, 0x6c, 0x65,
+	0xd0, 0x47, 0x43, 0xc6, 0x0d, 0x1f, 0x9f, 0x76, 0x20, 0xa8, 0x86, 0x9a, 0xab, 0x5e, 0xc7, 0xf7,
+	0xec, 0x86, 0x67, 0xe7, 0x20, 0x3e, 0x58, 0x12, 0x95, 0xe8, 0xc9, 0x98, 0xc2, 0xf9, 0x34, 0xbc,
+	0xf5, 0x26, 0x93, 0x34, 0x5c, 0xcc, 0x56, 0xcb, 0xdb, 0xdd, 0x3c, 0xf8, 0xb5, 0x9b, 0x3f, 0xb3,
+	0xb7, 0xec, 0xca, 0x4b, 0x0c, 0x8a, 0xd4, 0x4c, 0x6f, 0xf1, 0x7b, 0x2e, 0x58, 0x31, 0xac, 0x79,
+	0xf1, 0xe3, 0xfb, 0x0b, 0xe4, 0x4a, 0x58, 0xf3, 0x22, 0x8b, 0x6a, 0x90, 0xe7, 0xe6, 0x77, 0x1b,
+	0xde, 0xba, 0x5c, 0x6f, 0xd1, 0xa3, 0xcf, 0x0c, 0x2a, 0xea, 0xdb, 0x88, 0xef, 0xa7, 0xe1, 0xe2,
+	0xe1, 0xcb, 0x13, 0x6c, 0xeb, 0xc2, 0xbe, 0x2e, 0xbc, 0x76, 0x82, 0xd5, 0x83, 0xd1, 0xf8, 0xe6,
+	0xf7, 0x3c, 0xcc, 0x66, 0xe3, 0xa6, 0x3f, 0x8f, 0x5e, 0xa3, 0xb8, 0x69, 0x7b, 0x09, 0x52, 0xd0,
+	0x96, 0x6b, 0x2e, 0xc7, 0x43, 0x57, 0x4f, 0x3c, 0x35, 0xb7, 0x7c, 0xea, 0x78, 0xe6, 0xb1, 0x6d,
+	0xe8, 0xcd, 0xe4, 0xe6, 0xdb, 0x3c, 0x58, 0xbd, 0xbb, 0xdd, 0x27, 0xe1, 0xdd, 0x3e, 0x09, 0xff,
+	0xec, 0x93, 0xf0, 0xeb, 0x21, 0x09, 0xee, 0x0e, 0x49, 0xf0, 0xf3, 0x90, 0x04, 0x9f, 0xce, 0x04,
+	0xe8, 0x6d, 0x9f, 0xe3, 0x42, 0xd5, 0xc4, 0xbd, 0x85, 0x62, 0xcb, 0x40, 0xfa, 0x81, 0x7c, 0xf9,
+	0xff, 0x78, 0xf4, 0xd0, 0xf0, 0x2e, 0x9f, 0x9a, 0xd8, 0xaf, 0xfe, 0x0d, 0x00, 0x36, 0x2d, 0x16,
+	0x50, 0x5d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +180,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PruningRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruningRetentionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.FinalitySigTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalitySigTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
 		i--
@@ -139,6 +241,19 @@ func (m *Params) Size() (n int) {
 	if m.MinPubRand != 0 {
 		n += 1 + sovParams(uint64(m.MinPubRand))
 	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBlocksWindow))
+	}
+	if m.FinalitySigTimeout != 0 {
+		n += 1 + sovParams(uint64(m.FinalitySigTimeout))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.PruningRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PruningRetentionBlocks))
+	}
 	return n
 }
 
@@ -196,6 +311,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySigTimeout", wireType)
+			}
+			m.FinalitySigTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalitySigTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningRetentionBlocks", wireType)
+			}
+			m.PruningRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
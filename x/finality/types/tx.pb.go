@@ -268,6 +268,385 @@ func (m *MsgAddFinalitySigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddFinalitySigResponse proto.InternalMessageInfo
 
+// FinalityVote is a finality vote on a block, as in MsgAddFinalitySig
+type FinalityVote struct {
+	// block_height is the height of the voted block
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pub_rand is the public randomness committed at this height
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,2,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under the commitment
+	Proof *crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// block_app_hash is the AppHash of the voted block
+	BlockAppHash []byte `protobuf:"bytes,4,opt,name=block_app_hash,json=blockAppHash,proto3" json:"block_app_hash,omitempty"`
+	// finality_sig is the finality signature to this block
+	// where finality signature is an EOTS signature
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,5,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *FinalityVote) Reset()         { *m = FinalityVote{} }
+func (m *FinalityVote) String() string { return proto.CompactTextString(m) }
+func (*FinalityVote) ProtoMessage()    {}
+func (*FinalityVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+}
+func (m *FinalityVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityVote.Merge(m, src)
+}
+func (m *FinalityVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityVote proto.InternalMessageInfo
+
+func (m *FinalityVote) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FinalityVote) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *FinalityVote) GetBlockAppHash() []byte {
+	if m != nil {
+		return m.BlockAppHash
+	}
+	return nil
+}
+
+// MsgAddFinalitySigs defines a message for adding finality votes of a
+// finality provider on a range of blocks, e.g., when the finality provider
+// catches up after downtime
+type MsgAddFinalitySigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that casts these votes
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// votes are the finality votes in strictly increasing order of heights
+	Votes []*FinalityVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *MsgAddFinalitySigs) Reset()         { *m = MsgAddFinalitySigs{} }
+func (m *MsgAddFinalitySigs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigs) ProtoMessage()    {}
+func (*MsgAddFinalitySigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+}
+func (m *MsgAddFinalitySigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigs.Merge(m, src)
+}
+func (m *MsgAddFinalitySigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigs proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddFinalitySigs) GetVotes() []*FinalityVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+// MsgAddFinalitySigsResponse is the response to the MsgAddFinalitySigs message
+type MsgAddFinalitySigsResponse struct {
+}
+
+func (m *MsgAddFinalitySigsResponse) Reset()         { *m = MsgAddFinalitySigsResponse{} }
+func (m *MsgAddFinalitySigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigsResponse) ProtoMessage()    {}
+func (*MsgAddFinalitySigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.Merge(m, src)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigsResponse proto.InternalMessageInfo
+
+// MsgEquivocationEvidence is the message for submitting the evidence that a
+// finality provider has signed two conflicting blocks at the same height,
+// e.g., a fork block observed outside of Babylon. Anyone can submit it
+type MsgEquivocationEvidence struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that equivocates
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// block_height is the height of the conflicting blocks
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pub_rand is the public randomness committed at this height
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,4,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under the commitment
+	Proof *crypto.Proof `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	// canonical_app_hash is the AppHash of the canonical block
+	CanonicalAppHash []byte `protobuf:"bytes,6,opt,name=canonical_app_hash,json=canonicalAppHash,proto3" json:"canonical_app_hash,omitempty"`
+	// fork_app_hash is the AppHash of the fork block
+	ForkAppHash []byte `protobuf:"bytes,7,opt,name=fork_app_hash,json=forkAppHash,proto3" json:"fork_app_hash,omitempty"`
+	// canonical_finality_sig is the finality signature to the canonical block
+	// where finality signature is an EOTS signature
+	CanonicalFinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,8,opt,name=canonical_finality_sig,json=canonicalFinalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"canonical_finality_sig,omitempty"`
+	// fork_finality_sig is the finality signature to the fork block
+	// where finality signature is an EOTS signature
+	ForkFinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,9,opt,name=fork_finality_sig,json=forkFinalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"fork_finality_sig,omitempty"`
+}
+
+func (m *MsgEquivocationEvidence) Reset()         { *m = MsgEquivocationEvidence{} }
+func (m *MsgEquivocationEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgEquivocationEvidence) ProtoMessage()    {}
+func (*MsgEquivocationEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *MsgEquivocationEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEquivocationEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEquivocationEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEquivocationEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEquivocationEvidence.Merge(m, src)
+}
+func (m *MsgEquivocationEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEquivocationEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEquivocationEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEquivocationEvidence proto.InternalMessageInfo
+
+func (m *MsgEquivocationEvidence) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgEquivocationEvidence) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgEquivocationEvidence) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MsgEquivocationEvidence) GetCanonicalAppHash() []byte {
+	if m != nil {
+		return m.CanonicalAppHash
+	}
+	return nil
+}
+
+func (m *MsgEquivocationEvidence) GetForkAppHash() []byte {
+	if m != nil {
+		return m.ForkAppHash
+	}
+	return nil
+}
+
+// MsgEquivocationEvidenceResponse is the response to the MsgEquivocationEvidence message
+type MsgEquivocationEvidenceResponse struct {
+}
+
+func (m *MsgEquivocationEvidenceResponse) Reset()         { *m = MsgEquivocationEvidenceResponse{} }
+func (m *MsgEquivocationEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEquivocationEvidenceResponse) ProtoMessage()    {}
+func (*MsgEquivocationEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{8}
+}
+func (m *MsgEquivocationEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEquivocationEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEquivocationEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEquivocationEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEquivocationEvidenceResponse.Merge(m, src)
+}
+func (m *MsgEquivocationEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEquivocationEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEquivocationEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEquivocationEvidenceResponse proto.InternalMessageInfo
+
+// MsgUnjailFinalityProvider defines the Msg/UnjailFinalityProvider request type
+type MsgUnjailFinalityProvider struct {
+	// signer is the address of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider to unjail
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+}
+
+func (m *MsgUnjailFinalityProvider) Reset()         { *m = MsgUnjailFinalityProvider{} }
+func (m *MsgUnjailFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProvider) ProtoMessage()    {}
+func (*MsgUnjailFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{9}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProvider.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgUnjailFinalityProvider) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// MsgUnjailFinalityProviderResponse defines the Msg/UnjailFinalityProvider response type
+type MsgUnjailFinalityProviderResponse struct {
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Reset()         { *m = MsgUnjailFinalityProviderResponse{} }
+func (m *MsgUnjailFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgUnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{10}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailFinalityProviderResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating finality module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -285,7 +664,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+	return fileDescriptor_2dd6da066b6baf1d, []int{11}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +715,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+	return fileDescriptor_2dd6da066b6baf1d, []int{12}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,6 +749,13 @@ func init() {
 	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
 	proto.RegisterType((*MsgAddFinalitySig)(nil), "babylon.finality.v1.MsgAddFinalitySig")
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
+	proto.RegisterType((*FinalityVote)(nil), "babylon.finality.v1.FinalityVote")
+	proto.RegisterType((*MsgAddFinalitySigs)(nil), "babylon.finality.v1.MsgAddFinalitySigs")
+	proto.RegisterType((*MsgAddFinalitySigsResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigsResponse")
+	proto.RegisterType((*MsgEquivocationEvidence)(nil), "babylon.finality.v1.MsgEquivocationEvidence")
+	proto.RegisterType((*MsgEquivocationEvidenceResponse)(nil), "babylon.finality.v1.MsgEquivocationEvidenceResponse")
+	proto.RegisterType((*MsgUnjailFinalityProvider)(nil), "babylon.finality.v1.MsgUnjailFinalityProvider")
+	proto.RegisterType((*MsgUnjailFinalityProviderResponse)(nil), "babylon.finality.v1.MsgUnjailFinalityProviderResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
 }
@@ -377,52 +763,68 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0x52, 0x28, 0x2f, 0xd3, 0x86, 0x37, 0xec, 0x4b, 0x5e, 0x96, 0x82, 0xdb, 0xda, 0x10,
-	0x83, 0x44, 0x77, 0xa1, 0x20, 0x11, 0x6e, 0xd4, 0x68, 0x50, 0x6c, 0x6c, 0xb6, 0x7a, 0xd1, 0xc3,
-	0x66, 0xff, 0x75, 0x76, 0x02, 0x3b, 0x33, 0xee, 0xcc, 0x12, 0x7a, 0x33, 0x7e, 0x02, 0x0f, 0x7e,
-	0x10, 0x0e, 0x26, 0x9e, 0xbd, 0x71, 0x31, 0x21, 0x9e, 0x0c, 0x87, 0xc6, 0xc0, 0x81, 0xaf, 0x61,
-	0x76, 0x77, 0x4a, 0x29, 0x94, 0x58, 0x3d, 0x78, 0xdb, 0x99, 0xdf, 0x33, 0xf3, 0x3c, 0xfb, 0x7b,
-	0x9e, 0xdf, 0x80, 0x79, 0xdb, 0xb2, 0xdb, 0x7b, 0x04, 0xeb, 0x2d, 0x84, 0xad, 0x3d, 0xc4, 0xdb,
-	0xfa, 0xfe, 0x8a, 0xce, 0x0f, 0x34, 0x1a, 0x12, 0x4e, 0xe4, 0xff, 0x44, 0x55, 0xeb, 0x56, 0xb5,
-	0xfd, 0x95, 0xe2, 0x34, 0x24, 0x90, 0x24, 0x75, 0x3d, 0xfe, 0x4a, 0xa1, 0xc5, 0x5b, 0xdc, 0xc3,
-	0xae, 0x17, 0x06, 0x08, 0x73, 0xdd, 0x09, 0xdb, 0x94, 0x13, 0x9d, 0x86, 0x84, 0xb4, 0x44, 0x79,
-	0xd6, 0x21, 0x2c, 0x20, 0xcc, 0x4c, 0xcf, 0xa5, 0x0b, 0x51, 0x9a, 0x49, 0x57, 0x7a, 0xc0, 0x60,
-	0x4c, 0x1e, 0x30, 0x28, 0x0a, 0xe5, 0x41, 0xda, 0xa8, 0x15, 0x5a, 0x81, 0x38, 0x5a, 0xf9, 0x32,
-	0x02, 0xa6, 0xeb, 0x0c, 0x3e, 0x22, 0x41, 0x80, 0x78, 0x23, 0xb2, 0x0d, 0x0b, 0xbb, 0xcf, 0x11,
-	0xe3, 0xf2, 0xff, 0x20, 0xc7, 0x10, 0xc4, 0x5e, 0xa8, 0x48, 0x65, 0x69, 0x71, 0xc2, 0x10, 0x2b,
-	0xd9, 0x00, 0x13, 0x2d, 0x6a, 0xda, 0xdc, 0x31, 0xe9, 0xae, 0x32, 0x52, 0x96, 0x16, 0x0b, 0xb5,
-	0xf5, 0x93, 0x4e, 0xa9, 0x0a, 0x11, 0xf7, 0x23, 0x5b, 0x73, 0x48, 0xa0, 0x0b, 0x52, 0xc7, 0xb7,
-	0x10, 0xee, 0x2e, 0x74, 0xde, 0xa6, 0x1e, 0xd3, 0x6a, 0x4f, 0x1b, 0xab, 0x6b, 0xcb, 0x8d, 0xc8,
-	0xde, 0xf1, 0xda, 0xc6, 0x78, 0x8b, 0xd6, 0xb8, 0xd3, 0xd8, 0x95, 0x6f, 0x83, 0x02, 0xe3, 0x56,
-	0xc8, 0x4d, 0xdf, 0x43, 0xd0, 0xe7, 0x4a, 0xb6, 0x2c, 0x2d, 0x8e, 0x1a, 0xf9, 0x64, 0x6f, 0x3b,
-	0xd9, 0x92, 0xcb, 0xa0, 0x80, 0xa3, 0xc0, 0xa4, 0x91, 0x6d, 0x86, 0x16, 0x76, 0x95, 0xd1, 0x04,
-	0x02, 0x70, 0x14, 0x08, 0xd1, 0xb2, 0x0a, 0x80, 0x93, 0xfc, 0x45, 0xe0, 0x61, 0xae, 0x8c, 0xc5,
-	0xca, 0x8c, 0x4b, 0x3b, 0xf2, 0x0e, 0xc8, 0x32, 0x04, 0x95, 0x5c, 0x22, 0x79, 0xe3, 0xa4, 0x53,
-	0x7a, 0xf0, 0x3b, 0x92, 0x9b, 0x08, 0x62, 0x8b, 0x47, 0xa1, 0x67, 0xc4, 0xb7, 0x6c, 0xe6, 0xdf,
-	0x9f, 0x1f, 0x2e, 0x89, 0x96, 0x54, 0x54, 0x30, 0x3f, 0xa8, 0x85, 0x86, 0xc7, 0x28, 0xc1, 0xcc,
-	0xab, 0x7c, 0xce, 0x82, 0xa9, 0x3a, 0x83, 0x5b, 0xae, 0xfb, 0x44, 0xd8, 0xd0, 0x44, 0xf0, 0x6f,
-	0x37, 0xd8, 0xde, 0x23, 0xce, 0xee, 0x95, 0x06, 0x27, 0x7b, 0xa2, 0xc1, 0x4d, 0xf0, 0x4f, 0x5f,
-	0x73, 0x0b, 0xb5, 0x87, 0x27, 0x9d, 0xd2, 0xda, 0x70, 0xac, 0x4d, 0xc7, 0xc7, 0x24, 0x0c, 0xc5,
-	0xcf, 0x1b, 0xe3, 0x54, 0x78, 0xa2, 0x81, 0xb1, 0x24, 0xc2, 0x89, 0x1d, 0xf9, 0xaa, 0xa2, 0xf5,
-	0x22, 0xae, 0xa5, 0x11, 0xd7, 0x1a, 0x71, 0xdd, 0x48, 0x61, 0xf2, 0x02, 0x98, 0x4c, 0x75, 0x5a,
-	0x94, 0x9a, 0xbe, 0xc5, 0xfc, 0xd4, 0x2e, 0x23, 0x55, 0xbf, 0x45, 0xe9, 0xb6, 0xc5, 0x7c, 0xf9,
-	0x0d, 0x28, 0x74, 0xf3, 0x6c, 0xc6, 0x96, 0x8e, 0xff, 0xa1, 0xdc, 0xc7, 0x2f, 0x5e, 0x36, 0x9b,
-	0x08, 0x1a, 0xf9, 0x56, 0xcf, 0x96, 0x7e, 0x67, 0xe7, 0xc0, 0xec, 0x35, 0xe3, 0x2e, 0x6c, 0xfd,
-	0x28, 0x81, 0x7f, 0xeb, 0x0c, 0xbe, 0xa2, 0xae, 0xc5, 0xbd, 0x46, 0x32, 0x54, 0xf2, 0x3a, 0x98,
-	0xb0, 0x22, 0xee, 0x93, 0x10, 0xf1, 0x76, 0xea, 0x6b, 0x4d, 0xf9, 0xf6, 0xe9, 0xfe, 0xb4, 0x18,
-	0xd7, 0x2d, 0xd7, 0x0d, 0x3d, 0xc6, 0x9a, 0x3c, 0x44, 0x18, 0x1a, 0x3d, 0xa8, 0xbc, 0x01, 0x72,
-	0xe9, 0x58, 0x26, 0x8e, 0xe7, 0xab, 0x73, 0xda, 0x80, 0x77, 0x43, 0x4b, 0x49, 0x6a, 0xa3, 0x47,
-	0x9d, 0x52, 0xc6, 0x10, 0x07, 0x36, 0x27, 0x63, 0xc1, 0xbd, 0xab, 0x2a, 0xb3, 0x60, 0xe6, 0x8a,
-	0xaa, 0xae, 0xe2, 0xea, 0xd7, 0x11, 0x90, 0xad, 0x33, 0x28, 0xbf, 0x05, 0x53, 0xd7, 0x07, 0xfe,
-	0xee, 0x40, 0xca, 0x41, 0xc1, 0x2e, 0xae, 0x0c, 0x0d, 0xed, 0x52, 0xcb, 0x3e, 0x98, 0xbc, 0x92,
-	0xff, 0x3b, 0x37, 0x5d, 0xd2, 0x8f, 0x2b, 0x6a, 0xc3, 0xe1, 0x2e, 0x98, 0x6c, 0x50, 0xe8, 0xb3,
-	0x64, 0xe1, 0xa6, 0xf3, 0x97, 0x51, 0xc5, 0x7b, 0xc3, 0xa0, 0xba, 0x1c, 0xc5, 0xb1, 0x77, 0xe7,
-	0x87, 0x4b, 0x52, 0xed, 0xd9, 0xd1, 0xa9, 0x2a, 0x1d, 0x9f, 0xaa, 0xd2, 0x8f, 0x53, 0x55, 0xfa,
-	0x70, 0xa6, 0x66, 0x8e, 0xcf, 0xd4, 0xcc, 0xf7, 0x33, 0x35, 0xf3, 0x7a, 0xf9, 0x57, 0x41, 0x3c,
-	0xe8, 0x3d, 0xc9, 0x49, 0x26, 0xed, 0x5c, 0xf2, 0x1e, 0xaf, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x73, 0xec, 0x64, 0x4f, 0x06, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0x1b, 0x55,
+	0x14, 0xcd, 0xc4, 0x76, 0xda, 0x5c, 0x9b, 0x84, 0x0c, 0x56, 0xe2, 0x4c, 0x83, 0xed, 0x98, 0x0a,
+	0x42, 0x15, 0x66, 0x12, 0x37, 0x04, 0xda, 0x5d, 0x8c, 0x52, 0x15, 0x4a, 0x84, 0x35, 0x06, 0x16,
+	0xb0, 0xb0, 0xde, 0x7c, 0x78, 0xe6, 0x91, 0xcc, 0x7b, 0xd3, 0x79, 0xcf, 0x56, 0xcd, 0xaa, 0xe2,
+	0x17, 0xb0, 0xe0, 0x77, 0xa0, 0x2e, 0x90, 0x50, 0x97, 0x6c, 0x50, 0x97, 0x15, 0x2b, 0x94, 0x45,
+	0x84, 0x92, 0x45, 0xff, 0x04, 0x0b, 0x34, 0x1f, 0x1e, 0x7b, 0xec, 0x31, 0x75, 0xda, 0xaa, 0x62,
+	0xc1, 0xce, 0xf3, 0xee, 0x99, 0x7b, 0xcf, 0x3d, 0xf7, 0xcc, 0xd5, 0x33, 0x6c, 0x68, 0x48, 0xeb,
+	0x9f, 0x50, 0xa2, 0x74, 0x30, 0x41, 0x27, 0x98, 0xf7, 0x95, 0xde, 0xae, 0xc2, 0x1f, 0xc8, 0xae,
+	0x47, 0x39, 0x15, 0xdf, 0x8a, 0xa2, 0xf2, 0x20, 0x2a, 0xf7, 0x76, 0xa5, 0xa2, 0x45, 0x2d, 0x1a,
+	0xc4, 0x15, 0xff, 0x57, 0x08, 0x95, 0xde, 0xe6, 0x26, 0x31, 0x4c, 0xcf, 0xc1, 0x84, 0x2b, 0xba,
+	0xd7, 0x77, 0x39, 0x55, 0x5c, 0x8f, 0xd2, 0x4e, 0x14, 0x5e, 0xd7, 0x29, 0x73, 0x28, 0x6b, 0x87,
+	0xef, 0x85, 0x0f, 0x51, 0x68, 0x2d, 0x7c, 0x52, 0x1c, 0x66, 0xf9, 0xc5, 0x1d, 0x66, 0x45, 0x81,
+	0x6a, 0x1a, 0x37, 0x17, 0x79, 0xc8, 0x89, 0x5e, 0xad, 0xfd, 0x36, 0x0f, 0xc5, 0x23, 0x66, 0x7d,
+	0x42, 0x1d, 0x07, 0xf3, 0x66, 0x57, 0x53, 0x11, 0x31, 0x3e, 0xc7, 0x8c, 0x8b, 0xab, 0xb0, 0xc0,
+	0xb0, 0x45, 0x4c, 0xaf, 0x24, 0x54, 0x85, 0xad, 0x45, 0x35, 0x7a, 0x12, 0x55, 0x58, 0xec, 0xb8,
+	0x6d, 0x8d, 0xeb, 0x6d, 0xf7, 0xb8, 0x34, 0x5f, 0x15, 0xb6, 0x0a, 0x8d, 0xfd, 0xd3, 0xb3, 0x4a,
+	0xdd, 0xc2, 0xdc, 0xee, 0x6a, 0xb2, 0x4e, 0x1d, 0x25, 0x2a, 0xaa, 0xdb, 0x08, 0x93, 0xc1, 0x83,
+	0xc2, 0xfb, 0xae, 0xc9, 0xe4, 0xc6, 0xa7, 0xcd, 0x9b, 0x7b, 0x3b, 0xcd, 0xae, 0x76, 0xcf, 0xec,
+	0xab, 0x57, 0x3a, 0x6e, 0x83, 0xeb, 0xcd, 0x63, 0x71, 0x13, 0x0a, 0x8c, 0x23, 0x8f, 0xb7, 0x6d,
+	0x13, 0x5b, 0x36, 0x2f, 0x65, 0xaa, 0xc2, 0x56, 0x56, 0xcd, 0x07, 0x67, 0x77, 0x83, 0x23, 0xb1,
+	0x0a, 0x05, 0xd2, 0x75, 0xda, 0x6e, 0x57, 0x6b, 0x7b, 0x88, 0x18, 0xa5, 0x6c, 0x00, 0x01, 0xd2,
+	0x75, 0x22, 0xd2, 0x62, 0x19, 0x40, 0x0f, 0xba, 0x70, 0x4c, 0xc2, 0x4b, 0x39, 0x9f, 0x99, 0x3a,
+	0x72, 0x22, 0xde, 0x83, 0x0c, 0xc3, 0x56, 0x69, 0x21, 0xa0, 0x7c, 0xeb, 0xf4, 0xac, 0xf2, 0xe1,
+	0x65, 0x28, 0xb7, 0xb0, 0x45, 0x10, 0xef, 0x7a, 0xa6, 0xea, 0x67, 0xb9, 0x9d, 0xff, 0xe1, 0xd9,
+	0xa3, 0x1b, 0x91, 0x24, 0xb5, 0x32, 0x6c, 0xa4, 0x49, 0xa8, 0x9a, 0xcc, 0xa5, 0x84, 0x99, 0xb5,
+	0x5f, 0x33, 0xb0, 0x72, 0xc4, 0xac, 0x03, 0xc3, 0xb8, 0x13, 0x8d, 0xa1, 0x85, 0xad, 0xd7, 0x2d,
+	0xb0, 0x76, 0x42, 0xf5, 0xe3, 0x31, 0x81, 0x83, 0xb3, 0x48, 0xe0, 0x16, 0x5c, 0x4d, 0x88, 0x5b,
+	0x68, 0x7c, 0x7c, 0x7a, 0x56, 0xd9, 0x9b, 0xad, 0x6a, 0x4b, 0xb7, 0x09, 0xf5, 0xbc, 0xa8, 0x79,
+	0xf5, 0x8a, 0x1b, 0xcd, 0x44, 0x86, 0x5c, 0x60, 0xe1, 0x60, 0x1c, 0xf9, 0x7a, 0x49, 0x1e, 0x5a,
+	0x5c, 0x0e, 0x2d, 0x2e, 0x37, 0xfd, 0xb8, 0x1a, 0xc2, 0xc4, 0xeb, 0xb0, 0x14, 0xf2, 0x44, 0xae,
+	0xdb, 0xb6, 0x11, 0xb3, 0xc3, 0x71, 0xa9, 0x21, 0xfb, 0x03, 0xd7, 0xbd, 0x8b, 0x98, 0x2d, 0x7e,
+	0x0b, 0x85, 0x81, 0x9f, 0xdb, 0xfe, 0x48, 0xaf, 0xbc, 0x20, 0xdd, 0xc3, 0x2f, 0xbe, 0x6c, 0xb5,
+	0xb0, 0xa5, 0xe6, 0x3b, 0xc3, 0xb1, 0x24, 0x27, 0x7b, 0x0d, 0xd6, 0x27, 0x06, 0x17, 0x8f, 0xf5,
+	0xf1, 0x3c, 0x14, 0x06, 0xe7, 0x5f, 0x53, 0x6e, 0x4e, 0xa8, 0x2c, 0xfc, 0xbb, 0xca, 0xf3, 0xaf,
+	0x5c, 0xe5, 0xcc, 0x8b, 0xaa, 0x9c, 0x9d, 0x41, 0xe5, 0xdc, 0x2b, 0x54, 0xb9, 0xf6, 0xbb, 0x00,
+	0xe2, 0x84, 0xb2, 0xec, 0xb5, 0x7e, 0x13, 0x1f, 0x41, 0xae, 0x47, 0xb9, 0xc9, 0x4a, 0x99, 0x6a,
+	0x66, 0x2b, 0x5f, 0xdf, 0x94, 0x53, 0x36, 0xb5, 0x3c, 0x3a, 0x5f, 0x35, 0xc4, 0x27, 0x1d, 0xb2,
+	0x01, 0xd2, 0x64, 0x1f, 0xb1, 0x45, 0xfe, 0xce, 0xc2, 0xda, 0x11, 0xb3, 0x0e, 0xef, 0x77, 0x71,
+	0x8f, 0xea, 0x88, 0x63, 0x4a, 0x0e, 0x7b, 0xd8, 0x30, 0x89, 0x6e, 0x8a, 0x3b, 0xc9, 0x5e, 0x1b,
+	0xa5, 0x3f, 0x7e, 0xf9, 0xa0, 0x18, 0xad, 0xf5, 0x03, 0xc3, 0xf0, 0x4c, 0xc6, 0x5a, 0xdc, 0xc3,
+	0xc4, 0xfa, 0x7f, 0x33, 0xd0, 0x8e, 0xb8, 0x0d, 0xa2, 0x8e, 0x08, 0x25, 0x58, 0x47, 0x27, 0xe3,
+	0xdb, 0xe1, 0xcd, 0x38, 0x32, 0xf0, 0x6e, 0x0d, 0xde, 0xe8, 0x50, 0x6f, 0xc4, 0xe0, 0xc1, 0x8a,
+	0x50, 0xf3, 0xfe, 0xe1, 0x00, 0x43, 0x60, 0x75, 0x98, 0x31, 0xe1, 0xf4, 0xab, 0x2f, 0xe9, 0xf4,
+	0x62, 0x9c, 0x77, 0x74, 0xdf, 0x1b, 0xb0, 0x12, 0x70, 0x4a, 0x94, 0x5a, 0x7c, 0xc9, 0x52, 0xcb,
+	0x7e, 0xca, 0x3b, 0xd3, 0xd6, 0xd7, 0x26, 0x54, 0xa6, 0xb8, 0x2f, 0x76, 0xe8, 0xcf, 0x42, 0xb0,
+	0xe2, 0xbe, 0x22, 0xdf, 0x21, 0x1c, 0xd3, 0x6d, 0x7a, 0xd4, 0xc7, 0x79, 0xff, 0x0d, 0x8f, 0x26,
+	0x7b, 0x7a, 0x07, 0x36, 0xa7, 0xf2, 0x8d, 0xbb, 0xfa, 0x49, 0x80, 0x65, 0x1f, 0xe5, 0x1a, 0x88,
+	0x9b, 0xcd, 0xe0, 0xbe, 0x23, 0xee, 0xc3, 0x22, 0xea, 0x72, 0x9b, 0x7a, 0x98, 0xf7, 0x9f, 0xdb,
+	0xce, 0x10, 0x2a, 0xde, 0x82, 0x85, 0xf0, 0xc6, 0x14, 0xb4, 0x93, 0xaf, 0x5f, 0x4b, 0x5d, 0x14,
+	0x61, 0x91, 0x46, 0xf6, 0xc9, 0x59, 0x65, 0x4e, 0x8d, 0x5e, 0xb8, 0xbd, 0xe4, 0x13, 0x1f, 0xa6,
+	0xaa, 0xad, 0xc3, 0xda, 0x18, 0xab, 0x01, 0xe3, 0xfa, 0xe3, 0x1c, 0x64, 0x8e, 0x98, 0x25, 0xde,
+	0x87, 0x95, 0xc9, 0xbb, 0xd8, 0xfb, 0xa9, 0x25, 0xd3, 0xee, 0x1c, 0xd2, 0xee, 0xcc, 0xd0, 0x41,
+	0x69, 0xd1, 0x86, 0xa5, 0xb1, 0xab, 0xc9, 0xbb, 0xd3, 0x92, 0x24, 0x71, 0x92, 0x3c, 0x1b, 0x2e,
+	0xae, 0x74, 0x0c, 0xcb, 0xe3, 0x1b, 0xff, 0xbd, 0xd9, 0x52, 0x30, 0x49, 0x99, 0x11, 0x18, 0x17,
+	0xfb, 0x1e, 0x8a, 0xa9, 0x7b, 0x77, 0x7b, 0x5a, 0xa2, 0x34, 0xb4, 0xb4, 0x77, 0x19, 0x74, 0x5c,
+	0xfb, 0xa1, 0x00, 0xab, 0x53, 0x3e, 0xa9, 0xa9, 0x9a, 0xa5, 0xe3, 0xa5, 0xfd, 0xcb, 0xe1, 0x63,
+	0x0a, 0x1a, 0x14, 0x12, 0xf6, 0xbf, 0x3e, 0x35, 0xcf, 0x08, 0x4a, 0xda, 0x9e, 0x05, 0x35, 0xa8,
+	0x21, 0xe5, 0x1e, 0x3e, 0x7b, 0x74, 0x43, 0x68, 0x7c, 0xf6, 0xe4, 0xbc, 0x2c, 0x3c, 0x3d, 0x2f,
+	0x0b, 0x7f, 0x9d, 0x97, 0x85, 0x1f, 0x2f, 0xca, 0x73, 0x4f, 0x2f, 0xca, 0x73, 0x7f, 0x5e, 0x94,
+	0xe7, 0xbe, 0xd9, 0x79, 0xde, 0x67, 0xff, 0x60, 0xf8, 0xcf, 0x24, 0xd8, 0x00, 0xda, 0x42, 0xf0,
+	0xb7, 0xe4, 0xe6, 0x3f, 0x03, 0x00, 0x97, 0x47, 0x19, 0xae, 0x56, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,7 +843,14 @@ type MsgClient interface {
 	CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error)
-	// TODO: msg for evidence of equivocation. this is not specified yet
+	// AddFinalitySigs adds finality signatures to a range of blocks
+	AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error)
+	// EquivocationEvidence handles the evidence that a finality provider has
+	// signed two conflicting blocks at the same height. Anyone can submit it
+	EquivocationEvidence(ctx context.Context, in *MsgEquivocationEvidence, opts ...grpc.CallOption) (*MsgEquivocationEvidenceResponse, error)
+	// UnjailFinalityProvider defines a method for unjailing a jailed
+	// finality provider, thus it can receive voting power
+	UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error)
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -472,6 +881,33 @@ func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, o
 	return out, nil
 }
 
+func (c *msgClient) AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error) {
+	out := new(MsgAddFinalitySigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EquivocationEvidence(ctx context.Context, in *MsgEquivocationEvidence, opts ...grpc.CallOption) (*MsgEquivocationEvidenceResponse, error) {
+	out := new(MsgEquivocationEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/EquivocationEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error) {
+	out := new(MsgUnjailFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UnjailFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UpdateParams", in, out, opts...)
@@ -487,7 +923,14 @@ type MsgServer interface {
 	CommitPubRandList(context.Context, *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(context.Context, *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error)
-	// TODO: msg for evidence of equivocation. this is not specified yet
+	// AddFinalitySigs adds finality signatures to a range of blocks
+	AddFinalitySigs(context.Context, *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error)
+	// EquivocationEvidence handles the evidence that a finality provider has
+	// signed two conflicting blocks at the same height. Anyone can submit it
+	EquivocationEvidence(context.Context, *MsgEquivocationEvidence) (*MsgEquivocationEvidenceResponse, error)
+	// UnjailFinalityProvider defines a method for unjailing a jailed
+	// finality provider, thus it can receive voting power
+	UnjailFinalityProvider(context.Context, *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error)
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -502,6 +945,15 @@ func (*UnimplementedMsgServer) CommitPubRandList(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySig not implemented")
 }
+func (*UnimplementedMsgServer) AddFinalitySigs(ctx context.Context, req *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySigs not implemented")
+}
+func (*UnimplementedMsgServer) EquivocationEvidence(ctx context.Context, req *MsgEquivocationEvidence) (*MsgEquivocationEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquivocationEvidence not implemented")
+}
+func (*UnimplementedMsgServer) UnjailFinalityProvider(ctx context.Context, req *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailFinalityProvider not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -546,27 +998,81 @@ func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
+func _Msg_AddFinalitySigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalitySigs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+		return srv.(MsgServer).AddFinalitySigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Msg/UpdateParams",
+		FullMethod: "/babylon.finality.v1.Msg/AddFinalitySigs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+		return srv.(MsgServer).AddFinalitySigs(ctx, req.(*MsgAddFinalitySigs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.finality.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
+func _Msg_EquivocationEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEquivocationEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EquivocationEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/EquivocationEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EquivocationEvidence(ctx, req.(*MsgEquivocationEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/UnjailFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailFinalityProvider(ctx, req.(*MsgUnjailFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.finality.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CommitPubRandList",
@@ -576,6 +1082,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddFinalitySig",
 			Handler:    _Msg_AddFinalitySig_Handler,
 		},
+		{
+			MethodName: "AddFinalitySigs",
+			Handler:    _Msg_AddFinalitySigs_Handler,
+		},
+		{
+			MethodName: "EquivocationEvidence",
+			Handler:    _Msg_EquivocationEvidence_Handler,
+		},
+		{
+			MethodName: "UnjailFinalityProvider",
+			Handler:    _Msg_UnjailFinalityProvider_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -792,7 +1310,7 @@ func (m *MsgAddFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *FinalityVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -802,37 +1320,68 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinalityVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinalityVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockAppHash)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddFinalitySigs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -842,146 +1391,1201 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddFinalitySigs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddFinalitySigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCommitPubRandList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
-	}
-	if m.NumPubRand != 0 {
-		n += 1 + sovTx(uint64(m.NumPubRand))
-	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Sig != nil {
-		l = m.Sig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCommitPubRandListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddFinalitySigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddFinalitySig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.BlockHeight))
-	}
-	if m.PubRand != nil {
-		l = m.PubRand.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BlockAppHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FinalitySig != nil {
-		l = m.FinalitySig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgAddFinalitySigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddFinalitySigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAddFinalitySigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgEquivocationEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgEquivocationEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEquivocationEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForkFinalitySig != nil {
+		{
+			size := m.ForkFinalitySig.Size()
+			i -= size
+			if _, err := m.ForkFinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CanonicalFinalitySig != nil {
+		{
+			size := m.CanonicalFinalitySig.Size()
+			i -= size
+			if _, err := m.CanonicalFinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ForkAppHash) > 0 {
+		i -= len(m.ForkAppHash)
+		copy(dAtA[i:], m.ForkAppHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ForkAppHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CanonicalAppHash) > 0 {
+		i -= len(m.CanonicalAppHash)
+		copy(dAtA[i:], m.CanonicalAppHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CanonicalAppHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEquivocationEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEquivocationEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEquivocationEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCommitPubRandList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovTx(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitPubRandListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFinalitySig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FinalityVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEquivocationEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CanonicalAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ForkAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CanonicalFinalitySig != nil {
+		l = m.CanonicalFinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ForkFinalitySig != nil {
+		l = m.ForkFinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEquivocationEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
+func (m *FinalityVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1004,17 +2608,36 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitPubRandList: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitPubRandList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1024,27 +2647,66 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1071,17 +2733,16 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
-			m.StartHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1091,16 +2752,82 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.NumPubRand = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1110,14 +2837,27 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1144,16 +2884,17 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
-			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1163,24 +2904,23 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.Sig = &v
-			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, &FinalityVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +2945,7 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,10 +2968,10 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1255,7 +2995,7 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+func (m *MsgEquivocationEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,10 +3018,10 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEquivocationEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEquivocationEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1443,7 +3183,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1470,14 +3210,48 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockAppHash == nil {
-				m.BlockAppHash = []byte{}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,8 +3279,43 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.FinalitySig = &v
-			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1531,7 +3340,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEquivocationEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,10 +3363,177 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEquivocationEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEquivocationEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18,9 +18,12 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	// - send a portion of coins in the fee collector account to the incentive module account
 	// - accumulate BTC staking gauge at the current height
 	// - accumulate BTC timestamping gauge at the current epoch
+	// - accumulate BTC header relaying gauge
 	if sdk.UnwrapSDKContext(ctx).HeaderInfo().Height > 0 {
 		k.HandleCoinsInFeeCollector(ctx)
 	}
+	// reward relayers of BTC headers that have become k-deep
+	k.RewardBTCHeaderRelaying(ctx)
 	return nil
 }
 
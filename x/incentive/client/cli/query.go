@@ -26,6 +26,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryRewardGauges(),
 		CmdQueryBTCStakingGauge(),
 		CmdQueryBTCTimestampingGauge(),
+		CmdQueryBTCHeaderRelayingGauge(),
 	)
 
 	return cmd
@@ -130,3 +131,30 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBTCHeaderRelayingGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-header-relaying-gauge",
+		Short: "shows the gauge of rewards for BTC header relayers that are not distributed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCHeaderRelayingGauge(cmd.Context(), &types.QueryBTCHeaderRelayingGaugeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,15 +8,21 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
-	if err := k.SetParams(ctx, genState.Params); err != nil {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	if err := k.InitGenesis(ctx, genState); err != nil {
 		panic(err)
 	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params = k.GetParams(ctx)
+	genesis, err := k.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
 
 	return genesis
 }
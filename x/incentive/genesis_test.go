@@ -7,6 +7,8 @@ import (
 	"github.com/babylonchain/babylon/testutil/nullify"
 	"github.com/babylonchain/babylon/x/incentive"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,7 +17,13 @@ func TestGenesis(t *testing.T) {
 		Params: types.DefaultParams(),
 	}
 
-	k, ctx := keepertest.IncentiveKeeper(t, nil, nil, nil)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// the incentive module account holds nothing at genesis
+	bk := types.NewMockBankKeeper(ctrl)
+	bk.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(sdk.NewCoins()).Times(1)
+
+	k, ctx := keepertest.IncentiveKeeper(t, bk, nil, nil)
 	incentive.InitGenesis(ctx, *k, genesisState)
 	got := incentive.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardBTCHeaderRelaying distributes the BTC header relaying gauge to the relayers
+// of the BTC headers that have become k-deep since the last distribution, where
+// k is the BTC confirmation depth. Each such header relayed through a MsgInsertHeaders
+// earns its relayer an equal share of the gauge. Headers that are rolled back before
+// becoming k-deep are no longer in the main chain and thus never rewarded.
+// It is invoked upon every `BeginBlock`.
+func (k Keeper) RewardBTCHeaderRelaying(ctx context.Context) {
+	tip := k.btclcKeeper.GetTipInfo(ctx)
+	if tip == nil {
+		return
+	}
+	depth := k.btccKeeper.GetParams(ctx).BtcConfirmationDepth
+	if tip.Height < depth {
+		// no header is k-deep yet
+		return
+	}
+	// the highest header that is k-deep
+	confirmedHeight := tip.Height - depth
+
+	if !k.hasLastRewardedBTCHeight(ctx) {
+		// start rewarding BTC headers relayed after this point
+		k.setLastRewardedBTCHeight(ctx, confirmedHeight)
+		return
+	}
+	lastRewardedHeight := k.GetLastRewardedBTCHeight(ctx)
+	if confirmedHeight <= lastRewardedHeight {
+		return
+	}
+
+	// count the k-deep headers relayed by each relayer, in the order of
+	// their first relayed header for determinism
+	relayers := make([]sdk.AccAddress, 0)
+	numHeaders := make(map[string]int64)
+	totalNumHeaders := int64(0)
+	for height := lastRewardedHeight + 1; height <= confirmedHeight; height++ {
+		header := k.btclcKeeper.GetHeaderByHeight(ctx, height)
+		if header == nil {
+			// the main chain is shorter than the confirmed height, which
+			// can only be a programming error
+			panic("failed to get a k-deep BTC header")
+		}
+		relayer := k.btclcKeeper.GetHeaderReporter(ctx, header.Hash)
+		if relayer == nil {
+			// the header is not relayed through a MsgInsertHeaders
+			continue
+		}
+		if _, ok := numHeaders[relayer.String()]; !ok {
+			relayers = append(relayers, relayer)
+		}
+		numHeaders[relayer.String()]++
+		totalNumHeaders++
+	}
+	k.setLastRewardedBTCHeight(ctx, confirmedHeight)
+
+	// if no header is relayed, keep the gauge for the following headers
+	gauge := k.GetBTCHeaderRelayingGauge(ctx)
+	if totalNumHeaders == 0 || gauge == nil {
+		return
+	}
+
+	// distribute the gauge equally to each relayed header
+	coinsPerHeader := gauge.GetCoinsPortion(math.LegacyOneDec().QuoInt64(totalNumHeaders))
+	if !coinsPerHeader.IsAllPositive() {
+		return
+	}
+	for _, relayer := range relayers {
+		reward := coinsPerHeader.MulInt(math.NewInt(numHeaders[relayer.String()]))
+		k.accumulateRewardGauge(ctx, types.BTCHeaderRelayerType, relayer, reward)
+		gauge.Coins = gauge.Coins.Sub(reward...)
+	}
+	// the rest due to truncation remains in the gauge
+	k.SetBTCHeaderRelayingGauge(ctx, gauge)
+}
+
+func (k Keeper) accumulateBTCHeaderRelayingReward(ctx context.Context, btcHeaderRelayingReward sdk.Coins) {
+	// update BTC header relaying reward gauge
+	gauge := k.GetBTCHeaderRelayingGauge(ctx)
+	if gauge == nil {
+		// if there is no gauge yet, create a new one
+		gauge = types.NewGauge(btcHeaderRelayingReward...)
+	} else {
+		// if there is a gauge already, accumulate coins in the gauge
+		gauge.Coins = gauge.Coins.Add(btcHeaderRelayingReward...)
+	}
+
+	k.SetBTCHeaderRelayingGauge(ctx, gauge)
+
+	// transfer the BTC header relaying reward from fee collector account to incentive module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcHeaderRelayingReward)
+	if err != nil {
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+}
+
+func (k Keeper) SetBTCHeaderRelayingGauge(ctx context.Context, gauge *types.Gauge) {
+	store := k.storeService.OpenKVStore(ctx)
+	gaugeBytes := k.cdc.MustMarshal(gauge)
+	if err := store.Set(types.BTCHeaderRelayingGaugeKey, gaugeBytes); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) GetBTCHeaderRelayingGauge(ctx context.Context) *types.Gauge {
+	store := k.storeService.OpenKVStore(ctx)
+	gaugeBytes, err := store.Get(types.BTCHeaderRelayingGaugeKey)
+	if err != nil {
+		panic(err)
+	}
+	if gaugeBytes == nil {
+		return nil
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+	return &gauge
+}
+
+func (k Keeper) setLastRewardedBTCHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.LastRewardedBTCHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// hasLastRewardedBTCHeight returns whether BTC header relaying rewards are
+// started, i.e., whether the height of the last rewarded BTC header is set
+func (k Keeper) hasLastRewardedBTCHeight(ctx context.Context) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.LastRewardedBTCHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// GetLastRewardedBTCHeight returns the height of the last BTC header whose relayer
+// has been rewarded, or zero if BTC header relaying rewards are not started yet
+func (k Keeper) GetLastRewardedBTCHeight(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	heightBytes, err := store.Get(types.LastRewardedBTCHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if heightBytes == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(heightBytes)
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzRewardBTCHeaderRelaying(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint keepers
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		k := datagen.RandomInt(r, 10) + 1
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.Params{BtcConfirmationDepth: k}).AnyTimes()
+
+		keeper, ctx := testkeeper.IncentiveKeeperWithBTCKeepers(t, nil, nil, nil, btclcKeeper, btccKeeper)
+
+		// a random main chain, where each header on top of the base header is
+		// relayed by one of a few relayers or by nobody
+		baseHeight := datagen.RandomInt(r, 1000) + k
+		chainLen := datagen.RandomInt(r, 50) + 1
+		tipHeight := baseHeight + chainLen + k
+		relayers := []sdk.AccAddress{
+			datagen.GenRandomAccount().GetAddress(),
+			datagen.GenRandomAccount().GetAddress(),
+			datagen.GenRandomAccount().GetAddress(),
+		}
+		headers := make(map[uint64]*btclctypes.BTCHeaderInfo)
+		headerRelayers := make(map[string]sdk.AccAddress) // key: header hash
+		for height := baseHeight - k; height <= tipHeight; height++ {
+			header := datagen.GenRandomBTCHeaderInfo(r)
+			header.Height = height
+			headers[height] = header
+			if height > baseHeight && !datagen.OneInN(r, 4) {
+				headerRelayers[header.Hash.String()] = relayers[r.Intn(len(relayers))]
+			}
+		}
+		btclcKeeper.EXPECT().GetHeaderByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, height uint64) *btclctypes.BTCHeaderInfo {
+				return headers[height]
+			}).AnyTimes()
+		btclcKeeper.EXPECT().GetHeaderReporter(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, hash *bbn.BTCHeaderHashBytes) sdk.AccAddress {
+				return headerRelayers[hash.String()]
+			}).AnyTimes()
+
+		// at first the tip is the base header, from which rewarding starts
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(headers[baseHeight]).Times(1)
+		keeper.RewardBTCHeaderRelaying(ctx)
+		require.Equal(t, baseHeight-k, keeper.GetLastRewardedBTCHeight(ctx))
+
+		// set a random gauge, then extend the chain so that the headers up
+		// to baseHeight+chainLen are k-deep
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCHeaderRelayingGauge(ctx, gauge)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(headers[tipHeight]).Times(1)
+		keeper.RewardBTCHeaderRelaying(ctx)
+		require.Equal(t, baseHeight+chainLen, keeper.GetLastRewardedBTCHeight(ctx))
+
+		// each relayed header earns an equal share of the gauge
+		numHeaders := make(map[string]int64)
+		total := int64(0)
+		for height := baseHeight + 1; height <= baseHeight+chainLen; height++ {
+			if relayer, ok := headerRelayers[headers[height].Hash.String()]; ok {
+				numHeaders[relayer.String()]++
+				total++
+			}
+		}
+		distributed := sdk.NewCoins()
+		for _, relayer := range relayers {
+			rg := keeper.GetRewardGauge(ctx, types.BTCHeaderRelayerType, relayer)
+			n := numHeaders[relayer.String()]
+			if total == 0 || n == 0 {
+				require.Nil(t, rg)
+				continue
+			}
+			coinsPerHeader := gauge.GetCoinsPortion(math.LegacyOneDec().QuoInt64(total))
+			if !coinsPerHeader.IsAllPositive() {
+				require.Nil(t, rg)
+				continue
+			}
+			require.NotNil(t, rg)
+			require.Equal(t, coinsPerHeader.MulInt(math.NewInt(n)), rg.Coins)
+			distributed = distributed.Add(rg.Coins...)
+		}
+		// the rest remains in the gauge
+		newGauge := keeper.GetBTCHeaderRelayingGauge(ctx)
+		require.Equal(t, gauge.Coins, newGauge.Coins.Add(distributed...))
+	})
+}
+
+func TestRewardBTCHeaderRelayingFromHeightZero(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	k := datagen.RandomInt(r, 10) + 1
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.Params{BtcConfirmationDepth: k}).AnyTimes()
+
+	keeper, ctx := testkeeper.IncentiveKeeperWithBTCKeepers(t, nil, nil, nil, btclcKeeper, btccKeeper)
+
+	// a main chain from the BTC genesis, where each header is relayed by the
+	// same relayer
+	relayer := datagen.GenRandomAccount().GetAddress()
+	headers := make(map[uint64]*btclctypes.BTCHeaderInfo)
+	for height := uint64(0); height <= 2*k; height++ {
+		header := datagen.GenRandomBTCHeaderInfo(r)
+		header.Height = height
+		headers[height] = header
+	}
+	btclcKeeper.EXPECT().GetHeaderByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height uint64) *btclctypes.BTCHeaderInfo {
+			return headers[height]
+		}).AnyTimes()
+	btclcKeeper.EXPECT().GetHeaderReporter(gomock.Any(), gomock.Any()).Return(relayer).AnyTimes()
+
+	// the header at height 0 becomes k-deep, from which rewarding starts
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(headers[k]).Times(2)
+	keeper.RewardBTCHeaderRelaying(ctx)
+	require.Zero(t, keeper.GetLastRewardedBTCHeight(ctx))
+	gs, err := keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.True(t, gs.BtcHeaderRelayingStarted)
+
+	// rewarding is started already, so the same tip does not reward anything
+	gauge := datagen.GenRandomGauge(r)
+	keeper.SetBTCHeaderRelayingGauge(ctx, gauge)
+	keeper.RewardBTCHeaderRelaying(ctx)
+	require.Nil(t, keeper.GetRewardGauge(ctx, types.BTCHeaderRelayerType, relayer))
+
+	// the headers since height 1 are rewarded once they become k-deep
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(headers[2*k]).Times(1)
+	keeper.RewardBTCHeaderRelaying(ctx)
+	require.Equal(t, k, keeper.GetLastRewardedBTCHeight(ctx))
+	rg := keeper.GetRewardGauge(ctx, types.BTCHeaderRelayerType, relayer)
+	coinsPerHeader := gauge.GetCoinsPortion(math.LegacyOneDec().QuoInt64(int64(k)))
+	if coinsPerHeader.IsAllPositive() {
+		require.NotNil(t, rg)
+		require.Equal(t, coinsPerHeader.MulInt(math.NewInt(int64(k))), rg.Coins)
+	}
+}
@@ -24,7 +24,9 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		// get coins that will be allocated to the finality provider and its BTC delegations
 		fpPortion := filteredDc.GetFinalityProviderPortion(fp)
 		coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
-		// reward the finality provider with commission
+		// reward the finality provider with commission, where the commission
+		// rate is the one in effect at this height, as recorded in the
+		// voting power distribution cache of this height
 		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
 		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
 		// reward the rest of coins to each BTC delegation proportional to its voting power portion
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+// It ensures the incentive module account holds exactly the coins that are not
+// withdrawn from the gauges yet.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	for _, entry := range gs.BtcStakingGauges {
+		k.SetBTCStakingGauge(ctx, entry.Height, entry.Gauge)
+	}
+
+	for _, entry := range gs.BtcTimestampingGauges {
+		k.SetBTCTimestampingGauge(ctx, entry.EpochNumber, entry.Gauge)
+	}
+
+	if gs.BtcHeaderRelayingGauge != nil {
+		k.SetBTCHeaderRelayingGauge(ctx, gs.BtcHeaderRelayingGauge)
+	}
+	// a non-zero last rewarded BTC height implies that BTC header relaying
+	// rewards are started, e.g., in a genesis exported without the flag
+	if gs.BtcHeaderRelayingStarted || gs.LastRewardedBtcHeight > 0 {
+		k.setLastRewardedBTCHeight(ctx, gs.LastRewardedBtcHeight)
+	}
+
+	for _, entry := range gs.RewardGauges {
+		sType, err := types.NewStakeHolderTypeFromString(entry.StakeholderType)
+		if err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return err
+		}
+		k.SetRewardGauge(ctx, sType, addr, entry.RewardGauge)
+	}
+
+	moduleBalance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	if expected := gs.ModuleBalance(); !moduleBalance.Equal(expected) {
+		return fmt.Errorf("incentive module account balance %s does not match the balance %s of gauges", moduleBalance, expected)
+	}
+
+	return k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	btcStakingGauges, err := k.btcStakingGauges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	btcTimestampingGauges, err := k.btcTimestampingGauges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardGauges, err := k.rewardGauges(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:                   k.GetParams(ctx),
+		BtcStakingGauges:         btcStakingGauges,
+		BtcTimestampingGauges:    btcTimestampingGauges,
+		RewardGauges:             rewardGauges,
+		BtcHeaderRelayingGauge:   k.GetBTCHeaderRelayingGauge(ctx),
+		LastRewardedBtcHeight:    k.GetLastRewardedBTCHeight(ctx),
+		BtcHeaderRelayingStarted: k.hasLastRewardedBTCHeight(ctx),
+	}, nil
+}
+
+// btcStakingGauges loads the BTC staking gauges of all heights stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) btcStakingGauges(ctx context.Context) ([]*types.BTCStakingGaugeEntry, error) {
+	entries := make([]*types.BTCStakingGaugeEntry, 0)
+
+	iter := k.btcStakingGaugeStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var gauge types.Gauge
+		if err := k.cdc.Unmarshal(iter.Value(), &gauge); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.BTCStakingGaugeEntry{
+			Height: sdk.BigEndianToUint64(iter.Key()),
+			Gauge:  &gauge,
+		})
+	}
+
+	return entries, nil
+}
+
+// btcTimestampingGauges loads the BTC timestamping gauges of all epochs stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) btcTimestampingGauges(ctx context.Context) ([]*types.BTCTimestampingGaugeEntry, error) {
+	entries := make([]*types.BTCTimestampingGaugeEntry, 0)
+
+	iter := k.btcTimestampingGaugeStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var gauge types.Gauge
+		if err := k.cdc.Unmarshal(iter.Value(), &gauge); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.BTCTimestampingGaugeEntry{
+			EpochNumber: sdk.BigEndianToUint64(iter.Key()),
+			Gauge:       &gauge,
+		})
+	}
+
+	return entries, nil
+}
+
+// rewardGauges loads the reward gauges of all stakeholders in all types.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) rewardGauges(ctx context.Context) ([]*types.RewardGaugeEntry, error) {
+	entries := make([]*types.RewardGaugeEntry, 0)
+
+	for _, sType := range types.GetAllStakeholderTypes() {
+		iter := k.rewardGaugeStore(ctx, sType).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			var rg types.RewardGauge
+			if err := k.cdc.Unmarshal(iter.Value(), &rg); err != nil {
+				iter.Close()
+				return nil, err
+			}
+			entries = append(entries, &types.RewardGaugeEntry{
+				StakeholderType: sType.String(),
+				Address:         sdk.AccAddress(iter.Key()).String(),
+				RewardGauge:     &rg,
+			})
+		}
+		iter.Close()
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+func FuzzExportImportGenesis(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		bk := types.NewMockBankKeeper(ctrl)
+		ik, ctx := testkeeper.IncentiveKeeper(t, bk, nil, nil)
+
+		// set random gauges, each of which is distributed to a stakeholder
+		// that has withdrawn a random subset of its reward
+		numGauges := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numGauges; i++ {
+			gauge := datagen.GenRandomGauge(r)
+			if datagen.OneInN(r, 2) {
+				ik.SetBTCStakingGauge(ctx, i, gauge)
+			} else {
+				ik.SetBTCTimestampingGauge(ctx, i, gauge)
+			}
+			rg := types.NewRewardGauge(gauge.Coins...)
+			rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+			sType := datagen.GenRandomStakeholderType(r)
+			sAddr := datagen.GenRandomAccount().GetAddress()
+			ik.SetRewardGauge(ctx, sType, sAddr, rg)
+		}
+
+		// set random state of BTC header relaying rewards
+		if datagen.OneInN(r, 2) {
+			ik.SetBTCHeaderRelayingGauge(ctx, datagen.GenRandomGauge(r))
+		}
+
+		gs, err := ik.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Equal(t, int(numGauges), len(gs.BtcStakingGauges)+len(gs.BtcTimestampingGauges))
+		require.Len(t, gs.RewardGauges, int(numGauges))
+
+		// import fails if the module account balance does not match the gauges
+		newIk, newCtx := testkeeper.IncentiveKeeper(t, bk, nil, nil)
+		moduleBalance := gs.ModuleBalance()
+		bk.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(moduleBalance.Add(datagen.GenRandomCoins(r)...)).Times(1)
+		require.Error(t, newIk.InitGenesis(newCtx, *gs))
+
+		// import the exported state into a fresh keeper
+		newIk, newCtx = testkeeper.IncentiveKeeper(t, bk, nil, nil)
+		bk.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(moduleBalance).Times(1)
+		require.NoError(t, newIk.InitGenesis(newCtx, *gs))
+
+		for _, entry := range gs.BtcStakingGauges {
+			require.Equal(t, ik.GetBTCStakingGauge(ctx, entry.Height), newIk.GetBTCStakingGauge(newCtx, entry.Height))
+		}
+		for _, entry := range gs.BtcTimestampingGauges {
+			require.Equal(t, ik.GetBTCTimestampingGauge(ctx, entry.EpochNumber), newIk.GetBTCTimestampingGauge(newCtx, entry.EpochNumber))
+		}
+
+		require.Equal(t, ik.GetBTCHeaderRelayingGauge(ctx), newIk.GetBTCHeaderRelayingGauge(newCtx))
+
+		exported, err := newIk.ExportGenesis(newCtx)
+		require.NoError(t, err)
+		require.Equal(t, gs, exported)
+	})
+}
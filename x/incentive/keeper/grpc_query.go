@@ -71,3 +71,21 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 
 	return &types.QueryBTCTimestampingGaugeResponse{Gauge: gauge}, nil
 }
+
+func (k Keeper) BTCHeaderRelayingGauge(goCtx context.Context, req *types.QueryBTCHeaderRelayingGaugeRequest) (*types.QueryBTCHeaderRelayingGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// find gauge
+	gauge := k.GetBTCHeaderRelayingGauge(ctx)
+	if gauge == nil {
+		return nil, types.ErrBTCHeaderRelayingGaugeNotFound
+	}
+
+	return &types.QueryBTCHeaderRelayingGaugeResponse{
+		Gauge:                 gauge,
+		LastRewardedBtcHeight: k.GetLastRewardedBTCHeight(ctx),
+	}, nil
+}
@@ -51,8 +51,10 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		params := keeper.GetParams(ctx)
 		feesForBTCStaking := types.GetCoinsPortion(fees, params.BTCStakingPortion())
 		feesForBTCTimestamping := types.GetCoinsPortion(fees, params.BTCTimestampingPortion())
+		feesForBTCHeaderRelaying := types.GetCoinsPortion(fees, params.BTCHeaderRelayingPortion())
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCStaking)).Times(1)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCHeaderRelaying)).Times(1)
 
 		// handle coins in fee collector
 		keeper.HandleCoinsInFeeCollector(ctx)
@@ -69,6 +71,11 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		require.NotNil(t, btcTimestampingGauge)
 		require.Equal(t, btcTimestampingFee, btcTimestampingGauge.Coins)
 
+		// assert correctness of BTC header relaying gauge
+		btcHeaderRelayingGauge := keeper.GetBTCHeaderRelayingGauge(ctx)
+		require.NotNil(t, btcHeaderRelayingGauge)
+		require.Equal(t, feesForBTCHeaderRelaying, btcHeaderRelayingGauge.Coins)
+
 		// accumulate for this epoch again and see if the epoch's BTC timestamping gauge has accumulated or not
 		height += 1
 		ctx = datagen.WithCtxHeight(ctx, height)
@@ -77,6 +84,7 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(1)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCStaking)).Times(1)
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCHeaderRelaying)).Times(1)
 		// handle coins in fee collector
 		keeper.HandleCoinsInFeeCollector(ctx)
 		// assert BTC timestamping gauge has doubled
@@ -16,6 +16,8 @@ type (
 		storeService corestoretypes.KVStoreService
 
 		epochingKeeper types.EpochingKeeper
+		btclcKeeper    types.BTCLightClientKeeper
+		btccKeeper     types.BtcCheckpointKeeper
 		bankKeeper     types.BankKeeper
 		accountKeeper  types.AccountKeeper
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -32,6 +34,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
 	epochingKeeper types.EpochingKeeper,
+	btclcKeeper types.BTCLightClientKeeper,
+	btccKeeper types.BtcCheckpointKeeper,
 	authority string,
 	feeCollectorName string,
 ) Keeper {
@@ -39,6 +43,8 @@ func NewKeeper(
 		cdc:              cdc,
 		storeService:     storeService,
 		epochingKeeper:   epochingKeeper,
+		btclcKeeper:      btclcKeeper,
+		btccKeeper:       btccKeeper,
 		bankKeeper:       bankKeeper,
 		accountKeeper:    accountKeeper,
 		authority:        authority,
@@ -2,6 +2,9 @@ package types
 
 import (
 	"context"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,3 +24,13 @@ type BankKeeper interface {
 type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
 }
+
+type BTCLightClientKeeper interface {
+	GetTipInfo(ctx context.Context) *btclctypes.BTCHeaderInfo
+	GetHeaderByHeight(ctx context.Context, height uint64) *btclctypes.BTCHeaderInfo
+	GetHeaderReporter(ctx context.Context, hash *bbn.BTCHeaderHashBytes) sdk.AccAddress
+}
+
+type BtcCheckpointKeeper interface {
+	GetParams(ctx context.Context) (p btcctypes.Params)
+}
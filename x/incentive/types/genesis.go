@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +16,120 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	totalGauges := sdk.NewCoins()
+
+	heights := make(map[uint64]struct{})
+	for _, entry := range gs.BtcStakingGauges {
+		if _, ok := heights[entry.Height]; ok {
+			return fmt.Errorf("duplicate BTC staking gauge at height %d", entry.Height)
+		}
+		heights[entry.Height] = struct{}{}
+		if err := validateGauge(entry.Gauge); err != nil {
+			return fmt.Errorf("invalid BTC staking gauge at height %d: %w", entry.Height, err)
+		}
+		totalGauges = totalGauges.Add(entry.Gauge.Coins...)
+	}
+
+	epochs := make(map[uint64]struct{})
+	for _, entry := range gs.BtcTimestampingGauges {
+		if _, ok := epochs[entry.EpochNumber]; ok {
+			return fmt.Errorf("duplicate BTC timestamping gauge at epoch %d", entry.EpochNumber)
+		}
+		epochs[entry.EpochNumber] = struct{}{}
+		if err := validateGauge(entry.Gauge); err != nil {
+			return fmt.Errorf("invalid BTC timestamping gauge at epoch %d: %w", entry.EpochNumber, err)
+		}
+		totalGauges = totalGauges.Add(entry.Gauge.Coins...)
+	}
+
+	if gs.BtcHeaderRelayingGauge != nil {
+		if err := validateGauge(gs.BtcHeaderRelayingGauge); err != nil {
+			return fmt.Errorf("invalid BTC header relaying gauge: %w", err)
+		}
+	}
+
+	totalRewards := sdk.NewCoins()
+	rewardGauges := make(map[string]struct{})
+	for _, entry := range gs.RewardGauges {
+		sType, err := NewStakeHolderTypeFromString(entry.StakeholderType)
+		if err != nil {
+			return fmt.Errorf("invalid stakeholder type %q: %w", entry.StakeholderType, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return fmt.Errorf("invalid address of %s stakeholder: %w", sType, err)
+		}
+		key := sType.String() + "/" + entry.Address
+		if _, ok := rewardGauges[key]; ok {
+			return fmt.Errorf("duplicate reward gauge of %s stakeholder %s", sType, entry.Address)
+		}
+		rewardGauges[key] = struct{}{}
+		if err := validateRewardGauge(entry.RewardGauge); err != nil {
+			return fmt.Errorf("invalid reward gauge of %s stakeholder %s: %w", sType, entry.Address, err)
+		}
+		if sType == BTCHeaderRelayerType {
+			// rewards of BTC header relayers are deducted from the BTC header relaying gauge
+			continue
+		}
+		totalRewards = totalRewards.Add(entry.RewardGauge.Coins...)
+	}
+
+	// rewards can only be distributed from the gauges
+	if !totalGauges.IsAllGTE(totalRewards) {
+		return fmt.Errorf("total rewards %s exceed the total coins %s in gauges", totalRewards, totalGauges)
+	}
+
+	return nil
+}
+
+// ModuleBalance returns the balance that the incentive module account must hold
+// for the genesis state, i.e., all coins that have entered the gauges minus
+// all coins withdrawn by stakeholders. As the BTC header relaying gauge only
+// holds undistributed coins, the rewards of BTC header relayers are added back.
+func (gs GenesisState) ModuleBalance() sdk.Coins {
+	balance := sdk.NewCoins()
+	for _, entry := range gs.BtcStakingGauges {
+		balance = balance.Add(entry.Gauge.Coins...)
+	}
+	for _, entry := range gs.BtcTimestampingGauges {
+		balance = balance.Add(entry.Gauge.Coins...)
+	}
+	if gs.BtcHeaderRelayingGauge != nil {
+		balance = balance.Add(gs.BtcHeaderRelayingGauge.Coins...)
+	}
+	for _, entry := range gs.RewardGauges {
+		if entry.StakeholderType == BTCHeaderRelayerType.String() {
+			// rewards of BTC header relayers are deducted from the BTC header
+			// relaying gauge when distributed
+			balance = balance.Add(entry.RewardGauge.Coins...)
+		}
+		balance = balance.Sub(entry.RewardGauge.WithdrawnCoins...)
+	}
+	return balance
+}
+
+func validateGauge(gauge *Gauge) error {
+	if gauge == nil {
+		return fmt.Errorf("gauge is nil")
+	}
+	return gauge.Coins.Validate()
+}
+
+func validateRewardGauge(rg *RewardGauge) error {
+	if rg == nil {
+		return fmt.Errorf("reward gauge is nil")
+	}
+	if err := rg.Coins.Validate(); err != nil {
+		return err
+	}
+	if err := rg.WithdrawnCoins.Validate(); err != nil {
+		return err
+	}
+	if !rg.Coins.IsAllGTE(rg.WithdrawnCoins) {
+		return fmt.Errorf("withdrawn coins %s exceed the rewarded coins %s", rg.WithdrawnCoins, rg.Coins)
+	}
+	return nil
 }
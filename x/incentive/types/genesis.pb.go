@@ -26,6 +26,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the incentive module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// btc_staking_gauges are the gauges of rewards for BTC staking at each height
+	BtcStakingGauges []*BTCStakingGaugeEntry `protobuf:"bytes,2,rep,name=btc_staking_gauges,json=btcStakingGauges,proto3" json:"btc_staking_gauges,omitempty"`
+	// btc_timestamping_gauges are the gauges of rewards for BTC timestamping at each epoch
+	BtcTimestampingGauges []*BTCTimestampingGaugeEntry `protobuf:"bytes,3,rep,name=btc_timestamping_gauges,json=btcTimestampingGauges,proto3" json:"btc_timestamping_gauges,omitempty"`
+	// reward_gauges are the reward gauges of all stakeholders
+	RewardGauges []*RewardGaugeEntry `protobuf:"bytes,4,rep,name=reward_gauges,json=rewardGauges,proto3" json:"reward_gauges,omitempty"`
+	// btc_header_relaying_gauge is the gauge of rewards for BTC header relayers
+	// that are not distributed yet
+	BtcHeaderRelayingGauge *Gauge `protobuf:"bytes,5,opt,name=btc_header_relaying_gauge,json=btcHeaderRelayingGauge,proto3" json:"btc_header_relaying_gauge,omitempty"`
+	// last_rewarded_btc_height is the height of the last BTC header whose relayer
+	// has been rewarded. It is only meaningful if btc_header_relaying_started is true
+	LastRewardedBtcHeight uint64 `protobuf:"varint,6,opt,name=last_rewarded_btc_height,json=lastRewardedBtcHeight,proto3" json:"last_rewarded_btc_height,omitempty"`
+	// btc_header_relaying_started is whether BTC header relaying rewards are
+	// started, i.e., whether last_rewarded_btc_height is set
+	BtcHeaderRelayingStarted bool `protobuf:"varint,7,opt,name=btc_header_relaying_started,json=btcHeaderRelayingStarted,proto3" json:"btc_header_relaying_started,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,27 +83,268 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBtcStakingGauges() []*BTCStakingGaugeEntry {
+	if m != nil {
+		return m.BtcStakingGauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBtcTimestampingGauges() []*BTCTimestampingGaugeEntry {
+	if m != nil {
+		return m.BtcTimestampingGauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardGauges() []*RewardGaugeEntry {
+	if m != nil {
+		return m.RewardGauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBtcHeaderRelayingGauge() *Gauge {
+	if m != nil {
+		return m.BtcHeaderRelayingGauge
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastRewardedBtcHeight() uint64 {
+	if m != nil {
+		return m.LastRewardedBtcHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBtcHeaderRelayingStarted() bool {
+	if m != nil {
+		return m.BtcHeaderRelayingStarted
+	}
+	return false
+}
+
+// BTCStakingGaugeEntry is the gauge of rewards for BTC staking at a given height
+type BTCStakingGaugeEntry struct {
+	// height is the Babylon height of the gauge
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gauge is the gauge of rewards for BTC staking at this height
+	Gauge *Gauge `protobuf:"bytes,2,opt,name=gauge,proto3" json:"gauge,omitempty"`
+}
+
+func (m *BTCStakingGaugeEntry) Reset()         { *m = BTCStakingGaugeEntry{} }
+func (m *BTCStakingGaugeEntry) String() string { return proto.CompactTextString(m) }
+func (*BTCStakingGaugeEntry) ProtoMessage()    {}
+func (*BTCStakingGaugeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41d5400dc6b4b931, []int{1}
+}
+func (m *BTCStakingGaugeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCStakingGaugeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCStakingGaugeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCStakingGaugeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCStakingGaugeEntry.Merge(m, src)
+}
+func (m *BTCStakingGaugeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCStakingGaugeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCStakingGaugeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCStakingGaugeEntry proto.InternalMessageInfo
+
+func (m *BTCStakingGaugeEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BTCStakingGaugeEntry) GetGauge() *Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return nil
+}
+
+// BTCTimestampingGaugeEntry is the gauge of rewards for BTC timestamping at a given epoch
+type BTCTimestampingGaugeEntry struct {
+	// epoch_number is the epoch number of the gauge
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// gauge is the gauge of rewards for BTC timestamping at this epoch
+	Gauge *Gauge `protobuf:"bytes,2,opt,name=gauge,proto3" json:"gauge,omitempty"`
+}
+
+func (m *BTCTimestampingGaugeEntry) Reset()         { *m = BTCTimestampingGaugeEntry{} }
+func (m *BTCTimestampingGaugeEntry) String() string { return proto.CompactTextString(m) }
+func (*BTCTimestampingGaugeEntry) ProtoMessage()    {}
+func (*BTCTimestampingGaugeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41d5400dc6b4b931, []int{2}
+}
+func (m *BTCTimestampingGaugeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCTimestampingGaugeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCTimestampingGaugeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCTimestampingGaugeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCTimestampingGaugeEntry.Merge(m, src)
+}
+func (m *BTCTimestampingGaugeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCTimestampingGaugeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCTimestampingGaugeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCTimestampingGaugeEntry proto.InternalMessageInfo
+
+func (m *BTCTimestampingGaugeEntry) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *BTCTimestampingGaugeEntry) GetGauge() *Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return nil
+}
+
+// RewardGaugeEntry is the reward gauge of a stakeholder in a given type
+type RewardGaugeEntry struct {
+	// stakeholder_type is the type of the stakeholder, i.e., one of
+	// submitter, reporter, finality_provider, btc_delegation and btc_header_relayer
+	StakeholderType string `protobuf:"bytes,1,opt,name=stakeholder_type,json=stakeholderType,proto3" json:"stakeholder_type,omitempty"`
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// reward_gauge is the reward gauge holding all rewards of the stakeholder
+	// in this type
+	RewardGauge *RewardGauge `protobuf:"bytes,3,opt,name=reward_gauge,json=rewardGauge,proto3" json:"reward_gauge,omitempty"`
+}
+
+func (m *RewardGaugeEntry) Reset()         { *m = RewardGaugeEntry{} }
+func (m *RewardGaugeEntry) String() string { return proto.CompactTextString(m) }
+func (*RewardGaugeEntry) ProtoMessage()    {}
+func (*RewardGaugeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41d5400dc6b4b931, []int{3}
+}
+func (m *RewardGaugeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardGaugeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardGaugeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardGaugeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardGaugeEntry.Merge(m, src)
+}
+func (m *RewardGaugeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardGaugeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardGaugeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardGaugeEntry proto.InternalMessageInfo
+
+func (m *RewardGaugeEntry) GetStakeholderType() string {
+	if m != nil {
+		return m.StakeholderType
+	}
+	return ""
+}
+
+func (m *RewardGaugeEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardGaugeEntry) GetRewardGauge() *RewardGauge {
+	if m != nil {
+		return m.RewardGauge
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.incentive.GenesisState")
+	proto.RegisterType((*BTCStakingGaugeEntry)(nil), "babylon.incentive.BTCStakingGaugeEntry")
+	proto.RegisterType((*BTCTimestampingGaugeEntry)(nil), "babylon.incentive.BTCTimestampingGaugeEntry")
+	proto.RegisterType((*RewardGaugeEntry)(nil), "babylon.incentive.RewardGaugeEntry")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/genesis.proto", fileDescriptor_41d5400dc6b4b931) }
 
 var fileDescriptor_41d5400dc6b4b931 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0x2a, 0xd0,
-	0x83, 0x2b, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58, 0x10, 0x85, 0x52,
-	0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x52, 0x72, 0xe7, 0xe2, 0x71, 0x87,
-	0x98, 0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0x91, 0x97, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x49, 0x2f, 0x00, 0xac, 0xc0, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x72, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c,
-	0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63,
-	0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0x96,
-	0x9c, 0x91, 0x98, 0x99, 0x07, 0xe3, 0xe8, 0x57, 0x20, 0x39, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x38, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xa1, 0x97, 0x7e, 0x08,
-	0x01, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6f, 0xd3, 0x3e,
+	0x14, 0xc7, 0x9b, 0xb5, 0xeb, 0x7e, 0x73, 0xfb, 0x13, 0xc5, 0xda, 0x86, 0x5b, 0xa4, 0xac, 0x2b,
+	0x07, 0x8a, 0x84, 0x52, 0x31, 0x0e, 0x3b, 0x71, 0xa0, 0x08, 0x6d, 0x12, 0x12, 0x42, 0x6e, 0xb9,
+	0x70, 0x20, 0x72, 0x92, 0xa7, 0x24, 0xa2, 0x4d, 0x22, 0xdb, 0x05, 0xf2, 0x5f, 0x70, 0x86, 0x7f,
+	0x68, 0xc7, 0x1d, 0x39, 0x21, 0xd4, 0xfe, 0x23, 0xc8, 0x76, 0x5a, 0x22, 0x12, 0x40, 0xdc, 0xec,
+	0xf7, 0xbe, 0xef, 0xf3, 0x7d, 0xb6, 0x9f, 0xd1, 0xa9, 0xc7, 0xbc, 0x7c, 0x91, 0x26, 0x93, 0x38,
+	0xf1, 0x21, 0x91, 0xf1, 0x7b, 0x98, 0x84, 0x90, 0x80, 0x88, 0x85, 0x93, 0xf1, 0x54, 0xa6, 0xf8,
+	0x76, 0x21, 0x70, 0x76, 0x82, 0xc1, 0x51, 0x98, 0x86, 0xa9, 0xce, 0x4e, 0xd4, 0xca, 0x08, 0x07,
+	0x76, 0x95, 0x94, 0x31, 0xce, 0x96, 0x05, 0x68, 0x70, 0x56, 0xcd, 0xef, 0x56, 0x46, 0x32, 0xfa,
+	0xd2, 0x42, 0xdd, 0x4b, 0xe3, 0x3e, 0x93, 0x4c, 0x02, 0xbe, 0x40, 0x6d, 0xc3, 0x20, 0xd6, 0xd0,
+	0x1a, 0x77, 0xce, 0xfb, 0x4e, 0xa5, 0x1b, 0xe7, 0x95, 0x16, 0x4c, 0x5b, 0xd7, 0xdf, 0x4e, 0x1b,
+	0xb4, 0x90, 0xe3, 0xd7, 0x08, 0x7b, 0xd2, 0x77, 0x85, 0x64, 0xef, 0xe2, 0x24, 0x74, 0x43, 0xb6,
+	0x0a, 0x41, 0x90, 0xbd, 0x61, 0x73, 0xdc, 0x39, 0xbf, 0x5f, 0x03, 0x99, 0xce, 0x9f, 0xcd, 0x8c,
+	0xf6, 0x52, 0x49, 0x9f, 0x27, 0x92, 0xe7, 0xb4, 0xe7, 0x49, 0xbf, 0x1c, 0x15, 0x38, 0x40, 0x77,
+	0x14, 0x56, 0xc6, 0x4b, 0x10, 0x92, 0x2d, 0xb3, 0x12, 0xbb, 0xa9, 0xd9, 0x0f, 0xeb, 0xd9, 0xf3,
+	0x52, 0x41, 0xc9, 0xe0, 0xd8, 0x93, 0x7e, 0x25, 0x25, 0xf0, 0x15, 0xfa, 0x9f, 0xc3, 0x07, 0xc6,
+	0x83, 0x2d, 0xbb, 0xa5, 0xd9, 0xf7, 0x6a, 0xd8, 0x54, 0xeb, 0x4a, 0xc8, 0x2e, 0xff, 0x19, 0x11,
+	0x78, 0x86, 0xfa, 0xaa, 0xdf, 0x08, 0x58, 0x00, 0xdc, 0xe5, 0xb0, 0x60, 0xf9, 0xae, 0x65, 0xb2,
+	0xaf, 0xaf, 0x94, 0xd4, 0x50, 0x75, 0x35, 0x3d, 0xf1, 0xa4, 0x7f, 0xa5, 0x2b, 0x69, 0x51, 0xa8,
+	0xe3, 0xf8, 0x02, 0x91, 0x05, 0x13, 0xd2, 0x35, 0x4e, 0x10, 0xb8, 0xc6, 0x22, 0x0e, 0x23, 0x49,
+	0xda, 0x43, 0x6b, 0xdc, 0xa2, 0xc7, 0x2a, 0x4f, 0x8b, 0xf4, 0x54, 0x51, 0x54, 0x12, 0x3f, 0x41,
+	0x77, 0xeb, 0xba, 0x11, 0x92, 0x71, 0x09, 0x01, 0x39, 0x18, 0x5a, 0xe3, 0xff, 0x28, 0xa9, 0xb8,
+	0xce, 0x4c, 0x7e, 0xf4, 0x16, 0x1d, 0xd5, 0x3d, 0x13, 0x3e, 0x41, 0xed, 0xc2, 0xdd, 0xd2, 0xee,
+	0xc5, 0x0e, 0x3b, 0x68, 0xdf, 0x1c, 0x74, 0xef, 0x2f, 0x07, 0x35, 0xb2, 0x51, 0x82, 0xfa, 0xbf,
+	0x7d, 0x2a, 0x7c, 0x86, 0xba, 0x90, 0xa5, 0x7e, 0xe4, 0x26, 0xab, 0xa5, 0x07, 0xbc, 0xb0, 0xea,
+	0xe8, 0xd8, 0x4b, 0x1d, 0xfa, 0x67, 0xbf, 0xcf, 0x16, 0xea, 0xfd, 0xfa, 0x7e, 0xf8, 0x01, 0xea,
+	0xa9, 0xa1, 0x85, 0x28, 0x5d, 0xa8, 0x4b, 0x92, 0x79, 0x06, 0xda, 0xeb, 0x90, 0xde, 0x2a, 0xc5,
+	0xe7, 0x79, 0x06, 0x98, 0xa0, 0x03, 0x16, 0x04, 0x1c, 0x84, 0xd0, 0x8e, 0x87, 0x74, 0xbb, 0xc5,
+	0x4f, 0x51, 0xb7, 0x3c, 0x40, 0xa4, 0xa9, 0x1b, 0xb2, 0xff, 0x3c, 0x3f, 0xb4, 0x53, 0x1a, 0x9d,
+	0xe9, 0x8b, 0xeb, 0xb5, 0x6d, 0xdd, 0xac, 0x6d, 0xeb, 0xfb, 0xda, 0xb6, 0x3e, 0x6d, 0xec, 0xc6,
+	0xcd, 0xc6, 0x6e, 0x7c, 0xdd, 0xd8, 0x8d, 0x37, 0x8f, 0xc2, 0x58, 0x46, 0x2b, 0xcf, 0xf1, 0xd3,
+	0xe5, 0xa4, 0x00, 0xfa, 0x11, 0x8b, 0x93, 0xed, 0x66, 0xf2, 0xb1, 0xf4, 0xc3, 0x55, 0xff, 0xc2,
+	0x6b, 0xeb, 0xef, 0xfd, 0xf8, 0xc7, 0x00, 0xd8, 0xc5, 0x93, 0x45, 0x6d, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +367,75 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BtcHeaderRelayingStarted {
+		i--
+		if m.BtcHeaderRelayingStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastRewardedBtcHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRewardedBtcHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BtcHeaderRelayingGauge != nil {
+		{
+			size, err := m.BtcHeaderRelayingGauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RewardGauges) > 0 {
+		for iNdEx := len(m.RewardGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BtcTimestampingGauges) > 0 {
+		for iNdEx := len(m.BtcTimestampingGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcTimestampingGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BtcStakingGauges) > 0 {
+		for iNdEx := len(m.BtcStakingGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcStakingGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +449,135 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BTCStakingGaugeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCStakingGaugeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCStakingGaugeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCTimestampingGaugeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCTimestampingGaugeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCTimestampingGaugeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardGaugeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardGaugeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardGaugeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardGauge != nil {
+		{
+			size, err := m.RewardGauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakeholderType) > 0 {
+		i -= len(m.StakeholderType)
+		copy(dAtA[i:], m.StakeholderType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakeholderType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,24 +597,105 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BtcStakingGauges) > 0 {
+		for _, e := range m.BtcStakingGauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BtcTimestampingGauges) > 0 {
+		for _, e := range m.BtcTimestampingGauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardGauges) > 0 {
+		for _, e := range m.RewardGauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BtcHeaderRelayingGauge != nil {
+		l = m.BtcHeaderRelayingGauge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastRewardedBtcHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRewardedBtcHeight))
+	}
+	if m.BtcHeaderRelayingStarted {
+		n += 2
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BTCStakingGaugeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BTCTimestampingGaugeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *RewardGaugeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakeholderType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RewardGauge != nil {
+		l = m.RewardGauge.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -214,6 +749,543 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcStakingGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcStakingGauges = append(m.BtcStakingGauges, &BTCStakingGaugeEntry{})
+			if err := m.BtcStakingGauges[len(m.BtcStakingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTimestampingGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcTimestampingGauges = append(m.BtcTimestampingGauges, &BTCTimestampingGaugeEntry{})
+			if err := m.BtcTimestampingGauges[len(m.BtcTimestampingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardGauges = append(m.RewardGauges, &RewardGaugeEntry{})
+			if err := m.RewardGauges[len(m.RewardGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaderRelayingGauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcHeaderRelayingGauge == nil {
+				m.BtcHeaderRelayingGauge = &Gauge{}
+			}
+			if err := m.BtcHeaderRelayingGauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardedBtcHeight", wireType)
+			}
+			m.LastRewardedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaderRelayingStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BtcHeaderRelayingStarted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCStakingGaugeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCStakingGaugeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCStakingGaugeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCTimestampingGaugeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCTimestampingGaugeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCTimestampingGaugeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardGaugeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardGaugeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardGaugeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeholderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeholderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardGauge == nil {
+				m.RewardGauge = &RewardGauge{}
+			}
+			if err := m.RewardGauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
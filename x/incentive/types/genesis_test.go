@@ -3,25 +3,119 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	addr := datagen.GenRandomAccount().GetAddress().String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubbn", 100))
+	withdrawnCoins := sdk.NewCoins(sdk.NewInt64Coin("ubbn", 40))
+
+	// genState returns a genesis state with a BTC staking gauge that is fully
+	// distributed to a finality provider that has withdrawn a part of it
+	genState := func() *types.GenesisState {
+		gs := types.DefaultGenesis()
+		gs.BtcStakingGauges = []*types.BTCStakingGaugeEntry{
+			{Height: 1, Gauge: types.NewGauge(coins...)},
+		}
+		gs.BtcTimestampingGauges = []*types.BTCTimestampingGaugeEntry{
+			{EpochNumber: 1, Gauge: types.NewGauge(coins...)},
+		}
+		rg := types.NewRewardGauge(coins...)
+		rg.WithdrawnCoins = withdrawnCoins
+		gs.RewardGauges = []*types.RewardGaugeEntry{
+			{StakeholderType: types.FinalityProviderType.String(), Address: addr, RewardGauge: rg},
+		}
+		return gs
+	}
+
 	tests := []struct {
 		desc     string
-		genState *types.GenesisState
+		genState func() *types.GenesisState
 		valid    bool
 	}{
 		{
 			desc:     "default is valid",
-			genState: types.DefaultGenesis(),
+			genState: types.DefaultGenesis,
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state with gauges",
+			genState: genState,
 			valid:    true,
 		},
+		{
+			desc: "params without BTC header relayer portion predating it",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.Params.BtcHeaderRelayerPortion = math.LegacyDec{}
+				return gs
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate BTC staking gauge",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.BtcStakingGauges = append(gs.BtcStakingGauges, gs.BtcStakingGauges[0])
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "nil BTC timestamping gauge",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.BtcTimestampingGauges[0].Gauge = nil
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid stakeholder type",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.RewardGauges[0].StakeholderType = "validator"
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate reward gauge",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.RewardGauges = append(gs.RewardGauges, gs.RewardGauges[0])
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "withdrawn coins exceed rewarded coins",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.RewardGauges[0].RewardGauge.WithdrawnCoins = coins.Add(coins...)
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "rewards exceed gauges",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.BtcTimestampingGauges = nil
+				gs.RewardGauges[0].RewardGauge.Coins = coins.Add(coins...)
+				return gs
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := tc.genState.Validate()
+			err := tc.genState().Validate()
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
@@ -30,3 +124,20 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ModuleBalance(t *testing.T) {
+	gs := types.DefaultGenesis()
+	require.True(t, gs.ModuleBalance().IsZero())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ubbn", 100))
+	gs.BtcStakingGauges = []*types.BTCStakingGaugeEntry{{Height: 1, Gauge: types.NewGauge(coins...)}}
+	gs.BtcTimestampingGauges = []*types.BTCTimestampingGaugeEntry{{EpochNumber: 1, Gauge: types.NewGauge(coins...)}}
+	rg := types.NewRewardGauge(coins...)
+	rg.WithdrawnCoins = sdk.NewCoins(sdk.NewInt64Coin("ubbn", 40))
+	gs.RewardGauges = []*types.RewardGaugeEntry{{
+		StakeholderType: types.SubmitterType.String(),
+		Address:         datagen.GenRandomAccount().GetAddress().String(),
+		RewardGauge:     rg,
+	}}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 160)), gs.ModuleBalance())
+}
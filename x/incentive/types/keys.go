@@ -15,8 +15,10 @@ const (
 )
 
 var (
-	ParamsKey               = []byte{0x01} // key prefix for the parameters
-	BTCStakingGaugeKey      = []byte{0x02} // key prefix for BTC staking gauge at each height
-	BTCTimestampingGaugeKey = []byte{0x03} // key prefix for BTC timestamping gauge at each height
-	RewardGaugeKey          = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+	ParamsKey                 = []byte{0x01} // key prefix for the parameters
+	BTCStakingGaugeKey        = []byte{0x02} // key prefix for BTC staking gauge at each height
+	BTCTimestampingGaugeKey   = []byte{0x03} // key prefix for BTC timestamping gauge at each height
+	RewardGaugeKey            = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+	BTCHeaderRelayingGaugeKey = []byte{0x05} // key for the gauge of rewards for BTC header relayers
+	LastRewardedBTCHeightKey  = []byte{0x06} // key for the height of the last BTC header whose relayer is rewarded
 )
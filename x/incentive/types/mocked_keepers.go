@@ -8,8 +8,11 @@ import (
 	context "context"
 	reflect "reflect"
 
-	types "github.com/babylonchain/babylon/x/epoching/types"
-	types0 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/babylonchain/babylon/types"
+	types0 "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	types1 "github.com/babylonchain/babylon/x/btclightclient/types"
+	types2 "github.com/babylonchain/babylon/x/epoching/types"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,10 +40,10 @@ func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
 }
 
 // GetAccount mocks base method.
-func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types0.AccAddress) types0.AccountI {
+func (m *MockAccountKeeper) GetAccount(ctx context.Context, addr types3.AccAddress) types3.AccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAccount", ctx, addr)
-	ret0, _ := ret[0].(types0.AccountI)
+	ret0, _ := ret[0].(types3.AccountI)
 	return ret0
 }
 
@@ -51,10 +54,10 @@ func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr interface{}) *gomo
 }
 
 // GetModuleAccount mocks base method.
-func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types0.ModuleAccountI {
+func (m *MockAccountKeeper) GetModuleAccount(ctx context.Context, name string) types3.ModuleAccountI {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetModuleAccount", ctx, name)
-	ret0, _ := ret[0].(types0.ModuleAccountI)
+	ret0, _ := ret[0].(types3.ModuleAccountI)
 	return ret0
 }
 
@@ -88,10 +91,10 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 }
 
 // GetAllBalances mocks base method.
-func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
+func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types3.AccAddress) types3.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllBalances", ctx, addr)
-	ret0, _ := ret[0].(types0.Coins)
+	ret0, _ := ret[0].(types3.Coins)
 	return ret0
 }
 
@@ -102,7 +105,7 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gom
 }
 
 // SendCoinsFromModuleToAccount mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types3.AccAddress, amt types3.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
 	ret0, _ := ret[0].(error)
@@ -116,7 +119,7 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 }
 
 // SendCoinsFromModuleToModule mocks base method.
-func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types0.Coins) error {
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types3.Coins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
 	ret0, _ := ret[0].(error)
@@ -130,10 +133,10 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderMod
 }
 
 // SpendableCoins mocks base method.
-func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types0.AccAddress) types0.Coins {
+func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types3.AccAddress) types3.Coins {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SpendableCoins", ctx, addr)
-	ret0, _ := ret[0].(types0.Coins)
+	ret0, _ := ret[0].(types3.Coins)
 	return ret0
 }
 
@@ -167,10 +170,10 @@ func (m *MockEpochingKeeper) EXPECT() *MockEpochingKeeperMockRecorder {
 }
 
 // GetEpoch mocks base method.
-func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types.Epoch {
+func (m *MockEpochingKeeper) GetEpoch(ctx context.Context) *types2.Epoch {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetEpoch", ctx)
-	ret0, _ := ret[0].(*types.Epoch)
+	ret0, _ := ret[0].(*types2.Epoch)
 	return ret0
 }
 
@@ -179,3 +182,105 @@ func (mr *MockEpochingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockEpochingKeeper)(nil).GetEpoch), ctx)
 }
+
+// MockBTCLightClientKeeper is a mock of BTCLightClientKeeper interface.
+type MockBTCLightClientKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBTCLightClientKeeperMockRecorder
+}
+
+// MockBTCLightClientKeeperMockRecorder is the mock recorder for MockBTCLightClientKeeper.
+type MockBTCLightClientKeeperMockRecorder struct {
+	mock *MockBTCLightClientKeeper
+}
+
+// NewMockBTCLightClientKeeper creates a new mock instance.
+func NewMockBTCLightClientKeeper(ctrl *gomock.Controller) *MockBTCLightClientKeeper {
+	mock := &MockBTCLightClientKeeper{ctrl: ctrl}
+	mock.recorder = &MockBTCLightClientKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBTCLightClientKeeper) EXPECT() *MockBTCLightClientKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetHeaderByHeight mocks base method.
+func (m *MockBTCLightClientKeeper) GetHeaderByHeight(ctx context.Context, height uint64) *types1.BTCHeaderInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeaderByHeight", ctx, height)
+	ret0, _ := ret[0].(*types1.BTCHeaderInfo)
+	return ret0
+}
+
+// GetHeaderByHeight indicates an expected call of GetHeaderByHeight.
+func (mr *MockBTCLightClientKeeperMockRecorder) GetHeaderByHeight(ctx, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderByHeight", reflect.TypeOf((*MockBTCLightClientKeeper)(nil).GetHeaderByHeight), ctx, height)
+}
+
+// GetHeaderReporter mocks base method.
+func (m *MockBTCLightClientKeeper) GetHeaderReporter(ctx context.Context, hash *types.BTCHeaderHashBytes) types3.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeaderReporter", ctx, hash)
+	ret0, _ := ret[0].(types3.AccAddress)
+	return ret0
+}
+
+// GetHeaderReporter indicates an expected call of GetHeaderReporter.
+func (mr *MockBTCLightClientKeeperMockRecorder) GetHeaderReporter(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderReporter", reflect.TypeOf((*MockBTCLightClientKeeper)(nil).GetHeaderReporter), ctx, hash)
+}
+
+// GetTipInfo mocks base method.
+func (m *MockBTCLightClientKeeper) GetTipInfo(ctx context.Context) *types1.BTCHeaderInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTipInfo", ctx)
+	ret0, _ := ret[0].(*types1.BTCHeaderInfo)
+	return ret0
+}
+
+// GetTipInfo indicates an expected call of GetTipInfo.
+func (mr *MockBTCLightClientKeeperMockRecorder) GetTipInfo(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTipInfo", reflect.TypeOf((*MockBTCLightClientKeeper)(nil).GetTipInfo), ctx)
+}
+
+// MockBtcCheckpointKeeper is a mock of BtcCheckpointKeeper interface.
+type MockBtcCheckpointKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBtcCheckpointKeeperMockRecorder
+}
+
+// MockBtcCheckpointKeeperMockRecorder is the mock recorder for MockBtcCheckpointKeeper.
+type MockBtcCheckpointKeeperMockRecorder struct {
+	mock *MockBtcCheckpointKeeper
+}
+
+// NewMockBtcCheckpointKeeper creates a new mock instance.
+func NewMockBtcCheckpointKeeper(ctrl *gomock.Controller) *MockBtcCheckpointKeeper {
+	mock := &MockBtcCheckpointKeeper{ctrl: ctrl}
+	mock.recorder = &MockBtcCheckpointKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBtcCheckpointKeeper) EXPECT() *MockBtcCheckpointKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetParams mocks base method.
+func (m *MockBtcCheckpointKeeper) GetParams(ctx context.Context) types0.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types0.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockBtcCheckpointKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBtcCheckpointKeeper)(nil).GetParams), ctx)
+}
@@ -18,9 +18,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		SubmitterPortion:  math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		ReporterPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		BtcStakingPortion: math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		SubmitterPortion:        math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		ReporterPortion:         math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		BtcStakingPortion:       math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		BtcHeaderRelayerPortion: math.LegacyNewDecWithPrec(1, 2), // 1 * 10^{-2} = 0.01
 	}
 }
 
@@ -34,6 +35,7 @@ func (p *Params) TotalPortion() math.LegacyDec {
 	sum := p.SubmitterPortion
 	sum = sum.Add(p.ReporterPortion)
 	sum = sum.Add(p.BtcStakingPortion)
+	sum = sum.Add(p.BTCHeaderRelayingPortion())
 	return sum
 }
 
@@ -49,6 +51,16 @@ func (p *Params) BTCStakingPortion() math.LegacyDec {
 	return p.BtcStakingPortion
 }
 
+// BTCHeaderRelayingPortion calculates the sum of portions of all BTC header relaying stakeholders.
+// The portion is nil in the params of chains that predate BTC header relaying
+// rewards, in which case it defaults to zero
+func (p *Params) BTCHeaderRelayingPortion() math.LegacyDec {
+	if p.BtcHeaderRelayerPortion.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return p.BtcHeaderRelayerPortion
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if p.SubmitterPortion.IsNil() {
@@ -60,6 +72,8 @@ func (p Params) Validate() error {
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
+	// BtcHeaderRelayerPortion is allowed to be nil, which defaults to zero, so
+	// that the params of chains predating it remain valid
 
 	// sum of all portions should be less than 1
 	if p.TotalPortion().GTE(math.LegacyOneDec()) {
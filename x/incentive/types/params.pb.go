@@ -38,6 +38,10 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// btc_header_relayer_portion is the portion of rewards that goes to BTC header relayers
+	// NOTE: the portion is distributed among the relayers of BTC headers that have become
+	// k-deep on the BTC main chain, proportionally to the number of such headers they relayed
+	BtcHeaderRelayerPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=btc_header_relayer_portion,json=btcHeaderRelayerPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_header_relayer_portion"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,26 +83,28 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xad, 0x67, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x14, 0xc7, 0x25, 0x58, 0x5c, 0x9a, 0x94, 0x9b, 0x59, 0x52, 0x92,
-	0x5a, 0x14, 0x5f, 0x90, 0x5f, 0x54, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x64, 0x78, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xd2, 0x10, 0xbd, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9,
-	0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41, 0x8d, 0x76, 0x49, 0x4d, 0x0e, 0x12, 0x80, 0x9b, 0x15, 0x00,
-	0x31, 0x4a, 0x28, 0x86, 0x4b, 0xa0, 0x28, 0x15, 0x64, 0x2e, 0x92, 0xf1, 0x4c, 0xe4, 0x1a, 0xcf,
-	0x0f, 0x33, 0x0a, 0x66, 0x7a, 0x22, 0x97, 0x70, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
-	0x66, 0x5e, 0x3a, 0xdc, 0x02, 0x66, 0x72, 0x2d, 0x10, 0x4c, 0x2a, 0x49, 0x0e, 0x86, 0x18, 0x06,
-	0xb5, 0xc2, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x46, 0x4d, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x14, 0x93, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x55, 0x13,
-	0x48, 0x09, 0xeb, 0x01, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd2, 0xb1, 0x4a, 0x03, 0x31,
+	0x18, 0x07, 0xf0, 0x3b, 0x5b, 0x0a, 0x66, 0xb1, 0xad, 0x82, 0x5a, 0x21, 0x15, 0x27, 0x17, 0x2f,
+	0x14, 0x37, 0xc7, 0xd2, 0x41, 0xd0, 0xa1, 0xd4, 0x4d, 0xc4, 0x23, 0x49, 0xc3, 0x5d, 0x68, 0x2f,
+	0x39, 0x92, 0xaf, 0xe2, 0xbd, 0x85, 0xa3, 0x9b, 0x3e, 0x84, 0x0f, 0xd1, 0xb1, 0x38, 0x89, 0x43,
+	0x91, 0xf6, 0x45, 0xa4, 0x97, 0xbb, 0xd2, 0xf9, 0xb6, 0xfb, 0xf8, 0x5f, 0x7e, 0x7f, 0x42, 0x3e,
+	0x84, 0x19, 0x65, 0xd9, 0x54, 0x2b, 0x22, 0x15, 0x17, 0x0a, 0xe4, 0x8b, 0x20, 0x29, 0x35, 0x34,
+	0xb1, 0x41, 0x6a, 0x34, 0xe8, 0x76, 0xab, 0xc8, 0x83, 0x6d, 0xde, 0x39, 0x8a, 0x74, 0xa4, 0xf3,
+	0x94, 0x6c, 0xbe, 0xdc, 0x8f, 0x9d, 0x53, 0xae, 0x6d, 0xa2, 0x6d, 0xe8, 0x02, 0x37, 0xb8, 0xe8,
+	0xe2, 0xa3, 0x86, 0x1a, 0xc3, 0x1c, 0x6d, 0x3f, 0xa3, 0x96, 0x9d, 0xb1, 0x44, 0x02, 0x08, 0x13,
+	0xa6, 0xda, 0x80, 0xd4, 0xea, 0xc4, 0x3f, 0xf7, 0x2f, 0xf7, 0xfb, 0xbd, 0xf9, 0xb2, 0xeb, 0xfd,
+	0x2e, 0xbb, 0x67, 0xee, 0xac, 0x1d, 0x4f, 0x02, 0xa9, 0x49, 0x42, 0x21, 0x0e, 0xee, 0x45, 0x44,
+	0x79, 0x36, 0x10, 0xfc, 0xfb, 0xeb, 0x0a, 0x15, 0xf4, 0x40, 0xf0, 0x51, 0x73, 0x6b, 0x0d, 0x1d,
+	0xd5, 0x7e, 0x42, 0x4d, 0x23, 0x36, 0xee, 0x0e, 0xbf, 0x57, 0x95, 0x3f, 0x28, 0xa9, 0x52, 0xa7,
+	0xe8, 0x90, 0x01, 0x0f, 0x2d, 0xd0, 0x89, 0x54, 0xd1, 0xb6, 0xa0, 0x56, 0xb5, 0xa0, 0xc5, 0x80,
+	0x3f, 0x38, 0xac, 0xac, 0x50, 0xa8, 0xb3, 0xa9, 0x88, 0x05, 0x1d, 0x0b, 0x13, 0x1a, 0x31, 0xa5,
+	0xd9, 0xce, 0x55, 0xea, 0x55, 0x9b, 0x8e, 0x19, 0xf0, 0xdb, 0xdc, 0x1c, 0x39, 0xb2, 0xe8, 0xbb,
+	0xa9, 0xbf, 0x7f, 0x76, 0xbd, 0xfe, 0xdd, 0x7c, 0x85, 0xfd, 0xc5, 0x0a, 0xfb, 0x7f, 0x2b, 0xec,
+	0xbf, 0xad, 0xb1, 0xb7, 0x58, 0x63, 0xef, 0x67, 0x8d, 0xbd, 0xc7, 0x5e, 0x24, 0x21, 0x9e, 0xb1,
+	0x80, 0xeb, 0x84, 0x14, 0xab, 0xc0, 0x63, 0x2a, 0x55, 0x39, 0x90, 0xd7, 0x9d, 0xcd, 0x81, 0x2c,
+	0x15, 0x96, 0x35, 0xf2, 0x57, 0xbf, 0xfe, 0x1f, 0x00, 0xf0, 0x8e, 0xd3, 0x29, 0x5b, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +127,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BtcHeaderRelayerPortion.Size()
+		i -= size
+		if _, err := m.BtcHeaderRelayerPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -177,6 +193,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.BtcHeaderRelayerPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -317,6 +335,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaderRelayerPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtcHeaderRelayerPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
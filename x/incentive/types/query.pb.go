@@ -389,6 +389,98 @@ func (m *QueryBTCTimestampingGaugeResponse) GetGauge() *Gauge {
 	return nil
 }
 
+// QueryBTCHeaderRelayingGaugeRequest is request type for the Query/BTCHeaderRelayingGauge RPC method.
+type QueryBTCHeaderRelayingGaugeRequest struct {
+}
+
+func (m *QueryBTCHeaderRelayingGaugeRequest) Reset()         { *m = QueryBTCHeaderRelayingGaugeRequest{} }
+func (m *QueryBTCHeaderRelayingGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCHeaderRelayingGaugeRequest) ProtoMessage()    {}
+func (*QueryBTCHeaderRelayingGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{8}
+}
+func (m *QueryBTCHeaderRelayingGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCHeaderRelayingGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCHeaderRelayingGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCHeaderRelayingGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCHeaderRelayingGaugeRequest.Merge(m, src)
+}
+func (m *QueryBTCHeaderRelayingGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCHeaderRelayingGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCHeaderRelayingGaugeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCHeaderRelayingGaugeRequest proto.InternalMessageInfo
+
+// QueryBTCHeaderRelayingGaugeResponse is response type for the Query/BTCHeaderRelayingGauge RPC method.
+type QueryBTCHeaderRelayingGaugeResponse struct {
+	// gauge is the gauge of rewards for BTC header relayers that are not distributed yet
+	Gauge *Gauge `protobuf:"bytes,1,opt,name=gauge,proto3" json:"gauge,omitempty"`
+	// last_rewarded_btc_height is the height of the last BTC header whose relayer has been rewarded
+	LastRewardedBtcHeight uint64 `protobuf:"varint,2,opt,name=last_rewarded_btc_height,json=lastRewardedBtcHeight,proto3" json:"last_rewarded_btc_height,omitempty"`
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) Reset()         { *m = QueryBTCHeaderRelayingGaugeResponse{} }
+func (m *QueryBTCHeaderRelayingGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCHeaderRelayingGaugeResponse) ProtoMessage()    {}
+func (*QueryBTCHeaderRelayingGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{9}
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCHeaderRelayingGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCHeaderRelayingGaugeResponse.Merge(m, src)
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCHeaderRelayingGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCHeaderRelayingGaugeResponse proto.InternalMessageInfo
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) GetGauge() *Gauge {
+	if m != nil {
+		return m.Gauge
+	}
+	return nil
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) GetLastRewardedBtcHeight() uint64 {
+	if m != nil {
+		return m.LastRewardedBtcHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
@@ -399,51 +491,58 @@ func init() {
 	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
 	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
 	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryBTCHeaderRelayingGaugeRequest)(nil), "babylon.incentive.QueryBTCHeaderRelayingGaugeRequest")
+	proto.RegisterType((*QueryBTCHeaderRelayingGaugeResponse)(nil), "babylon.incentive.QueryBTCHeaderRelayingGaugeResponse")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
 
 var fileDescriptor_e1a59cc0c7c44135 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0xb4, 0x09, 0xf4, 0x5a, 0x04, 0x3d, 0x22, 0xe4, 0x38, 0xc5, 0x24, 0x96, 0x40,
-	0x95, 0x00, 0x5b, 0xcd, 0x1f, 0x15, 0x90, 0x00, 0x29, 0x08, 0x31, 0x20, 0x45, 0xe0, 0x76, 0x62,
-	0x89, 0x2e, 0xce, 0xc9, 0xb1, 0x1a, 0xfb, 0x5c, 0xfb, 0x5c, 0x08, 0x55, 0x16, 0x3e, 0x01, 0x12,
-	0x13, 0x3b, 0x0b, 0xdf, 0x82, 0xb1, 0x63, 0x25, 0x16, 0x26, 0x04, 0x09, 0x1f, 0x04, 0xe5, 0xee,
-	0x1c, 0xb9, 0x8d, 0x5d, 0x5a, 0xb6, 0xf3, 0xfb, 0x3e, 0xef, 0xf3, 0xfe, 0x72, 0x7e, 0x1c, 0x70,
-	0xb3, 0x87, 0x7a, 0xa3, 0x21, 0xf1, 0x0c, 0xc7, 0xb3, 0xb0, 0x47, 0x9d, 0x03, 0x6c, 0xec, 0x47,
-	0x38, 0x18, 0xe9, 0x7e, 0x40, 0x28, 0x81, 0xeb, 0xa2, 0xad, 0xcf, 0xdb, 0x4a, 0xc9, 0x26, 0x36,
-	0x61, 0x5d, 0x63, 0x76, 0xe2, 0x42, 0x65, 0xc3, 0x26, 0xc4, 0x1e, 0x62, 0x03, 0xf9, 0x8e, 0x81,
-	0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x85, 0xa2, 0xab, 0x2e, 0x6e, 0xf1, 0x51, 0x80, 0xdc, 0xb8,
-	0x5f, 0x5b, 0xec, 0xcf, 0x4f, 0x5c, 0xa2, 0x95, 0x00, 0x7c, 0x3d, 0x03, 0x7b, 0xc5, 0xe6, 0x4c,
-	0xbc, 0x1f, 0xe1, 0x90, 0x6a, 0x1d, 0x70, 0xfd, 0x44, 0x35, 0xf4, 0x89, 0x17, 0x62, 0xb8, 0x0d,
-	0x8a, 0xdc, 0x5f, 0x96, 0xaa, 0xd2, 0xe6, 0x6a, 0xbd, 0xac, 0x2f, 0xfc, 0x0e, 0x9d, 0x8f, 0xb4,
-	0x97, 0x8f, 0x7e, 0xde, 0xca, 0x99, 0x42, 0xae, 0x35, 0x81, 0xcc, 0xfc, 0x4c, 0xfc, 0x16, 0x05,
-	0xfd, 0x17, 0x28, 0xb2, 0x71, 0xbc, 0x0b, 0xca, 0xe0, 0x12, 0xea, 0xf7, 0x03, 0x1c, 0x72, 0xd7,
-	0x15, 0x33, 0x7e, 0xd4, 0x7e, 0x4b, 0xa0, 0x9c, 0x32, 0x26, 0x60, 0x2c, 0x70, 0x25, 0x60, 0xf5,
-	0xae, 0xcd, 0x1a, 0xb2, 0x54, 0x5d, 0xda, 0x5c, 0xad, 0x3f, 0x49, 0x61, 0xca, 0x34, 0xd1, 0x93,
-	0xc5, 0xe7, 0x1e, 0x0d, 0x46, 0xe6, 0x5a, 0x90, 0x28, 0x29, 0x5d, 0xb0, 0xbe, 0x20, 0x81, 0xd7,
-	0xc0, 0xd2, 0x1e, 0x1e, 0x09, 0xda, 0xd9, 0x11, 0x36, 0x41, 0xe1, 0x00, 0x0d, 0x23, 0x2c, 0xe7,
-	0xd9, 0xbd, 0xa8, 0x29, 0x0c, 0x09, 0x1b, 0x93, 0x8b, 0x1f, 0xe5, 0x1f, 0x48, 0x5a, 0x0b, 0x54,
-	0x18, 0x5d, 0x7b, 0xf7, 0xd9, 0x0e, 0x45, 0x7b, 0x8e, 0x67, 0x73, 0x89, 0xb8, 0x9c, 0x1b, 0xa0,
-	0x38, 0xc0, 0x8e, 0x3d, 0xa0, 0x6c, 0xdb, 0xb2, 0x29, 0x9e, 0xb4, 0x0e, 0xd8, 0x48, 0x1f, 0x13,
-	0x97, 0xa3, 0x83, 0x02, 0xbb, 0x15, 0xf1, 0xa2, 0xe4, 0x14, 0x20, 0x81, 0xc2, 0x64, 0xda, 0x53,
-	0x50, 0x8d, 0xfd, 0x76, 0x1d, 0x17, 0x87, 0x14, 0xb9, 0xfe, 0x69, 0x96, 0x0a, 0x58, 0xc1, 0x3e,
-	0xb1, 0x06, 0x5d, 0x2f, 0x72, 0x05, 0xce, 0x65, 0x56, 0xe8, 0x44, 0xae, 0xb6, 0x03, 0x6a, 0x67,
-	0x18, 0xfc, 0x1f, 0x55, 0xfd, 0x73, 0x01, 0x14, 0x98, 0x2b, 0x7c, 0x0f, 0x8a, 0x3c, 0x58, 0xf0,
-	0x76, 0xd6, 0xfb, 0x3d, 0x91, 0x60, 0xe5, 0xce, 0xbf, 0x64, 0x1c, 0x49, 0xab, 0x7d, 0xf8, 0xfe,
-	0xe7, 0x53, 0xbe, 0x02, 0xcb, 0x46, 0xd6, 0xb7, 0x04, 0xbf, 0x48, 0x60, 0x2d, 0x19, 0x02, 0x78,
-	0xf7, 0x7c, 0x11, 0xe3, 0x20, 0xf7, 0x2e, 0x92, 0x47, 0xed, 0x21, 0xc3, 0x69, 0xc0, 0xad, 0x14,
-	0x1c, 0xf1, 0x59, 0x18, 0x87, 0xe2, 0x30, 0x36, 0x92, 0xf9, 0x87, 0x5f, 0x25, 0x70, 0xf5, 0x54,
-	0x1c, 0xa0, 0x9e, 0xb5, 0x3c, 0x3d, 0x6e, 0x8a, 0x71, 0x6e, 0xbd, 0xe0, 0x6d, 0x31, 0x5e, 0x03,
-	0xde, 0x4f, 0xe1, 0xed, 0x51, 0xab, 0x1b, 0xf2, 0x21, 0x8e, 0x68, 0x1c, 0xf2, 0xf4, 0x8e, 0xe1,
-	0x37, 0x09, 0x94, 0xd2, 0x92, 0x02, 0x1b, 0x67, 0x00, 0x64, 0x05, 0x53, 0x69, 0x5e, 0x6c, 0x48,
-	0xa0, 0x3f, 0x66, 0xe8, 0xdb, 0xb0, 0x95, 0x81, 0x4e, 0x13, 0x93, 0x31, 0xff, 0x3c, 0xff, 0xe3,
-	0xf6, 0xcb, 0xa3, 0x89, 0x2a, 0x1d, 0x4f, 0x54, 0xe9, 0xd7, 0x44, 0x95, 0x3e, 0x4e, 0xd5, 0xdc,
-	0xf1, 0x54, 0xcd, 0xfd, 0x98, 0xaa, 0xb9, 0x37, 0x5b, 0xb6, 0x43, 0x07, 0x51, 0x4f, 0xb7, 0x88,
-	0x1b, 0x5b, 0x5b, 0x03, 0xe4, 0x78, 0xf3, 0x3d, 0xef, 0x12, 0x9b, 0xe8, 0xc8, 0xc7, 0x61, 0xaf,
-	0xc8, 0xfe, 0x8c, 0x1b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1f, 0x12, 0x0c, 0x37, 0x06,
-	0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdf, 0x4e, 0xd4, 0x4e,
+	0x14, 0xc7, 0x77, 0x16, 0xd8, 0xdf, 0x8f, 0x03, 0x46, 0x19, 0x91, 0x2c, 0x05, 0x2b, 0xd4, 0x3f,
+	0x21, 0x11, 0xdb, 0x00, 0xbb, 0xa2, 0x26, 0x6a, 0xb2, 0xc6, 0x48, 0x62, 0x42, 0xb4, 0x70, 0xe5,
+	0x4d, 0x33, 0xdb, 0x9d, 0x74, 0x1b, 0x76, 0xdb, 0xd2, 0x4e, 0xd1, 0x95, 0x70, 0xe3, 0x03, 0x18,
+	0x13, 0x5f, 0xc1, 0x1b, 0x1f, 0xc2, 0xc4, 0x4b, 0x2e, 0x49, 0xbc, 0xd1, 0x1b, 0xa3, 0xe0, 0x83,
+	0x98, 0x9d, 0x99, 0x6e, 0x0a, 0xdb, 0xae, 0xc0, 0xdd, 0x74, 0xce, 0x39, 0xdf, 0xf3, 0x39, 0x33,
+	0xf3, 0xdd, 0x85, 0xab, 0x75, 0x52, 0xef, 0xb4, 0x7c, 0xcf, 0x70, 0x3d, 0x9b, 0x7a, 0xcc, 0xdd,
+	0xa1, 0xc6, 0x76, 0x4c, 0xc3, 0x8e, 0x1e, 0x84, 0x3e, 0xf3, 0xf1, 0x84, 0x0c, 0xeb, 0xbd, 0xb0,
+	0x32, 0xe9, 0xf8, 0x8e, 0xcf, 0xa3, 0x46, 0x77, 0x25, 0x12, 0x95, 0x59, 0xc7, 0xf7, 0x9d, 0x16,
+	0x35, 0x48, 0xe0, 0x1a, 0xc4, 0xf3, 0x7c, 0x46, 0x98, 0xeb, 0x7b, 0x91, 0x8c, 0xaa, 0xfd, 0x5d,
+	0x02, 0x12, 0x92, 0x76, 0x12, 0x9f, 0xef, 0x8f, 0xf7, 0x56, 0x22, 0x45, 0x9b, 0x04, 0xfc, 0xb2,
+	0x0b, 0xf6, 0x82, 0xd7, 0x99, 0x74, 0x3b, 0xa6, 0x11, 0xd3, 0xd6, 0xe1, 0xf2, 0xb1, 0xdd, 0x28,
+	0xf0, 0xbd, 0x88, 0xe2, 0x55, 0x28, 0x09, 0xfd, 0x32, 0x9a, 0x43, 0x0b, 0x63, 0xcb, 0xd3, 0x7a,
+	0xdf, 0x1c, 0xba, 0x28, 0xa9, 0x0d, 0xef, 0xff, 0xbc, 0x56, 0x30, 0x65, 0xba, 0x56, 0x81, 0x32,
+	0xd7, 0x33, 0xe9, 0x6b, 0x12, 0x36, 0x9e, 0x91, 0xd8, 0xa1, 0x49, 0x2f, 0x5c, 0x86, 0xff, 0x48,
+	0xa3, 0x11, 0xd2, 0x48, 0xa8, 0x8e, 0x9a, 0xc9, 0xa7, 0xf6, 0x1b, 0xc1, 0x74, 0x46, 0x99, 0x84,
+	0xb1, 0xe1, 0x42, 0xc8, 0xf7, 0x2d, 0x87, 0x07, 0xca, 0x68, 0x6e, 0x68, 0x61, 0x6c, 0xf9, 0x51,
+	0x06, 0x53, 0xae, 0x88, 0x9e, 0xde, 0x7c, 0xea, 0xb1, 0xb0, 0x63, 0x8e, 0x87, 0xa9, 0x2d, 0xc5,
+	0x82, 0x89, 0xbe, 0x14, 0x7c, 0x09, 0x86, 0xb6, 0x68, 0x47, 0xd2, 0x76, 0x97, 0xb8, 0x02, 0x23,
+	0x3b, 0xa4, 0x15, 0xd3, 0x72, 0x91, 0x9f, 0x8b, 0x9a, 0xc1, 0x90, 0x92, 0x31, 0x45, 0xf2, 0x83,
+	0xe2, 0x3d, 0xa4, 0x55, 0x61, 0x86, 0xd3, 0xd5, 0x36, 0x9f, 0x6c, 0x30, 0xb2, 0xe5, 0x7a, 0x8e,
+	0x48, 0x91, 0x87, 0x33, 0x05, 0xa5, 0x26, 0x75, 0x9d, 0x26, 0xe3, 0xdd, 0x86, 0x4d, 0xf9, 0xa5,
+	0xad, 0xc3, 0x6c, 0x76, 0x99, 0x3c, 0x1c, 0x1d, 0x46, 0xf8, 0xa9, 0xc8, 0x8b, 0x2a, 0x67, 0x00,
+	0x49, 0x14, 0x9e, 0xa6, 0x3d, 0x86, 0xb9, 0x44, 0x6f, 0xd3, 0x6d, 0xd3, 0x88, 0x91, 0x76, 0x70,
+	0x92, 0x65, 0x06, 0x46, 0x69, 0xe0, 0xdb, 0x4d, 0xcb, 0x8b, 0xdb, 0x12, 0xe7, 0x7f, 0xbe, 0xb1,
+	0x1e, 0xb7, 0xb5, 0x0d, 0x98, 0x1f, 0x20, 0x70, 0x4e, 0xaa, 0x1b, 0xa0, 0x25, 0xa2, 0x6b, 0x94,
+	0x34, 0x68, 0x68, 0xd2, 0x16, 0xe9, 0x9c, 0xe0, 0xd2, 0xde, 0x23, 0xb8, 0x3e, 0x30, 0xed, 0x7c,
+	0xdd, 0xf1, 0x2a, 0x94, 0x5b, 0x24, 0x62, 0x96, 0x78, 0x10, 0xb4, 0x61, 0xd5, 0x99, 0x6d, 0xc9,
+	0xdb, 0x28, 0xf2, 0xf1, 0xaf, 0x74, 0xe3, 0xa6, 0x0c, 0xd7, 0x98, 0xbd, 0xc6, 0x83, 0xcb, 0x3f,
+	0x4a, 0x30, 0xc2, 0x81, 0xf0, 0x5b, 0x28, 0x09, 0x3f, 0xe0, 0x9b, 0x79, 0xcf, 0xf2, 0x98, 0xf1,
+	0x94, 0x5b, 0xff, 0x4a, 0x13, 0xb3, 0x68, 0xf3, 0xef, 0xbe, 0xfd, 0xf9, 0x58, 0x9c, 0xc1, 0xd3,
+	0x46, 0xde, 0x4f, 0x00, 0xfe, 0x84, 0x60, 0x3c, 0xfd, 0x76, 0xf1, 0xed, 0xd3, 0x39, 0x43, 0x80,
+	0x2c, 0x9e, 0xc5, 0x46, 0xda, 0x7d, 0x8e, 0xb3, 0x82, 0x97, 0x32, 0x70, 0xa4, 0x9b, 0x8d, 0x5d,
+	0xb9, 0xd8, 0x33, 0xd2, 0xb6, 0xc5, 0x9f, 0x11, 0x5c, 0x3c, 0xf1, 0x8a, 0xb1, 0x9e, 0xd7, 0x3c,
+	0xdb, 0x25, 0x8a, 0x71, 0xea, 0x7c, 0xc9, 0x5b, 0xe5, 0xbc, 0x06, 0xbe, 0x93, 0xc1, 0xdb, 0xbd,
+	0xe5, 0x48, 0x14, 0x09, 0x44, 0x63, 0x57, 0x5c, 0xfa, 0x1e, 0xfe, 0x8a, 0x60, 0x32, 0xeb, 0x81,
+	0xe3, 0x95, 0x01, 0x00, 0x79, 0x7e, 0x52, 0x2a, 0x67, 0x2b, 0x92, 0xe8, 0x0f, 0x39, 0xfa, 0x2a,
+	0xae, 0xe6, 0xa0, 0xb3, 0x54, 0x65, 0xc2, 0xdf, 0xb3, 0xed, 0x1e, 0xfe, 0x82, 0x60, 0x2a, 0xdb,
+	0x27, 0xb8, 0x3a, 0x80, 0x27, 0xdf, 0x7e, 0xca, 0xdd, 0xb3, 0x96, 0xc9, 0x41, 0x2a, 0x7c, 0x10,
+	0x1d, 0x2f, 0xe6, 0x0c, 0xd2, 0xe4, 0xb5, 0x56, 0x28, 0x8b, 0xc5, 0x2c, 0xb5, 0xe7, 0xfb, 0x87,
+	0x2a, 0x3a, 0x38, 0x54, 0xd1, 0xaf, 0x43, 0x15, 0x7d, 0x38, 0x52, 0x0b, 0x07, 0x47, 0x6a, 0xe1,
+	0xfb, 0x91, 0x5a, 0x78, 0xb5, 0xe4, 0xb8, 0xac, 0x19, 0xd7, 0x75, 0xdb, 0x6f, 0x27, 0x8a, 0x76,
+	0x93, 0xb8, 0x5e, 0x4f, 0xfe, 0x4d, 0xaa, 0x01, 0xeb, 0x04, 0x34, 0xaa, 0x97, 0xf8, 0x7f, 0xe0,
+	0xca, 0xdf, 0x01, 0x00, 0x07, 0x27, 0x3b, 0xb6, 0xae, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +565,9 @@ type QueryClient interface {
 	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+	// BTCHeaderRelayingGauge queries the gauge of rewards for BTC header relayers
+	// that are not distributed yet
+	BTCHeaderRelayingGauge(ctx context.Context, in *QueryBTCHeaderRelayingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCHeaderRelayingGaugeResponse, error)
 }
 
 type queryClient struct {
@@ -512,6 +614,15 @@ func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTime
 	return out, nil
 }
 
+func (c *queryClient) BTCHeaderRelayingGauge(ctx context.Context, in *QueryBTCHeaderRelayingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCHeaderRelayingGaugeResponse, error) {
+	out := new(QueryBTCHeaderRelayingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCHeaderRelayingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -522,6 +633,9 @@ type QueryServer interface {
 	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
 	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
 	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+	// BTCHeaderRelayingGauge queries the gauge of rewards for BTC header relayers
+	// that are not distributed yet
+	BTCHeaderRelayingGauge(context.Context, *QueryBTCHeaderRelayingGaugeRequest) (*QueryBTCHeaderRelayingGaugeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -540,6 +654,9 @@ func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
 }
+func (*UnimplementedQueryServer) BTCHeaderRelayingGauge(ctx context.Context, req *QueryBTCHeaderRelayingGaugeRequest) (*QueryBTCHeaderRelayingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCHeaderRelayingGauge not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -617,6 +734,24 @@ func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BTCHeaderRelayingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCHeaderRelayingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCHeaderRelayingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCHeaderRelayingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCHeaderRelayingGauge(ctx, req.(*QueryBTCHeaderRelayingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.incentive.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -637,6 +772,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BTCTimestampingGauge",
 			Handler:    _Query_BTCTimestampingGauge_Handler,
 		},
+		{
+			MethodName: "BTCHeaderRelayingGauge",
+			Handler:    _Query_BTCHeaderRelayingGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/incentive/query.proto",
@@ -903,6 +1042,69 @@ func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBTCHeaderRelayingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCHeaderRelayingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCHeaderRelayingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRewardedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRewardedBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1019,6 +1221,31 @@ func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBTCHeaderRelayingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBTCHeaderRelayingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastRewardedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastRewardedBtcHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1729,6 +1956,161 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBTCHeaderRelayingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCHeaderRelayingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCHeaderRelayingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCHeaderRelayingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCHeaderRelayingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCHeaderRelayingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardedBtcHeight", wireType)
+			}
+			m.LastRewardedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
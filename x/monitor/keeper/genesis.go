@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/monitor/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	for _, record := range gs.EpochEndRecords {
+		key := types.GetEpochEndLightClientHeightKey(record.EpochNum)
+		if err := store.Set(key, sdk.Uint64ToBigEndian(record.BtcLightClientHeight)); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range gs.CheckpointReportRecords {
+		key, err := types.GetCheckpointReportedLightClientHeightKey(record.CkptHash)
+		if err != nil {
+			return err
+		}
+		if err := store.Set(key, sdk.Uint64ToBigEndian(record.BtcLightClientHeight)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	epochEndRecords, err := k.epochEndRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpointReportRecords, err := k.checkpointReportRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		EpochEndRecords:         epochEndRecords,
+		CheckpointReportRecords: checkpointReportRecords,
+	}, nil
+}
+
+// epochEndRecords loads the light client heights at the end of all epochs.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) epochEndRecords(ctx context.Context) ([]*types.EpochEndLightClientHeight, error) {
+	records := make([]*types.EpochEndLightClientHeight, 0)
+
+	iter := k.prefixStore(ctx, types.EpochEndLightClientHeightPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		epoch, err := bytesToUint64(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		btcHeight, err := bytesToUint64(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &types.EpochEndLightClientHeight{
+			EpochNum:             epoch,
+			BtcLightClientHeight: btcHeight,
+		})
+	}
+
+	return records, nil
+}
+
+// checkpointReportRecords loads the light client heights at which all checkpoints are reported.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) checkpointReportRecords(ctx context.Context) ([]*types.CheckpointReportedLightClientHeight, error) {
+	records := make([]*types.CheckpointReportedLightClientHeight, 0)
+
+	iter := k.prefixStore(ctx, types.CheckpointReportedLightClientHeightPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		btcHeight, err := bytesToUint64(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &types.CheckpointReportedLightClientHeight{
+			CkptHash:             hex.EncodeToString(iter.Key()),
+			BtcLightClientHeight: btcHeight,
+		})
+	}
+
+	return records, nil
+}
+
+func (k Keeper) prefixStore(ctx context.Context, p []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, p)
+}
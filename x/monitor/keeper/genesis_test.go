@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func FuzzExportImportGenesis(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+
+		// extend the BTC light client, and record its height upon each epoch end
+		// and each checkpoint report
+		numEpochs := datagen.RandomInt(r, 5) + 1
+		ckpts := make([]*ckpttypes.RawCheckpoint, 0, numEpochs)
+		for epoch := uint64(0); epoch < numEpochs; epoch++ {
+			tip := lck.GetTipInfo(ctx)
+			chain := datagen.GenRandomValidChainStartingFrom(
+				r,
+				tip.Height,
+				tip.Header.ToBlockHeader(),
+				nil,
+				uint32(datagen.RandomInt(r, 5)+1),
+			)
+			err := lck.InsertHeaders(ctx, datagen.HeaderToHeaderBytes(chain))
+			require.NoError(t, err)
+
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+			ckpt := datagen.GenRandomRawCheckpoint(r)
+			err = mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt)
+			require.NoError(t, err)
+			ckpts = append(ckpts, ckpt)
+		}
+
+		gs, err := mk.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.EpochEndRecords, int(numEpochs))
+		require.Len(t, gs.CheckpointReportRecords, int(numEpochs))
+
+		// import the exported state into a fresh app
+		newApp := app.Setup(t, false)
+		newCtx := newApp.NewContext(false)
+		newMk := newApp.MonitorKeeper
+		require.NoError(t, newMk.InitGenesis(newCtx, *gs))
+
+		for epoch := uint64(0); epoch < numEpochs; epoch++ {
+			height, err := mk.LightclientHeightAtEpochEnd(ctx, epoch)
+			require.NoError(t, err)
+			importedHeight, err := newMk.LightclientHeightAtEpochEnd(newCtx, epoch)
+			require.NoError(t, err)
+			require.Equal(t, height, importedHeight)
+		}
+		for _, ckpt := range ckpts {
+			height, err := mk.LightclientHeightAtCheckpointReported(ctx, ckpt.HashStr())
+			require.NoError(t, err)
+			importedHeight, err := newMk.LightclientHeightAtCheckpointReported(newCtx, ckpt.HashStr())
+			require.NoError(t, err)
+			require.Equal(t, height, importedHeight)
+		}
+
+		exported, err := newMk.ExportGenesis(newCtx)
+		require.NoError(t, err)
+		require.Equal(t, gs, exported)
+	})
+}
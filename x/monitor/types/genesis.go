@@ -1,12 +1,44 @@
 package types
 
+import (
+	"fmt"
+
+	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		EpochEndRecords:         []*EpochEndLightClientHeight{},
+		CheckpointReportRecords: []*CheckpointReportedLightClientHeight{},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	epochs := make(map[uint64]struct{})
+	for _, record := range gs.EpochEndRecords {
+		if _, ok := epochs[record.EpochNum]; ok {
+			return fmt.Errorf("duplicate light client height of epoch %d", record.EpochNum)
+		}
+		epochs[record.EpochNum] = struct{}{}
+	}
+
+	ckpts := make(map[string]struct{})
+	for _, record := range gs.CheckpointReportRecords {
+		hash, err := ckpttypes.FromStringToCkptHash(record.CkptHash)
+		if err != nil {
+			return fmt.Errorf("invalid checkpoint hash %s: %w", record.CkptHash, err)
+		}
+		if len(hash) != ckpttypes.HashSize {
+			return fmt.Errorf("invalid checkpoint hash %s: expected %d bytes, got %d", record.CkptHash, ckpttypes.HashSize, len(hash))
+		}
+		if _, ok := ckpts[string(hash)]; ok {
+			return fmt.Errorf("duplicate light client height of checkpoint %s", record.CkptHash)
+		}
+		ckpts[string(hash)] = struct{}{}
+	}
+
 	return nil
 }
@@ -24,6 +24,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the monitor module's genesis state.
 type GenesisState struct {
+	// epoch_end_records are the BTC light client heights at the end of each
+	// epoch
+	EpochEndRecords []*EpochEndLightClientHeight `protobuf:"bytes,1,rep,name=epoch_end_records,json=epochEndRecords,proto3" json:"epoch_end_records,omitempty"`
+	// checkpoint_report_records are the BTC light client heights at which each
+	// checkpoint is reported
+	CheckpointReportRecords []*CheckpointReportedLightClientHeight `protobuf:"bytes,2,rep,name=checkpoint_report_records,json=checkpointReportRecords,proto3" json:"checkpoint_report_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,23 +65,165 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetEpochEndRecords() []*EpochEndLightClientHeight {
+	if m != nil {
+		return m.EpochEndRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCheckpointReportRecords() []*CheckpointReportedLightClientHeight {
+	if m != nil {
+		return m.CheckpointReportRecords
+	}
+	return nil
+}
+
+// EpochEndLightClientHeight is the BTC light client height at the end of an
+// epoch
+type EpochEndLightClientHeight struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// btc_light_client_height is the height of the BTC light client tip at the
+	// end of the epoch
+	BtcLightClientHeight uint64 `protobuf:"varint,2,opt,name=btc_light_client_height,json=btcLightClientHeight,proto3" json:"btc_light_client_height,omitempty"`
+}
+
+func (m *EpochEndLightClientHeight) Reset()         { *m = EpochEndLightClientHeight{} }
+func (m *EpochEndLightClientHeight) String() string { return proto.CompactTextString(m) }
+func (*EpochEndLightClientHeight) ProtoMessage()    {}
+func (*EpochEndLightClientHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb844fd916189e7b, []int{1}
+}
+func (m *EpochEndLightClientHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochEndLightClientHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEndLightClientHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochEndLightClientHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEndLightClientHeight.Merge(m, src)
+}
+func (m *EpochEndLightClientHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochEndLightClientHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEndLightClientHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochEndLightClientHeight proto.InternalMessageInfo
+
+func (m *EpochEndLightClientHeight) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EpochEndLightClientHeight) GetBtcLightClientHeight() uint64 {
+	if m != nil {
+		return m.BtcLightClientHeight
+	}
+	return 0
+}
+
+// CheckpointReportedLightClientHeight is the BTC light client height at which
+// a checkpoint is reported
+type CheckpointReportedLightClientHeight struct {
+	// ckpt_hash is the hex-encoded hash of the raw checkpoint
+	CkptHash string `protobuf:"bytes,1,opt,name=ckpt_hash,json=ckptHash,proto3" json:"ckpt_hash,omitempty"`
+	// btc_light_client_height is the height of the BTC light client tip when
+	// the checkpoint is reported
+	BtcLightClientHeight uint64 `protobuf:"varint,2,opt,name=btc_light_client_height,json=btcLightClientHeight,proto3" json:"btc_light_client_height,omitempty"`
+}
+
+func (m *CheckpointReportedLightClientHeight) Reset()         { *m = CheckpointReportedLightClientHeight{} }
+func (m *CheckpointReportedLightClientHeight) String() string { return proto.CompactTextString(m) }
+func (*CheckpointReportedLightClientHeight) ProtoMessage()    {}
+func (*CheckpointReportedLightClientHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb844fd916189e7b, []int{2}
+}
+func (m *CheckpointReportedLightClientHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointReportedLightClientHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointReportedLightClientHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointReportedLightClientHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointReportedLightClientHeight.Merge(m, src)
+}
+func (m *CheckpointReportedLightClientHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointReportedLightClientHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointReportedLightClientHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointReportedLightClientHeight proto.InternalMessageInfo
+
+func (m *CheckpointReportedLightClientHeight) GetCkptHash() string {
+	if m != nil {
+		return m.CkptHash
+	}
+	return ""
+}
+
+func (m *CheckpointReportedLightClientHeight) GetBtcLightClientHeight() uint64 {
+	if m != nil {
+		return m.BtcLightClientHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.monitor.v1.GenesisState")
+	proto.RegisterType((*EpochEndLightClientHeight)(nil), "babylon.monitor.v1.EpochEndLightClientHeight")
+	proto.RegisterType((*CheckpointReportedLightClientHeight)(nil), "babylon.monitor.v1.CheckpointReportedLightClientHeight")
 }
 
 func init() { proto.RegisterFile("babylon/monitor/v1/genesis.proto", fileDescriptor_fb844fd916189e7b) }
 
 var fileDescriptor_fb844fd916189e7b = []byte{
-	// 144 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcd, 0xcf, 0xcb, 0x2c, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xaa,
-	0xd0, 0x83, 0xaa, 0xd0, 0x2b, 0x33, 0x54, 0xe2, 0xe3, 0xe2, 0x71, 0x87, 0x28, 0x0a, 0x2e, 0x49,
-	0x2c, 0x49, 0x75, 0xf2, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x41, 0xc9, 0x19, 0x89,
-	0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0xdc, 0xe6, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0,
-	0xad, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x9f, 0x6b, 0xe2, 0x99, 0x00, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4b, 0xfb, 0x30,
+	0x14, 0xc7, 0x97, 0xfd, 0x7e, 0xc8, 0x16, 0x05, 0xb1, 0x08, 0xdb, 0x10, 0xca, 0x98, 0x97, 0x5d,
+	0x6c, 0x99, 0x22, 0xde, 0x1d, 0xc3, 0x09, 0xe2, 0xa1, 0x9e, 0xf4, 0x12, 0xda, 0xec, 0xb1, 0x84,
+	0xad, 0x49, 0x49, 0xde, 0x86, 0xfb, 0x2f, 0xfc, 0xb3, 0x3c, 0xee, 0xe8, 0x49, 0x64, 0xfb, 0x47,
+	0xa4, 0x59, 0xed, 0xc1, 0x4d, 0x10, 0x6f, 0xed, 0xcb, 0xe7, 0xfb, 0x3e, 0x2f, 0xe1, 0xd1, 0x76,
+	0x12, 0x27, 0x8b, 0xa9, 0x56, 0x61, 0xaa, 0x95, 0x44, 0x6d, 0xc2, 0x79, 0x2f, 0x1c, 0x83, 0x02,
+	0x2b, 0x6d, 0x90, 0x19, 0x8d, 0xda, 0xf3, 0x0a, 0x22, 0x28, 0x88, 0x60, 0xde, 0xeb, 0xbc, 0x13,
+	0x7a, 0x70, 0xb3, 0xa1, 0x1e, 0x30, 0x46, 0xf0, 0x1e, 0xe9, 0x11, 0x64, 0x9a, 0x0b, 0x06, 0x6a,
+	0xc4, 0x0c, 0x70, 0x6d, 0x46, 0xb6, 0x49, 0xda, 0xff, 0xba, 0xfb, 0xe7, 0x67, 0xc1, 0x76, 0x83,
+	0x60, 0x90, 0xc3, 0x03, 0x35, 0xba, 0x93, 0x63, 0x81, 0xfd, 0xa9, 0x04, 0x85, 0x43, 0xc8, 0xbf,
+	0xa3, 0x43, 0x28, 0x8e, 0xa2, 0x4d, 0x17, 0xcf, 0xd2, 0x16, 0x17, 0xc0, 0x27, 0x99, 0x96, 0x0a,
+	0x99, 0x81, 0x4c, 0x1b, 0x2c, 0x15, 0x55, 0xa7, 0xb8, 0xda, 0xa5, 0xe8, 0x97, 0xa1, 0xc8, 0x65,
+	0x60, 0x87, 0xac, 0xc1, 0xbf, 0x41, 0x85, 0xb4, 0xa3, 0x69, 0xeb, 0xc7, 0x11, 0xbd, 0x13, 0x5a,
+	0xdf, 0x5c, 0x56, 0xcd, 0xd2, 0x26, 0x69, 0x93, 0xee, 0xff, 0xa8, 0xe6, 0x0a, 0xf7, 0xb3, 0xd4,
+	0xbb, 0xa4, 0x8d, 0x04, 0x39, 0x9b, 0xe6, 0x24, 0xe3, 0x2e, 0xc6, 0x84, 0xcb, 0x35, 0xab, 0x0e,
+	0x3d, 0x4e, 0x90, 0x6f, 0xf5, 0xec, 0x2c, 0xe8, 0xe9, 0x2f, 0x06, 0xce, 0xd5, 0x7c, 0x92, 0x21,
+	0x13, 0xb1, 0x15, 0x4e, 0x5d, 0x8f, 0x6a, 0x79, 0x61, 0x18, 0x5b, 0xf1, 0x47, 0xf5, 0xf5, 0xed,
+	0xeb, 0xca, 0x27, 0xcb, 0x95, 0x4f, 0x3e, 0x56, 0x3e, 0x79, 0x59, 0xfb, 0x95, 0xe5, 0xda, 0xaf,
+	0xbc, 0xad, 0xfd, 0xca, 0x53, 0x38, 0x96, 0x28, 0x66, 0x49, 0xc0, 0x75, 0x1a, 0x16, 0x2f, 0xcc,
+	0x45, 0x2c, 0xd5, 0xd7, 0x4f, 0xf8, 0x5c, 0xae, 0x0d, 0x2e, 0x32, 0xb0, 0xc9, 0x9e, 0x5b, 0x99,
+	0x8b, 0xcf, 0x01, 0x00, 0x15, 0x0d, 0xa8, 0xaf, 0x56, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -98,6 +246,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CheckpointReportRecords) > 0 {
+		for iNdEx := len(m.CheckpointReportRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckpointReportRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EpochEndRecords) > 0 {
+		for iNdEx := len(m.EpochEndRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochEndRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochEndLightClientHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEndLightClientHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEndLightClientHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointReportedLightClientHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointReportedLightClientHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointReportedLightClientHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CkptHash) > 0 {
+		i -= len(m.CkptHash)
+		copy(dAtA[i:], m.CkptHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CkptHash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +362,49 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.EpochEndRecords) > 0 {
+		for _, e := range m.EpochEndRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CheckpointReportRecords) > 0 {
+		for _, e := range m.CheckpointReportRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochEndLightClientHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNum))
+	}
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *CheckpointReportedLightClientHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CkptHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BtcLightClientHeight))
+	}
 	return n
 }
 
@@ -156,6 +443,263 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochEndRecords = append(m.EpochEndRecords, &EpochEndLightClientHeight{})
+			if err := m.EpochEndRecords[len(m.EpochEndRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointReportRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointReportRecords = append(m.CheckpointReportRecords, &CheckpointReportedLightClientHeight{})
+			if err := m.CheckpointReportRecords[len(m.CheckpointReportRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEndLightClientHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEndLightClientHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEndLightClientHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointReportedLightClientHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointReportedLightClientHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointReportedLightClientHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CkptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/babylonchain/babylon/x/monitor/types"
@@ -8,19 +9,73 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	ckptHash := hex.EncodeToString(make([]byte, 32))
+	genState := func() *types.GenesisState {
+		return &types.GenesisState{
+			EpochEndRecords: []*types.EpochEndLightClientHeight{
+				{EpochNum: 0, BtcLightClientHeight: 10},
+				{EpochNum: 1, BtcLightClientHeight: 12},
+			},
+			CheckpointReportRecords: []*types.CheckpointReportedLightClientHeight{
+				{CkptHash: ckptHash, BtcLightClientHeight: 11},
+			},
+		}
+	}
+
 	for _, tc := range []struct {
 		desc     string
-		genState *types.GenesisState
+		genState func() *types.GenesisState
 		valid    bool
 	}{
 		{
 			desc:     "default is valid",
-			genState: types.DefaultGenesis(),
+			genState: types.DefaultGenesis,
 			valid:    true,
 		},
+		{
+			desc:     "valid genesis state",
+			genState: genState,
+			valid:    true,
+		},
+		{
+			desc: "duplicate epoch record",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.EpochEndRecords = append(gs.EpochEndRecords, &types.EpochEndLightClientHeight{EpochNum: 1, BtcLightClientHeight: 13})
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid checkpoint hash hex",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.CheckpointReportRecords[0].CkptHash = "not-hex"
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid checkpoint hash length",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.CheckpointReportRecords[0].CkptHash = hex.EncodeToString(make([]byte, 31))
+				return gs
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate checkpoint record",
+			genState: func() *types.GenesisState {
+				gs := genState()
+				gs.CheckpointReportRecords = append(gs.CheckpointReportRecords, &types.CheckpointReportedLightClientHeight{CkptHash: ckptHash, BtcLightClientHeight: 12})
+				return gs
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := tc.genState.Validate()
+			err := tc.genState().Validate()
 			if tc.valid {
 				require.NoError(t, err)
 			} else {
@@ -10,12 +10,15 @@ import (
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisState) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	// set params for this module
-	if err := k.SetParams(ctx, genState.Params); err != nil {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	// set params, port and the indexes of CZs for this module
+	if err := k.InitGenesis(ctx, genState); err != nil {
 		panic(err)
 	}
 
-	k.SetPort(ctx, genState.PortId)
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(sdkCtx, genState.PortId) {
@@ -30,8 +33,9 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, genState types.GenesisSta
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params = k.GetParams(ctx)
-	genesis.PortId = k.GetPort(ctx)
+	genesis, err := k.ExportGenesis(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return genesis
 }
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/zoneconcierge/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the keeper state from a provided initial genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		return err
+	}
+	k.SetPort(ctx, gs.PortId)
+
+	for _, chainInfo := range gs.ChainsInfo {
+		k.setChainInfo(ctx, chainInfo)
+	}
+
+	for _, header := range gs.ChainsHeaders {
+		if err := k.insertHeader(ctx, header.ChainId, header); err != nil {
+			return err
+		}
+	}
+
+	for _, header := range gs.ChainsForks {
+		if err := k.insertForkHeader(ctx, header.ChainId, header); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range gs.ChainsEpochsInfo {
+		k.setEpochChainInfo(ctx, entry.ChainInfo.ChainInfo.ChainId, entry.EpochNumber, entry.ChainInfo)
+	}
+
+	for _, entry := range gs.SealedEpochsProofs {
+		k.sealedEpochProofStore(ctx).Set(sdk.Uint64ToBigEndian(entry.EpochNumber), k.cdc.MustMarshal(entry.Proof))
+	}
+
+	if gs.LastSentSegment != nil {
+		k.setLastSentSegment(ctx, gs.LastSentSegment)
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the keeper state into a exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	chainsInfo, err := k.chainsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := k.chainsHeaders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forks, err := k.chainsForks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	epochsInfo, err := k.chainsEpochsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := k.sealedEpochsProofs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		PortId:             k.GetPort(ctx),
+		Params:             k.GetParams(ctx),
+		ChainsInfo:         chainsInfo,
+		ChainsHeaders:      headers,
+		ChainsForks:        forks,
+		ChainsEpochsInfo:   epochsInfo,
+		SealedEpochsProofs: proofs,
+		LastSentSegment:    k.GetLastSentSegment(ctx),
+	}, nil
+}
+
+// chainsInfo loads the latest chain info of all CZs.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) chainsInfo(ctx context.Context) ([]*types.ChainInfo, error) {
+	chainsInfo := make([]*types.ChainInfo, 0)
+
+	iter := k.chainInfoStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var chainInfo types.ChainInfo
+		if err := k.cdc.Unmarshal(iter.Value(), &chainInfo); err != nil {
+			return nil, err
+		}
+		chainsInfo = append(chainsInfo, &chainInfo)
+	}
+
+	return chainsInfo, nil
+}
+
+// chainsHeaders loads the timestamped headers of the canonical chains of all CZs.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) chainsHeaders(ctx context.Context) ([]*types.IndexedHeader, error) {
+	headers := make([]*types.IndexedHeader, 0)
+
+	iter := k.prefixStore(ctx, types.CanonicalChainKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var header types.IndexedHeader
+		if err := k.cdc.Unmarshal(iter.Value(), &header); err != nil {
+			return nil, err
+		}
+		headers = append(headers, &header)
+	}
+
+	return headers, nil
+}
+
+// chainsForks loads the timestamped fork headers of all CZs.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) chainsForks(ctx context.Context) ([]*types.IndexedHeader, error) {
+	headers := make([]*types.IndexedHeader, 0)
+
+	iter := k.prefixStore(ctx, types.ForkKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var forks types.Forks
+		if err := k.cdc.Unmarshal(iter.Value(), &forks); err != nil {
+			return nil, err
+		}
+		headers = append(headers, forks.Headers...)
+	}
+
+	return headers, nil
+}
+
+// chainsEpochsInfo loads the chain info of all CZs recorded at the end of each epoch.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) chainsEpochsInfo(ctx context.Context) ([]*types.EpochChainInfoEntry, error) {
+	entries := make([]*types.EpochChainInfoEntry, 0)
+
+	iter := k.prefixStore(ctx, types.EpochChainInfoKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var chainInfo types.ChainInfoWithProof
+		if err := k.cdc.Unmarshal(iter.Value(), &chainInfo); err != nil {
+			return nil, err
+		}
+		// the key is chainID || epoch number
+		key := iter.Key()
+		entries = append(entries, &types.EpochChainInfoEntry{
+			EpochNumber: sdk.BigEndianToUint64(key[len(key)-8:]),
+			ChainInfo:   &chainInfo,
+		})
+	}
+
+	return entries, nil
+}
+
+// sealedEpochsProofs loads the proofs that each epoch is sealed.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) sealedEpochsProofs(ctx context.Context) ([]*types.SealedEpochProofEntry, error) {
+	entries := make([]*types.SealedEpochProofEntry, 0)
+
+	iter := k.sealedEpochProofStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var proof types.ProofEpochSealed
+		if err := k.cdc.Unmarshal(iter.Value(), &proof); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &types.SealedEpochProofEntry{
+			EpochNumber: sdk.BigEndianToUint64(iter.Key()),
+			Proof:       &proof,
+		})
+	}
+
+	return entries, nil
+}
+
+// prefixStore returns the KVStore of the given prefix across all CZs
+func (k Keeper) prefixStore(ctx context.Context, p []byte) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, p)
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+)
+
+func FuzzExportImportGenesis(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		zcKeeper := babylonApp.ZoneConciergeKeeper
+		ctx := babylonApp.NewContext(false)
+		hooks := zcKeeper.Hooks()
+
+		// enter a random epoch
+		for j := datagen.RandomInt(r, 10); j > 0; j-- {
+			babylonApp.EpochingKeeper.IncEpoch(ctx)
+		}
+		epochNum := babylonApp.EpochingKeeper.GetEpoch(ctx).EpochNumber
+
+		// simulate headers and forks of a random number of chains
+		numChains := datagen.RandomInt(r, 5) + 1
+		chainIDs := make([]string, 0, numChains)
+		for i := uint64(0); i < numChains; i++ {
+			chainID := datagen.GenRandomHexStr(r, 30)
+			chainIDs = append(chainIDs, chainID)
+			numHeaders := datagen.RandomInt(r, 50) + 1
+			numForkHeaders := datagen.RandomInt(r, 5) + 1
+			SimulateNewHeadersAndForks(ctx, r, &zcKeeper, chainID, 0, numHeaders, numForkHeaders)
+		}
+
+		// end this epoch
+		hooks.AfterEpochEnds(ctx, epochNum)
+
+		gs, err := zcKeeper.ExportGenesis(ctx)
+		require.NoError(t, err)
+		require.NoError(t, gs.Validate())
+		require.Len(t, gs.ChainsInfo, int(numChains))
+		require.Len(t, gs.ChainsEpochsInfo, int(numChains))
+
+		// import the exported state into a fresh keeper
+		newKeeper, newCtx := keepertest.ZoneConciergeKeeper(t, nil, nil, nil, nil)
+		require.NoError(t, newKeeper.InitGenesis(newCtx, *gs))
+
+		for _, chainID := range chainIDs {
+			chainInfo, err := zcKeeper.GetChainInfo(ctx, chainID)
+			require.NoError(t, err)
+			importedChainInfo, err := newKeeper.GetChainInfo(newCtx, chainID)
+			require.NoError(t, err)
+			require.Equal(t, chainInfo, importedChainInfo)
+
+			latestHeight := chainInfo.LatestHeader.Height
+			require.Equal(t, zcKeeper.GetForks(ctx, chainID, latestHeight), newKeeper.GetForks(newCtx, chainID, latestHeight))
+
+			headers, err := zcKeeper.GetEpochHeaders(ctx, chainID, epochNum)
+			require.NoError(t, err)
+			importedHeaders, err := newKeeper.GetEpochHeaders(newCtx, chainID, epochNum)
+			require.NoError(t, err)
+			require.Equal(t, headers, importedHeaders)
+		}
+
+		exported, err := newKeeper.ExportGenesis(newCtx)
+		require.NoError(t, err)
+		require.Equal(t, gs, exported)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
@@ -14,7 +15,7 @@ import (
 
 func (k Keeper) ProveCZHeaderInEpoch(_ context.Context, header *types.IndexedHeader, epoch *epochingtypes.Epoch) (*cmtcrypto.ProofOps, error) {
 	czHeaderKey := types.GetCZHeaderKey(header.ChainId, header.Height)
-	_, _, proof, err := k.QueryStore(types.StoreKey, czHeaderKey, int64(epoch.GetSealerBlockHeight()))
+	_, _, proof, err := bbn.QueryStore(k.storeQuerier, types.StoreKey, czHeaderKey, int64(epoch.GetSealerBlockHeight()))
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +25,7 @@ func (k Keeper) ProveCZHeaderInEpoch(_ context.Context, header *types.IndexedHea
 
 func (k Keeper) ProveEpochInfo(epoch *epochingtypes.Epoch) (*cmtcrypto.ProofOps, error) {
 	epochInfoKey := types.GetEpochInfoKey(epoch.EpochNumber)
-	_, _, proof, err := k.QueryStore(epochingtypes.StoreKey, epochInfoKey, int64(epoch.GetSealerBlockHeight()))
+	_, _, proof, err := bbn.QueryStore(k.storeQuerier, epochingtypes.StoreKey, epochInfoKey, int64(epoch.GetSealerBlockHeight()))
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +35,7 @@ func (k Keeper) ProveEpochInfo(epoch *epochingtypes.Epoch) (*cmtcrypto.ProofOps,
 
 func (k Keeper) ProveValSet(epoch *epochingtypes.Epoch) (*cmtcrypto.ProofOps, error) {
 	valSetKey := types.GetValSetKey(epoch.EpochNumber)
-	_, _, proof, err := k.QueryStore(checkpointingtypes.StoreKey, valSetKey, int64(epoch.GetSealerBlockHeight()))
+	_, _, proof, err := bbn.QueryStore(k.storeQuerier, checkpointingtypes.StoreKey, valSetKey, int64(epoch.GetSealerBlockHeight()))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
+
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
@@ -29,5 +32,139 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	chainsInfo := make(map[string]*ChainInfo)
+	for _, chainInfo := range gs.ChainsInfo {
+		if len(chainInfo.ChainId) == 0 {
+			return fmt.Errorf("chain info with empty chain ID")
+		}
+		if _, ok := chainsInfo[chainInfo.ChainId]; ok {
+			return fmt.Errorf("duplicate chain info of chain %s", chainInfo.ChainId)
+		}
+		chainsInfo[chainInfo.ChainId] = chainInfo
+		if chainInfo.LatestForks == nil {
+			return fmt.Errorf("chain info of chain %s has nil latest forks", chainInfo.ChainId)
+		}
+		for _, header := range chainInfo.LatestForks.Headers {
+			if err := validateChainHeader(chainInfo.ChainId, header); err != nil {
+				return fmt.Errorf("invalid latest fork header: %w", err)
+			}
+		}
+	}
+
+	// chain ID -> height -> header
+	headers := make(map[string]map[uint64]*IndexedHeader)
+	for _, header := range gs.ChainsHeaders {
+		if err := validateChainHeader("", header); err != nil {
+			return fmt.Errorf("invalid canonical header: %w", err)
+		}
+		if _, ok := chainsInfo[header.ChainId]; !ok {
+			return fmt.Errorf("canonical header of chain %s without chain info", header.ChainId)
+		}
+		if _, ok := headers[header.ChainId]; !ok {
+			headers[header.ChainId] = make(map[uint64]*IndexedHeader)
+		}
+		if _, ok := headers[header.ChainId][header.Height]; ok {
+			return fmt.Errorf("duplicate canonical header of chain %s at height %d", header.ChainId, header.Height)
+		}
+		headers[header.ChainId][header.Height] = header
+	}
+
+	for _, chainInfo := range gs.ChainsInfo {
+		if chainInfo.LatestHeader == nil {
+			if len(headers[chainInfo.ChainId]) > 0 {
+				return fmt.Errorf("chain %s has canonical headers but no latest header", chainInfo.ChainId)
+			}
+			continue
+		}
+		if err := validateChainHeader(chainInfo.ChainId, chainInfo.LatestHeader); err != nil {
+			return fmt.Errorf("invalid latest header: %w", err)
+		}
+		header, ok := headers[chainInfo.ChainId][chainInfo.LatestHeader.Height]
+		if !ok || !bytes.Equal(header.Hash, chainInfo.LatestHeader.Hash) {
+			return fmt.Errorf("latest header of chain %s is not in its canonical chain", chainInfo.ChainId)
+		}
+	}
+
+	// chain ID -> height -> hashes of fork headers
+	forks := make(map[string]map[uint64]map[string]struct{})
+	for _, header := range gs.ChainsForks {
+		if err := validateChainHeader("", header); err != nil {
+			return fmt.Errorf("invalid fork header: %w", err)
+		}
+		if _, ok := chainsInfo[header.ChainId]; !ok {
+			return fmt.Errorf("fork header of chain %s without chain info", header.ChainId)
+		}
+		if _, ok := forks[header.ChainId]; !ok {
+			forks[header.ChainId] = make(map[uint64]map[string]struct{})
+		}
+		if _, ok := forks[header.ChainId][header.Height]; !ok {
+			forks[header.ChainId][header.Height] = make(map[string]struct{})
+		}
+		if _, ok := forks[header.ChainId][header.Height][string(header.Hash)]; ok {
+			return fmt.Errorf("duplicate fork header of chain %s at height %d", header.ChainId, header.Height)
+		}
+		forks[header.ChainId][header.Height][string(header.Hash)] = struct{}{}
+	}
+
+	// chain ID -> epoch numbers
+	epochsInfo := make(map[string]map[uint64]struct{})
+	for _, entry := range gs.ChainsEpochsInfo {
+		if entry.ChainInfo == nil || entry.ChainInfo.ChainInfo == nil {
+			return fmt.Errorf("empty chain info of epoch %d", entry.EpochNumber)
+		}
+		chainID := entry.ChainInfo.ChainInfo.ChainId
+		if _, ok := chainsInfo[chainID]; !ok {
+			return fmt.Errorf("chain info of epoch %d of chain %s without chain info", entry.EpochNumber, chainID)
+		}
+		if _, ok := epochsInfo[chainID]; !ok {
+			epochsInfo[chainID] = make(map[uint64]struct{})
+		}
+		if _, ok := epochsInfo[chainID][entry.EpochNumber]; ok {
+			return fmt.Errorf("duplicate chain info of epoch %d of chain %s", entry.EpochNumber, chainID)
+		}
+		epochsInfo[chainID][entry.EpochNumber] = struct{}{}
+		if latestHeader := entry.ChainInfo.ChainInfo.LatestHeader; latestHeader != nil {
+			if err := validateChainHeader(chainID, latestHeader); err != nil {
+				return fmt.Errorf("invalid latest header of epoch %d: %w", entry.EpochNumber, err)
+			}
+			if latestHeader.BabylonEpoch > entry.EpochNumber {
+				return fmt.Errorf("latest header of chain %s in epoch %d is timestamped in a later epoch %d", chainID, entry.EpochNumber, latestHeader.BabylonEpoch)
+			}
+		}
+	}
+
+	sealedEpochs := make(map[uint64]struct{})
+	for _, entry := range gs.SealedEpochsProofs {
+		if _, ok := sealedEpochs[entry.EpochNumber]; ok {
+			return fmt.Errorf("duplicate proof of sealed epoch %d", entry.EpochNumber)
+		}
+		sealedEpochs[entry.EpochNumber] = struct{}{}
+		if entry.Proof == nil {
+			return fmt.Errorf("empty proof of sealed epoch %d", entry.EpochNumber)
+		}
+		if err := entry.Proof.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid proof of sealed epoch %d: %w", entry.EpochNumber, err)
+		}
+	}
+
+	return nil
+}
+
+// validateChainHeader checks the header is well-formed and, if chainID is not
+// empty, belongs to the given chain
+func validateChainHeader(chainID string, header *IndexedHeader) error {
+	if header == nil {
+		return fmt.Errorf("header is nil")
+	}
+	if len(header.ChainId) == 0 {
+		return fmt.Errorf("empty ChainID")
+	}
+	if len(header.Hash) == 0 {
+		return fmt.Errorf("empty Hash")
+	}
+	if len(chainID) > 0 && header.ChainId != chainID {
+		return fmt.Errorf("header of chain %s is indexed under chain %s", header.ChainId, chainID)
+	}
 	return nil
 }
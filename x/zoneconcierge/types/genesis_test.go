@@ -8,6 +8,25 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	header := &types.IndexedHeader{
+		ChainId:      "test-chainid",
+		Hash:         []byte("header-hash"),
+		Height:       10,
+		BabylonEpoch: 1,
+	}
+	forkHeader := &types.IndexedHeader{
+		ChainId:      "test-chainid",
+		Hash:         []byte("fork-header-hash"),
+		Height:       10,
+		BabylonEpoch: 1,
+	}
+	chainInfo := &types.ChainInfo{
+		ChainId:                 "test-chainid",
+		LatestHeader:            header,
+		LatestForks:             &types.Forks{Headers: []*types.IndexedHeader{forkHeader}},
+		TimestampedHeadersCount: 1,
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +45,71 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid genesis state with chain info",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				ChainsInfo:    []*types.ChainInfo{chainInfo},
+				ChainsHeaders: []*types.IndexedHeader{header},
+				ChainsForks:   []*types.IndexedHeader{forkHeader},
+				ChainsEpochsInfo: []*types.EpochChainInfoEntry{
+					{EpochNumber: 1, ChainInfo: &types.ChainInfoWithProof{ChainInfo: chainInfo}},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate chain info",
+			genState: &types.GenesisState{
+				PortId:     types.PortID,
+				Params:     types.DefaultParams(),
+				ChainsInfo: []*types.ChainInfo{chainInfo, chainInfo},
+			},
+			valid: false,
+		},
+		{
+			desc: "latest header not in canonical chain",
+			genState: &types.GenesisState{
+				PortId:     types.PortID,
+				Params:     types.DefaultParams(),
+				ChainsInfo: []*types.ChainInfo{chainInfo},
+			},
+			valid: false,
+		},
+		{
+			desc: "header without chain info",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				ChainsHeaders: []*types.IndexedHeader{header},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate fork header",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				ChainsInfo:    []*types.ChainInfo{chainInfo},
+				ChainsHeaders: []*types.IndexedHeader{header},
+				ChainsForks:   []*types.IndexedHeader{forkHeader, forkHeader},
+			},
+			valid: false,
+		},
+		{
+			desc: "epoch chain info with header of a later epoch",
+			genState: &types.GenesisState{
+				PortId:        types.PortID,
+				Params:        types.DefaultParams(),
+				ChainsInfo:    []*types.ChainInfo{chainInfo},
+				ChainsHeaders: []*types.IndexedHeader{header},
+				ChainsEpochsInfo: []*types.EpochChainInfoEntry{
+					{EpochNumber: 0, ChainInfo: &types.ChainInfoWithProof{ChainInfo: chainInfo}},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()